@@ -0,0 +1,241 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func loadTestGenerator(t *testing.T) *Generator {
+	protocol, err := LoadProtocol("testdata")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%-v'", err)
+	}
+	gen, err := NewGenerator(protocol, "github.com/mkenney/go-chrome/test", "tot")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%-v'", err)
+	}
+	return gen
+}
+
+func TestLoadProtocol(t *testing.T) {
+	protocol, err := LoadProtocol("testdata")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%-v'", err)
+	}
+	if "1" != protocol.Version.Major || "3" != protocol.Version.Minor {
+		t.Errorf("Expected version 1.3, got %s.%s", protocol.Version.Major, protocol.Version.Minor)
+	}
+	if 3 != len(protocol.Domains) {
+		t.Fatalf("Expected 3 domains, got %d", len(protocol.Domains))
+	}
+	if "DOMDebugger" != protocol.Domains[0].Domain {
+		t.Errorf("Expected domains sorted by name, got '%s' first", protocol.Domains[0].Domain)
+	}
+
+	_, err = LoadProtocol("does-not-exist")
+	if nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+}
+
+func TestGeneratorImports(t *testing.T) {
+	gen := loadTestGenerator(t)
+	network := gen.domains["Network"]
+	page := gen.domains["Page"]
+
+	// Network is resolved first and imports Page, so Page cannot import
+	// Network and has to duplicate the types it uses.
+	if !gen.imports[network][page] {
+		t.Errorf("Expected Network to import Page")
+	}
+	if gen.imports[page][network] {
+		t.Errorf("Expected Page not to import Network")
+	}
+	if "LoaderID" != page.local["Network.LoaderId"] {
+		t.Errorf("Expected local LoaderID duplicate, got '%s'", page.local["Network.LoaderId"])
+	}
+	if "ResourceTypeEnum" != page.local["Network.ResourceType"] {
+		t.Errorf("Expected local ResourceTypeEnum duplicate, got '%s'", page.local["Network.ResourceType"])
+	}
+
+	var initiator *GoType
+	for _, goType := range network.Types {
+		if "Initiator" == goType.Name {
+			initiator = goType
+		}
+	}
+	if nil == initiator || "page.FrameID" != initiator.Fields[0].Type {
+		t.Errorf("Expected Initiator.FrameID to reference page.FrameID")
+	}
+
+	domDebugger := gen.domains["DOMDebugger"]
+	if "domDebugger" != domDebugger.Alias || "debugger" != domDebugger.Name {
+		t.Errorf("Expected package debugger imported as domDebugger, got %s/%s", domDebugger.Name, domDebugger.Alias)
+	}
+}
+
+func TestGeneratorDefinitions(t *testing.T) {
+	gen := loadTestGenerator(t)
+	page := gen.domains["Page"]
+
+	var frame *GoType
+	for _, goType := range page.Types {
+		if "Frame" == goType.Name {
+			frame = goType
+		}
+	}
+	if nil == frame {
+		t.Fatalf("Expected Frame type")
+	}
+	childIDs := frame.Fields[2]
+	if "ChildIDs" != childIDs.Name || "[]FrameID" != childIDs.Type || "childIds,omitempty" != childIDs.JSON {
+		t.Errorf("Unexpected field %s %s `%s`", childIDs.Name, childIDs.Type, childIDs.JSON)
+	}
+	if !strings.HasPrefix(childIDs.Doc, "Optional.") || !strings.HasSuffix(childIDs.Doc, "EXPERIMENTAL.") {
+		t.Errorf("Expected optional experimental field, got '%s'", childIDs.Doc)
+	}
+
+	capture := page.Commands[0]
+	if "CaptureScreenshot" != capture.Name || "FormatEnum" != capture.Params[0].Type {
+		t.Errorf("Expected CaptureScreenshot with a FormatEnum parameter")
+	}
+	if !strings.Contains(capture.Params[0].Doc, "Format.Jpeg") {
+		t.Errorf("Expected allowed values in field doc, got '%s'", capture.Params[0].Doc)
+	}
+	if "https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-captureScreenshot" != capture.Link {
+		t.Errorf("Unexpected link '%s'", capture.Link)
+	}
+	if "EXPERIMENTAL." != page.Commands[1].Flags {
+		t.Errorf("Expected experimental command, got '%s'", page.Commands[1].Flags)
+	}
+	if "*Frame" != page.Commands[1].Returns[0].Type {
+		t.Errorf("Expected *Frame, got '%s'", page.Commands[1].Returns[0].Type)
+	}
+
+	event := page.Events[0]
+	if "FrameNavigated" != event.Name || "FrameNavigatedEvent" != event.Type || "DEPRECATED." != event.Flags {
+		t.Errorf("Unexpected event %s %s %s", event.Name, event.Type, event.Flags)
+	}
+	network := gen.domains["Network"]
+	if "RequestWillBeSentEvent" != network.Events[0].Type {
+		t.Errorf("Expected RequestWillBeSentEvent, got '%s'", network.Events[0].Type)
+	}
+}
+
+func TestGeneratorWrite(t *testing.T) {
+	gen := loadTestGenerator(t)
+	dir, err := ioutil.TempDir("", "cdtpgen")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Hand written files are kept, stale generated files are removed.
+	os.MkdirAll(filepath.Join(dir, "page"), 0755)
+	ioutil.WriteFile(filepath.Join(dir, "page", "helpers.go"), []byte("package page\n"), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "page", "enum.stale.go"), []byte(header+"package page\n"), 0644)
+	os.MkdirAll(filepath.Join(dir, "socket"), 0755)
+	ioutil.WriteFile(filepath.Join(dir, "socket", "cdtp.page_test.go"), []byte("package socket\n"), 0644)
+
+	if err := gen.Write(dir); nil != err {
+		t.Fatalf("Expected nil, got error: '%-v'", err)
+	}
+
+	files := []string{
+		"dom/debugger/cdtp.go",
+		"dom/debugger/command.go",
+		"dom/debugger/event.go",
+		"dom/debugger/enum.dom_breakpoint_type.go",
+		"dom/debugger/enum.dom_breakpoint_type_test.go",
+		"network/cdtp.go",
+		"network/enum.resource_type.go",
+		"page/cdtp.go",
+		"page/command.go",
+		"page/event.go",
+		"page/enum.format.go",
+		"page/enum.format_test.go",
+		"page/enum.resource_type.go",
+		"page/helpers.go",
+		"socket/cdtp.dom.debugger.go",
+		"socket/cdtp.dom.debugger_test.go",
+		"socket/cdtp.network.go",
+		"socket/cdtp.page.go",
+		"socket/cdtp.page_test.go",
+		"socket/interface.protocoller.go",
+		"socket/socket.protocoller.go",
+		"tab.socket.protocoller.go",
+	}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		path := filepath.Join(dir, filepath.FromSlash(file))
+		if _, err := parser.ParseFile(fset, path, nil, parser.ParseComments); nil != err {
+			t.Errorf("Expected valid Go source in %s, got error: '%s'", file, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "page", "enum.stale.go")); !os.IsNotExist(err) {
+		t.Errorf("Expected stale generated file to be removed")
+	}
+
+	src, _ := ioutil.ReadFile(filepath.Join(dir, "socket", "cdtp.page_test.go"))
+	if "package socket\n" != string(src) {
+		t.Errorf("Expected hand written test to be kept, got:\n%s", src)
+	}
+	src, _ = ioutil.ReadFile(filepath.Join(dir, "socket", "cdtp.dom.debugger.go"))
+	if !strings.Contains(string(src), `domDebugger "github.com/mkenney/go-chrome/test/dom/debugger"`) {
+		t.Errorf("Expected aliased import in socket wrapper:\n%s", src)
+	}
+	// The documentation starts with the protocol description and the flags
+	// follow the link, which uses the protocol name, on their own line.
+	src, _ = ioutil.ReadFile(filepath.Join(dir, "socket", "cdtp.page.go"))
+	if !strings.Contains(string(src), "\nCaptureScreenshot capture page screenshot.\n\nhttps://chromedevtools.github.io/devtools-protocol/tot/Page/#method-captureScreenshot\n*/") {
+		t.Errorf("Expected the command description and link:\n%s", src)
+	}
+	if !strings.Contains(string(src), "\nGetResourceTree sends the Page.getResourceTree command.\n\nhttps://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getResourceTree\nEXPERIMENTAL.\n*/") {
+		t.Errorf("Expected the EXPERIMENTAL flag after the link:\n%s", src)
+	}
+	src, _ = ioutil.ReadFile(filepath.Join(dir, "page", "cdtp.go"))
+	if !strings.Contains(strings.Join(strings.Fields(string(src)), " "), "This is a duplicate of Network.LoaderId to avoid an invalid import cycle.") {
+		t.Errorf("Expected duplicate note in page types:\n%s", src)
+	}
+}
+
+func TestGeneratorSelect(t *testing.T) {
+	gen := loadTestGenerator(t)
+	if err := gen.Select([]string{"DOMDebugger", "Unknown"}); nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+	if err := gen.Select([]string{"Page"}); nil != err {
+		t.Fatalf("Expected nil, got error: '%-v'", err)
+	}
+	if 1 != len(gen.Packages) || "page" != gen.Packages[0].Path {
+		t.Fatalf("Expected only the page package, got %d packages", len(gen.Packages))
+	}
+
+	dir, err := ioutil.TempDir("", "cdtpgen")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Generated files of other domains and the accessors are left alone.
+	os.MkdirAll(filepath.Join(dir, "socket"), 0755)
+	ioutil.WriteFile(filepath.Join(dir, "socket", "cdtp.network.go"), []byte(header+"package socket\n"), 0644)
+	if err := gen.Write(dir); nil != err {
+		t.Fatalf("Expected nil, got error: '%-v'", err)
+	}
+	for _, file := range []string{"page/cdtp.go", "socket/cdtp.page.go", "socket/cdtp.network.go"} {
+		if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(file))); nil != err {
+			t.Errorf("Expected %s to exist, got error: '%s'", file, err)
+		}
+	}
+	for _, file := range []string{"network/cdtp.go", "socket/socket.protocoller.go", "tab.socket.protocoller.go"} {
+		if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(file))); !os.IsNotExist(err) {
+			t.Errorf("Expected %s not to be written", file)
+		}
+	}
+}
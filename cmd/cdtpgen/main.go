@@ -0,0 +1,71 @@
+/*
+Cdtpgen generates the Chrome DevTools Protocol domain packages and socket
+wrappers from the protocol definition files published by the DevTools team.
+
+It reads browser_protocol.json and js_protocol.json from the -protocol directory
+and writes, below the -out directory, a package for each domain containing the
+type definitions (cdtp.go), command parameters and results (command.go), event
+data (event.go) and enums (enum.*.go), along with the socket package wrappers
+(socket/cdtp.*.go), the socket and Tab protocol accessors, and tests for all of
+them. Files written by a previous run are replaced, hand written files are left
+alone. -domains restricts the output to a comma separated list of domains
+without the socket and Tab protocol accessors, for trees in which the other
+domains are still maintained by hand.
+
+Usage:
+
+	go run ./cmd/cdtpgen -protocol protocol/tot -out tot \
+		-import github.com/mkenney/go-chrome/tot -version tot
+
+Upgrading to a newer protocol is a matter of replacing the definition files
+and regenerating.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bdlm/log"
+)
+
+func main() {
+	protocolDir := flag.String("protocol", "protocol/tot", "directory containing the protocol definition files")
+	outDir := flag.String("out", "tot", "directory to write the generated packages to")
+	importPath := flag.String("import", "github.com/mkenney/go-chrome/tot", "import path of the output directory")
+	version := flag.String("version", "tot", "protocol version used in documentation links, e.g. 'tot' or '1-3'")
+	domains := flag.String("domains", "", "comma separated list of the domains to generate, e.g. 'IO,Schema', all domains if empty")
+	flag.Parse()
+
+	protocol, err := LoadProtocol(*protocolDir)
+	if nil != err {
+		log.WithFields(log.Fields{"protocol": *protocolDir}).Error(fmt.Sprintf("%-v", err))
+		os.Exit(1)
+	}
+
+	gen, err := NewGenerator(protocol, *importPath, *version)
+	if nil != err {
+		log.WithFields(log.Fields{"protocol": *protocolDir}).Error(fmt.Sprintf("%-v", err))
+		os.Exit(1)
+	}
+
+	if "" != *domains {
+		if err := gen.Select(strings.Split(*domains, ",")); nil != err {
+			log.WithFields(log.Fields{"domains": *domains}).Error(fmt.Sprintf("%-v", err))
+			os.Exit(1)
+		}
+	}
+
+	if err := gen.Write(*outDir); nil != err {
+		log.WithFields(log.Fields{"out": *outDir}).Error(fmt.Sprintf("%-v", err))
+		os.Exit(1)
+	}
+
+	log.WithFields(log.Fields{
+		"domains": len(gen.Packages),
+		"out":     *outDir,
+		"version": protocol.Version.Major + "." + protocol.Version.Minor,
+	}).Info("protocol packages generated")
+}
@@ -0,0 +1,728 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+Package is the generated Go package for a protocol domain.
+*/
+type Package struct {
+	// Domain is the protocol domain definition.
+	Domain *Domain
+
+	// Path is the package path relative to the version root, e.g.
+	// "dom/debugger".
+	Path string
+
+	// Name is the Go package name, e.g. "debugger".
+	Name string
+
+	// Alias is the name the package is imported as from other packages, e.g.
+	// "domDebugger".
+	Alias string
+
+	// Types holds the type definitions written to cdtp.go, including local
+	// duplicates of types that cannot be imported.
+	Types []*GoType
+
+	// Enums holds the enum definitions written to enum.*.go.
+	Enums []*Enum
+
+	// Commands holds the command parameter and result definitions written to
+	// command.go.
+	Commands []*GoCommand
+
+	// Events holds the event definitions written to event.go.
+	Events []*GoEvent
+
+	// Imports holds the packages imported by each generated file.
+	Imports map[string]map[*Package]bool
+
+	defs   map[string]*Type
+	local  map[string]string
+	idents map[string]bool
+}
+
+/*
+GoType is a generated type definition.
+*/
+type GoType struct {
+	Name        string
+	Doc         string
+	Link        string
+	Flags       string
+	Def         string
+	Fields      []*Field
+	DuplicateOf string
+}
+
+/*
+Field is a generated struct field.
+*/
+type Field struct {
+	Name string
+	Type string
+	JSON string
+	Doc  string
+}
+
+/*
+Enum is a generated enum type.
+*/
+type Enum struct {
+	// Name is the name of the variable providing named access to the enum
+	// values, e.g. "Format".
+	Name string
+
+	// Type is the name of the enum type, e.g. "FormatEnum".
+	Type string
+
+	// Accessor is the name of the unexported struct type of the Name
+	// variable, e.g. "formatEnum".
+	Accessor string
+
+	// File is the file name, e.g. "enum.format.go".
+	File   string
+	Doc    string
+	Links  []string
+	Values []*EnumValue
+}
+
+/*
+EnumValue is a single generated enum value.
+*/
+type EnumValue struct {
+	Name  string
+	Const string
+	Value string
+}
+
+/*
+GoCommand is a generated command.
+*/
+type GoCommand struct {
+	Name    string
+	Method  string
+	Doc     string
+	Link    string
+	Flags   string
+	Params  []*Field
+	Returns []*Field
+
+	// HasParams is true if the command accepts parameters.
+	HasParams bool
+}
+
+/*
+GoEvent is a generated event.
+*/
+type GoEvent struct {
+	// Name is the event name used for the On* handler method, e.g.
+	// "AnimationCanceled".
+	Name string
+
+	// Type is the name of the event data type, e.g. "CanceledEvent".
+	Type string
+
+	Method string
+	Doc    string
+	Link   string
+	Flags  string
+	Params []*Field
+}
+
+/*
+Generator builds and writes the Go packages for a protocol definition.
+*/
+type Generator struct {
+	// ImportPath is the import path of the version root, e.g.
+	// "github.com/mkenney/go-chrome/tot".
+	ImportPath string
+
+	// Version is the protocol version used in documentation links, e.g. "tot"
+	// or "1-3".
+	Version string
+
+	// Packages holds the generated packages, sorted by domain name.
+	Packages []*Package
+
+	domains  map[string]*Package
+	imports  map[*Package]map[*Package]bool
+	selected bool
+}
+
+/*
+NewGenerator resolves a protocol definition into Go packages.
+*/
+func NewGenerator(protocol *Protocol, importPath, version string) (*Generator, error) {
+	gen := &Generator{
+		ImportPath: importPath,
+		Version:    version,
+		domains:    make(map[string]*Package),
+		imports:    make(map[*Package]map[*Package]bool),
+	}
+
+	for _, domain := range protocol.Domains {
+		pkg := &Package{
+			Domain:  domain,
+			Path:    DomainPath(domain.Domain),
+			Imports: make(map[string]map[*Package]bool),
+			defs:    make(map[string]*Type),
+			local:   make(map[string]string),
+			idents:  make(map[string]bool),
+		}
+		pkg.Name = pkg.Path[strings.LastIndex(pkg.Path, "/")+1:]
+		pkg.Alias = pkg.Name
+		if strings.Contains(pkg.Path, "/") {
+			pkg.Alias = LowerName(domain.Domain)
+		}
+		for _, def := range domain.Types {
+			pkg.defs[def.ID] = def
+			if len(def.Enum) > 0 {
+				pkg.local[def.ID] = GoName(def.ID) + "Enum"
+				pkg.idents[GoName(def.ID)] = true
+			} else {
+				pkg.local[def.ID] = GoName(def.ID)
+			}
+			pkg.idents[pkg.local[def.ID]] = true
+		}
+		for _, command := range domain.Commands {
+			pkg.idents[GoName(command.Name)+"Params"] = true
+			pkg.idents[GoName(command.Name)+"Result"] = true
+		}
+		for _, event := range domain.Events {
+			pkg.idents[eventType(pkg, GoName(event.Name))] = true
+		}
+		gen.domains[domain.Domain] = pkg
+		gen.Packages = append(gen.Packages, pkg)
+	}
+
+	// Named enums are defined up front so that references to them from
+	// other domains can list their values.
+	for _, pkg := range gen.Packages {
+		for _, def := range pkg.Domain.Types {
+			if len(def.Enum) > 0 {
+				gen.namedEnum(pkg, pkg.Domain.Domain, def)
+			}
+		}
+	}
+
+	for _, pkg := range gen.Packages {
+		if err := gen.resolve(pkg); nil != err {
+			return nil, err
+		}
+	}
+	return gen, nil
+}
+
+/*
+Select restricts the written packages to the named domains, e.g. 'IO' or
+'HeapProfiler'. The socket and Tab protocol accessors list every domain, so
+they are not written for a selection and remain maintained by hand.
+*/
+func (gen *Generator) Select(domains []string) error {
+	selected := make(map[*Package]bool)
+	for _, domain := range domains {
+		pkg, ok := gen.domains[domain]
+		if !ok {
+			return errs.New(codes.GeneratorUnknownDomain, "unknown domain '"+domain+"'")
+		}
+		selected[pkg] = true
+	}
+	packages := []*Package{}
+	for _, pkg := range gen.Packages {
+		if selected[pkg] {
+			packages = append(packages, pkg)
+		}
+	}
+	gen.Packages = packages
+	gen.selected = true
+	return nil
+}
+
+/*
+Link returns the documentation URL for a domain item.
+*/
+func (gen *Generator) Link(domain, kind, name string) string {
+	link := fmt.Sprintf("https://chromedevtools.github.io/devtools-protocol/%s/%s/", gen.Version, domain)
+	if "" != kind {
+		link += fmt.Sprintf("#%s-%s", kind, name)
+	}
+	return link
+}
+
+/*
+resolve builds the Go definitions for a package.
+*/
+func (gen *Generator) resolve(pkg *Package) error {
+	domain := pkg.Domain.Domain
+
+	for _, def := range pkg.Domain.Types {
+		if len(def.Enum) > 0 {
+			continue
+		}
+		goType := &GoType{
+			Name:  pkg.local[def.ID],
+			Doc:   typeDoc(pkg.local[def.ID], domain, def),
+			Link:  gen.Link(domain, "type", def.ID),
+			Flags: flags(def.Experimental, def.Deprecated),
+		}
+		if err := gen.define(pkg, "cdtp.go", goType, def); nil != err {
+			return err
+		}
+		pkg.Types = append(pkg.Types, goType)
+	}
+
+	for _, command := range pkg.Domain.Commands {
+		name := GoName(command.Name)
+		goCommand := &GoCommand{
+			Name:      name,
+			Method:    domain + "." + command.Name,
+			Doc:       description(command.Description),
+			Link:      gen.Link(domain, "method", command.Name),
+			Flags:     flags(command.Experimental, command.Deprecated),
+			HasParams: len(command.Parameters) > 0,
+		}
+		var err error
+		if goCommand.Params, err = gen.fields(pkg, "command.go", name+"Params", command.Parameters); nil != err {
+			return err
+		}
+		if goCommand.Returns, err = gen.fields(pkg, "command.go", name+"Result", command.Returns); nil != err {
+			return err
+		}
+		pkg.Commands = append(pkg.Commands, goCommand)
+	}
+
+	for _, event := range pkg.Domain.Events {
+		name := GoName(event.Name)
+		goEvent := &GoEvent{
+			Name:   name,
+			Type:   eventType(pkg, name),
+			Method: domain + "." + event.Name,
+			Doc:    description(event.Description),
+			Link:   gen.Link(domain, "event", event.Name),
+			Flags:  flags(event.Experimental, event.Deprecated),
+		}
+		var err error
+		if goEvent.Params, err = gen.fields(pkg, "event.go", goEvent.Type, event.Parameters); nil != err {
+			return err
+		}
+		pkg.Events = append(pkg.Events, goEvent)
+	}
+
+	sort.Slice(pkg.Types, func(i, j int) bool {
+		if (pkg.Types[i].DuplicateOf != "") != (pkg.Types[j].DuplicateOf != "") {
+			return pkg.Types[i].DuplicateOf != ""
+		}
+		return pkg.Types[i].Name < pkg.Types[j].Name
+	})
+	sort.Slice(pkg.Enums, func(i, j int) bool {
+		return pkg.Enums[i].Name < pkg.Enums[j].Name
+	})
+	sort.Slice(pkg.Commands, func(i, j int) bool {
+		return pkg.Commands[i].Name < pkg.Commands[j].Name
+	})
+	sort.Slice(pkg.Events, func(i, j int) bool {
+		return pkg.Events[i].Name < pkg.Events[j].Name
+	})
+	return nil
+}
+
+/*
+define fills in the Go definition of a protocol type.
+*/
+func (gen *Generator) define(pkg *Package, file string, goType *GoType, def *Type) error {
+	var err error
+	if "object" == def.Type && len(def.Properties) > 0 {
+		goType.Def = "struct"
+		goType.Fields, err = gen.fields(pkg, file, goType.Name, def.Properties)
+		return err
+	}
+	goType.Def, err = gen.goType(pkg, file, goType.Name, def)
+	return err
+}
+
+/*
+fields resolves a list of properties or parameters into struct fields.
+*/
+func (gen *Generator) fields(pkg *Package, file, owner string, props []*Type) ([]*Field, error) {
+	fields := make([]*Field, 0, len(props))
+	for _, prop := range props {
+		goType, err := gen.goType(pkg, file, owner, prop)
+		if nil != err {
+			return nil, errs.Wrap(err, codes.GeneratorUnknownType, fmt.Sprintf("%s.%s", owner, prop.Name))
+		}
+		field := &Field{
+			Name: GoName(prop.Name),
+			Type: goType,
+			JSON: prop.Name,
+			Doc:  description(prop.Description),
+		}
+		if prop.Optional {
+			field.JSON += ",omitempty"
+			field.Doc = strings.TrimSpace("Optional. " + field.Doc)
+		}
+		if enum := gen.enumOf(pkg, goType); nil != enum {
+			field.Doc = strings.TrimSpace(field.Doc + " Allowed values:")
+			for _, value := range enum.Values {
+				field.Doc += fmt.Sprintf("\n\t- %s.%s", enum.Name, value.Name)
+			}
+		}
+		if flag := flags(prop.Experimental, prop.Deprecated); "" != flag {
+			field.Doc = strings.TrimSpace(field.Doc + " " + strings.Replace(flag, "\n", " ", -1))
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+/*
+enumOf returns the enum a resolved Go type refers to, if any.
+*/
+func (gen *Generator) enumOf(pkg *Package, goType string) *Enum {
+	goType = strings.TrimLeft(goType, "[]*")
+	if k := strings.Index(goType, "."); k > 0 {
+		for _, target := range gen.Packages {
+			if target.Alias == goType[:k] {
+				pkg = target
+				goType = goType[k+1:]
+				break
+			}
+		}
+	}
+	for _, enum := range pkg.Enums {
+		if enum.Type == goType {
+			return enum
+		}
+	}
+	return nil
+}
+
+/*
+goType returns the Go type expression for a protocol type reference.
+*/
+func (gen *Generator) goType(pkg *Package, file, owner string, def *Type) (string, error) {
+	if "" != def.Ref {
+		return gen.ref(pkg, file, def.Ref)
+	}
+	if len(def.Enum) > 0 {
+		return gen.inlineEnum(pkg, owner, def).Type, nil
+	}
+	switch def.Type {
+	case "any":
+		return "interface{}", nil
+	case "array":
+		if nil == def.Items {
+			return "[]interface{}", nil
+		}
+		items, err := gen.goType(pkg, file, owner, def.Items)
+		return "[]" + items, err
+	case "binary", "string":
+		return "string", nil
+	case "boolean":
+		return "bool", nil
+	case "integer":
+		return "int", nil
+	case "number":
+		return "float64", nil
+	case "object":
+		return "map[string]interface{}", nil
+	}
+	return "", errs.New(codes.GeneratorUnknownType, fmt.Sprintf("unknown type '%s'", def.Type))
+}
+
+/*
+splitRef splits a type reference into domain and type ID.
+*/
+func (gen *Generator) splitRef(pkg *Package, ref string) (string, string) {
+	if k := strings.Index(ref, "."); k > 0 {
+		return ref[:k], ref[k+1:]
+	}
+	return pkg.Domain.Domain, ref
+}
+
+/*
+ref returns the Go type expression for a reference to a named type. References
+to other domains are imported when possible and duplicated locally when the
+import would create an import cycle.
+*/
+func (gen *Generator) ref(pkg *Package, file, ref string) (string, error) {
+	domain, id := gen.splitRef(pkg, ref)
+	target, ok := gen.domains[domain]
+	if !ok {
+		return "", errs.New(codes.GeneratorUnknownType, fmt.Sprintf("unknown domain in reference '%s'", ref))
+	}
+	def, ok := target.defs[id]
+	if !ok {
+		return "", errs.New(codes.GeneratorUnknownType, fmt.Sprintf("unknown type in reference '%s'", ref))
+	}
+
+	pointer := ""
+	if "object" == def.Type && len(def.Properties) > 0 {
+		pointer = "*"
+	}
+
+	if target == pkg {
+		return pointer + pkg.local[id], nil
+	}
+	if gen.canImport(pkg, target) {
+		if nil == pkg.Imports[file] {
+			pkg.Imports[file] = make(map[*Package]bool)
+		}
+		pkg.Imports[file][target] = true
+		return pointer + target.Alias + "." + target.local[id], nil
+	}
+	name, err := gen.duplicate(pkg, target, def)
+	return pointer + name, err
+}
+
+/*
+canImport returns whether pkg can import target without creating an import
+cycle, and records the import if it can.
+*/
+func (gen *Generator) canImport(pkg, target *Package) bool {
+	if gen.imports[pkg][target] {
+		return true
+	}
+	if gen.reaches(target, pkg, make(map[*Package]bool)) {
+		return false
+	}
+	if nil == gen.imports[pkg] {
+		gen.imports[pkg] = make(map[*Package]bool)
+	}
+	gen.imports[pkg][target] = true
+	return true
+}
+
+/*
+reaches returns whether from imports to, directly or indirectly.
+*/
+func (gen *Generator) reaches(from, to *Package, seen map[*Package]bool) bool {
+	if from == to {
+		return true
+	}
+	seen[from] = true
+	for next := range gen.imports[from] {
+		if !seen[next] && gen.reaches(next, to, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+duplicate defines a local copy of a type from another domain in pkg and returns
+its name.
+*/
+func (gen *Generator) duplicate(pkg, target *Package, def *Type) (string, error) {
+	key := target.Domain.Domain + "." + def.ID
+	if name, ok := pkg.local[key]; ok {
+		return name, nil
+	}
+
+	name := GoName(def.ID)
+	if len(def.Enum) > 0 {
+		if pkg.idents[name] || pkg.idents[name+"Enum"] {
+			name = GoName(target.Domain.Domain) + name
+		}
+		pkg.local[key] = name + "Enum"
+		pkg.idents[name] = true
+		pkg.idents[name+"Enum"] = true
+		enum := gen.enum(pkg, name, SnakeName(name), def.Enum)
+		enum.Doc = typeDoc(enum.Type, target.Domain.Domain, def) + fmt.Sprintf(
+			" This is a duplicate of %s to avoid an invalid import cycle.", key,
+		)
+		enum.Links = append(enum.Links, gen.Link(target.Domain.Domain, "type", def.ID))
+		return pkg.local[key], nil
+	}
+
+	if pkg.idents[name] {
+		name = GoName(target.Domain.Domain) + name
+	}
+	pkg.local[key] = name
+	pkg.idents[name] = true
+
+	goType := &GoType{
+		Name: name,
+		Doc: typeDoc(name, target.Domain.Domain, def) + fmt.Sprintf(
+			" This is a duplicate of %s to avoid an invalid import cycle.", key,
+		),
+		Link:        gen.Link(target.Domain.Domain, "type", def.ID),
+		Flags:       flags(def.Experimental, def.Deprecated),
+		DuplicateOf: key,
+	}
+	pkg.Types = append(pkg.Types, goType)
+
+	// Resolve the duplicate from the point of view of the importing package
+	// so that references back into it become local references.
+	copied := *def
+	copied.Properties = make([]*Type, len(def.Properties))
+	for k, prop := range def.Properties {
+		copied.Properties[k] = gen.localize(target, prop)
+	}
+	copied.Items = gen.localize(target, def.Items)
+	return name, gen.define(pkg, "cdtp.go", goType, &copied)
+}
+
+/*
+localize qualifies the local references in a property of the target domain so
+the property can be resolved from another package.
+*/
+func (gen *Generator) localize(target *Package, prop *Type) *Type {
+	if nil == prop {
+		return nil
+	}
+	copied := *prop
+	if "" != copied.Ref && !strings.Contains(copied.Ref, ".") {
+		copied.Ref = target.Domain.Domain + "." + copied.Ref
+	}
+	copied.Items = gen.localize(target, prop.Items)
+	return &copied
+}
+
+/*
+namedEnum defines the enum for a named enum type.
+*/
+func (gen *Generator) namedEnum(pkg *Package, domain string, def *Type) {
+	name := GoName(def.ID)
+	enum := gen.enum(pkg, name, SnakeName(def.ID), def.Enum)
+	enum.Doc = typeDoc(enum.Type, domain, def)
+	if flag := flags(def.Experimental, def.Deprecated); "" != flag {
+		enum.Doc += " " + strings.Replace(flag, "\n", " ", -1)
+	}
+	enum.Links = append(enum.Links, gen.Link(domain, "type", def.ID))
+}
+
+/*
+inlineEnum defines, or reuses, the enum for an inline enum property. Enums are
+named after the property; if that name is already taken by a different type the
+name of the owning type is prepended.
+*/
+func (gen *Generator) inlineEnum(pkg *Package, owner string, prop *Type) *Enum {
+	name := GoName(prop.Name)
+	if "" == name {
+		name = owner
+	}
+	for _, candidate := range []string{name, owner + name} {
+		for _, enum := range pkg.Enums {
+			if enum.Name == candidate && sameValues(enum, prop.Enum) {
+				return enum
+			}
+		}
+	}
+	if pkg.idents[name] || pkg.idents[name+"Enum"] {
+		name = owner + name
+	}
+	pkg.idents[name] = true
+	pkg.idents[name+"Enum"] = true
+	enum := gen.enum(pkg, name, SnakeName(name), prop.Enum)
+	enum.Doc = fmt.Sprintf("%sEnum represents the allowed values of %s.%s.", name, owner, GoName(prop.Name))
+	if "" != prop.Description {
+		enum.Doc += " " + description(prop.Description)
+	}
+	return enum
+}
+
+/*
+enum adds a new enum definition to a package.
+*/
+func (gen *Generator) enum(pkg *Package, name, file string, values []string) *Enum {
+	enum := &Enum{
+		Name:     name,
+		Type:     name + "Enum",
+		Accessor: LowerName(name) + "Enum",
+		File:     "enum." + file + ".go",
+	}
+	seen := make(map[string]bool)
+	for _, value := range values {
+		valueName := GoName(value)
+		if "" == valueName || (valueName[0] >= '0' && valueName[0] <= '9') {
+			valueName = "Value" + valueName
+		}
+		for base, k := valueName, 2; seen[valueName]; k++ {
+			valueName = fmt.Sprintf("%s%d", base, k)
+		}
+		seen[valueName] = true
+		enum.Values = append(enum.Values, &EnumValue{
+			Name:  valueName,
+			Const: LowerName(name) + valueName,
+			Value: value,
+		})
+	}
+	pkg.Enums = append(pkg.Enums, enum)
+	return enum
+}
+
+/*
+sameValues returns whether an enum has exactly the specified values.
+*/
+func sameValues(enum *Enum, values []string) bool {
+	if len(enum.Values) != len(values) {
+		return false
+	}
+	for k, value := range values {
+		if enum.Values[k].Value != value {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+eventType returns the name of the data type for an event. Event names that
+repeat the domain name drop it, "animationCanceled" => "CanceledEvent".
+*/
+func eventType(pkg *Package, name string) string {
+	prefix := GoName(pkg.Domain.Domain)
+	if len(name) > len(prefix) && strings.HasPrefix(name, prefix) {
+		trimmed := name[len(prefix):] + "Event"
+		if !pkg.idents[trimmed] {
+			return trimmed
+		}
+	}
+	return name + "Event"
+}
+
+/*
+typeDoc returns the documentation for a named type.
+*/
+func typeDoc(name, domain string, def *Type) string {
+	doc := fmt.Sprintf("%s represents the %s.%s type.", name, domain, def.ID)
+	if "" != def.Description {
+		doc += " " + description(def.Description)
+	}
+	return doc
+}
+
+/*
+description normalizes a protocol description into a single paragraph ending
+with a period.
+*/
+func description(desc string) string {
+	desc = strings.Join(strings.Fields(desc), " ")
+	if "" != desc && !strings.HasSuffix(desc, ".") {
+		desc += "."
+	}
+	return desc
+}
+
+/*
+flags returns the EXPERIMENTAL and DEPRECATED markers for a definition, one per
+line.
+*/
+func flags(experimental, deprecated bool) string {
+	var result []string
+	if experimental {
+		result = append(result, "EXPERIMENTAL.")
+	}
+	if deprecated {
+		result = append(result, "DEPRECATED.")
+	}
+	return strings.Join(result, "\n")
+}
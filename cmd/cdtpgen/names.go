@@ -0,0 +1,141 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+/*
+initialisms lists the words that are written in upper case in Go identifiers,
+see https://github.com/golang/go/wiki/CodeReviewComments#initialisms.
+*/
+var initialisms = map[string]bool{
+	"API":   true,
+	"ASCII": true,
+	"AX":    true,
+	"CPU":   true,
+	"CSS":   true,
+	"DB":    true,
+	"DOM":   true,
+	"EOF":   true,
+	"GPU":   true,
+	"HTML":  true,
+	"HTTP":  true,
+	"HTTPS": true,
+	"ID":    true,
+	"IO":    true,
+	"IP":    true,
+	"JS":    true,
+	"JSON":  true,
+	"SQL":   true,
+	"SSL":   true,
+	"TCP":   true,
+	"TLS":   true,
+	"UI":    true,
+	"URL":   true,
+	"UUID":  true,
+	"XHR":   true,
+	"XML":   true,
+}
+
+var wordRE = regexp.MustCompile(`[A-Z]+[0-9]*(?:[a-z][a-z0-9]*)?|[a-z][a-z0-9]*|[0-9]+`)
+
+/*
+words splits a protocol name such as "DOMContentEventFired", "loaderId" or
+"set-cookie" into its component words.
+*/
+func words(name string) []string {
+	var result []string
+	for _, word := range wordRE.FindAllString(name, -1) {
+		// A run of capitals followed by a lower case word is an initialism
+		// followed by a word, "DOMContent" => "DOM", "Content".
+		upper := 0
+		for upper < len(word) && unicode.IsUpper(rune(word[upper])) {
+			upper++
+		}
+		if upper > 1 && upper < len(word) && unicode.IsLower(rune(word[upper])) &&
+			!("s" == word[upper:] && initialisms[word[:upper]]) {
+			result = append(result, word[:upper-1], word[upper-1:])
+			continue
+		}
+		result = append(result, word)
+	}
+	return result
+}
+
+/*
+GoName returns the exported Go identifier for a protocol name, "loaderId" =>
+"LoaderID".
+*/
+func GoName(name string) string {
+	var result string
+	for _, word := range words(name) {
+		stem := strings.TrimSuffix(word, "s")
+		if initialisms[strings.ToUpper(word)] {
+			result += strings.ToUpper(word)
+		} else if "" != stem && stem != word && initialisms[strings.ToUpper(stem)] {
+			// Plural initialisms, "Ids" => "IDs".
+			result += strings.ToUpper(stem) + "s"
+		} else {
+			result += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return result
+}
+
+/*
+LowerName returns the unexported Go identifier for a protocol name,
+"DOMDebugger" => "domDebugger".
+*/
+func LowerName(name string) string {
+	parts := words(name)
+	if 0 == len(parts) {
+		return ""
+	}
+	return strings.ToLower(parts[0]) + GoName(strings.Join(parts[1:], "-"))
+}
+
+/*
+SnakeName returns the snake_case form of a protocol name, used to name enum
+files, "ResourceType" => "resource_type".
+*/
+func SnakeName(name string) string {
+	parts := words(name)
+	for k, part := range parts {
+		parts[k] = strings.ToLower(part)
+	}
+	return strings.Join(parts, "_")
+}
+
+/*
+DomainPath returns the package path of a domain relative to the version root,
+"DOMDebugger" => "dom/debugger".
+*/
+func DomainPath(domain string) string {
+	parts := words(domain)
+	for k, part := range parts {
+		parts[k] = strings.ToLower(part)
+	}
+	return strings.Join(parts, "/")
+}
+
+/*
+ucFirst upper-cases the first letter of a string.
+*/
+func ucFirst(s string) string {
+	if "" == s {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+/*
+lcFirst lower-cases the first letter of a string.
+*/
+func lcFirst(s string) string {
+	if "" == s {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestNames(t *testing.T) {
+	tests := []struct {
+		name   string
+		goName string
+		lower  string
+		snake  string
+		path   string
+	}{
+		{"loaderId", "LoaderID", "loaderID", "loader_id", "loader/id"},
+		{"DOMDebugger", "DOMDebugger", "domDebugger", "dom_debugger", "dom/debugger"},
+		{"DOMContentEventFired", "DOMContentEventFired", "domContentEventFired", "dom_content_event_fired", "dom/content/event/fired"},
+		{"IndexedDB", "IndexedDB", "indexedDB", "indexed_db", "indexed/db"},
+		{"IO", "IO", "io", "io", "io"},
+		{"AXNodeId", "AXNodeID", "axNodeID", "ax_node_id", "ax/node/id"},
+		{"set-cookie", "SetCookie", "setCookie", "set_cookie", "set/cookie"},
+		{"v8", "V8", "v8", "v8", "v8"},
+		{"childIds", "ChildIDs", "childIDs", "child_ids", "child/ids"},
+		{"redirectURLs", "RedirectURLs", "redirectURLs", "redirect_urls", "redirect/urls"},
+	}
+	for _, test := range tests {
+		if result := GoName(test.name); test.goName != result {
+			t.Errorf("GoName(%q): expected '%s', got '%s'", test.name, test.goName, result)
+		}
+		if result := LowerName(test.name); test.lower != result {
+			t.Errorf("LowerName(%q): expected '%s', got '%s'", test.name, test.lower, result)
+		}
+		if result := SnakeName(test.name); test.snake != result {
+			t.Errorf("SnakeName(%q): expected '%s', got '%s'", test.name, test.snake, result)
+		}
+		if result := DomainPath(test.name); test.path != result {
+			t.Errorf("DomainPath(%q): expected '%s', got '%s'", test.name, test.path, result)
+		}
+	}
+}
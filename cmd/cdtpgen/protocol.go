@@ -0,0 +1,116 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+protocolFiles lists the protocol definition files, in the format published at
+https://github.com/ChromeDevTools/devtools-protocol/tree/master/json, that are
+read from the protocol directory.
+*/
+var protocolFiles = []string{
+	"browser_protocol.json",
+	"js_protocol.json",
+}
+
+/*
+Protocol represents a protocol definition file.
+*/
+type Protocol struct {
+	Version struct {
+		Major string `json:"major"`
+		Minor string `json:"minor"`
+	} `json:"version"`
+	Domains []*Domain `json:"domains"`
+}
+
+/*
+Domain represents a protocol domain definition.
+*/
+type Domain struct {
+	Domain       string     `json:"domain"`
+	Description  string     `json:"description,omitempty"`
+	Experimental bool       `json:"experimental,omitempty"`
+	Deprecated   bool       `json:"deprecated,omitempty"`
+	Dependencies []string   `json:"dependencies,omitempty"`
+	Types        []*Type    `json:"types,omitempty"`
+	Commands     []*Command `json:"commands,omitempty"`
+	Events       []*Event   `json:"events,omitempty"`
+}
+
+/*
+Type represents a type definition, an object property, a command parameter or
+return value, or an event parameter. Type definitions are identified by ID,
+everything else by Name.
+*/
+type Type struct {
+	ID           string   `json:"id,omitempty"`
+	Name         string   `json:"name,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Experimental bool     `json:"experimental,omitempty"`
+	Deprecated   bool     `json:"deprecated,omitempty"`
+	Optional     bool     `json:"optional,omitempty"`
+	Type         string   `json:"type,omitempty"`
+	Ref          string   `json:"$ref,omitempty"`
+	Enum         []string `json:"enum,omitempty"`
+	Items        *Type    `json:"items,omitempty"`
+	Properties   []*Type  `json:"properties,omitempty"`
+}
+
+/*
+Command represents a protocol method definition.
+*/
+type Command struct {
+	Name         string  `json:"name"`
+	Description  string  `json:"description,omitempty"`
+	Experimental bool    `json:"experimental,omitempty"`
+	Deprecated   bool    `json:"deprecated,omitempty"`
+	Redirect     string  `json:"redirect,omitempty"`
+	Parameters   []*Type `json:"parameters,omitempty"`
+	Returns      []*Type `json:"returns,omitempty"`
+}
+
+/*
+Event represents a protocol event definition.
+*/
+type Event struct {
+	Name         string  `json:"name"`
+	Description  string  `json:"description,omitempty"`
+	Experimental bool    `json:"experimental,omitempty"`
+	Deprecated   bool    `json:"deprecated,omitempty"`
+	Parameters   []*Type `json:"parameters,omitempty"`
+}
+
+/*
+LoadProtocol reads and merges the protocol definition files found in dir. The
+returned domains are sorted by name.
+*/
+func LoadProtocol(dir string) (*Protocol, error) {
+	merged := &Protocol{}
+	for _, file := range protocolFiles {
+		path := filepath.Join(dir, file)
+		data, err := ioutil.ReadFile(path)
+		if nil != err {
+			return nil, errs.Wrap(err, codes.GeneratorReadFailed, "cannot read protocol file '"+path+"'")
+		}
+		protocol := &Protocol{}
+		if err := json.Unmarshal(data, protocol); nil != err {
+			return nil, errs.Wrap(err, codes.GeneratorInvalidProtocol, "cannot parse protocol file '"+path+"'")
+		}
+		if "" == merged.Version.Major {
+			merged.Version = protocol.Version
+		}
+		merged.Domains = append(merged.Domains, protocol.Domains...)
+	}
+	sort.Slice(merged.Domains, func(i, j int) bool {
+		return merged.Domains[i].Domain < merged.Domains[j].Domain
+	})
+	return merged, nil
+}
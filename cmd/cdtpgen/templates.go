@@ -0,0 +1,512 @@
+package main
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+/*
+wrap word-wraps a paragraph to the specified width. Lines beginning with a tab,
+such as lists of allowed enum values, are kept as they are.
+*/
+func wrap(text string, width int) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		if strings.HasPrefix(paragraph, "\t") {
+			lines = append(lines, paragraph)
+			continue
+		}
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			if "" != line && len(line)+1+len(word) > width {
+				lines = append(lines, line)
+				line = ""
+			}
+			if "" != line {
+				line += " "
+			}
+			line += word
+		}
+		if "" != line {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+var funcs = template.FuncMap{
+	// doc formats the body of a block comment.
+	"doc": func(text string) string {
+		return strings.Join(wrap(text, 80), "\n")
+	},
+	// comment formats an indented line comment.
+	"comment": func(text string) string {
+		lines := wrap(text, 72)
+		for k, line := range lines {
+			lines[k] = "\t// " + line
+		}
+		return strings.Join(lines, "\n")
+	},
+	"lower": LowerName,
+	// summary starts the documentation of a method with its name, followed by
+	// the protocol description or the fallback sentence.
+	"summary": func(name, doc, fallback string) string {
+		if "" == doc {
+			return name + " " + fallback
+		}
+		if len(doc) > 1 && unicode.IsLower(rune(doc[1])) {
+			doc = lcFirst(doc)
+		}
+		return name + " " + doc
+	},
+	// quote returns the Go string literal for a JSON encoded string.
+	"quote": func(value string) string {
+		data, _ := json.Marshal(value)
+		return strconv.Quote(string(data))
+	},
+}
+
+const header = `// Code generated by cdtpgen. DO NOT EDIT.
+
+`
+
+const importsTemplate = `{{define "imports"}}{{if .}}
+import (
+{{range .}}	{{.}}
+{{end}})
+{{end}}{{end}}`
+
+var cdtpTemplate = template.Must(template.New("cdtp.go").Funcs(funcs).Parse(header + importsTemplate + `/*
+{{doc (printf "Package %s provides type definitions for use with the Chrome %s protocol." .Pkg.Name .Pkg.Domain.Domain)}}
+{{with .Description}}
+{{doc .}}
+{{end}}
+{{.Link}}{{with .Flags}}
+{{.}}{{end}}
+*/
+package {{.Pkg.Name}}
+{{template "imports" .Imports}}
+{{range .Pkg.Types}}
+/*
+{{doc .Doc}}
+
+{{.Link}}{{with .Flags}}
+{{.}}{{end}}
+*/
+{{if eq .Def "struct"}}type {{.Name}} struct {
+{{range $k, $field := .Fields}}{{if $k}}
+{{end}}{{with .Doc}}{{comment .}}
+{{end}}	{{.Name}} {{.Type}} ` + "`" + `json:"{{.JSON}}"` + "`" + `
+{{end}}}{{else}}type {{.Name}} {{.Def}}{{end}}
+{{end}}`))
+
+var commandTemplate = template.Must(template.New("command.go").Funcs(funcs).Parse(header + importsTemplate + `package {{.Pkg.Name}}
+{{template "imports" .Imports}}
+{{range .Pkg.Commands}}{{if .HasParams}}
+/*
+{{.Name}}Params represents {{.Method}} parameters.
+
+{{.Link}}{{with .Flags}}
+{{.}}{{end}}
+*/
+type {{.Name}}Params struct {
+{{range $k, $field := .Params}}{{if $k}}
+{{end}}{{with .Doc}}{{comment .}}
+{{end}}	{{.Name}} {{.Type}} ` + "`" + `json:"{{.JSON}}"` + "`" + `
+{{end}}}
+{{end}}
+/*
+{{.Name}}Result represents the result of calls to {{.Method}}.
+
+{{.Link}}{{with .Flags}}
+{{.}}{{end}}
+*/
+type {{.Name}}Result struct {
+{{range .Returns}}{{with .Doc}}{{comment .}}
+{{end}}	{{.Name}} {{.Type}} ` + "`" + `json:"{{.JSON}}"` + "`" + `
+
+{{end}}	// Error information related to executing this method
+	Err error ` + "`" + `json:"-"` + "`" + `
+}
+{{end}}`))
+
+var eventTemplate = template.Must(template.New("event.go").Funcs(funcs).Parse(header + importsTemplate + `package {{.Pkg.Name}}
+{{template "imports" .Imports}}
+{{range .Pkg.Events}}
+/*
+{{.Type}} represents {{.Method}} event data.
+
+{{.Link}}{{with .Flags}}
+{{.}}{{end}}
+*/
+type {{.Type}} struct {
+{{range .Params}}{{with .Doc}}{{comment .}}
+{{end}}	{{.Name}} {{.Type}} ` + "`" + `json:"{{.JSON}}"` + "`" + `
+
+{{end}}	// Error information related to this event
+	Err error ` + "`" + `json:"-"` + "`" + `
+}
+{{end}}`))
+
+var enumTemplate = template.Must(template.New("enum.go").Funcs(funcs).Parse(header + `package {{.Pkg.Name}}
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type {{.Enum.Accessor}} struct {
+{{range .Enum.Values}}	{{.Name}} {{$.Enum.Type}}
+{{end}}}
+
+/*
+{{.Enum.Name}} provides named access to the {{.Enum.Type}} values.
+*/
+var {{.Enum.Name}} = {{.Enum.Accessor}}{
+{{range .Enum.Values}}	{{.Name}}: {{.Const}},
+{{end}}}
+
+/*
+{{doc (printf "%s Allowed values:" .Enum.Doc)}}
+{{range .Enum.Values}}	- {{$.Enum.Name}}.{{.Name}} "{{.Value}}"
+{{end}}{{with .Enum.Links}}
+{{range .}}{{.}}
+{{end}}{{end}}*/
+type {{.Enum.Type}} int
+
+/*
+String implements Stringer
+*/
+func (enum {{.Enum.Type}}) String() string {
+	return _{{.Enum.Accessor}}s[enum]
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum {{.Enum.Type}}) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler
+*/
+func (enum *{{.Enum.Type}}) UnmarshalJSON(bytes []byte) error {
+	var err error
+	var val string
+
+	err = json.Unmarshal(bytes, &val)
+	if nil != err {
+		return err
+	}
+
+	for k, v := range _{{.Enum.Accessor}}s {
+		if v == val {
+			*enum = k
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s is not a valid type value", bytes)
+}
+
+const (
+{{range $k, $value := .Enum.Values}}	// {{.Const}} represents the "{{.Value}}" value.
+	{{.Const}}{{if not $k}} {{$.Enum.Type}} = iota + 1{{end}}
+{{end}})
+
+var _{{.Enum.Accessor}}s = map[{{.Enum.Type}}]string{
+	{{.Enum.Type}}(0): "",
+{{range .Enum.Values}}	{{.Const}}: {{printf "%q" .Value}},
+{{end}}}
+`))
+
+var enumTestTemplate = template.Must(template.New("enum_test.go").Funcs(funcs).Parse(header + `package {{.Pkg.Name}}
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnum{{.Enum.Name}}(t *testing.T) {
+	var enum {{.Enum.Type}}
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(` + "`" + `""` + "`" + `), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	err = json.Unmarshal([]byte(` + "`" + `"invalid value"` + "`" + `), &enum)
+	if nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if ` + "`" + `""` + "`" + ` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+{{range .Enum.Values}}
+	enum = {{$.Enum.Name}}.{{.Name}}
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if {{quote .Value}} != string(result) {
+		t.Errorf("Expected '%s', got '%s'", {{quote .Value}}, result)
+	}
+	json.Unmarshal([]byte({{quote .Value}}), &enum)
+	if {{$.Enum.Name}}.{{.Name}} != enum {
+		t.Errorf("Expected %d, got %d", {{$.Enum.Name}}.{{.Name}}, enum)
+	}
+{{end}}}
+`))
+
+var socketTemplate = template.Must(template.New("socket.go").Funcs(funcs).Parse(header + importsTemplate + `package socket
+{{template "imports" .Imports}}
+/*
+{{doc (printf "%sProtocol provides a namespace for the Chrome %s protocol methods." .Pkg.Domain.Domain .Pkg.Domain.Domain)}}
+{{with .Description}}
+{{doc .}}
+{{end}}
+{{.Link}}{{with .Flags}}
+{{.}}{{end}}
+*/
+type {{.Pkg.Domain.Domain}}Protocol struct {
+	Socket Socketer
+}
+{{$domain := .Pkg.Domain.Domain}}{{$pkg := .Pkg.Alias}}{{range .Pkg.Commands}}
+/*
+{{doc (summary .Name .Doc (printf "sends the %s command." .Method))}}
+
+{{.Link}}{{with .Flags}}
+{{.}}{{end}}
+*/
+func (protocol *{{$domain}}Protocol) {{.Name}}({{if .HasParams}}
+	params *{{$pkg}}.{{.Name}}Params,
+{{end}}) <-chan *{{$pkg}}.{{.Name}}Result {
+	return protocol.{{.Name}}Context(context.Background(){{if .HasParams}}, params{{end}})
+}
+
+/*
+{{doc (printf "%sContext performs %s and returns its result, or returns the context error if ctx is cancelled or times out before a response arrives." .Name .Name)}}
+
+{{.Link}}{{with .Flags}}
+{{.}}{{end}}
+*/
+func (protocol *{{$domain}}Protocol) {{.Name}}Context(
+	ctx context.Context,{{if .HasParams}}
+	params *{{$pkg}}.{{.Name}}Params,{{end}}
+) <-chan *{{$pkg}}.{{.Name}}Result {
+	resultChan := make(chan *{{$pkg}}.{{.Name}}Result, 1)
+	command := NewCommand(protocol.Socket, "{{.Method}}", {{if .HasParams}}params{{else}}nil{{end}})
+	result := &{{$pkg}}.{{.Name}}Result{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}{{if .Returns}} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}{{end}}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+{{end}}{{range .Pkg.Events}}
+/*
+{{doc (printf "On%s adds a handler to the %s event. %s" .Name .Method .Doc)}}
+
+{{.Link}}{{with .Flags}}
+{{.}}{{end}}
+*/
+func (protocol *{{$domain}}Protocol) On{{.Name}}(
+	callback func(event *{{$pkg}}.{{.Type}}),
+) *Subscription {
+	handler := NewEventHandler(
+		"{{.Method}}",
+		func(response *Response) {
+			event := &{{$pkg}}.{{.Type}}{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+{{doc (printf "On%sChan returns a channel of %s events. The event handler is removed and the channel closed when ctx is done." .Name .Method)}}
+
+{{.Link}}{{with .Flags}}
+{{.}}{{end}}
+*/
+func (protocol *{{$domain}}Protocol) On{{.Name}}Chan(
+	ctx context.Context,
+) <-chan *{{$pkg}}.{{.Type}} {
+	eventChan := make(chan *{{$pkg}}.{{.Type}})
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.On{{.Name}}(func(event *{{$pkg}}.{{.Type}}) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+{{end}}`))
+
+var socketTestTemplate = template.Must(template.New("socket_test.go").Funcs(funcs).Parse(header + importsTemplate + `package socket
+{{template "imports" .Imports}}{{$domain := .Pkg.Domain.Domain}}{{$pkg := .Pkg.Alias}}{{range .Pkg.Commands}}
+func Test{{$domain}}{{.Name}}(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/Test{{$domain}}{{.Name}}")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &{{$pkg}}.{{.Name}}Result{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.{{$domain}}().{{.Name}}({{if .HasParams}}&{{$pkg}}.{{.Name}}Params{}{{end}})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.{{$domain}}().{{.Name}}({{if .HasParams}}&{{$pkg}}.{{.Name}}Params{}{{end}})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(` + "`" + `"error data"` + "`" + `),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+{{end}}{{range .Pkg.Events}}
+func Test{{$domain}}On{{.Name}}(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/Test{{$domain}}On{{.Name}}")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *{{$pkg}}.{{.Type}})
+	sub := mockSocket.{{$domain}}().On{{.Name}}(func(eventData *{{$pkg}}.{{.Type}}) {
+		resultChan <- eventData
+	})
+	mockResult := &{{$pkg}}.{{.Type}}{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "{{.Method}}",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.{{$domain}}().On{{.Name}}Chan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(` + "`" + `"error data"` + "`" + `),
+			Message: "error message",
+		},
+		Method: "{{.Method}}",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+{{end}}`))
+
+var protocollerInterfaceTemplate = template.Must(template.New("interface.protocoller.go").Funcs(funcs).Parse(header + `package socket
+
+/*
+Protocoller defines the Chrome DevTools Protocol API methods
+
+https://chromedevtools.github.io/devtools-protocol/
+*/
+type Protocoller interface {
+{{range $k, $pkg := .}}{{if $k}}
+{{end}}	// {{.Domain.Domain}} returns the {{.Domain.Domain}}Protocol instance.
+	{{.Domain.Domain}}() *{{.Domain.Domain}}Protocol
+{{end}}}
+`))
+
+var protocollerTemplate = template.Must(template.New("socket.protocoller.go").Funcs(funcs).Parse(header + `package socket
+
+/*
+protocols holds the protocol API instances of a Socket.
+*/
+type protocols struct {
+{{range .}}	{{lower .Domain.Domain}} *{{.Domain.Domain}}Protocol
+{{end}}}
+
+/*
+newProtocols returns the protocol API instances for a socket.
+*/
+func newProtocols(socket Socketer) protocols {
+	return protocols{
+{{range .}}		{{lower .Domain.Domain}}: &{{.Domain.Domain}}Protocol{Socket: socket},
+{{end}}	}
+}
+{{range .}}
+/*
+{{.Domain.Domain}} returns the {{.Domain.Domain}}Protocol instance.
+
+{{.Domain.Domain}} is a Protocoller implementation.
+*/
+func (socket *Socket) {{.Domain.Domain}}() *{{.Domain.Domain}}Protocol {
+	return socket.{{lower .Domain.Domain}}
+}
+{{end}}`))
+
+var tabProtocollerTemplate = template.Must(template.New("tab.socket.protocoller.go").Funcs(funcs).Parse(header + `package chrome
+
+import (
+	"{{.ImportPath}}/socket"
+)
+{{range .Packages}}
+/*
+{{.Domain.Domain}} implements socket.Protocoller
+*/
+func (tab *Tab) {{.Domain.Domain}}() *socket.{{.Domain.Domain}}Protocol {
+	return tab.protocol.{{.Domain.Domain}}()
+}
+{{end}}`))
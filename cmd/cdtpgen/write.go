@@ -0,0 +1,226 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+view is the data passed to the file templates.
+*/
+type view struct {
+	Pkg         *Package
+	Enum        *Enum
+	Imports     []string
+	Description string
+	Link        string
+	Flags       string
+}
+
+/*
+Write writes the generated packages to the version root directory dir, along
+with the Tab protocol accessors of the root package. Files left over from a
+previous run are removed first, see Select for writing a subset of the
+domains.
+*/
+func (gen *Generator) Write(dir string) error {
+	socketDir := filepath.Join(dir, "socket")
+	if !gen.selected {
+		if err := clean(socketDir); nil != err {
+			return err
+		}
+	}
+
+	for _, pkg := range gen.Packages {
+		pkgDir := filepath.Join(dir, filepath.FromSlash(pkg.Path))
+		if err := clean(pkgDir); nil != err {
+			return err
+		}
+
+		base := view{
+			Pkg:         pkg,
+			Description: description(pkg.Domain.Description),
+			Link:        gen.Link(pkg.Domain.Domain, "", ""),
+			Flags:       flags(pkg.Domain.Experimental, pkg.Domain.Deprecated),
+		}
+
+		files := map[string]*template.Template{
+			"cdtp.go":    cdtpTemplate,
+			"command.go": commandTemplate,
+			"event.go":   eventTemplate,
+		}
+		for file, tpl := range files {
+			data := base
+			data.Imports = gen.importSpecs(pkg.Imports[file], nil)
+			if err := render(filepath.Join(pkgDir, file), tpl, data); nil != err {
+				return err
+			}
+		}
+
+		for _, enum := range pkg.Enums {
+			data := base
+			data.Enum = enum
+			if err := render(filepath.Join(pkgDir, enum.File), enumTemplate, data); nil != err {
+				return err
+			}
+			testFile := filepath.Join(pkgDir, strings.TrimSuffix(enum.File, ".go")+"_test.go")
+			if handWritten(testFile) {
+				continue
+			}
+			if err := render(testFile, enumTestTemplate, data); nil != err {
+				return err
+			}
+		}
+
+		var std []string
+		if len(pkg.Commands) > 0 || len(pkg.Events) > 0 {
+			std = append(std, "context")
+		}
+		unmarshals := len(pkg.Events) > 0
+		for _, command := range pkg.Commands {
+			unmarshals = unmarshals || len(command.Returns) > 0
+		}
+		if unmarshals {
+			std = append(std, "encoding/json")
+		}
+		var domain map[*Package]bool
+		if len(pkg.Commands) > 0 || len(pkg.Events) > 0 {
+			domain = map[*Package]bool{pkg: true}
+		}
+
+		name := "cdtp." + strings.Replace(pkg.Path, "/", ".", -1)
+		data := base
+		data.Imports = gen.importSpecs(domain, std)
+		if err := render(filepath.Join(socketDir, name+".go"), socketTemplate, data); nil != err {
+			return err
+		}
+		if nil != domain {
+			test := []string{"encoding/json", "net/url", "testing"}
+			if len(pkg.Events) > 0 {
+				test = append([]string{"context"}, test...)
+			}
+			data.Imports = gen.importSpecs(domain, test)
+			testFile := filepath.Join(socketDir, name+"_test.go")
+			if handWritten(testFile) {
+				continue
+			}
+			if err := render(testFile, socketTestTemplate, data); nil != err {
+				return err
+			}
+		}
+	}
+
+	if gen.selected {
+		return nil
+	}
+	if err := render(filepath.Join(socketDir, "interface.protocoller.go"), protocollerInterfaceTemplate, gen.Packages); nil != err {
+		return err
+	}
+	if err := render(filepath.Join(socketDir, "socket.protocoller.go"), protocollerTemplate, gen.Packages); nil != err {
+		return err
+	}
+	if err := clean(dir); nil != err {
+		return err
+	}
+	return render(filepath.Join(dir, "tab.socket.protocoller.go"), tabProtocollerTemplate, gen)
+}
+
+/*
+importSpecs returns the import specs for a file, standard library packages
+first.
+*/
+func (gen *Generator) importSpecs(pkgs map[*Package]bool, std []string) []string {
+	var result []string
+	for _, path := range std {
+		result = append(result, fmt.Sprintf("%q", path))
+	}
+	var local []string
+	for pkg := range pkgs {
+		spec := fmt.Sprintf("%q", gen.ImportPath+"/"+pkg.Path)
+		if pkg.Alias != pkg.Name {
+			spec = pkg.Alias + " " + spec
+		}
+		local = append(local, spec)
+	}
+	sort.Strings(local)
+	if len(result) > 0 && len(local) > 0 {
+		result = append(result, "")
+	}
+	return append(result, local...)
+}
+
+/*
+render executes a template and writes the formatted source to path.
+*/
+func render(path string, tpl *template.Template, data interface{}) error {
+	buf := &bytes.Buffer{}
+	if err := tpl.Execute(buf, data); nil != err {
+		return errs.Wrap(err, codes.GeneratorWriteFailed, "cannot render '"+path+"'")
+	}
+	// Unformatted source is still written so that it can be inspected.
+	src, fmtErr := format.Source(buf.Bytes())
+	if nil != fmtErr {
+		src = buf.Bytes()
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); nil != err {
+		return errs.Wrap(err, codes.GeneratorWriteFailed, "cannot create directory for '"+path+"'")
+	}
+	if err := ioutil.WriteFile(path, src, 0644); nil != err {
+		return errs.Wrap(err, codes.GeneratorWriteFailed, "cannot write '"+path+"'")
+	}
+	if nil != fmtErr {
+		return errs.Wrap(fmtErr, codes.GeneratorWriteFailed, "cannot format '"+path+"'")
+	}
+	return nil
+}
+
+/*
+handWritten returns whether path is an existing file that was not generated.
+Hand written tests are usually more thorough than the generated ones and are
+kept.
+*/
+func handWritten(path string) bool {
+	src, err := ioutil.ReadFile(path)
+	return nil == err && !bytes.HasPrefix(src, []byte(header))
+}
+
+/*
+clean removes the files generated by a previous run from dir. Hand written
+files are left alone.
+*/
+func clean(dir string) error {
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if nil != err {
+		return errs.Wrap(err, codes.GeneratorWriteFailed, "cannot read directory '"+dir+"'")
+	}
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".go") {
+			continue
+		}
+		path := filepath.Join(dir, file.Name())
+		src, err := ioutil.ReadFile(path)
+		if nil != err {
+			return errs.Wrap(err, codes.GeneratorWriteFailed, "cannot read '"+path+"'")
+		}
+		if !bytes.HasPrefix(src, []byte(header)) {
+			continue
+		}
+		if err := os.Remove(path); nil != err {
+			return errs.Wrap(err, codes.GeneratorWriteFailed, "cannot remove '"+path+"'")
+		}
+	}
+	return nil
+}
@@ -36,6 +36,26 @@ const (
 	ChromeTabNotFound
 	// ChromeVersionQueryFailed - 2008: Chromium version query failed.
 	ChromeVersionQueryFailed
+	// ChromePipeFailed - 2009: Cannot open the debugging pipes.
+	ChromePipeFailed
+	// ChromeProcessExited - 2010: The Chromium process exited unexpectedly.
+	ChromeProcessExited
+	// ChromeRestartFailed - 2011: The Chromium process could not be relaunched.
+	ChromeRestartFailed
+	// ChromeBinaryNotFound - 2012: No usable Chromium binary was found.
+	ChromeBinaryNotFound
+	// ChromeVersionUnsupported - 2013: The Chromium version is too old.
+	ChromeVersionUnsupported
+	// ChromeProfileFailed - 2014: Cannot create the profile directory.
+	ChromeProfileFailed
+	// ChromePoolClosed - 2015: The browser pool is closed.
+	ChromePoolClosed
+	// ChromePoolFailed - 2016: The browser pool could not lease a tab.
+	ChromePoolFailed
+	// ChromeBrowserContextFailed - 2017: A browser context command failed.
+	ChromeBrowserContextFailed
+	// ChromeEndpointInvalid - 2018: The developer tools endpoint URL is invalid.
+	ChromeEndpointInvalid
 )
 
 ////////////////////////////////////////////////////////////////////////////
@@ -46,6 +66,8 @@ const (
 	FlagDoesNotExist std.Code = iota + 3000
 	// FlagTypeInvalid - 3001: Invalid data type for the specified argument.
 	FlagTypeInvalid
+	// FlagUnknown - 3002: The flag name is not known.
+	FlagUnknown
 )
 
 ////////////////////////////////////////////////////////////////////////////
@@ -59,6 +81,22 @@ const (
 	TabURLInvalid
 	// TabWebsocketURLInvalid - 4002: Invalid websocket URL.
 	TabWebsocketURLInvalid
+	// TabDocumentWriteFailed - 4003: Could not write the document.
+	TabDocumentWriteFailed
+	// TabNavigateFailed - 4004: The navigation failed.
+	TabNavigateFailed
+	// TabElementFailed - 4005: The element operation failed.
+	TabElementFailed
+	// TabSelectorInvalid - 4006: The selector is invalid.
+	TabSelectorInvalid
+	// TabWaitTimeout - 4007: Timed out waiting for the element.
+	TabWaitTimeout
+	// TabKeyUnknown - 4008: The key is not known.
+	TabKeyUnknown
+	// TabInputFailed - 4009: The input event could not be dispatched.
+	TabInputFailed
+	// TabDragFailed - 4010: The native drag did not start.
+	TabDragFailed
 )
 
 ////////////////////////////////////////////////////////////////////////////
@@ -83,6 +121,22 @@ const (
 	SocketWriteFailed
 	// SocketPanic - 5003: A panic occurred while reading from a websocket.
 	SocketPanic
+	// SocketSessionAttachFailed - 5009: Could not attach to a target session.
+	SocketSessionAttachFailed
+	// SocketSessionClosed - 5010: The target session has been closed.
+	SocketSessionClosed
+	// SocketSessionNotFound - 5011: Target session not found.
+	SocketSessionNotFound
+	// SocketReconnectFailed - 5012: The socket could not reconnect.
+	SocketReconnectFailed
+	// SocketConnectionLost - 5013: The socket connection was lost.
+	SocketConnectionLost
+	// SocketEventQueueFull - 5014: The event queue is full.
+	SocketEventQueueFull
+	// SocketTargetCrashed - 5015: The target renderer has crashed.
+	SocketTargetCrashed
+	// SocketSessionBufferFull - 5016: The session message buffer is full.
+	SocketSessionBufferFull
 )
 
 ////////////////////////////////////////////////////////////////////////////
@@ -95,6 +149,25 @@ const (
 	WebsocketNotConnected
 	// WebsocketPanic - 5002: A panic occurred while reading from a websocket.
 	WebsocketPanic
+	// WebsocketMessageTooLarge - 6003: The message exceeds the maximum message
+	// size.
+	WebsocketMessageTooLarge
+)
+
+////////////////////////////////////////////////////////////////////////////
+// Generator errors
+////////////////////////////////////////////////////////////////////////////
+const (
+	// GeneratorReadFailed - 7000: Cannot read a protocol definition file.
+	GeneratorReadFailed std.Code = iota + 7000
+	// GeneratorInvalidProtocol - 7001: Invalid protocol definition.
+	GeneratorInvalidProtocol
+	// GeneratorUnknownType - 7002: Unknown protocol type.
+	GeneratorUnknownType
+	// GeneratorWriteFailed - 7003: Cannot write a generated file.
+	GeneratorWriteFailed
+	// GeneratorUnknownDomain - 7004: Unknown protocol domain.
+	GeneratorUnknownDomain
 )
 
 func init() {
@@ -111,19 +184,53 @@ func init() {
 	errs.Codes[ChromeStartTimeout] = errs.ErrCode{Int: "Chromium took too long to start", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[ChromeTabNotFound] = errs.ErrCode{Int: "Chromium tab not found", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[ChromeVersionQueryFailed] = errs.ErrCode{Int: "Chromium version query failed", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromePipeFailed] = errs.ErrCode{Int: "Cannot open the debugging pipes", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeProcessExited] = errs.ErrCode{Int: "The Chromium process exited unexpectedly", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeRestartFailed] = errs.ErrCode{Int: "The Chromium process could not be relaunched", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeBinaryNotFound] = errs.ErrCode{Int: "No usable Chromium binary was found", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeVersionUnsupported] = errs.ErrCode{Int: "The Chromium version is too old", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeProfileFailed] = errs.ErrCode{Int: "Cannot create the profile directory", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromePoolClosed] = errs.ErrCode{Int: "The browser pool is closed", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromePoolFailed] = errs.ErrCode{Int: "The browser pool could not lease a tab", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeBrowserContextFailed] = errs.ErrCode{Int: "A browser context command failed", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeEndpointInvalid] = errs.ErrCode{Int: "The developer tools endpoint URL is invalid", Ext: "An unknown error occurred", HTTP: 500}
 
 	errs.Codes[FlagDoesNotExist] = errs.ErrCode{Int: "The specified argument does not exist", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[FlagTypeInvalid] = errs.ErrCode{Int: "Invalid data type for the specified argument", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[FlagUnknown] = errs.ErrCode{Int: "The flag name is not known", Ext: "An unknown error occurred", HTTP: 500}
 
 	errs.Codes[TabQueryFailed] = errs.ErrCode{Int: "The new tab query failed", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[TabURLInvalid] = errs.ErrCode{Int: "Invalid URL passed to NewTab", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[TabWebsocketURLInvalid] = errs.ErrCode{Int: "Invalid websocket URL", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[TabDocumentWriteFailed] = errs.ErrCode{Int: "Could not write the document", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[TabNavigateFailed] = errs.ErrCode{Int: "The navigation failed", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[TabElementFailed] = errs.ErrCode{Int: "The element operation failed", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[TabSelectorInvalid] = errs.ErrCode{Int: "The selector is invalid", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[TabWaitTimeout] = errs.ErrCode{Int: "Timed out waiting for the element", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[TabKeyUnknown] = errs.ErrCode{Int: "The key is not known", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[TabInputFailed] = errs.ErrCode{Int: "The input event could not be dispatched", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[TabDragFailed] = errs.ErrCode{Int: "The native drag did not start", Ext: "An unknown error occurred", HTTP: 500}
 
 	errs.Codes[SocketCloseFailed] = errs.ErrCode{Int: "A failure occurred while closing a websocket", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[SocketReadFailed] = errs.ErrCode{Int: "A failure occurred while reading from a websocket", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[SocketPanic] = errs.ErrCode{Int: "A panic occurred while reading from a websocket", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketSessionAttachFailed] = errs.ErrCode{Int: "Could not attach to a target session", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketSessionClosed] = errs.ErrCode{Int: "The target session has been closed", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketSessionNotFound] = errs.ErrCode{Int: "Target session not found", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketReconnectFailed] = errs.ErrCode{Int: "The socket could not reconnect", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketConnectionLost] = errs.ErrCode{Int: "The socket connection was lost", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketEventQueueFull] = errs.ErrCode{Int: "The event queue is full", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketTargetCrashed] = errs.ErrCode{Int: "The target renderer has crashed", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketSessionBufferFull] = errs.ErrCode{Int: "The session message buffer is full", Ext: "An unknown error occurred", HTTP: 500}
 
 	errs.Codes[WebsocketConnectFailed] = errs.ErrCode{Int: "Websocket connection failed", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[WebsocketNotConnected] = errs.ErrCode{Int: "Websocket not connected", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[WebsocketPanic] = errs.ErrCode{Int: "A panic occurred while reading from a websocket", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[WebsocketMessageTooLarge] = errs.ErrCode{Int: "The message exceeds the maximum message size", Ext: "An unknown error occurred", HTTP: 500}
+
+	errs.Codes[GeneratorReadFailed] = errs.ErrCode{Int: "Cannot read a protocol definition file", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[GeneratorInvalidProtocol] = errs.ErrCode{Int: "Invalid protocol definition", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[GeneratorUnknownType] = errs.ErrCode{Int: "Unknown protocol type", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[GeneratorWriteFailed] = errs.ErrCode{Int: "Cannot write a generated file", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[GeneratorUnknownDomain] = errs.ErrCode{Int: "Unknown protocol domain", Ext: "An unknown error occurred", HTTP: 500}
 }
@@ -1,5 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package storage
 
+import (
+	"github.com/mkenney/go-chrome/tot/storage"
+)
+
 /*
 DeleteCacheParams represents CacheStorage.deleteCache parameters.
 
@@ -26,7 +32,7 @@ DeleteEntryParams represents CacheStorage.deleteEntry parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#method-deleteEntry
 */
 type DeleteEntryParams struct {
-	// ID of cache where the entry will be deleted.
+	// Id of cache where the entry will be deleted.
 	CacheID CacheID `json:"cacheId"`
 
 	// URL spec of the request.
@@ -49,8 +55,15 @@ RequestCacheNamesParams represents CacheStorage.requestCacheNames parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#method-requestCacheNames
 */
 type RequestCacheNamesParams struct {
-	// Security origin.
-	SecurityOrigin string `json:"securityOrigin"`
+	// Optional. At least and at most one of securityOrigin, storageKey,
+	// storageBucket must be specified. Security origin.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+
+	// Optional. Storage key.
+	StorageKey string `json:"storageKey,omitempty"`
+
+	// Optional. Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *storage.StorageBucket `json:"storageBucket,omitempty"`
 }
 
 /*
@@ -72,11 +85,14 @@ RequestCachedResponseParams represents CacheStorage.requestCachedResponse parame
 https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#method-requestCachedResponse
 */
 type RequestCachedResponseParams struct {
-	// ID of cache that contains the enty.
+	// Id of cache that contains the entry.
 	CacheID CacheID `json:"cacheId"`
 
 	// URL spec of the request.
 	RequestURL string `json:"requestURL"`
+
+	// headers of the request.
+	RequestHeaders []*Header `json:"requestHeaders"`
 }
 
 /*
@@ -101,11 +117,15 @@ type RequestEntriesParams struct {
 	// ID of cache to get entries from.
 	CacheID CacheID `json:"cacheId"`
 
-	// Number of records to skip.
-	SkipCount int `json:"skipCount"`
+	// Optional. Number of records to skip.
+	SkipCount int `json:"skipCount,omitempty"`
+
+	// Optional. Number of records to fetch.
+	PageSize int `json:"pageSize,omitempty"`
 
-	// Number of records to fetch.
-	PageSize int `json:"pageSize"`
+	// Optional. If present, only return the entries containing this substring
+	// in the path.
+	PathFilter string `json:"pathFilter,omitempty"`
 }
 
 /*
@@ -117,8 +137,9 @@ type RequestEntriesResult struct {
 	// Array of object store data entries.
 	CacheDataEntries []*DataEntry `json:"cacheDataEntries"`
 
-	// If true, there are more entries to fetch in the given range.
-	HasMore bool `json:"hasMore"`
+	// Count of returned entries from this storage. If pathFilter is empty, it
+	// is the count of all entries from this storage.
+	ReturnCount float64 `json:"returnCount"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
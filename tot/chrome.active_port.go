@@ -0,0 +1,148 @@
+package chrome
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+activePortFile is the file in the user-data-dir Chromium writes the debugging
+port and the browser websocket path to.
+*/
+const activePortFile = "DevToolsActivePort"
+
+/*
+listeningPattern matches the line Chromium writes to STDERR once the debugging
+endpoints are available.
+*/
+var listeningPattern = regexp.MustCompile(`DevTools listening on (ws://\S+)`)
+
+/*
+DynamicPort returns whether Chromium chooses the debugging port, which is the
+case when the 'remote-debugging-port' flag is set to 0. Launch then reads the
+port from Chromium and DebuggingPort and Port return it, so several instances
+can run side by side.
+*/
+func (chrome *Chrome) DynamicPort() bool {
+	value, err := chrome.Flags().Get("remote-debugging-port")
+	return nil == err && 0 == value
+}
+
+/*
+DebuggingURL implements Chromium.
+
+In dynamic port mode the URL is the one reported by Chromium at launch,
+otherwise it is read from the /json/version endpoint.
+*/
+func (chrome *Chrome) DebuggingURL() string {
+	chrome.mux.Lock()
+	port, path := chrome.activePort, chrome.activePath
+	chrome.mux.Unlock()
+	if "" != path {
+		return fmt.Sprintf("ws://%s:%d%s", chrome.Address(), port, path)
+	}
+	version, err := chrome.Version()
+	if nil != err {
+		return ""
+	}
+	return version.WebSocketDebuggerURL
+}
+
+/*
+setActivePort records the debugging port and browser websocket path reported by
+Chromium.
+*/
+func (chrome *Chrome) setActivePort(port int, path string) {
+	log.WithFields(log.Fields{"path": path, "port": port}).
+		Info("DevTools listening")
+	chrome.mux.Lock()
+	chrome.activePort = port
+	chrome.activePath = path
+	chrome.mux.Unlock()
+}
+
+/*
+waitActivePort waits for Chromium to report the debugging port, either on
+STDERR or in the DevToolsActivePort file. Files written before the process was
+started are ignored.
+*/
+func (chrome *Chrome) waitActivePort(listening <-chan string, exited chan bool, started time.Time) error {
+	timeout := time.After(10 * time.Second)
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case uri := <-listening:
+			port, path, err := parseDebuggingURL(uri)
+			if nil == err {
+				chrome.setActivePort(port, path)
+				return nil
+			}
+			log.WithFields(log.Fields{"error": err, "url": uri}).
+				Warn(err)
+		case <-ticker.C:
+			if port, path, err := chrome.readActivePortFile(started); nil == err {
+				chrome.setActivePort(port, path)
+				return nil
+			}
+		case <-exited:
+			return errs.New(codes.ChromeProcessExited, "chromium exited before reporting the debugging port")
+		case <-timeout:
+			return errs.New(codes.ChromeStartTimeout, "chromium did not report the debugging port")
+		}
+	}
+}
+
+/*
+readActivePortFile reads the debugging port and browser websocket path from the
+DevToolsActivePort file in the user-data-dir.
+*/
+func (chrome *Chrome) readActivePortFile(started time.Time) (int, string, error) {
+	file := filepath.Join(chrome.UserDataDir(), activePortFile)
+	info, err := os.Stat(file)
+	if nil != err {
+		return 0, "", err
+	}
+	if info.ModTime().Before(started.Truncate(time.Second)) {
+		return 0, "", errs.New(codes.ChromeStartTimeout, fmt.Sprintf("'%s' is stale", file))
+	}
+	content, err := ioutil.ReadFile(file)
+	if nil != err {
+		return 0, "", err
+	}
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if 2 != len(lines) {
+		return 0, "", errs.New(codes.ChromeStartTimeout, fmt.Sprintf("'%s' is incomplete", file))
+	}
+	port, err := strconv.Atoi(strings.TrimSpace(lines[0]))
+	if nil != err {
+		return 0, "", err
+	}
+	return port, strings.TrimSpace(lines[1]), nil
+}
+
+/*
+parseDebuggingURL returns the port and path of a browser websocket URL.
+*/
+func parseDebuggingURL(uri string) (int, string, error) {
+	websocketURL, err := url.Parse(uri)
+	if nil != err {
+		return 0, "", errs.Wrap(err, codes.TabWebsocketURLInvalid, fmt.Sprintf("invalid websocket URL '%s'", uri))
+	}
+	port, err := strconv.Atoi(websocketURL.Port())
+	if nil != err {
+		return 0, "", errs.Wrap(err, codes.TabWebsocketURLInvalid, fmt.Sprintf("invalid websocket URL '%s'", uri))
+	}
+	return port, websocketURL.Path, nil
+}
@@ -0,0 +1,92 @@
+package chrome
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testDynamicPort(t *testing.T, script string) (*Chrome, string, func()) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/version", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&Version{Browser: "HeadlessChrome/70.0.3538.77"})
+	})
+	server := httptest.NewServer(mux)
+	_, port, _ := net.SplitHostPort(strings.TrimPrefix(server.URL, "http://"))
+	os.Setenv("GO_CHROME_TEST_PORT", port)
+
+	dir, err := ioutil.TempDir("", "go-chrome-port")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	binary := writeTestBinary(t, dir, "chrome", script)
+	flags := &Flags{}
+	flags.Set("addr", "127.0.0.1")
+	flags.Set("remote-debugging-port", 0)
+	chrome := New(flags, binary, dir, "", filepath.Join(dir, "stderr.log"))
+
+	return chrome, port, func() {
+		os.Unsetenv("GO_CHROME_TEST_PORT")
+		server.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestChromiumDynamicPortStderr(t *testing.T) {
+	chrome, port, cleanup := testDynamicPort(t, `
+echo "DevTools listening on ws://127.0.0.1:$GO_CHROME_TEST_PORT/devtools/browser/stderr-id" >&2
+exec sleep 5`)
+	defer cleanup()
+
+	if !chrome.DynamicPort() {
+		t.Errorf("Expected dynamic port mode")
+	}
+	if err := chrome.Launch(); nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	defer chrome.Close()
+
+	if port != fmt.Sprintf("%d", chrome.DebuggingPort()) || port != fmt.Sprintf("%d", chrome.Port()) {
+		t.Errorf("Expected port %s, got %d and %d", port, chrome.DebuggingPort(), chrome.Port())
+	}
+	expected := "ws://127.0.0.1:" + port + "/devtools/browser/stderr-id"
+	if expected != chrome.DebuggingURL() {
+		t.Errorf("Expected '%s', got '%s'", expected, chrome.DebuggingURL())
+	}
+	if !strings.Contains(chrome.Flags().String(), "--remote-debugging-port=0") {
+		t.Errorf("Expected the port flag to be unchanged, got '%s'", chrome.Flags().String())
+	}
+
+	chrome.Close()
+	output, _ := ioutil.ReadFile(chrome.STDERR())
+	if !strings.Contains(string(output), "DevTools listening on") {
+		t.Errorf("Expected the error output to be copied, got '%s'", output)
+	}
+}
+
+func TestChromiumDynamicPortFile(t *testing.T) {
+	chrome, port, cleanup := testDynamicPort(t, `
+for arg in "$@"; do
+	case "$arg" in --user-data-dir=*) dir="${arg#--user-data-dir=}";; esac
+done
+printf '%s\n/devtools/browser/file-id\n' "$GO_CHROME_TEST_PORT" > "$dir/DevToolsActivePort"
+exec sleep 5`)
+	defer cleanup()
+
+	if err := chrome.Launch(); nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	defer chrome.Close()
+
+	expected := "ws://127.0.0.1:" + port + "/devtools/browser/file-id"
+	if expected != chrome.DebuggingURL() {
+		t.Errorf("Expected '%s', got '%s'", expected, chrome.DebuggingURL())
+	}
+}
@@ -0,0 +1,216 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+BinaryNames lists the executable names FindBinary searches the PATH for, in
+order of preference.
+*/
+var BinaryNames = []string{
+	"google-chrome",
+	"google-chrome-stable",
+	"chromium",
+	"chromium-browser",
+	"headless_shell",
+}
+
+/*
+BinaryPaths lists the well-known Chromium install locations FindBinary checks
+after searching the PATH.
+*/
+var BinaryPaths = []string{
+	"/usr/bin/google-chrome",
+	"/usr/bin/google-chrome-stable",
+	"/opt/google/chrome/chrome",
+	"/usr/bin/chromium",
+	"/usr/bin/chromium-browser",
+	"/usr/lib/chromium/chromium",
+	"/snap/bin/chromium",
+	"/headless-shell/headless-shell",
+}
+
+/*
+versionPattern matches the version number in the --version output, e.g.
+'Google Chrome 70.0.3538.77'.
+*/
+var versionPattern = regexp.MustCompile(`(\d+)\.\d+\.\d+\.\d+`)
+
+/*
+ChromiumBinary describes a Chromium executable.
+*/
+type ChromiumBinary struct {
+	// Path is the path to the executable.
+	Path string
+
+	// Version is the full version number, e.g. '70.0.3538.77'.
+	Version string
+
+	// Major is the major version number, e.g. 70.
+	Major int
+}
+
+/*
+FindBinary searches for a Chromium executable and returns the first candidate
+that reports a major version of at least minVersion. A minVersion of 0 accepts
+any version.
+
+When the $CHROME_PATH environment variable is set it is the only candidate, an
+error is returned if it can not be run or is too old. Otherwise the candidates
+are the BinaryNames found on the PATH and the BinaryPaths, in that order. Each
+candidate is run with the --version flag.
+*/
+func FindBinary(minVersion int) (*ChromiumBinary, error) {
+	if path := os.Getenv("CHROME_PATH"); "" != path {
+		return checkVersion(path, minVersion)
+	}
+
+	candidates := []string{}
+	for _, name := range BinaryNames {
+		if path, err := exec.LookPath(name); nil == err {
+			candidates = append(candidates, path)
+		}
+	}
+	candidates = append(candidates, BinaryPaths...)
+
+	var found *ChromiumBinary
+	checked := map[string]bool{}
+	for _, path := range candidates {
+		if checked[path] {
+			continue
+		}
+		checked[path] = true
+		if info, err := os.Stat(path); nil != err || info.IsDir() {
+			continue
+		}
+		binary, err := ProbeBinary(path)
+		if nil != err {
+			log.WithFields(log.Fields{"error": err, "path": path}).
+				Debug("Skipping Chromium binary")
+			continue
+		}
+		if binary.Major >= minVersion {
+			return binary, nil
+		}
+		if nil == found {
+			found = binary
+		}
+	}
+
+	if nil != found {
+		return nil, errs.New(codes.ChromeVersionUnsupported, fmt.Sprintf(
+			"chromium %d or later is required, found version %s at '%s'",
+			minVersion,
+			found.Version,
+			found.Path,
+		))
+	}
+	return nil, errs.New(codes.ChromeBinaryNotFound, "no chromium binary found, set $CHROME_PATH")
+}
+
+/*
+ProbeBinary runs a Chromium executable with the --version flag and returns its
+version information.
+*/
+func ProbeBinary(path string) (*ChromiumBinary, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	output, err := exec.CommandContext(ctx, path, "--version").Output()
+	if nil != err {
+		return nil, errs.Wrap(err, codes.ChromeBinaryNotFound, fmt.Sprintf("could not run '%s --version'", path))
+	}
+	match := versionPattern.FindStringSubmatch(string(output))
+	if nil == match {
+		return nil, errs.New(codes.ChromeBinaryNotFound, fmt.Sprintf(
+			"'%s' did not report a chromium version: %s",
+			path,
+			strings.TrimSpace(string(output)),
+		))
+	}
+	major, _ := strconv.Atoi(match[1])
+	return &ChromiumBinary{
+		Path:    path,
+		Version: match[0],
+		Major:   major,
+	}, nil
+}
+
+/*
+MinVersion returns the minimum major Chromium version required by Launch, 0 if
+any version is accepted.
+*/
+func (chrome *Chrome) MinVersion() int {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	return chrome.minVersion
+}
+
+/*
+SetMinVersion sets the minimum major Chromium version required by Launch. When
+set, Launch runs the binary with the --version flag and fails if it is older.
+*/
+func (chrome *Chrome) SetMinVersion(major int) {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	chrome.minVersion = major
+}
+
+/*
+resolveBinary finds the binary when none is specified, see FindBinary, and
+verifies the binary meets the minimum version requirement.
+*/
+func (chrome *Chrome) resolveBinary() error {
+	minVersion := chrome.MinVersion()
+	chrome.mux.Lock()
+	path := chrome.binary
+	chrome.mux.Unlock()
+
+	if "" != path {
+		if minVersion <= 0 {
+			return nil
+		}
+		_, err := checkVersion(path, minVersion)
+		return err
+	}
+
+	binary, err := FindBinary(minVersion)
+	if nil != err {
+		return err
+	}
+	chrome.mux.Lock()
+	chrome.foundBinary = binary.Path
+	chrome.mux.Unlock()
+	return nil
+}
+
+/*
+checkVersion probes a binary and verifies it reports a major version of at
+least minVersion.
+*/
+func checkVersion(path string, minVersion int) (*ChromiumBinary, error) {
+	binary, err := ProbeBinary(path)
+	if nil != err {
+		return nil, err
+	}
+	if binary.Major < minVersion {
+		return nil, errs.New(codes.ChromeVersionUnsupported, fmt.Sprintf(
+			"chromium %d or later is required, found version %s at '%s'",
+			minVersion,
+			binary.Version,
+			binary.Path,
+		))
+	}
+	return binary, nil
+}
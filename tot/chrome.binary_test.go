@@ -0,0 +1,124 @@
+package chrome
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+func writeTestBinary(t *testing.T, dir, name, script string) string {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0700); nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	return path
+}
+
+func TestFindBinary(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-chrome-binary")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	defer os.RemoveAll(dir)
+	old := writeTestBinary(t, dir, "chrome-old", `echo "Google Chrome 60.0.3112.113"`)
+	writeTestBinary(t, dir, "headless_shell", `exit 1`)
+	current := writeTestBinary(t, dir, "chromium", `echo "Chromium 70.0.3538.77 built on Debian buster/sid"`)
+
+	defer func(names, paths []string, path, chromePath string) {
+		BinaryNames = names
+		BinaryPaths = paths
+		os.Setenv("PATH", path)
+		os.Setenv("CHROME_PATH", chromePath)
+	}(BinaryNames, BinaryPaths, os.Getenv("PATH"), os.Getenv("CHROME_PATH"))
+	BinaryNames = []string{"headless_shell", "chromium"}
+	BinaryPaths = []string{filepath.Join(dir, "missing")}
+	os.Setenv("PATH", dir)
+	os.Setenv("CHROME_PATH", old)
+
+	binary, err := FindBinary(0)
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	if old != binary.Path || "60.0.3112.113" != binary.Version || 60 != binary.Major {
+		t.Errorf("Expected '%s' version 60.0.3112.113, got '%s' version %s", old, binary.Path, binary.Version)
+	}
+
+	// An explicitly configured binary is never replaced.
+	_, err = FindBinary(65)
+	if nil == err || codes.ChromeVersionUnsupported != err.(errs.Err).Code() {
+		t.Errorf("Expected a ChromeVersionUnsupported error, got '%v'", err)
+	}
+
+	os.Setenv("CHROME_PATH", "")
+	binary, err = FindBinary(65)
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	if current != binary.Path || 70 != binary.Major {
+		t.Errorf("Expected '%s' version 70, got '%s' version %d", current, binary.Path, binary.Major)
+	}
+
+	_, err = FindBinary(80)
+	if nil == err || codes.ChromeVersionUnsupported != err.(errs.Err).Code() {
+		t.Errorf("Expected a ChromeVersionUnsupported error, got '%v'", err)
+	}
+
+	BinaryNames = nil
+	_, err = FindBinary(0)
+	if nil == err || codes.ChromeBinaryNotFound != err.(errs.Err).Code() {
+		t.Errorf("Expected a ChromeBinaryNotFound error, got '%v'", err)
+	}
+}
+
+func TestChromiumLaunchMinVersion(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-chrome-binary")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	defer os.RemoveAll(dir)
+
+	chrome := New(&Flags{}, writeTestBinary(t, dir, "chrome", `echo "Google Chrome 60.0.3112.113"`), dir, "", "")
+	chrome.SetMinVersion(65)
+	err = chrome.Launch()
+	if nil == err || codes.ChromeVersionUnsupported != err.(errs.Err).Code() {
+		t.Errorf("Expected a ChromeVersionUnsupported error, got '%v'", err)
+	}
+}
+
+func TestChromiumLaunchBinaryNotFound(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-chrome-binary")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(names, paths []string, chromePath string) {
+		BinaryNames = names
+		BinaryPaths = paths
+		os.Setenv("CHROME_PATH", chromePath)
+	}(BinaryNames, BinaryPaths, os.Getenv("CHROME_PATH"))
+	BinaryNames = nil
+	BinaryPaths = []string{filepath.Join(dir, "missing")}
+	os.Setenv("CHROME_PATH", "")
+
+	chrome := New(&Flags{}, "", dir, "", "")
+	err = chrome.Launch()
+	if nil == err || codes.ChromeBinaryNotFound != err.(errs.Err).Code() {
+		t.Errorf("Expected a ChromeBinaryNotFound error, got '%v'", err)
+	}
+
+	// A $CHROME_PATH that is too old is not replaced by another binary.
+	BinaryPaths = []string{writeTestBinary(t, dir, "chromium", `echo "Chromium 70.0.3538.77"`)}
+	os.Setenv("CHROME_PATH", writeTestBinary(t, dir, "chrome", `echo "Google Chrome 60.0.3112.113"`))
+	chrome.SetMinVersion(65)
+	err = chrome.Launch()
+	if nil == err || codes.ChromeVersionUnsupported != err.(errs.Err).Code() {
+		t.Errorf("Expected a ChromeVersionUnsupported error, got '%v'", err)
+	}
+	if "/usr/bin/google-chrome" != chrome.Binary() {
+		t.Errorf("Expected no binary to be found, got '%s'", chrome.Binary())
+	}
+}
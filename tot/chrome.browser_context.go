@@ -0,0 +1,167 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/socket"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+BrowserContext is an incognito-like group of tabs. Tabs in different browser
+contexts do not share cookies, storage or cache.
+*/
+type BrowserContext struct {
+	browser *socket.Socket
+	chrome  *Chrome
+	closed  bool
+	id      target.BrowserContextID
+	mux     sync.Mutex
+	tabs    []*Tab
+}
+
+/*
+NewBrowserContext creates a browser context over the browser-level socket
+connection.
+*/
+func (chrome *Chrome) NewBrowserContext(ctx context.Context) (*BrowserContext, error) {
+	browser, err := chrome.BrowserSocket()
+	if nil != err {
+		return nil, err
+	}
+	result := <-browser.Target().CreateBrowserContextContext(ctx)
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.ChromeBrowserContextFailed, "could not create a browser context")
+	}
+	log.WithFields(log.Fields{"browserContextID": result.BrowserContextID}).
+		Debug("Created browser context")
+	return &BrowserContext{
+		browser: browser,
+		chrome:  chrome,
+		id:      result.BrowserContextID,
+	}, nil
+}
+
+/*
+ID returns the browser context ID.
+*/
+func (browserContext *BrowserContext) ID() target.BrowserContextID {
+	return browserContext.id
+}
+
+/*
+NewTab creates a target in the browser context and returns a Tab attached to it,
+see Chrome.NewSessionTab.
+*/
+func (browserContext *BrowserContext) NewTab(ctx context.Context, uri string) (*Tab, error) {
+	if "" == uri {
+		uri = "about:blank"
+	}
+	targetURL, err := url.Parse(uri)
+	if nil != err {
+		return nil, errs.Wrap(err, codes.TabURLInvalid, "invalid URL")
+	}
+
+	browserContext.mux.Lock()
+	closed := browserContext.closed
+	browserContext.mux.Unlock()
+	if closed {
+		return nil, errs.New(codes.ChromeBrowserContextFailed, fmt.Sprintf("browser context '%s' is closed", browserContext.id))
+	}
+
+	result := <-browserContext.browser.Target().CreateTargetContext(ctx, &target.CreateTargetParams{
+		BrowserContextID: browserContext.id,
+		URL:              uri,
+	})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.TabQueryFailed, fmt.Sprintf("could not create target for '%s'", uri))
+	}
+	tab, err := browserContext.chrome.AttachTab(ctx, string(result.ID))
+	if nil != err {
+		return nil, err
+	}
+	tab.mux.Lock()
+	updated := *tab.data
+	updated.URL = uri
+	tab.data = &updated
+	tab.url = targetURL
+	tab.mux.Unlock()
+
+	browserContext.mux.Lock()
+	browserContext.tabs = append(browserContext.tabs, tab)
+	browserContext.mux.Unlock()
+	return tab, nil
+}
+
+/*
+Tabs returns the tabs opened with NewTab that are still open.
+*/
+func (browserContext *BrowserContext) Tabs() []*Tab {
+	browserContext.mux.Lock()
+	defer browserContext.mux.Unlock()
+	tabs := []*Tab{}
+	for _, tab := range browserContext.tabs {
+		if _, err := browserContext.chrome.GetTab(tab.Data().ID); nil == err {
+			tabs = append(tabs, tab)
+		}
+	}
+	return tabs
+}
+
+/*
+Targets lists all targets in the browser context, including targets opened by
+the pages themselves.
+*/
+func (browserContext *BrowserContext) Targets(ctx context.Context) ([]*target.Info, error) {
+	result := <-browserContext.browser.Target().GetTargetsContext(ctx, &target.GetTargetsParams{})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.ChromeBrowserContextFailed, "could not list the targets")
+	}
+	infos := []*target.Info{}
+	for _, info := range result.Infos {
+		if browserContext.id == info.BrowserContextID {
+			infos = append(infos, info)
+		}
+	}
+	return infos, nil
+}
+
+/*
+Close closes the tabs in the browser context and disposes it. Disposing the
+context also closes targets the pages opened themselves.
+*/
+func (browserContext *BrowserContext) Close(ctx context.Context) error {
+	browserContext.mux.Lock()
+	if browserContext.closed {
+		browserContext.mux.Unlock()
+		return nil
+	}
+	browserContext.closed = true
+	tabs := browserContext.tabs
+	browserContext.tabs = nil
+	browserContext.mux.Unlock()
+
+	for _, tab := range tabs {
+		if _, err := browserContext.chrome.GetTab(tab.Data().ID); nil != err {
+			continue
+		}
+		if _, err := tab.Close(); nil != err {
+			log.WithFields(log.Fields{"error": err, "id": tab.Data().ID}).
+				Warn(err)
+		}
+	}
+
+	result := <-browserContext.browser.Target().DisposeBrowserContextContext(ctx, &target.DisposeBrowserContextParams{
+		BrowserContextID: browserContext.id,
+	})
+	if nil != result.Err {
+		return errs.Wrap(result.Err, codes.ChromeBrowserContextFailed, fmt.Sprintf("could not dispose browser context '%s'", browserContext.id))
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package chrome
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+func TestBrowserContext(t *testing.T) {
+	chrome := New(
+		&Flags{"remote-debugging-pipe": nil},
+		"", //"path/to/chrome",
+		"", //"path/to/stderr",
+		"", //"path/to/stdout",
+		"", //"path/to/workdir",
+	)
+	browser, payloads := newPipeBrowser(map[string]string{
+		"Target.createBrowserContext": `{"browserContextId":"context-1"}`,
+		"Target.createTarget":         `{"targetId":"target-1"}`,
+		"Target.attachToTarget":       `{"sessionId":"session-1"}`,
+		"Target.getTargets": `{"targetInfos":[
+			{"targetId":"target-1","type":"page","title":"","url":"about:blank","browserContextId":"context-1"},
+			{"targetId":"target-2","type":"page","title":"","url":"about:blank","browserContextId":"context-2"}
+		]}`,
+		"Target.closeTarget": `{"success":true}`,
+	})
+	chrome.browser = browser
+	defer chrome.Close()
+
+	browserContext, err := chrome.NewBrowserContext(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if "context-1" != browserContext.ID() {
+		t.Errorf("Expected browser context 'context-1', got '%s'", browserContext.ID())
+	}
+
+	tab, err := browserContext.NewTab(context.Background(), "https://www.example.com/")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	// The tab enables the Page domain through its session to track its URL,
+	// browser commands received in the meantime are checked below.
+	received := []*socket.Payload{}
+	timeout := time.After(time.Second)
+	for pageEnabled := false; !pageEnabled; {
+		select {
+		case payload := <-payloads:
+			if "" == payload.SessionID {
+				received = append(received, payload)
+			}
+			pageEnabled = "Page.enable" == payload.Method && "session-1" == payload.SessionID
+		case <-timeout:
+			t.Fatalf("Expected the Page domain to be enabled on 'session-1'")
+		}
+	}
+	next := func() *socket.Payload {
+		for {
+			var payload *socket.Payload
+			if 0 < len(received) {
+				payload, received = received[0], received[1:]
+			} else {
+				payload = <-payloads
+			}
+			if "" == payload.SessionID {
+				return payload
+			}
+		}
+	}
+
+	if "https://www.example.com/" != tab.URL().String() {
+		t.Errorf("Expected 'https://www.example.com/', got '%s'", tab.URL())
+	}
+	if tabs := browserContext.Tabs(); 1 != len(tabs) || tab != tabs[0] {
+		t.Errorf("Expected the tab to be listed, got %v", tabs)
+	}
+
+	targets, err := browserContext.Targets(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if 1 != len(targets) || "target-1" != targets[0].ID {
+		t.Errorf("Expected target 'target-1', got %v", targets)
+	}
+
+	if err := browserContext.Close(context.Background()); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+	if 0 != len(chrome.Tabs()) {
+		t.Errorf("Expected no tabs, got %d", len(chrome.Tabs()))
+	}
+	if _, err := browserContext.NewTab(context.Background(), ""); nil == err {
+		t.Errorf("Expected an error after Close")
+	}
+
+	for _, expected := range []string{
+		"Target.createBrowserContext",
+		"Target.createTarget",
+		"Target.attachToTarget",
+		"Target.getTargets",
+		"Target.closeTarget",
+		"Target.disposeBrowserContext",
+	} {
+		payload := next()
+		if expected != payload.Method {
+			t.Errorf("Expected '%s', got '%s'", expected, payload.Method)
+		}
+		if "Target.createTarget" == payload.Method || "Target.disposeBrowserContext" == payload.Method {
+			params, _ := payload.Params.(map[string]interface{})
+			if "context-1" != params["browserContextId"] {
+				t.Errorf("Expected '%s' to target 'context-1', got %v", payload.Method, payload.Params)
+			}
+		}
+	}
+}
@@ -1,18 +1,21 @@
 package chrome
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
 	errs "github.com/bdlm/errors"
 	"github.com/bdlm/log"
 	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/socket"
 )
 
 /*
@@ -41,9 +44,22 @@ type Chrome struct {
 	// flags stores CLI arguments for the Chromium binary.
 	flags ChromiumFlags
 
-	// Optional. binary is the path to the Chromium binary. Defaults to
-	// '/usr/bin/google-chrome'.
-	binary string
+	// activePath and activePort are the browser websocket path and the
+	// debugging port reported by Chromium in dynamic port mode.
+	activePath string
+	activePort int
+
+	// Optional. binary is the path to the Chromium binary. When empty Launch
+	// uses FindBinary and stores the result in foundBinary.
+	binary      string
+	foundBinary string
+
+	// browser is the browser-level socket connection, opened on first use.
+	browser *socket.Socket
+
+	// Optional. endpoint is the client for the developer tools HTTP
+	// endpoints. Defaults to http://Address():Port().
+	endpoint *Endpoint
 
 	// Optional. port is the port number the developer tools endpoints will
 	// listen on. Defaults to 9222.
@@ -75,21 +91,52 @@ type Chrome struct {
 	// output.
 	stdOUTFile *os.File
 
+	// Optional. minVersion is the minimum major Chromium version required by
+	// Launch.
+	minVersion int
+
+	// profileDir is the temporary profile directory created by Launch,
+	// profileTemplate is copied into it.
+	profileDir      string
+	profileTemplate string
+
 	// process is a pointer to the os.Process struct containing the process PID.
 	process *os.Process
+
+	// Process supervision. closing is set while Close stops the process,
+	// exited is closed when the process exits.
+	closing       bool
+	exitErr       error
+	exitState     *os.ProcessState
+	exited        chan bool
+	mux           sync.Mutex
+	restartPolicy *RestartPolicy
+	restarts      int
+	watchers      []ProcessWatcher
+
+	// shutdownStage is the step of the shutdown sequence that stopped the
+	// process, shutdownTimeouts configures the sequence.
+	shutdownStage    ShutdownStage
+	shutdownTimeouts ShutdownTimeouts
+
+	// logOutput enables the output parser, outputLog keeps the recent output
+	// lines of the process.
+	logOutput bool
+	outputLog *outputLog
 }
 
 /*
 Address implements Chromium.
 
-Default value is 'localhost'
+Default value is 'localhost'. A value that is not a string is replaced by the
+default, Launch and Connect fail with a FlagTypeInvalid error.
 */
 func (chrome *Chrome) Address() string {
 	if !chrome.Flags().Has("addr") {
 		chrome.Flags().Set("addr", "localhost")
 	}
-	value, _ := chrome.Flags().Get("addr")
-	return value.(string)
+	value, _ := stringFlag(chrome.Flags(), "addr", "localhost")
+	return value
 }
 
 /*
@@ -102,63 +149,149 @@ func (chrome *Chrome) Flags() ChromiumFlags {
 /*
 Binary implements Chromium.
 
-Default value is '/usr/bin/google-chrome' for use with the mkenney/chromium-headless
-Docker image.
+When no binary is specified Launch uses the binary found by FindBinary. Until
+then the default value '/usr/bin/google-chrome' is returned, for use with the
+mkenney/chromium-headless Docker image.
 */
 func (chrome *Chrome) Binary() string {
-	if "" == chrome.binary {
-		chrome.binary = "/usr/bin/google-chrome"
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	if "" != chrome.binary {
+		return chrome.binary
+	}
+	if "" != chrome.foundBinary {
+		return chrome.foundBinary
+	}
+	return "/usr/bin/google-chrome"
+}
+
+/*
+BrowserSocket returns the browser-level socket connection, connecting to the
+Version.WebSocketDebuggerURL endpoint on first use. Target sessions attached
+through the browser socket share its connection.
+*/
+func (chrome *Chrome) BrowserSocket() (*socket.Socket, error) {
+	chrome.mux.Lock()
+	browser := chrome.browser
+	chrome.mux.Unlock()
+	if nil != browser {
+		return browser, nil
+	}
+
+	version, err := chrome.Version()
+	if nil != err {
+		return nil, err
+	}
+	endpoint := chrome.Endpoint()
+	websocketURL, err := endpoint.WebsocketURL(version.WebSocketDebuggerURL)
+	if nil != err {
+		return nil, err
 	}
-	return chrome.binary
+	browser = socket.NewWithOptions(websocketURL, endpoint.WebsocketOptions())
+
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	if nil == chrome.browser {
+		chrome.browser = browser
+	}
+	return chrome.browser, nil
+}
+
+/*
+Pipe returns whether Chromium is driven over the --remote-debugging-pipe
+interface instead of a TCP debugging port. Pipe mode is enabled by setting the
+'remote-debugging-pipe' flag.
+
+In pipe mode the HTTP endpoints are unavailable: the browser socket is opened by
+Launch, Version queries the browser socket and NewTab creates session tabs.
+*/
+func (chrome *Chrome) Pipe() bool {
+	return chrome.Flags().Has("remote-debugging-pipe")
 }
 
 /*
 Close implements Chromium.
 */
 func (chrome *Chrome) Close() error {
-	if chrome.process != nil {
+	chrome.mux.Lock()
+	chrome.closing = true
+	process := chrome.process
+	exited := chrome.exited
+	chrome.mux.Unlock()
+
+	if process != nil {
+		// The tabs are closed with the browser.
 		for _, tab := range chrome.Tabs() {
-			tab.Close()
+			tab.disconnect()
 		}
-		if err := chrome.process.Signal(os.Interrupt); err != nil {
-			return errs.Wrap(err, codes.ChromeSigintFailed, "chrome process interrupt failed")
+		stage, err := chrome.shutdown(process, exited)
+		chrome.mux.Lock()
+		ps := chrome.exitState
+		chrome.shutdownStage = stage
+		chrome.process = nil
+		chrome.exited = nil
+		chrome.tabs = nil
+		if nil == err {
+			err = chrome.exitErr
 		}
-		ps, err := chrome.process.Wait()
+		chrome.mux.Unlock()
 		if err != nil {
+			chrome.stopBrowser()
+			chrome.closeOutput()
+			chrome.removeProfile()
 			return errs.Wrap(err, codes.ChromeExitTimeout, "error waiting for process exit, result unknown")
 		}
 		log.WithFields(log.Fields{
 			"signal": ps.String(),
+			"stage":  stage.String(),
 		}).Info("Chromium exited")
+	} else {
+		// The targets of a connected instance are left open.
+		for _, tab := range chrome.Tabs() {
+			tab.disconnect()
+		}
+		chrome.mux.Lock()
+		chrome.tabs = nil
+		chrome.mux.Unlock()
 	}
-	if chrome.stdOUTFile != nil {
-		chrome.stdOUTFile.Close()
-	}
+	chrome.stopBrowser()
+	chrome.closeOutput()
+	chrome.removeProfile()
 	return nil
 }
 
 /*
 DebuggingAddress implements Chromium.
 
-Default value is '0.0.0.0'.
+Default value is '0.0.0.0'. A value that is not a string is replaced by the
+default, Launch fails with a FlagTypeInvalid error.
 */
 func (chrome *Chrome) DebuggingAddress() string {
 	if !chrome.Flags().Has("remote-debugging-address") {
 		chrome.Flags().Set("remote-debugging-address", "0.0.0.0")
 	}
-	value, _ := chrome.Flags().Get("remote-debugging-address")
-	return value.(string)
+	value, _ := stringFlag(chrome.Flags(), "remote-debugging-address", "0.0.0.0")
+	return value
 }
 
 /*
 DebuggingPort implements Chromium.
+
+Default value is 9222. In dynamic port mode the port reported by Chromium is
+returned, see DynamicPort. A value that is not an integer is replaced by the
+default, Launch and Connect fail with a FlagTypeInvalid error.
 */
 func (chrome *Chrome) DebuggingPort() int {
 	if !chrome.Flags().Has("remote-debugging-port") {
 		chrome.Flags().Set("remote-debugging-port", 9222)
 	}
-	value, _ := chrome.Flags().Get("remote-debugging-port")
-	return value.(int)
+	value, _ := intFlag(chrome.Flags(), "remote-debugging-port", 9222)
+	if 0 == value {
+		chrome.mux.Lock()
+		defer chrome.mux.Unlock()
+		return chrome.activePort
+	}
+	return value
 }
 
 /*
@@ -186,26 +319,64 @@ they aren't included in the Flags definition:
 	remote-debugging-address = "0.0.0.0"
 	remote-debugging-port = 9222
 	port = 9222
-	user-data-dir = os.TempDir() + chrome.Workdir()
 	chrome.workdir = "headless-chrome"
 	chrome.output = "/dev/stdout"
+
+Unless the 'user-data-dir' flag is set, each launch uses a temporary profile
+directory in Workdir() that is removed by Close, see SetProfileTemplate.
+
+When the 'remote-debugging-pipe' flag is set no debugging port is opened, the
+address and port defaults are skipped and the browser socket communicates with
+Chromium over file descriptors 3 and 4.
+
+When the 'remote-debugging-port' flag is set to 0 Chromium chooses the port,
+which is read from its STDERR output or from the DevToolsActivePort file in the
+user-data-dir, see DynamicPort.
+
+When no binary is specified it is found by FindBinary, Launch fails with a
+ChromeBinaryNotFound error if there is none. When a MinVersion is set the
+version of the binary is checked before the process is started.
 */
 func (chrome *Chrome) Launch() error {
 	var err error
 
+	chrome.mux.Lock()
+	chrome.closing = false
+	chrome.activePath = ""
+	chrome.activePort = 0
+	chrome.mux.Unlock()
+
+	if err = chrome.checkFlags(); nil != err {
+		return err
+	}
+
+	// Fail fast if there is no binary or it is too old to drive.
+	if err = chrome.resolveBinary(); nil != err {
+		return err
+	}
+
 	// Default values for required parameters
-	chrome.Address()
-	chrome.DebuggingAddress()
-	chrome.DebuggingPort()
-	chrome.Port()
-	if !chrome.Flags().Has("user-data-dir") {
-		chrome.Flags().Set("user-data-dir", os.TempDir())
+	if !chrome.Pipe() {
+		chrome.Address()
+		chrome.DebuggingAddress()
+		chrome.DebuggingPort()
+		chrome.Port()
 	}
 
 	if err = os.MkdirAll(chrome.Workdir(), 0700); err != nil {
 		return errs.Wrap(err, codes.ChromeInvalidWorkdir, fmt.Sprintf("cannot create working directory '%s'", chrome.Workdir()))
 	}
 
+	// The first argument is the program name.
+	args := append([]string{chrome.Binary()}, chrome.Flags().List()...)
+	if !chrome.Flags().Has("user-data-dir") {
+		profile, err := chrome.createProfile()
+		if nil != err {
+			return err
+		}
+		args = append(args, fmt.Sprintf("--user-data-dir=%s", profile))
+	}
+
 	if "" == chrome.STDERR() {
 		chrome.stdERRFile = os.Stderr
 	} else {
@@ -215,6 +386,7 @@ func (chrome *Chrome) Launch() error {
 			0600,
 		)
 		if err != nil {
+			chrome.removeProfile()
 			return errs.Wrap(err, codes.ChromeCannotOpenStderr, fmt.Sprintf("cannot open error output file '%s'", chrome.STDERR()))
 		}
 	}
@@ -228,6 +400,7 @@ func (chrome *Chrome) Launch() error {
 			0600,
 		)
 		if err != nil {
+			chrome.removeProfile()
 			return errs.Wrap(err, codes.ChromeCannotOpenStdout, fmt.Sprintf("cannot open standard output file '%s'", chrome.STDOUT()))
 		}
 	}
@@ -238,28 +411,111 @@ func (chrome *Chrome) Launch() error {
 	}).Info("Starting process")
 	var procAttributes os.ProcAttr
 	procAttributes.Dir = chrome.Workdir()
+	procAttributes.Sys = processAttributes()
 	procAttributes.Files = []*os.File{nil, chrome.stdOUTFile, chrome.stdERRFile}
-	chrome.process, err = os.StartProcess(
+
+	// The output is parsed when it is logged, and in dynamic port mode where
+	// Chromium reports the debugging URL on STDERR.
+	var listening <-chan string
+	var outputPipes []*os.File
+	logOutput := chrome.LogOutput()
+	var output *outputLog
+	if logOutput || (chrome.DynamicPort() && !chrome.Pipe()) {
+		output = newOutputLog(logOutput)
+		streams := map[int]string{2: "stderr"}
+		if logOutput {
+			streams[1] = "stdout"
+		}
+		for fd, stream := range streams {
+			pipe, err := output.pipe(stream, procAttributes.Files[fd])
+			if nil != err {
+				for _, pipe := range outputPipes {
+					pipe.Close()
+				}
+				chrome.closeOutput()
+				chrome.removeProfile()
+				return err
+			}
+			procAttributes.Files[fd] = pipe
+			outputPipes = append(outputPipes, pipe)
+		}
+		if !chrome.Pipe() {
+			listening = output.listening
+		}
+	}
+	chrome.mux.Lock()
+	chrome.outputLog = output
+	chrome.mux.Unlock()
+
+	// In pipe mode Chromium reads commands from fd 3 and writes responses
+	// to fd 4.
+	var pipes []*os.File
+	if chrome.Pipe() {
+		if pipes, err = openPipes(); nil != err {
+			chrome.closeOutput()
+			chrome.removeProfile()
+			return err
+		}
+		procAttributes.Files = append(procAttributes.Files, pipes[0], pipes[3])
+	}
+
+	started := time.Now()
+	process, err := os.StartProcess(
 		chrome.Binary(),
-		chrome.Flags().List(),
+		args,
 		&procAttributes,
 	)
+	// The write ends belong to the Chromium process.
+	for _, pipe := range outputPipes {
+		pipe.Close()
+	}
+	if nil != pipes {
+		// The child ends belong to the Chromium process.
+		pipes[0].Close()
+		pipes[3].Close()
+	}
 	if nil != err {
-		chrome.stdOUTFile.Close()
+		if nil != pipes {
+			pipes[1].Close()
+			pipes[2].Close()
+		}
+		chrome.closeOutput()
+		chrome.removeProfile()
 		return errs.Wrap(err, codes.ChromeCannotOpenStdout, "error starting chrome")
 	}
 
-	// Wait up to 10 seconds for Chromium to start
-	for i := 0; i < 10; i++ {
-		time.Sleep(time.Second)
-		if _, err = chrome.Version(); nil == err {
-			break
+	// The supervisor reports unexpected exits and applies the restart policy.
+	exited := make(chan bool)
+	chrome.mux.Lock()
+	chrome.process = process
+	chrome.exited = exited
+	chrome.mux.Unlock()
+	go chrome.supervise(process, exited)
+
+	if chrome.Pipe() {
+		chrome.browser = socket.NewPipeSocket(pipes[2], pipes[1])
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		_, err = chrome.pipeVersion(ctx)
+		cancel()
+	} else if nil != listening {
+		if err = chrome.waitActivePort(listening, exited, started); nil == err {
+			_, err = chrome.Version()
+		}
+	} else {
+		// Wait up to 10 seconds for Chromium to start
+		for i := 0; i < 10; i++ {
+			time.Sleep(time.Second)
+			if _, err = chrome.Version(); nil == err {
+				break
+			}
 		}
 	}
 	if err != nil {
 		log.Error("Chromium took too long to start")
+		// The output is read before Close closes the output files.
+		tail := chrome.outputTail()
 		chrome.Close()
-		return errs.Wrap(err, codes.ChromeStartTimeout, "chromium took too long to start")
+		return errs.Wrap(err, codes.ChromeStartTimeout, fmt.Sprintf("chromium took too long to start%s", tail))
 	}
 
 	return nil
@@ -268,51 +524,54 @@ func (chrome *Chrome) Launch() error {
 /*
 Port implements Chromium.
 
-Default value is 9222
+Default value is 9222. In dynamic port mode the port defaults to the debugging
+port reported by Chromium, see DynamicPort. A value that is not an integer is
+replaced by the default, Launch and Connect fail with a FlagTypeInvalid error.
 */
 func (chrome *Chrome) Port() int {
 	if !chrome.Flags().Has("port") {
+		if chrome.DynamicPort() {
+			return chrome.DebuggingPort()
+		}
 		chrome.Flags().Set("port", 9222)
 	}
-	value, _ := chrome.Flags().Get("port")
-	return value.(int)
+	value, _ := intFlag(chrome.Flags(), "port", 9222)
+	if 0 == value {
+		return chrome.DebuggingPort()
+	}
+	return value
 }
 
 /*
 Query implements Chromium.
+
+The request is sent with the Endpoint client. Responses that are not JSON, such
+as the message returned by /json/close, are ChromeQueryFailed errors, use the
+Endpoint methods for these endpoints.
 */
 func (chrome *Chrome) Query(
 	path string,
 	params url.Values,
 	msg interface{}, // Data receiver
 ) (interface{}, error) {
-	if len(params) > 0 {
-		path += fmt.Sprintf("?%s", params.Encode())
+	rawQuery := ""
+	if k := strings.Index(path, "?"); k >= 0 {
+		path, rawQuery = path[:k], path[k+1:]
 	}
-
-	uri := fmt.Sprintf("http://%s:%d%s", chrome.Address(), chrome.Port(), path)
-	resp, err := http.Get(uri)
-	if err != nil {
-		return nil, errs.Wrap(err, codes.ChromeQueryFailed, "get uri failed")
+	if len(params) > 0 {
+		if "" != rawQuery {
+			rawQuery += "&"
+		}
+		rawQuery += params.Encode()
 	}
-	defer resp.Body.Close()
 
-	log.WithFields(log.Fields{
-		"path":   path,
-		"status": resp.Status,
-	}).Debug("querying chrome")
-	if 200 != resp.StatusCode {
-		return nil, errs.New(codes.ChromeQueryFailed, resp.Status)
+	content, err := chrome.Endpoint().Do(context.Background(), http.MethodGet, path, rawQuery)
+	if nil != err {
+		return nil, err
 	}
-
-	content, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, errs.Wrap(err, codes.ChromeQueryFailed, "read failed")
-	} else if err := json.Unmarshal(content, &msg); err != nil {
-		// it's not JSON so just return it
-		return content, nil
+	if err := json.Unmarshal(content, &msg); nil != err {
+		return nil, errs.Wrap(err, codes.ChromeQueryFailed, fmt.Sprintf("invalid JSON response from %s: %s", path, strings.TrimSpace(string(content))))
 	}
-
 	return msg, nil
 }
 
@@ -320,14 +579,30 @@ func (chrome *Chrome) Query(
 RemoveTab implements Chromium.
 */
 func (chrome *Chrome) RemoveTab(tab *Tab) {
-	tabs := make([]*Tab, 0)
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
 	for k, t := range chrome.tabs {
 		if t == tab {
 			chrome.tabs = append(chrome.tabs[:k], chrome.tabs[k+1:]...)
 			break
 		}
 	}
-	chrome.tabs = tabs
+}
+
+/*
+addTab adds a tab to the tab list. A tab adopted for the same target by Connect
+is replaced.
+*/
+func (chrome *Chrome) addTab(tab *Tab) {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	for k, t := range chrome.tabs {
+		if t.Data().ID == tab.Data().ID {
+			chrome.tabs[k] = tab
+			return
+		}
+	}
+	chrome.tabs = append(chrome.tabs, tab)
 }
 
 /*
@@ -348,21 +623,27 @@ func (chrome *Chrome) STDOUT() string {
 Tabs implements Chromium.
 */
 func (chrome *Chrome) Tabs() []*Tab {
-	return chrome.tabs
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	if nil == chrome.tabs {
+		return nil
+	}
+	return append([]*Tab{}, chrome.tabs...)
 }
 
 /*
 Version implements Chromium.
 */
 func (chrome *Chrome) Version() (*Version, error) {
+	if chrome.Pipe() {
+		return chrome.pipeVersion(context.Background())
+	}
 	if nil == chrome.version {
-		if _, err := chrome.Query(
-			"/json/version",
-			url.Values{},
-			&chrome.version,
-		); err != nil {
+		version, err := chrome.Endpoint().Version(context.Background())
+		if nil != err {
 			return nil, errs.Wrap(err, codes.ChromeVersionQueryFailed, "version query failed")
 		}
+		chrome.version = version
 	}
 	return chrome.version, nil
 }
@@ -378,3 +659,72 @@ func (chrome *Chrome) Workdir() string {
 	}
 	return chrome.workdir
 }
+
+/*
+stopBrowser closes the browser-level socket connection.
+*/
+func (chrome *Chrome) stopBrowser() {
+	chrome.mux.Lock()
+	browser := chrome.browser
+	chrome.browser = nil
+	chrome.mux.Unlock()
+	if nil != browser {
+		browser.Stop()
+	}
+}
+
+/*
+closeOutput closes the STDOUT and STDERR capture files. The system STDOUT and
+STDERR are left open.
+*/
+func (chrome *Chrome) closeOutput() {
+	if nil != chrome.stdOUTFile && os.Stdout != chrome.stdOUTFile {
+		chrome.stdOUTFile.Close()
+	}
+	if nil != chrome.stdERRFile && os.Stderr != chrome.stdERRFile {
+		chrome.stdERRFile.Close()
+	}
+}
+
+/*
+openPipes returns the debugging pipes for a Chromium process: the read and
+write ends of the command pipe followed by the read and write ends of the
+response pipe.
+*/
+func openPipes() ([]*os.File, error) {
+	cmdReader, cmdWriter, err := os.Pipe()
+	if nil != err {
+		return nil, errs.Wrap(err, codes.ChromePipeFailed, "cannot open the debugging command pipe")
+	}
+	respReader, respWriter, err := os.Pipe()
+	if nil != err {
+		cmdReader.Close()
+		cmdWriter.Close()
+		return nil, errs.Wrap(err, codes.ChromePipeFailed, "cannot open the debugging response pipe")
+	}
+	return []*os.File{cmdReader, cmdWriter, respReader, respWriter}, nil
+}
+
+/*
+pipeVersion queries the version data over the browser socket, the HTTP
+endpoints are not available in pipe mode.
+*/
+func (chrome *Chrome) pipeVersion(ctx context.Context) (*Version, error) {
+	if nil == chrome.version {
+		if nil == chrome.browser {
+			return nil, errs.New(codes.ChromeVersionQueryFailed, "version query failed, the debugging pipe is not open")
+		}
+		result := <-chrome.browser.Browser().GetVersionContext(ctx)
+		if nil != result.Err {
+			return nil, errs.Wrap(result.Err, codes.ChromeVersionQueryFailed, "version query failed")
+		}
+		chrome.version = &Version{
+			Browser:         result.Product,
+			ProtocolVersion: result.ProtocolVersion,
+			UserAgent:       result.UserAgent,
+			V8Version:       result.JSVersion,
+			WebKitVersion:   result.Revision,
+		}
+	}
+	return chrome.version, nil
+}
@@ -98,3 +98,53 @@ String implements ChromiumFlags
 func (flags Flags) String() string {
 	return strings.Join(flags.List(), " ")
 }
+
+/*
+checkFlags verifies the flags read by Address, DebuggingAddress, DebuggingPort
+and Port have the expected type.
+*/
+func (chrome *Chrome) checkFlags() error {
+	for _, name := range []string{"addr", "remote-debugging-address"} {
+		if _, err := stringFlag(chrome.Flags(), name, ""); nil != err {
+			return err
+		}
+	}
+	for _, name := range []string{"port", "remote-debugging-port"} {
+		if _, err := intFlag(chrome.Flags(), name, 0); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+intFlag returns the value of an integer flag, defaultValue if the flag is not
+set or is not an integer.
+*/
+func intFlag(flags ChromiumFlags, name string, defaultValue int) (int, error) {
+	value, err := flags.Get(name)
+	if nil != err {
+		return defaultValue, nil
+	}
+	number, ok := value.(int)
+	if !ok {
+		return defaultValue, errs.New(codes.FlagTypeInvalid, fmt.Sprintf("Invalid data type '%T' for argument %s: %+v, expected an integer", value, name, value))
+	}
+	return number, nil
+}
+
+/*
+stringFlag returns the value of a string flag, defaultValue if the flag is not
+set or is not a string.
+*/
+func stringFlag(flags ChromiumFlags, name string, defaultValue string) (string, error) {
+	value, err := flags.Get(name)
+	if nil != err {
+		return defaultValue, nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return defaultValue, errs.New(codes.FlagTypeInvalid, fmt.Sprintf("Invalid data type '%T' for argument %s: %+v, expected a string", value, name, value))
+	}
+	return str, nil
+}
@@ -1,10 +1,21 @@
 package chrome
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/socket"
 )
 
 func TestChromiumNew(t *testing.T) {
@@ -80,6 +91,65 @@ func TestChromiumLaunch(t *testing.T) {
 	}
 }
 
+func TestChromiumLaunchFlagType(t *testing.T) {
+	chrome := New(
+		NewLaunchOptions().Bool("remote-debugging-port", true),
+		"", //"path/to/chrome",
+		"", //"path/to/stderr",
+		"", //"path/to/stdout",
+		"", //"path/to/workdir",
+	)
+	if 9222 != chrome.DebuggingPort() {
+		t.Errorf("Expected 9222, received '%d'", chrome.DebuggingPort())
+	}
+	if 9222 != chrome.Port() {
+		t.Errorf("Expected 9222, received '%d'", chrome.Port())
+	}
+	err := chrome.Launch()
+	if nil == err || codes.FlagTypeInvalid != err.(errs.Err).Code() {
+		t.Errorf("Expected a FlagTypeInvalid error, got '%v'", err)
+	}
+	err = chrome.Connect(context.Background())
+	if nil == err || codes.FlagTypeInvalid != err.(errs.Err).Code() {
+		t.Errorf("Expected a FlagTypeInvalid error, got '%v'", err)
+	}
+
+	chrome = New(&Flags{"addr": 1}, "", "", "", "")
+	if "localhost" != chrome.Address() {
+		t.Errorf("Expected 'localhost', received '%s'", chrome.Address())
+	}
+	err = chrome.Launch()
+	if nil == err || codes.FlagTypeInvalid != err.(errs.Err).Code() {
+		t.Errorf("Expected a FlagTypeInvalid error, got '%v'", err)
+	}
+}
+
+func TestChromiumLaunchArgs(t *testing.T) {
+	chrome, _, cleanup := testDynamicPort(t, `
+for arg in "$0" "$@"; do echo "$arg"; done > "$(dirname "$0")/args"
+exit 1`)
+	defer cleanup()
+	chrome.Flags().Set("headless", nil)
+
+	if err := chrome.Launch(); nil == err {
+		t.Fatalf("Expected an error, got nil")
+	}
+	output, err := ioutil.ReadFile(filepath.Join(filepath.Dir(chrome.Binary()), "args"))
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+
+	// The program name is not taken from the flags.
+	args := strings.Split(strings.TrimSpace(string(output)), "\n")
+	if chrome.Binary() != args[0] {
+		t.Errorf("Expected the program name '%s', got '%s'", chrome.Binary(), args[0])
+	}
+	expected := chrome.Flags().List()
+	if len(args) < len(expected)+1 || fmt.Sprintf("%v", expected) != fmt.Sprintf("%v", args[1:len(expected)+1]) {
+		t.Errorf("Expected the arguments %v, got %v", expected, args[1:])
+	}
+}
+
 func TestChromiumQuery(t *testing.T) {
 	chrome := New(
 		&Flags{
@@ -117,6 +187,19 @@ func TestChromiumTabs(t *testing.T) {
 	}
 }
 
+func TestChromiumRemoveTab(t *testing.T) {
+	chrome := New(&Flags{}, "", "", "", "")
+	tab1 := &Tab{chrome: chrome, data: &TabData{ID: "tab-1"}}
+	tab2 := &Tab{chrome: chrome, data: &TabData{ID: "tab-2"}}
+	chrome.addTab(tab1)
+	chrome.addTab(tab2)
+
+	chrome.RemoveTab(tab1)
+	if tabs := chrome.Tabs(); 1 != len(tabs) || tab2 != tabs[0] {
+		t.Errorf("Expected only 'tab-2' to remain, got %v", tabs)
+	}
+}
+
 func TestChromiumVersion(t *testing.T) {
 	chrome := New(
 		&Flags{
@@ -138,3 +221,139 @@ func TestChromiumVersion(t *testing.T) {
 		t.Errorf("Expected nil, received %v", version)
 	}
 }
+
+func TestChromiumBrowserSocket(t *testing.T) {
+	chrome := New(
+		&Flags{
+			"addr": "devnul",
+			"remote-debugging-address": "devnul",
+			"port":                  9222,
+			"remote-debugging-port": 9222,
+		},
+		"", //"path/to/chrome",
+		"", //"path/to/stderr",
+		"", //"path/to/stdout",
+		"", //"path/to/workdir",
+	)
+	browser, err := chrome.BrowserSocket()
+	if nil == err {
+		t.Errorf("Expected error, received nil")
+	}
+	if nil != browser {
+		t.Errorf("Expected nil, received %v", browser)
+	}
+	if _, err = chrome.AttachTab(context.Background(), "target-1"); nil == err {
+		t.Errorf("Expected error, received nil")
+	}
+}
+
+func TestChromiumPipe(t *testing.T) {
+	chrome := New(
+		&Flags{"remote-debugging-pipe": nil},
+		"", //"path/to/chrome",
+		"", //"path/to/stderr",
+		"", //"path/to/stdout",
+		"", //"path/to/workdir",
+	)
+	if !chrome.Pipe() {
+		t.Fatalf("Expected pipe mode")
+	}
+	if _, err := chrome.Version(); nil == err {
+		t.Errorf("Expected an error before the pipe is open")
+	}
+
+	browser, payloads := newPipeBrowser(map[string]string{
+		"Browser.getVersion":    `{"product":"HeadlessChrome/70.0","protocolVersion":"1.3"}`,
+		"Target.createTarget":   `{"targetId":"target-1"}`,
+		"Target.attachToTarget": `{"sessionId":"session-1"}`,
+		"Target.closeTarget":    `{"success":true}`,
+	})
+	chrome.browser = browser
+	defer chrome.Close()
+
+	version, err := chrome.Version()
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if "HeadlessChrome/70.0" != version.Browser {
+		t.Errorf("Expected 'HeadlessChrome/70.0', got '%s'", version.Browser)
+	}
+
+	tab, err := chrome.NewTab("https://www.example.com/")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if "target-1" != tab.Data().ID {
+		t.Errorf("Expected tab ID 'target-1', got '%s'", tab.Data().ID)
+	}
+	if _, err := tab.Close(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+	for _, expected := range []string{"Browser.getVersion", "Target.createTarget", "Target.attachToTarget", "Target.closeTarget"} {
+		if payload := <-payloads; expected != payload.Method {
+			t.Errorf("Expected '%s', got '%s'", expected, payload.Method)
+		}
+	}
+}
+
+/*
+newPipeBrowser returns a socket connected to an emulated browser over a
+debugging pipe. The browser responds to each command with the result for its
+method, or an empty result, and sends the received payloads to the returned
+channel.
+*/
+func newPipeBrowser(results map[string]string) (*socket.Socket, chan *socket.Payload) {
+	return newPipeBrowserFunc(func(payload *socket.Payload) string {
+		if result, ok := results[payload.Method]; ok {
+			return result
+		}
+		return `{}`
+	})
+}
+
+/*
+newPipeBrowserFunc returns a socket connected to an emulated browser over a
+debugging pipe, which responds to each command with the result returned by
+respond.
+*/
+func newPipeBrowserFunc(respond func(payload *socket.Payload) string) (*socket.Socket, chan *socket.Payload) {
+	return newPipeBrowserEvents(respond, func(payload *socket.Payload) []*socket.Response {
+		return nil
+	})
+}
+
+/*
+newPipeBrowserEvents returns a socket connected to an emulated browser over a
+debugging pipe, which responds to each command with the result returned by
+respond and then sends the events returned by emit.
+*/
+func newPipeBrowserEvents(
+	respond func(payload *socket.Payload) string,
+	emit func(payload *socket.Payload) []*socket.Response,
+) (*socket.Socket, chan *socket.Payload) {
+	cmdReader, cmdWriter := io.Pipe()
+	respReader, respWriter := io.Pipe()
+	payloads := make(chan *socket.Payload, 100)
+	go func() {
+		reader := bufio.NewReader(cmdReader)
+		for {
+			message, err := reader.ReadBytes(0)
+			if nil != err {
+				return
+			}
+			payload := &socket.Payload{}
+			json.Unmarshal(message[:len(message)-1], payload)
+			select {
+			case payloads <- payload:
+			default:
+			}
+			response, _ := json.Marshal(&socket.Response{ID: payload.ID, Result: []byte(respond(payload)), SessionID: payload.SessionID})
+			respWriter.Write(append(response, 0))
+			for _, event := range emit(payload) {
+				message, _ := json.Marshal(event)
+				respWriter.Write(append(message, 0))
+			}
+		}
+	}()
+	return socket.NewPipeSocket(respReader, cmdWriter), payloads
+}
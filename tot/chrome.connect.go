@@ -0,0 +1,273 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/socket"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+ConnectTargetTypes lists the target types Connect creates tabs for.
+*/
+var ConnectTargetTypes = map[string]bool{
+	"iframe":         true,
+	"page":           true,
+	"service_worker": true,
+	"shared_worker":  true,
+	"worker":         true,
+}
+
+/*
+TargetTracker keeps the tab list of a Chrome instance in sync with the targets
+of the browser, see TrackTargets. The Chrome type of each protocol version
+provides one.
+*/
+type TargetTracker interface {
+	// Adopt adds a tab for a target opened outside of the Chrome instance.
+	// Targets that already have a tab are ignored.
+	Adopt(data *TabData)
+
+	// Changed updates the title and URL of the tab for a target.
+	Changed(data *TabData)
+
+	// Destroyed disconnects and removes the tab for a target that has been
+	// closed.
+	Destroyed(targetID string)
+}
+
+/*
+Connect attaches to an already running Chromium instance listening on
+Address():Port() instead of launching a process.
+
+A tab is created for each existing target of one of the ConnectTargetTypes
+listed by the /json/list endpoint. Target discovery is then enabled on the
+browser socket so targets opened and closed by other clients are added to and
+removed from Tabs(). Adopted tabs open their websocket connection on first use.
+*/
+func (chrome *Chrome) Connect(ctx context.Context) error {
+	if err := chrome.checkFlags(); nil != err {
+		return err
+	}
+	browser, err := chrome.BrowserSocket()
+	if nil != err {
+		return err
+	}
+	return chrome.TrackTargets(ctx, browser, &targetTracker{chrome: chrome})
+}
+
+/*
+TrackTargets passes the existing targets of one of the ConnectTargetTypes
+listed by the /json/list endpoint to tracker and enables target discovery on
+the browser socket so targets opened, changed and closed by other clients are
+passed to it as well. It implements Connect for every protocol version.
+*/
+func (chrome *Chrome) TrackTargets(
+	ctx context.Context,
+	browser socket.Socketer,
+	tracker TargetTracker,
+) error {
+	if err := chrome.checkFlags(); nil != err {
+		return err
+	}
+	targets, err := chrome.Endpoint().List(ctx)
+	if nil != err {
+		return errs.Wrap(err, codes.ChromeQueryFailed, "/json/list query failed")
+	}
+	for _, data := range targets {
+		if nil != data && ConnectTargetTypes[data.Type] {
+			tracker.Adopt(data)
+		}
+	}
+
+	// The target events of all protocol versions share the Tip-of-Tree
+	// format.
+	protocol := &socket.TargetProtocol{Socket: browser}
+	protocol.OnTargetCreated(func(event *target.CreatedEvent) {
+		if data := chrome.targetData(event.Info); nil != data && ConnectTargetTypes[data.Type] {
+			tracker.Adopt(data)
+		}
+	})
+	protocol.OnTargetInfoChanged(func(event *target.InfoChangedEvent) {
+		if data := chrome.targetData(event.Info); nil != data {
+			tracker.Changed(data)
+		}
+	})
+	protocol.OnTargetDestroyed(func(event *target.DestroyedEvent) {
+		tracker.Destroyed(string(event.ID))
+	})
+	result := <-protocol.SetDiscoverTargetsContext(ctx, &target.SetDiscoverTargetsParams{Discover: true})
+	if nil != result.Err {
+		return errs.Wrap(result.Err, codes.ChromeQueryFailed, "could not enable target discovery")
+	}
+	return nil
+}
+
+/*
+Activate brings the tab to the foreground.
+*/
+func (tab *Tab) Activate() error {
+	if nil != tab.browser {
+		result := <-tab.browser.Target().ActivateTarget(&target.ActivateTargetParams{
+			ID: target.ID(tab.Data().ID),
+		})
+		if nil != result.Err {
+			return errs.Wrap(result.Err, 0, fmt.Sprintf("could not activate target '%s'", tab.Data().ID))
+		}
+		return nil
+	}
+	var err error
+	if chrome, ok := tab.Chromium().(*Chrome); ok {
+		err = chrome.Endpoint().Activate(context.Background(), tab.Data().ID)
+	} else {
+		var result interface{}
+		_, err = tab.Chromium().Query(fmt.Sprintf("/json/activate/%s", tab.Data().ID), url.Values{}, &result)
+	}
+	if nil != err {
+		return errs.Wrap(err, codes.ChromeQueryFailed, fmt.Sprintf("activate/%s query failed", tab.Data().ID))
+	}
+	return nil
+}
+
+/*
+targetData returns the tab metadata for a discovered target. The websocket URL
+follows the /json/list format, on the host of the Endpoint.
+*/
+func (chrome *Chrome) targetData(info *target.Info) *TabData {
+	if nil == info {
+		return nil
+	}
+	data := &TabData{
+		ID:    string(info.ID),
+		Title: info.Title,
+		Type:  info.Type,
+		URL:   info.URL,
+	}
+	if websocketURL, err := chrome.Endpoint().WebsocketURL("/devtools/page/" + string(info.ID)); nil == err {
+		data.WebSocketDebuggerURL = websocketURL.String()
+	}
+	return data
+}
+
+/*
+targetTracker is a TargetTracker implementation.
+*/
+type targetTracker struct {
+	chrome *Chrome
+}
+
+/*
+Adopt adds a tab for a target opened outside of this Chrome instance.
+
+Adopt is a TargetTracker implementation.
+*/
+func (tracker *targetTracker) Adopt(data *TabData) {
+	chrome := tracker.chrome
+	targetURL, _ := url.Parse(data.URL)
+
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	for _, tab := range chrome.tabs {
+		if tab.Data().ID == data.ID {
+			return
+		}
+	}
+	log.WithFields(log.Fields{"id": data.ID, "type": data.Type, "url": data.URL}).
+		Debug("Adopting target")
+	chrome.tabs = append(chrome.tabs, &Tab{
+		chrome: chrome,
+		data:   data,
+		url:    targetURL,
+	})
+}
+
+/*
+Changed updates the title and URL of the tab for a target.
+
+Changed is a TargetTracker implementation.
+*/
+func (tracker *targetTracker) Changed(data *TabData) {
+	for _, tab := range tracker.chrome.Tabs() {
+		if tab.Data().ID != data.ID {
+			continue
+		}
+		targetURL, err := url.Parse(data.URL)
+		tab.mux.Lock()
+		updated := *tab.data
+		updated.Title = data.Title
+		updated.URL = data.URL
+		tab.data = &updated
+		if nil == err {
+			tab.url = targetURL
+		}
+		tab.mux.Unlock()
+	}
+}
+
+/*
+Destroyed removes the tab for a target that has been closed.
+
+Destroyed is a TargetTracker implementation.
+*/
+func (tracker *targetTracker) Destroyed(targetID string) {
+	for _, tab := range tracker.chrome.Tabs() {
+		if tab.Data().ID == targetID {
+			tab.disconnect()
+			tracker.chrome.RemoveTab(tab)
+		}
+	}
+}
+
+/*
+connect opens the websocket connection of an adopted tab.
+*/
+func (tab *Tab) connect() {
+	// Adopted tabs belong to a Chrome instance, see TrackTargets.
+	chrome, ok := tab.chrome.(*Chrome)
+	if !ok {
+		return
+	}
+	endpoint := chrome.Endpoint()
+	tab.mux.Lock()
+	if nil != tab.socket || nil == tab.data || "" == tab.data.WebSocketDebuggerURL {
+		tab.mux.Unlock()
+		return
+	}
+	websocketURL, err := endpoint.WebsocketURL(tab.data.WebSocketDebuggerURL)
+	if nil != err {
+		tab.mux.Unlock()
+		log.WithFields(log.Fields{"error": err, "url": tab.data.WebSocketDebuggerURL}).
+			Warn("invalid websocket URL")
+		return
+	}
+	conn := socket.NewWithOptions(websocketURL, endpoint.WebsocketOptions())
+	tab.socket = conn
+	tab.protocol = conn
+	tab.mux.Unlock()
+	tab.watch()
+}
+
+/*
+openSocket returns the socket of a tab without connecting adopted tabs that were
+never used, nil if the tab is not connected.
+*/
+func (tab *Tab) openSocket() socket.Socketer {
+	tab.mux.Lock()
+	defer tab.mux.Unlock()
+	return tab.socket
+}
+
+/*
+disconnect stops the socket connection of a tab without connecting adopted tabs
+that were never used.
+*/
+func (tab *Tab) disconnect() {
+	if conn := tab.openSocket(); nil != conn {
+		conn.Stop()
+	}
+}
@@ -0,0 +1,156 @@
+package chrome
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+func newConnectTestServer(activated chan string) (*httptest.Server, *Flags) {
+	var host string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/list", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]*TabData{
+			{ID: "page-1", Type: "page", URL: "http://example.com/", WebSocketDebuggerURL: "ws://" + host + "/devtools/page/page-1"},
+			{ID: "page-2", Type: "page", URL: "about:blank", WebSocketDebuggerURL: "ws://" + host + "/devtools/page/page-2"},
+			{ID: "worker-1", Type: "service_worker", URL: "http://example.com/sw.js", WebSocketDebuggerURL: "ws://" + host + "/devtools/page/worker-1"},
+			{ID: "browser-1", Type: "browser"},
+		})
+	})
+	mux.HandleFunc("/json/version", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&Version{WebSocketDebuggerURL: "ws://" + host + "/devtools/browser/browser-1"})
+	})
+	mux.HandleFunc("/json/activate/", func(w http.ResponseWriter, r *http.Request) {
+		activated <- strings.TrimPrefix(r.URL.Path, "/json/activate/")
+		w.Write([]byte("Target activated"))
+	})
+	mux.HandleFunc("/json/close/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("Target is closing"))
+	})
+	mux.HandleFunc("/devtools/", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if nil != err {
+			return
+		}
+		defer conn.Close()
+		for {
+			payload := &socket.Payload{}
+			if err := conn.ReadJSON(payload); nil != err {
+				return
+			}
+			conn.WriteJSON(&socket.Response{ID: payload.ID, Result: []byte(`{}`)})
+			if "Target.setDiscoverTargets" != payload.Method {
+				continue
+			}
+			// Changes made by other clients.
+			conn.WriteJSON(&socket.Response{
+				Method: "Target.targetCreated",
+				Params: []byte(`{"targetInfo":{"targetId":"page-3","type":"page","title":"","url":"about:blank"}}`),
+			})
+			conn.WriteJSON(&socket.Response{
+				Method: "Target.targetCreated",
+				Params: []byte(`{"targetInfo":{"targetId":"browser-2","type":"browser","title":"","url":""}}`),
+			})
+			conn.WriteJSON(&socket.Response{
+				Method: "Target.targetInfoChanged",
+				Params: []byte(`{"targetInfo":{"targetId":"page-1","type":"page","title":"Example","url":"http://example.com/next"}}`),
+			})
+			conn.WriteJSON(&socket.Response{
+				Method: "Target.targetDestroyed",
+				Params: []byte(`{"targetId":"page-2"}`),
+			})
+		}
+	})
+	server := httptest.NewServer(mux)
+
+	host = strings.TrimPrefix(server.URL, "http://")
+	addr, port, _ := net.SplitHostPort(host)
+	portNum, _ := strconv.Atoi(port)
+	flags := &Flags{}
+	flags.Set("addr", addr)
+	flags.Set("port", portNum)
+	return server, flags
+}
+
+func TestChromiumConnect(t *testing.T) {
+	activated := make(chan string, 1)
+	server, flags := newConnectTestServer(activated)
+	defer server.Close()
+	chrome := New(flags, "", "", "", "")
+
+	if err := chrome.Connect(context.Background()); nil != err {
+		t.Fatalf("Expected nil, got error %s", err)
+	}
+
+	// The discovery events are handled asynchronously.
+	ids := func() string {
+		list := []string{}
+		for _, tab := range chrome.Tabs() {
+			list = append(list, tab.Data().ID)
+		}
+		return strings.Join(list, ",")
+	}
+	timeout := time.After(5 * time.Second)
+	for "page-1,worker-1,page-3" != ids() || "Example" != chrome.Tabs()[0].Data().Title {
+		select {
+		case <-timeout:
+			t.Fatalf("Expected tabs 'page-1,worker-1,page-3', got '%s'", ids())
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	tab, err := chrome.GetTab("page-3")
+	if nil != err {
+		t.Fatalf("Expected nil, got error %s", err)
+	}
+	if "ws://"+strings.TrimPrefix(server.URL, "http://")+"/devtools/page/page-3" != tab.Data().WebSocketDebuggerURL {
+		t.Errorf("Unexpected websocket URL '%s'", tab.Data().WebSocketDebuggerURL)
+	}
+	if "http://example.com/next" != chrome.Tabs()[0].URL().String() {
+		t.Errorf("Expected the updated URL, got '%s'", chrome.Tabs()[0].URL())
+	}
+
+	// Adopted tabs connect on first use.
+	if nil != chrome.Tabs()[0].socket {
+		t.Errorf("Expected the socket to be opened on first use")
+	}
+	result := <-chrome.Tabs()[0].Protocol().Page().Enable()
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error %s", result.Err)
+	}
+
+	if err := chrome.Tabs()[0].Activate(); nil != err {
+		t.Errorf("Expected nil, got error %s", err)
+	}
+	select {
+	case id := <-activated:
+		if "page-1" != id {
+			t.Errorf("Expected 'page-1' to be activated, got '%s'", id)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Expected the tab to be activated")
+	}
+
+	if _, err := tab.Close(); nil != err {
+		t.Errorf("Expected nil, got error %s", err)
+	}
+	if "page-1,worker-1" != ids() {
+		t.Errorf("Expected tabs 'page-1,worker-1', got '%s'", ids())
+	}
+
+	if err := chrome.Close(); nil != err {
+		t.Errorf("Expected nil, got error %s", err)
+	}
+	if 0 != len(chrome.Tabs()) {
+		t.Errorf("Expected no tabs, got %d", len(chrome.Tabs()))
+	}
+}
@@ -0,0 +1,341 @@
+package chrome
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+DefaultEndpointTimeout is the request timeout of endpoints without a custom
+HTTP client.
+*/
+var DefaultEndpointTimeout = 10 * time.Second
+
+/*
+Endpoint is a client for the developer tools HTTP endpoints (/json/version,
+/json/list, etc.).
+
+The websocket connections to the browser and its targets are opened through the
+same URL, see WebsocketURL and WebsocketOptions.
+*/
+type Endpoint struct {
+	// URL is the base URL of the endpoints, e.g. 'http://localhost:9222'. Use
+	// the https scheme for a remote browser behind a TLS proxy.
+	URL *url.URL
+
+	// Optional. Client sends the requests, set it to configure TLS, proxies or
+	// timeouts. Defaults to a client with the DefaultEndpointTimeout. The TLS
+	// and proxy settings of an *http.Transport also apply to the websocket
+	// connections.
+	Client *http.Client
+
+	// Optional. Header is added to each request and websocket handshake, e.g.
+	// the authorization required by a proxy.
+	Header http.Header
+}
+
+/*
+ProtocolDescriptor is the protocol description returned by the /json/protocol
+endpoint.
+*/
+type ProtocolDescriptor struct {
+	Version struct {
+		Major string `json:"major"`
+		Minor string `json:"minor"`
+	} `json:"version"`
+	Domains []*ProtocolDomain `json:"domains"`
+}
+
+/*
+ProtocolDomain describes a protocol domain.
+*/
+type ProtocolDomain struct {
+	Domain       string            `json:"domain"`
+	Description  string            `json:"description,omitempty"`
+	Experimental bool              `json:"experimental,omitempty"`
+	Deprecated   bool              `json:"deprecated,omitempty"`
+	Dependencies []string          `json:"dependencies,omitempty"`
+	Types        []json.RawMessage `json:"types,omitempty"`
+	Commands     []*ProtocolMember `json:"commands,omitempty"`
+	Events       []*ProtocolMember `json:"events,omitempty"`
+}
+
+/*
+ProtocolMember describes a protocol command or event.
+*/
+type ProtocolMember struct {
+	Name         string            `json:"name"`
+	Description  string            `json:"description,omitempty"`
+	Experimental bool              `json:"experimental,omitempty"`
+	Deprecated   bool              `json:"deprecated,omitempty"`
+	Parameters   []json.RawMessage `json:"parameters,omitempty"`
+	Returns      []json.RawMessage `json:"returns,omitempty"`
+}
+
+/*
+defaultEndpointClient is the HTTP client of endpoints without a custom client.
+*/
+var defaultEndpointClient = &http.Client{}
+
+/*
+NewEndpoint returns a client for the endpoints at baseURL, e.g.
+'http://localhost:9222'.
+*/
+func NewEndpoint(baseURL string) (*Endpoint, error) {
+	endpointURL, err := url.Parse(baseURL)
+	if nil != err {
+		return nil, errs.Wrap(err, codes.ChromeEndpointInvalid, fmt.Sprintf("invalid endpoint URL '%s'", baseURL))
+	}
+	if ("http" != endpointURL.Scheme && "https" != endpointURL.Scheme) || "" == endpointURL.Host {
+		return nil, errs.New(codes.ChromeEndpointInvalid, fmt.Sprintf("invalid endpoint URL '%s'", baseURL))
+	}
+	return &Endpoint{URL: endpointURL}, nil
+}
+
+/*
+Endpoint returns the client for the developer tools HTTP endpoints. Unless one
+was set with SetEndpoint, it queries http://Address():Port().
+*/
+func (chrome *Chrome) Endpoint() *Endpoint {
+	chrome.mux.Lock()
+	endpoint := chrome.endpoint
+	chrome.mux.Unlock()
+	if nil != endpoint {
+		return endpoint
+	}
+	return &Endpoint{URL: &url.URL{
+		Scheme: "http",
+		Host:   net.JoinHostPort(chrome.Address(), strconv.Itoa(chrome.Port())),
+	}}
+}
+
+/*
+SetEndpoint sets the client for the developer tools HTTP endpoints, e.g. to
+reach a remote browser over TLS. A nil endpoint restores the default.
+*/
+func (chrome *Chrome) SetEndpoint(endpoint *Endpoint) {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	chrome.endpoint = endpoint
+}
+
+/*
+Activate brings a target to the foreground.
+*/
+func (endpoint *Endpoint) Activate(ctx context.Context, targetID string) error {
+	_, err := endpoint.Do(ctx, http.MethodGet, "/json/activate/"+targetID, "")
+	return err
+}
+
+/*
+Close closes a target.
+*/
+func (endpoint *Endpoint) Close(ctx context.Context, targetID string) error {
+	_, err := endpoint.Do(ctx, http.MethodGet, "/json/close/"+targetID, "")
+	return err
+}
+
+/*
+List returns the targets.
+*/
+func (endpoint *Endpoint) List(ctx context.Context) ([]*TabData, error) {
+	targets := []*TabData{}
+	if err := endpoint.getJSON(ctx, http.MethodGet, "/json/list", "", &targets); nil != err {
+		return nil, err
+	}
+	return targets, nil
+}
+
+/*
+New opens a tab and returns its target data. The request is sent with PUT,
+which newer versions of Chromium require, and repeated with GET if the method
+is rejected.
+*/
+func (endpoint *Endpoint) New(ctx context.Context, uri string) (*TabData, error) {
+	data := &TabData{}
+	rawQuery := url.QueryEscape(uri)
+	content, status, err := endpoint.do(ctx, http.MethodPut, "/json/new", rawQuery)
+	if http.StatusMethodNotAllowed == status {
+		content, _, err = endpoint.do(ctx, http.MethodGet, "/json/new", rawQuery)
+	}
+	if nil != err {
+		return nil, err
+	}
+	if err := endpoint.decode("/json/new", content, data); nil != err {
+		return nil, err
+	}
+	return data, nil
+}
+
+/*
+Protocol returns the protocol description.
+*/
+func (endpoint *Endpoint) Protocol(ctx context.Context) (*ProtocolDescriptor, error) {
+	protocol := &ProtocolDescriptor{}
+	if err := endpoint.getJSON(ctx, http.MethodGet, "/json/protocol", "", protocol); nil != err {
+		return nil, err
+	}
+	return protocol, nil
+}
+
+/*
+Version returns the browser version data.
+*/
+func (endpoint *Endpoint) Version(ctx context.Context) (*Version, error) {
+	version := &Version{}
+	if err := endpoint.getJSON(ctx, http.MethodGet, "/json/version", "", version); nil != err {
+		return nil, err
+	}
+	return version, nil
+}
+
+/*
+WebsocketURL returns the URL of a websocket debugger endpoint, such as the
+WebSocketDebuggerURL reported by Chromium, on the host of the endpoint URL. The
+scheme is wss if the endpoint URL uses https.
+
+Chromium reports the address it listens on, which is not reachable through a
+proxy or from another host, so only the path of debuggerURL is kept.
+*/
+func (endpoint *Endpoint) WebsocketURL(debuggerURL string) (*url.URL, error) {
+	if nil == endpoint.URL {
+		return nil, errs.New(codes.ChromeEndpointInvalid, "the endpoint URL is not set")
+	}
+	reported, err := url.Parse(debuggerURL)
+	if nil != err {
+		return nil, errs.Wrap(err, codes.TabWebsocketURLInvalid, fmt.Sprintf("invalid websocket URL '%s'", debuggerURL))
+	}
+	if "" == reported.Path {
+		return nil, errs.New(codes.TabWebsocketURLInvalid, fmt.Sprintf("invalid websocket URL '%s'", debuggerURL))
+	}
+	websocketURL := *endpoint.URL
+	websocketURL.Scheme = "ws"
+	if "https" == endpoint.URL.Scheme {
+		websocketURL.Scheme = "wss"
+	}
+	websocketURL.Path = strings.TrimSuffix(websocketURL.Path, "/") + reported.Path
+	websocketURL.RawPath = ""
+	websocketURL.RawQuery = reported.RawQuery
+	websocketURL.Fragment = ""
+	return &websocketURL, nil
+}
+
+/*
+WebsocketOptions returns the options of the websocket connections: the Header,
+and the TLS and proxy settings of a Client with an *http.Transport.
+*/
+func (endpoint *Endpoint) WebsocketOptions() *socket.WebsocketOptions {
+	options := &socket.WebsocketOptions{Header: endpoint.Header}
+	if nil != endpoint.Client {
+		if transport, ok := endpoint.Client.Transport.(*http.Transport); ok {
+			options.Proxy = transport.Proxy
+			options.TLSClientConfig = transport.TLSClientConfig
+		}
+	}
+	return options
+}
+
+/*
+Do sends a request to an endpoint and returns the response body. Responses
+other than 2xx are returned as errors.
+*/
+func (endpoint *Endpoint) Do(ctx context.Context, method, path, rawQuery string) ([]byte, error) {
+	content, _, err := endpoint.do(ctx, method, path, rawQuery)
+	return content, err
+}
+
+/*
+do sends a request to an endpoint and returns the response body and status
+code.
+*/
+func (endpoint *Endpoint) do(ctx context.Context, method, path, rawQuery string) ([]byte, int, error) {
+	if nil == endpoint.URL {
+		return nil, 0, errs.New(codes.ChromeEndpointInvalid, "the endpoint URL is not set")
+	}
+	requestURL := *endpoint.URL
+	requestURL.Path = strings.TrimSuffix(requestURL.Path, "/") + path
+	requestURL.RawPath = ""
+	requestURL.RawQuery = rawQuery
+
+	client := endpoint.Client
+	if nil == client {
+		client = defaultEndpointClient
+		if DefaultEndpointTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, DefaultEndpointTimeout)
+			defer cancel()
+		}
+	}
+
+	request, err := http.NewRequest(method, requestURL.String(), nil)
+	if nil != err {
+		return nil, 0, errs.Wrap(err, codes.ChromeQueryFailed, fmt.Sprintf("invalid request %s %s", method, path))
+	}
+	request = request.WithContext(ctx)
+	for name, values := range endpoint.Header {
+		for _, value := range values {
+			request.Header.Add(name, value)
+		}
+	}
+
+	response, err := client.Do(request)
+	if nil != err {
+		return nil, 0, errs.Wrap(err, codes.ChromeQueryFailed, fmt.Sprintf("%s %s failed", method, path))
+	}
+	defer response.Body.Close()
+
+	log.WithFields(log.Fields{
+		"method": method,
+		"path":   path,
+		"status": response.Status,
+	}).Debug("querying chrome")
+
+	content, err := ioutil.ReadAll(response.Body)
+	if nil != err {
+		return nil, response.StatusCode, errs.Wrap(err, codes.ChromeQueryFailed, fmt.Sprintf("%s %s: read failed", method, path))
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return nil, response.StatusCode, errs.New(codes.ChromeQueryFailed, fmt.Sprintf(
+			"%s %s: %s: %s",
+			method,
+			path,
+			response.Status,
+			strings.TrimSpace(string(content)),
+		))
+	}
+	return content, response.StatusCode, nil
+}
+
+/*
+getJSON sends a request to an endpoint and decodes the JSON response into msg.
+*/
+func (endpoint *Endpoint) getJSON(ctx context.Context, method, path, rawQuery string, msg interface{}) error {
+	content, err := endpoint.Do(ctx, method, path, rawQuery)
+	if nil != err {
+		return err
+	}
+	return endpoint.decode(path, content, msg)
+}
+
+/*
+decode decodes a JSON response.
+*/
+func (endpoint *Endpoint) decode(path string, content []byte, msg interface{}) error {
+	if err := json.Unmarshal(content, msg); nil != err {
+		return errs.Wrap(err, codes.ChromeQueryFailed, fmt.Sprintf("invalid JSON response from %s: %s", path, strings.TrimSpace(string(content))))
+	}
+	return nil
+}
@@ -4,9 +4,18 @@ implementation.
 
 This version implements the Tip-of-Tree API. See
 https://chromedevtools.github.io/devtools-protocol/tot/ for details.
+
+The domain packages listed in the go:generate directive below are generated
+from the protocol definitions in /protocol/tot. The other domain packages, the
+socket protocol accessors and the Tab protocol accessors are maintained by
+hand: generating them would rename exported types and fields, e.g.
+target.Info to target.TargetInfo and the ID fields of the target parameters
+to TargetID, and drop the commands that were removed from the protocol.
 */
 package chrome
 
+//go:generate go run ../cmd/cdtpgen -protocol ../protocol/tot -out . -import github.com/mkenney/go-chrome/tot -version tot -domains CacheStorage,HeapProfiler,IO,Profiler,Schema,Tethering
+
 import (
 	"os"
 
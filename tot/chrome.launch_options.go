@@ -0,0 +1,414 @@
+package chrome
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+KnownFlags lists the flag names accepted by LaunchOptions.Validate. Chromium
+has many more switches, add the ones you use before validating.
+*/
+var KnownFlags = map[string]bool{
+	// go-chrome settings, see Chrome.Address and Chrome.Port.
+	"addr": true,
+	"port": true,
+
+	"allow-running-insecure-content":                     true,
+	"auto-open-devtools-for-tabs":                        true,
+	"blink-settings":                                     true,
+	"disable-background-networking":                      true,
+	"disable-background-timer-throttling":                true,
+	"disable-backgrounding-occluded-windows":             true,
+	"disable-breakpad":                                   true,
+	"disable-component-extensions-with-background-pages": true,
+	"disable-default-apps":                               true,
+	"disable-dev-shm-usage":                              true,
+	"disable-extensions":                                 true,
+	"disable-features":                                   true,
+	"disable-font-subpixel-positioning":                  true,
+	"disable-gpu":                                        true,
+	"disable-hang-monitor":                               true,
+	"disable-lcd-text":                                   true,
+	"disable-popup-blocking":                             true,
+	"disable-prompt-on-repost":                           true,
+	"disable-renderer-backgrounding":                     true,
+	"disable-setuid-sandbox":                             true,
+	"disable-sync":                                       true,
+	"disable-translate":                                  true,
+	"disable-web-security":                               true,
+	"enable-automation":                                  true,
+	"enable-features":                                    true,
+	"enable-logging":                                     true,
+	"font-render-hinting":                                true,
+	"force-color-profile":                                true,
+	"force-device-scale-factor":                          true,
+	"headless":                                           true,
+	"hide-scrollbars":                                    true,
+	"host-resolver-rules":                                true,
+	"ignore-certificate-errors":                          true,
+	"incognito":                                          true,
+	"lang":                                               true,
+	"load-extension":                                     true,
+	"log-level":                                          true,
+	"metrics-recording-only":                             true,
+	"mute-audio":                                         true,
+	"no-default-browser-check":                           true,
+	"no-first-run":                                       true,
+	"no-sandbox":                                         true,
+	"no-zygote":                                          true,
+	"password-store":                                     true,
+	"proxy-bypass-list":                                  true,
+	"proxy-server":                                       true,
+	"remote-debugging-address":                           true,
+	"remote-debugging-pipe":                              true,
+	"remote-debugging-port":                              true,
+	"run-all-compositor-stages-before-draw":              true,
+	"safebrowsing-disable-auto-update":                   true,
+	"single-process":                                     true,
+	"use-gl":                                             true,
+	"use-mock-keychain":                                  true,
+	"user-agent":                                         true,
+	"user-data-dir":                                      true,
+	"v":                                                  true,
+	"vmodule":                                            true,
+	"window-position":                                    true,
+	"window-size":                                        true,
+}
+
+/*
+LaunchPreset configures a set of launch options for a common use case.
+*/
+type LaunchPreset func(options *LaunchOptions)
+
+/*
+PresetHeadless runs Chromium without a user interface.
+*/
+func PresetHeadless(options *LaunchOptions) {
+	options.
+		Bool("headless", true).
+		Bool("hide-scrollbars", true).
+		Bool("mute-audio", true).
+		Bool("no-first-run", true).
+		Bool("no-default-browser-check", true)
+}
+
+/*
+PresetContainer allows Chromium to run as root in a container with a small
+/dev/shm. The sandbox is disabled, only use it with trusted content.
+*/
+func PresetContainer(options *LaunchOptions) {
+	options.
+		Bool("no-sandbox", true).
+		Bool("disable-setuid-sandbox", true).
+		Bool("disable-dev-shm-usage", true)
+}
+
+/*
+PresetDeterministic makes rendering reproducible across machines for
+screenshot comparisons: software rendering, fixed font hinting and color
+profile, and no background throttling.
+*/
+func PresetDeterministic(options *LaunchOptions) {
+	options.
+		Bool("disable-gpu", true).
+		Value("use-gl", "swiftshader").
+		Value("font-render-hinting", "none").
+		Bool("disable-font-subpixel-positioning", true).
+		Bool("disable-lcd-text", true).
+		Value("force-color-profile", "srgb").
+		Int("force-device-scale-factor", 1).
+		Bool("run-all-compositor-stages-before-draw", true).
+		Bool("disable-background-timer-throttling", true).
+		Bool("disable-renderer-backgrounding", true).
+		Bool("disable-backgrounding-occluded-windows", true).
+		DisableFeatures("PaintHolding")
+}
+
+/*
+NewLaunchOptions returns launch options configured by the presets.
+*/
+func NewLaunchOptions(presets ...LaunchPreset) *LaunchOptions {
+	options := &LaunchOptions{
+		values: map[string]interface{}{},
+	}
+	return options.Apply(presets...)
+}
+
+/*
+LaunchOptions is a ChromiumFlags implementation with typed setters.
+
+Unlike Flags, switches are listed in the order they were added, switches may be
+repeated, the --enable-features and --disable-features lists are merged, and
+positional arguments such as a start URL are listed after the switches.
+
+LaunchOptions implements ChromiumFlags.
+*/
+type LaunchOptions struct {
+	args     []string
+	disabled []string
+	enabled  []string
+	names    []string
+	values   map[string]interface{}
+}
+
+/*
+Apply applies presets to the options.
+*/
+func (options *LaunchOptions) Apply(presets ...LaunchPreset) *LaunchOptions {
+	for _, preset := range presets {
+		preset(options)
+	}
+	return options
+}
+
+/*
+Arg adds positional arguments, e.g. the URL to open on start.
+*/
+func (options *LaunchOptions) Arg(args ...string) *LaunchOptions {
+	options.args = append(options.args, args...)
+	return options
+}
+
+/*
+Bool adds a switch without a value, e.g. --headless. A false value removes the
+switch.
+*/
+func (options *LaunchOptions) Bool(name string, value bool) *LaunchOptions {
+	if !value {
+		options.remove(name)
+		return options
+	}
+	options.set(name, true)
+	return options
+}
+
+/*
+DisableFeatures adds features to the --disable-features list.
+*/
+func (options *LaunchOptions) DisableFeatures(features ...string) *LaunchOptions {
+	for _, feature := range features {
+		options.enabled = removeFeature(options.enabled, feature)
+		options.disabled = addFeature(options.disabled, feature)
+	}
+	return options
+}
+
+/*
+EnableFeatures adds features to the --enable-features list.
+*/
+func (options *LaunchOptions) EnableFeatures(features ...string) *LaunchOptions {
+	for _, feature := range features {
+		options.disabled = removeFeature(options.disabled, feature)
+		options.enabled = addFeature(options.enabled, feature)
+	}
+	return options
+}
+
+/*
+Int adds a switch with an integer value, e.g. --remote-debugging-port=9222.
+*/
+func (options *LaunchOptions) Int(name string, value int) *LaunchOptions {
+	options.set(name, value)
+	return options
+}
+
+/*
+Repeat adds a switch once for each value, Repeat("name", "a", "b") lists
+--name=a --name=b.
+*/
+func (options *LaunchOptions) Repeat(name string, values ...string) *LaunchOptions {
+	current, _ := options.values[name].([]string)
+	options.set(name, append(current, values...))
+	return options
+}
+
+/*
+Value adds a switch with a string value, e.g. --user-agent=go-chrome.
+*/
+func (options *LaunchOptions) Value(name string, value string) *LaunchOptions {
+	options.set(name, value)
+	return options
+}
+
+/*
+Validate returns an error listing the switches that are not in KnownFlags.
+*/
+func (options *LaunchOptions) Validate() error {
+	unknown := []string{}
+	for _, name := range options.names {
+		if !KnownFlags[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return errs.New(codes.FlagUnknown, fmt.Sprintf("unknown flags: %s", strings.Join(unknown, ", ")))
+	}
+	return nil
+}
+
+/*
+Get implements ChromiumFlags.
+
+Switches without a value return true, repeated switches return a []string and
+the feature lists return a comma separated string.
+*/
+func (options *LaunchOptions) Get(name string) (interface{}, error) {
+	switch {
+	case "enable-features" == name && len(options.enabled) > 0:
+		return strings.Join(options.enabled, ","), nil
+	case "disable-features" == name && len(options.disabled) > 0:
+		return strings.Join(options.disabled, ","), nil
+	}
+	value, ok := options.values[name]
+	if !ok {
+		return nil, errs.New(codes.FlagDoesNotExist, fmt.Sprintf("The specified argument '%s' does not exist", name))
+	}
+	return value, nil
+}
+
+/*
+Has implements ChromiumFlags.
+*/
+func (options *LaunchOptions) Has(name string) bool {
+	_, err := options.Get(name)
+	return nil == err
+}
+
+/*
+List implements ChromiumFlags.
+*/
+func (options *LaunchOptions) List() []string {
+	list := []string{}
+	for _, name := range options.names {
+		switch value := options.values[name].(type) {
+		case int:
+			list = append(list, fmt.Sprintf("--%s=%d", name, value))
+		case string:
+			list = append(list, fmt.Sprintf("--%s=%s", name, value))
+		case []string:
+			for _, v := range value {
+				list = append(list, fmt.Sprintf("--%s=%s", name, v))
+			}
+		default:
+			list = append(list, fmt.Sprintf("--%s", name))
+		}
+	}
+	if len(options.enabled) > 0 {
+		list = append(list, fmt.Sprintf("--enable-features=%s", strings.Join(options.enabled, ",")))
+	}
+	if len(options.disabled) > 0 {
+		list = append(list, fmt.Sprintf("--disable-features=%s", strings.Join(options.disabled, ",")))
+	}
+	return append(list, options.args...)
+}
+
+/*
+Set implements ChromiumFlags.
+
+A nil or true value adds a switch without a value, false removes the switch.
+Values for the feature lists are merged into the lists.
+*/
+func (options *LaunchOptions) Set(name string, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		options.Bool(name, true)
+	case bool:
+		options.Bool(name, v)
+	case int:
+		options.Int(name, v)
+	case []string:
+		options.Repeat(name, v...)
+	case string:
+		switch name {
+		case "enable-features":
+			options.EnableFeatures(strings.Split(v, ",")...)
+		case "disable-features":
+			options.DisableFeatures(strings.Split(v, ",")...)
+		default:
+			options.Value(name, v)
+		}
+	default:
+		return errs.New(codes.FlagTypeInvalid, fmt.Sprintf("Invalid data type '%T' for argument %s: %+v", value, name, value))
+	}
+	return nil
+}
+
+/*
+String implements ChromiumFlags.
+*/
+func (options *LaunchOptions) String() string {
+	return strings.Join(options.List(), " ")
+}
+
+/*
+set stores a switch value, keeping the position of an existing switch.
+*/
+func (options *LaunchOptions) set(name string, value interface{}) {
+	if "enable-features" == name || "disable-features" == name {
+		if features, ok := value.(string); ok {
+			options.Set(name, features)
+		}
+		return
+	}
+	if _, ok := options.values[name]; !ok {
+		options.names = append(options.names, name)
+	}
+	options.values[name] = value
+}
+
+/*
+remove removes a switch.
+*/
+func (options *LaunchOptions) remove(name string) {
+	switch name {
+	case "enable-features":
+		options.enabled = nil
+	case "disable-features":
+		options.disabled = nil
+	}
+	if _, ok := options.values[name]; !ok {
+		return
+	}
+	delete(options.values, name)
+	for k, n := range options.names {
+		if n == name {
+			options.names = append(options.names[:k], options.names[k+1:]...)
+			break
+		}
+	}
+}
+
+/*
+addFeature adds a feature to a feature list if it is not already listed.
+*/
+func addFeature(features []string, feature string) []string {
+	feature = strings.TrimSpace(feature)
+	if "" == feature {
+		return features
+	}
+	for _, f := range features {
+		if f == feature {
+			return features
+		}
+	}
+	return append(features, feature)
+}
+
+/*
+removeFeature removes a feature from a feature list.
+*/
+func removeFeature(features []string, feature string) []string {
+	feature = strings.TrimSpace(feature)
+	for k, f := range features {
+		if f == feature {
+			return append(features[:k], features[k+1:]...)
+		}
+	}
+	return features
+}
@@ -0,0 +1,72 @@
+package chrome
+
+import (
+	"strings"
+	"testing"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+func TestLaunchOptionsList(t *testing.T) {
+	options := NewLaunchOptions(PresetHeadless).
+		Int("remote-debugging-port", 0).
+		Value("window-size", "1280,720").
+		Repeat("vmodule", "a=1", "b=2").
+		Bool("mute-audio", false).
+		EnableFeatures("NetworkService", "VizDisplayCompositor").
+		DisableFeatures("Translate", "VizDisplayCompositor").
+		Arg("https://www.example.com/")
+
+	expected := "--headless --hide-scrollbars --no-first-run --no-default-browser-check " +
+		"--remote-debugging-port=0 --window-size=1280,720 --vmodule=a=1 --vmodule=b=2 " +
+		"--enable-features=NetworkService --disable-features=Translate,VizDisplayCompositor " +
+		"https://www.example.com/"
+	if expected != options.String() {
+		t.Errorf("Expected '%s', received '%s'", expected, options.String())
+	}
+
+	// ChromiumFlags values used by Chrome.
+	if value, err := options.Get("remote-debugging-port"); nil != err || 0 != value.(int) {
+		t.Errorf("Expected 0, received %v (%v)", value, err)
+	}
+	if value, err := options.Get("disable-features"); nil != err || "Translate,VizDisplayCompositor" != value {
+		t.Errorf("Expected the feature list, received %v (%v)", value, err)
+	}
+	if options.Has("mute-audio") {
+		t.Errorf("Expected the switch to be removed")
+	}
+	if err := options.Set("disable-features", "PaintHolding"); nil != err {
+		t.Errorf("Expected nil, received '%v'", err)
+	}
+	if err := options.Set("enable-features", "Translate"); nil != err {
+		t.Errorf("Expected nil, received '%v'", err)
+	}
+	if value, _ := options.Get("disable-features"); "VizDisplayCompositor,PaintHolding" != value {
+		t.Errorf("Expected the merged feature list, received %v", value)
+	}
+	if err := options.Set("window-size", 1.5); nil == err || codes.FlagTypeInvalid != err.(errs.Err).Code() {
+		t.Errorf("Expected a FlagTypeInvalid error, received '%v'", err)
+	}
+}
+
+func TestLaunchOptionsPresets(t *testing.T) {
+	options := NewLaunchOptions(PresetHeadless, PresetContainer, PresetDeterministic)
+	for _, name := range []string{"headless", "no-sandbox", "disable-dev-shm-usage", "disable-gpu", "font-render-hinting"} {
+		if !options.Has(name) {
+			t.Errorf("Expected '%s' to be set", name)
+		}
+	}
+	if err := options.Validate(); nil != err {
+		t.Errorf("Expected nil, received '%v'", err)
+	}
+
+	options.Bool("headles", true).Value("user-agnet", "go-chrome")
+	err := options.Validate()
+	if nil == err || codes.FlagUnknown != err.(errs.Err).Code() {
+		t.Fatalf("Expected a FlagUnknown error, received '%v'", err)
+	}
+	if !strings.Contains(err.Error(), "headles, user-agnet") {
+		t.Errorf("Expected the unknown flags to be listed, received '%v'", err)
+	}
+}
@@ -0,0 +1,295 @@
+package chrome
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+OutputBufferSize is the number of recent output lines kept for OutputLines and
+for launch and crash errors.
+*/
+var OutputBufferSize = 100
+
+/*
+outputErrorLines is the number of recent output lines added to launch and crash
+errors.
+*/
+const outputErrorLines = 20
+
+/*
+outputPrefix matches the prefix Chromium adds to its log messages, e.g.
+'[1234:5678:1017/021845.123456:ERROR:gpu_init.cc(440)] message'. The process
+ID, thread ID and time are optional.
+*/
+var outputPrefix = regexp.MustCompile(`^\[(?:(\d+):)?(?:(\d+):)?(?:([\d/.]+):)?(?:\d+:)?([A-Z]+\d*):([^:()\]]+)\((\d+)\)\] ?(.*)$`)
+
+/*
+OutputLine is a line written to STDOUT or STDERR by the Chromium process.
+*/
+type OutputLine struct {
+	// Stream is 'stdout' or 'stderr'.
+	Stream string
+
+	// Text is the line as written by Chromium.
+	Text string
+
+	// PID, TID, Time, Level, File and Line are decoded from the log message
+	// prefix, they are empty for lines without a prefix.
+	PID   int
+	TID   int
+	Time  string
+	Level string
+	File  string
+	Line  int
+
+	// Message is the text following the log message prefix.
+	Message string
+}
+
+/*
+String implements Stringer.
+*/
+func (line *OutputLine) String() string {
+	return line.Text
+}
+
+/*
+ParseOutputLine decodes the log message prefix of a line written by Chromium.
+*/
+func ParseOutputLine(stream, text string) *OutputLine {
+	line := &OutputLine{
+		Stream:  stream,
+		Text:    text,
+		Message: text,
+	}
+	match := outputPrefix.FindStringSubmatch(text)
+	if nil == match {
+		return line
+	}
+	line.PID, _ = strconv.Atoi(match[1])
+	line.TID, _ = strconv.Atoi(match[2])
+	line.Time = match[3]
+	line.Level = match[4]
+	line.File = match[5]
+	line.Line, _ = strconv.Atoi(match[6])
+	line.Message = match[7]
+	return line
+}
+
+/*
+LogOutput returns whether the Chromium output is logged.
+*/
+func (chrome *Chrome) LogOutput() bool {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	return chrome.logOutput
+}
+
+/*
+SetLogOutput sets whether Launch pipes the Chromium STDOUT and STDERR through a
+line parser that writes each line to the log, with the level and source
+location decoded from the Chromium log message prefix. Lines are still copied
+to the STDOUT and STDERR files.
+*/
+func (chrome *Chrome) SetLogOutput(enabled bool) {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	chrome.logOutput = enabled
+}
+
+/*
+OutputLines returns the recent lines written by the Chromium process, oldest
+first. Lines are captured when the output is logged, and the STDERR lines in
+dynamic port mode.
+*/
+func (chrome *Chrome) OutputLines() []*OutputLine {
+	chrome.mux.Lock()
+	output := chrome.outputLog
+	chrome.mux.Unlock()
+	if nil == output {
+		return nil
+	}
+	return output.Lines()
+}
+
+/*
+outputTail returns the recent output lines to add to an error message. The
+process has exited, the lines it wrote last are read first.
+*/
+func (chrome *Chrome) outputTail() string {
+	chrome.mux.Lock()
+	output := chrome.outputLog
+	chrome.mux.Unlock()
+	if nil == output {
+		return ""
+	}
+	output.wait(500 * time.Millisecond)
+	lines := output.Lines()
+	if 0 == len(lines) {
+		return ""
+	}
+	if len(lines) > outputErrorLines {
+		lines = lines[len(lines)-outputErrorLines:]
+	}
+	text := make([]string, len(lines))
+	for k, line := range lines {
+		text[k] = line.Text
+	}
+	return fmt.Sprintf(", recent output:\n%s", strings.Join(text, "\n"))
+}
+
+/*
+outputLog parses the output of a Chromium process and keeps the recent lines.
+*/
+type outputLog struct {
+	// listening receives the debugging URL reported by Chromium.
+	listening chan string
+	log       bool
+	lines     []*OutputLine
+	mux       sync.Mutex
+	next      int
+	readers   sync.WaitGroup
+	size      int
+}
+
+/*
+newOutputLog returns an output parser, which writes the lines to the log if
+logOutput is set.
+*/
+func newOutputLog(logOutput bool) *outputLog {
+	size := OutputBufferSize
+	if size < 1 {
+		size = 1
+	}
+	return &outputLog{
+		listening: make(chan string, 1),
+		log:       logOutput,
+		size:      size,
+	}
+}
+
+/*
+Lines returns the recent lines, oldest first.
+*/
+func (output *outputLog) Lines() []*OutputLine {
+	output.mux.Lock()
+	defer output.mux.Unlock()
+	if len(output.lines) < output.size {
+		return append([]*OutputLine{}, output.lines...)
+	}
+	return append(append([]*OutputLine{}, output.lines[output.next:]...), output.lines[:output.next]...)
+}
+
+/*
+pipe returns a pipe to use as an output stream of the Chromium process. Lines
+read from the pipe are copied to the output file, parsed and kept.
+*/
+func (output *outputLog) pipe(stream string, file io.Writer) (*os.File, error) {
+	reader, writer, err := os.Pipe()
+	if nil != err {
+		code := codes.ChromeCannotOpenStderr
+		if "stdout" == stream {
+			code = codes.ChromeCannotOpenStdout
+		}
+		return nil, errs.Wrap(err, code, fmt.Sprintf("cannot open the %s pipe", stream))
+	}
+	output.readers.Add(1)
+	go func() {
+		defer output.readers.Done()
+		defer reader.Close()
+		// A bufio.Scanner stops at lines longer than its buffer, which would
+		// leave the pipe undrained and block the process.
+		buffer := bufio.NewReader(reader)
+		for {
+			text, err := buffer.ReadString('\n')
+			if "" != text {
+				text = strings.TrimRight(text, "\r\n")
+				if nil != file {
+					fmt.Fprintln(file, text)
+				}
+				output.add(ParseOutputLine(stream, text))
+			}
+			if nil != err {
+				if io.EOF != err {
+					log.WithFields(log.Fields{"error": err, "stream": stream}).
+						Warn("Cannot read the Chromium output")
+				}
+				return
+			}
+		}
+	}()
+	return writer, nil
+}
+
+/*
+wait waits until the output streams are closed, or the timeout expires. Child
+processes of Chromium may keep the streams open after it exits.
+*/
+func (output *outputLog) wait(timeout time.Duration) {
+	done := make(chan bool)
+	go func() {
+		output.readers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+	}
+}
+
+/*
+add keeps a line, writes it to the log and detects the debugging URL.
+*/
+func (output *outputLog) add(line *OutputLine) {
+	output.mux.Lock()
+	if len(output.lines) < output.size {
+		output.lines = append(output.lines, line)
+	} else {
+		output.lines[output.next] = line
+		output.next = (output.next + 1) % output.size
+	}
+	output.mux.Unlock()
+
+	if match := listeningPattern.FindStringSubmatch(line.Text); nil != match {
+		select {
+		case output.listening <- match[1]:
+		default:
+		}
+	}
+
+	if !output.log {
+		return
+	}
+	entry := log.WithFields(log.Fields{"stream": line.Stream})
+	if "" != line.Level {
+		entry = log.WithFields(log.Fields{
+			"file":   fmt.Sprintf("%s(%d)", line.File, line.Line),
+			"pid":    line.PID,
+			"stream": line.Stream,
+			"tid":    line.TID,
+			"time":   line.Time,
+		})
+	}
+	switch {
+	case "ERROR" == line.Level || "FATAL" == line.Level:
+		entry.Error(line.Message)
+	case "WARNING" == line.Level:
+		entry.Warn(line.Message)
+	case strings.HasPrefix(line.Level, "VERBOSE"):
+		entry.Debug(line.Message)
+	default:
+		entry.Info(line.Message)
+	}
+}
@@ -0,0 +1,97 @@
+package chrome
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+func TestParseOutputLine(t *testing.T) {
+	line := ParseOutputLine("stderr", "[1234:5678:1017/021845.123456:ERROR:gpu_init.cc(440)] Passthrough is not supported")
+	if 1234 != line.PID || 5678 != line.TID || "1017/021845.123456" != line.Time {
+		t.Errorf("Unexpected prefix %+v", line)
+	}
+	if "ERROR" != line.Level || "gpu_init.cc" != line.File || 440 != line.Line {
+		t.Errorf("Unexpected prefix %+v", line)
+	}
+	if "Passthrough is not supported" != line.Message {
+		t.Errorf("Expected the message, got '%s'", line.Message)
+	}
+
+	line = ParseOutputLine("stderr", "[1017/021845.123456:VERBOSE1:device_event_log_impl.cc(214)] [12:34] message")
+	if 0 != line.PID || "VERBOSE1" != line.Level || "[12:34] message" != line.Message {
+		t.Errorf("Unexpected line %+v", line)
+	}
+
+	line = ParseOutputLine("stdout", "DevTools listening on ws://127.0.0.1:9222/devtools/browser/id")
+	if "" != line.Level || line.Text != line.Message {
+		t.Errorf("Expected a line without prefix, got %+v", line)
+	}
+}
+
+func TestChromiumLogOutput(t *testing.T) {
+	chrome, _, cleanup := testDynamicPort(t, `
+echo "[1:2:1017/021845.123456:INFO:startup.cc(1)] starting"
+echo "[1:2:1017/021845.123456:FATAL:zygote_host_impl_linux.cc(117)] No usable sandbox!" >&2
+exit 1`)
+	defer cleanup()
+	chrome.SetLogOutput(true)
+
+	err := chrome.Launch()
+	if nil == err || codes.ChromeStartTimeout != err.(errs.Err).Code() {
+		t.Fatalf("Expected a ChromeStartTimeout error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "No usable sandbox!") {
+		t.Errorf("Expected the error to contain the output, got '%s'", err.Error())
+	}
+
+	if lines := chrome.OutputLines(); 2 != len(lines) {
+		t.Errorf("Expected 2 lines, got %d", len(lines))
+	}
+
+	// The output is still copied to the STDERR file.
+	output, _ := ioutil.ReadFile(chrome.STDERR())
+	if !strings.Contains(string(output), "No usable sandbox!") {
+		t.Errorf("Expected the error output to be copied, got '%s'", output)
+	}
+}
+
+func TestOutputLogLines(t *testing.T) {
+	OutputBufferSize = 2
+	defer func() { OutputBufferSize = 100 }()
+
+	output := newOutputLog(false)
+	for _, text := range []string{"a", "b", "c"} {
+		output.add(ParseOutputLine("stdout", text))
+	}
+	lines := output.Lines()
+	if 2 != len(lines) || "b" != lines[0].Text || "c" != lines[1].Text {
+		t.Errorf("Expected lines 'b' and 'c', got %v", lines)
+	}
+}
+
+func TestOutputLogPipeLongLine(t *testing.T) {
+	output := newOutputLog(false)
+	writer, err := output.pipe("stderr", nil)
+	if nil != err {
+		t.Fatalf("Expected nil, got error %s", err)
+	}
+	long := strings.Repeat("x", 100*1024)
+	if _, err := writer.WriteString(long + "\nafter\nlast"); nil != err {
+		t.Fatalf("Expected nil, got error %s", err)
+	}
+	writer.Close()
+	output.wait(5 * time.Second)
+
+	lines := output.Lines()
+	if 3 != len(lines) {
+		t.Fatalf("Expected 3 lines, got %d", len(lines))
+	}
+	if long != lines[0].Text || "after" != lines[1].Text || "last" != lines[2].Text {
+		t.Errorf("Expected the long line to be followed by 'after' and 'last'")
+	}
+}
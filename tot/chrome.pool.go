@@ -0,0 +1,512 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/runtime"
+)
+
+/*
+PoolOptions configures a Pool.
+*/
+type PoolOptions struct {
+	// Optional. Browsers is the number of browser processes. Defaults to 1.
+	Browsers int
+
+	// Optional. TabsPerBrowser is the maximum number of tabs opened in each
+	// browser. Defaults to 4.
+	TabsPerBrowser int
+
+	// Optional. Isolated opens each tab in its own browser context, tabs then
+	// do not share cookies, storage or cache.
+	Isolated bool
+
+	// Optional. MaxUses is the number of leases after which a tab is closed
+	// and replaced. Defaults to 0, tabs are reused indefinitely.
+	MaxUses int
+
+	// Optional. MaxHeapSize is the JavaScript heap size in bytes above which a
+	// tab is closed and replaced when it is released, measured with the
+	// JSHeapUsedSize performance metric. Defaults to 0, the heap size is not
+	// checked.
+	MaxHeapSize float64
+
+	// Optional. NewBrowser returns a launched or connected browser. Defaults
+	// to launching headless Chromium on a dynamic debugging port.
+	NewBrowser func() (*Chrome, error)
+
+	// Optional. HealthCheck verifies a tab before it is leased, unhealthy tabs
+	// are replaced. Defaults to evaluating an expression in the tab.
+	HealthCheck func(ctx context.Context, tab *Tab) error
+}
+
+/*
+PoolStats contains Pool usage information.
+*/
+type PoolStats struct {
+	// Browsers is the number of running browsers.
+	Browsers int
+
+	// Tabs is the number of open tabs, Idle and Leased the number of those
+	// tabs that are available or in use.
+	Tabs   int
+	Idle   int
+	Leased int
+
+	// Leases is the total number of leases, Recycled the total number of
+	// tabs closed because they were worn out, unhealthy or discarded.
+	Leases   uint64
+	Recycled uint64
+}
+
+/*
+Pool manages a set of browsers and hands out leases on their tabs to
+concurrent workloads.
+*/
+type Pool struct {
+	browsers []*poolBrowser
+	closed   bool
+	done     chan struct{}
+	idle     []*poolTab
+	leases   sync.WaitGroup
+	mux      sync.Mutex
+	options  PoolOptions
+	slots    chan struct{}
+	stats    PoolStats
+}
+
+/*
+poolBrowser is a browser managed by a Pool.
+*/
+type poolBrowser struct {
+	chrome *Chrome
+	closed bool
+	tabs   int
+}
+
+/*
+poolTab is a tab managed by a Pool.
+*/
+type poolTab struct {
+	browser *poolBrowser
+	context *BrowserContext
+	tab     *Tab
+	uses    int
+}
+
+/*
+Lease is a tab leased from a Pool. The tab must be returned with Release, or
+with Discard if it should not be reused.
+*/
+type Lease struct {
+	once   sync.Once
+	pool   *Pool
+	pooled *poolTab
+}
+
+/*
+NewPool starts the browsers of a pool. Tabs are opened as they are leased.
+*/
+func NewPool(options PoolOptions) (*Pool, error) {
+	if options.Browsers <= 0 {
+		options.Browsers = 1
+	}
+	if options.TabsPerBrowser <= 0 {
+		options.TabsPerBrowser = 4
+	}
+	if nil == options.NewBrowser {
+		options.NewBrowser = launchPoolBrowser
+	}
+	if nil == options.HealthCheck {
+		options.HealthCheck = checkPoolTab
+	}
+
+	pool := &Pool{
+		done:    make(chan struct{}),
+		options: options,
+		slots:   make(chan struct{}, options.Browsers*options.TabsPerBrowser),
+	}
+	for a := 0; a < options.Browsers; a++ {
+		chrome, err := options.NewBrowser()
+		if nil != err {
+			pool.Close(context.Background())
+			return nil, errs.Wrap(err, codes.ChromePoolFailed, "could not start the pool browsers")
+		}
+		pool.browsers = append(pool.browsers, &poolBrowser{chrome: chrome})
+	}
+	return pool, nil
+}
+
+/*
+Acquire leases a healthy tab, waiting until one is available, ctx is done or
+the pool is closed.
+*/
+func (pool *Pool) Acquire(ctx context.Context) (*Lease, error) {
+	select {
+	case <-pool.done:
+		return nil, errs.New(codes.ChromePoolClosed, "the pool is closed")
+	case <-ctx.Done():
+		return nil, errs.Wrap(ctx.Err(), codes.ChromePoolFailed, "no tab became available")
+	case pool.slots <- struct{}{}:
+	}
+
+	pool.mux.Lock()
+	if pool.closed {
+		pool.mux.Unlock()
+		<-pool.slots
+		return nil, errs.New(codes.ChromePoolClosed, "the pool is closed")
+	}
+	pool.leases.Add(1)
+	pool.mux.Unlock()
+
+	var err error
+	for attempt := 0; attempt < 3; attempt++ {
+		var pooled *poolTab
+		if pooled, err = pool.take(ctx); nil != err {
+			continue
+		}
+		checkCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		err = pool.options.HealthCheck(checkCtx, pooled.tab)
+		cancel()
+		if nil != err {
+			log.WithFields(log.Fields{"error": err, "id": pooled.tab.Data().ID}).
+				Warn("Replacing unhealthy tab")
+			pool.recycle(pooled)
+			continue
+		}
+
+		pool.mux.Lock()
+		pooled.uses++
+		pool.stats.Leases++
+		pool.mux.Unlock()
+		return &Lease{pool: pool, pooled: pooled}, nil
+	}
+
+	<-pool.slots
+	pool.leases.Done()
+	return nil, errs.Wrap(err, codes.ChromePoolFailed, "could not lease a healthy tab")
+}
+
+/*
+Close stops handing out leases, waits until the leased tabs are returned or ctx
+is done, and closes the browsers.
+*/
+func (pool *Pool) Close(ctx context.Context) error {
+	pool.mux.Lock()
+	if pool.closed {
+		pool.mux.Unlock()
+		return nil
+	}
+	pool.closed = true
+	close(pool.done)
+	pool.mux.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		pool.leases.Wait()
+		close(drained)
+	}()
+	var err error
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		err = errs.Wrap(ctx.Err(), codes.ChromePoolFailed, "leased tabs were not returned before the pool was closed")
+	}
+
+	pool.mux.Lock()
+	idle := pool.idle
+	pool.idle = nil
+	browsers := pool.browsers
+	pool.browsers = nil
+	for _, browser := range browsers {
+		browser.closed = true
+	}
+	pool.mux.Unlock()
+
+	for _, pooled := range idle {
+		pool.closeTab(pooled)
+	}
+	for _, browser := range browsers {
+		if closeErr := browser.chrome.Close(); nil != closeErr {
+			log.WithFields(log.Fields{"error": closeErr}).
+				Warn(closeErr)
+		}
+	}
+	return err
+}
+
+/*
+Stats returns the pool usage information.
+*/
+func (pool *Pool) Stats() PoolStats {
+	pool.mux.Lock()
+	defer pool.mux.Unlock()
+	stats := pool.stats
+	stats.Browsers = len(pool.browsers)
+	stats.Tabs = 0
+	for _, browser := range pool.browsers {
+		stats.Tabs += browser.tabs
+	}
+	stats.Idle = len(pool.idle)
+	stats.Leased = stats.Tabs - stats.Idle
+	return stats
+}
+
+/*
+Browser returns the browser the leased tab belongs to.
+*/
+func (lease *Lease) Browser() *Chrome {
+	return lease.pooled.browser.chrome
+}
+
+/*
+Discard returns the leased tab to the pool, which closes and replaces it.
+*/
+func (lease *Lease) Discard() {
+	lease.once.Do(func() {
+		lease.pool.release(lease.pooled, true)
+	})
+}
+
+/*
+Release returns the leased tab to the pool. The tab is replaced if it has
+reached MaxUses or MaxHeapSize.
+*/
+func (lease *Lease) Release() {
+	lease.once.Do(func() {
+		lease.pool.release(lease.pooled, false)
+	})
+}
+
+/*
+Tab returns the leased tab.
+*/
+func (lease *Lease) Tab() *Tab {
+	return lease.pooled.tab
+}
+
+/*
+take returns an idle tab, or opens a tab in the least busy browser.
+*/
+func (pool *Pool) take(ctx context.Context) (*poolTab, error) {
+	pool.mux.Lock()
+	if count := len(pool.idle); count > 0 {
+		pooled := pool.idle[count-1]
+		pool.idle = pool.idle[:count-1]
+		pool.mux.Unlock()
+		return pooled, nil
+	}
+	var browser *poolBrowser
+	for _, b := range pool.browsers {
+		if !b.closed && b.tabs < pool.options.TabsPerBrowser && (nil == browser || b.tabs < browser.tabs) {
+			browser = b
+		}
+	}
+	if nil == browser {
+		pool.mux.Unlock()
+		return nil, errs.New(codes.ChromePoolFailed, "no browser is available")
+	}
+	browser.tabs++
+	pool.mux.Unlock()
+
+	pooled, err := pool.open(ctx, browser)
+	if nil != err {
+		pool.mux.Lock()
+		browser.tabs--
+		pool.mux.Unlock()
+		pool.checkBrowser(ctx, browser)
+		return nil, err
+	}
+	return pooled, nil
+}
+
+/*
+open opens a tab in a browser.
+*/
+func (pool *Pool) open(ctx context.Context, browser *poolBrowser) (*poolTab, error) {
+	pooled := &poolTab{browser: browser}
+	if pool.options.Isolated {
+		browserContext, err := browser.chrome.NewBrowserContext(ctx)
+		if nil != err {
+			return nil, err
+		}
+		pooled.context = browserContext
+		if pooled.tab, err = browserContext.NewTab(ctx, "about:blank"); nil != err {
+			pool.disposeContext(pooled)
+			return nil, err
+		}
+	} else {
+		tab, err := browser.chrome.NewTab("about:blank")
+		if nil != err {
+			return nil, err
+		}
+		pooled.tab = tab
+	}
+
+	if pool.options.MaxHeapSize > 0 {
+		if result := <-pooled.tab.Protocol().Performance().EnableContext(ctx); nil != result.Err {
+			pool.closeTab(pooled)
+			return nil, errs.Wrap(result.Err, codes.ChromePoolFailed, "could not enable performance metrics")
+		}
+	}
+	return pooled, nil
+}
+
+/*
+release returns a leased tab to the idle list, or closes it.
+*/
+func (pool *Pool) release(pooled *poolTab, discard bool) {
+	recycle := discard || (pool.options.MaxUses > 0 && pooled.uses >= pool.options.MaxUses)
+	if !recycle && pool.options.MaxHeapSize > 0 {
+		size, err := pool.heapSize(pooled.tab)
+		recycle = nil != err || size > pool.options.MaxHeapSize
+	}
+
+	pool.mux.Lock()
+	if pool.closed || pooled.browser.closed {
+		recycle = true
+	}
+	if !recycle {
+		pool.idle = append(pool.idle, pooled)
+	}
+	pool.mux.Unlock()
+
+	if recycle {
+		pool.recycle(pooled)
+	}
+	<-pool.slots
+	pool.leases.Done()
+}
+
+/*
+recycle closes a tab that will not be reused.
+*/
+func (pool *Pool) recycle(pooled *poolTab) {
+	pool.closeTab(pooled)
+	pool.mux.Lock()
+	pool.stats.Recycled++
+	pool.mux.Unlock()
+}
+
+/*
+closeTab closes a tab, or the browser context of an isolated tab.
+*/
+func (pool *Pool) closeTab(pooled *poolTab) {
+	if nil != pooled.context {
+		pool.disposeContext(pooled)
+	} else if _, err := pooled.tab.Close(); nil != err {
+		log.WithFields(log.Fields{"error": err, "id": pooled.tab.Data().ID}).
+			Warn(err)
+	}
+	pool.mux.Lock()
+	pooled.browser.tabs--
+	pool.mux.Unlock()
+}
+
+/*
+disposeContext closes the browser context of an isolated tab.
+*/
+func (pool *Pool) disposeContext(pooled *poolTab) {
+	if nil == pooled.context {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := pooled.context.Close(ctx); nil != err {
+		log.WithFields(log.Fields{"browserContextID": pooled.context.ID(), "error": err}).
+			Warn(err)
+	}
+}
+
+/*
+checkBrowser replaces a browser that no longer responds.
+*/
+func (pool *Pool) checkBrowser(ctx context.Context, browser *poolBrowser) {
+	conn, err := browser.chrome.BrowserSocket()
+	if nil == err {
+		checkCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		result := <-conn.Browser().GetVersionContext(checkCtx)
+		cancel()
+		if err = result.Err; nil == err {
+			return
+		}
+	}
+
+	pool.mux.Lock()
+	if pool.closed || browser.closed {
+		pool.mux.Unlock()
+		return
+	}
+	browser.closed = true
+	pool.mux.Unlock()
+	log.WithFields(log.Fields{"error": err}).
+		Warn("Replacing unresponsive browser")
+	browser.chrome.Close()
+
+	chrome, err := pool.options.NewBrowser()
+	if nil != err {
+		log.WithFields(log.Fields{"error": err}).
+			Error("Could not replace the browser")
+		return
+	}
+	pool.mux.Lock()
+	defer pool.mux.Unlock()
+	if pool.closed {
+		chrome.Close()
+		return
+	}
+	for k, b := range pool.browsers {
+		if b == browser {
+			pool.browsers[k] = &poolBrowser{chrome: chrome}
+		}
+	}
+}
+
+/*
+heapSize returns the JSHeapUsedSize performance metric of a tab.
+*/
+func (pool *Pool) heapSize(tab *Tab) (float64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	result := <-tab.Protocol().Performance().GetMetricsContext(ctx)
+	if nil != result.Err {
+		return 0, result.Err
+	}
+	for _, metric := range result.Metrics {
+		if "JSHeapUsedSize" == metric.Name {
+			return metric.Value, nil
+		}
+	}
+	return 0, errs.New(codes.ChromePoolFailed, fmt.Sprintf("tab '%s' did not report the heap size", tab.Data().ID))
+}
+
+/*
+launchPoolBrowser launches headless Chromium on a dynamic debugging port.
+*/
+func launchPoolBrowser() (*Chrome, error) {
+	chrome := New(NewLaunchOptions(PresetHeadless).Int("remote-debugging-port", 0), "", "", "", "")
+	if err := chrome.Launch(); nil != err {
+		return nil, err
+	}
+	return chrome, nil
+}
+
+/*
+checkPoolTab verifies the tab evaluates JavaScript.
+*/
+func checkPoolTab(ctx context.Context, tab *Tab) error {
+	result := <-tab.Protocol().Runtime().EvaluateContext(ctx, &runtime.EvaluateParams{Expression: "1"})
+	if nil != result.Err {
+		return result.Err
+	}
+	if nil != result.ExceptionDetails {
+		return errs.New(codes.ChromePoolFailed, "%s", result.ExceptionDetails.Text)
+	}
+	return nil
+}
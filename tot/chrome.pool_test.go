@@ -0,0 +1,216 @@
+package chrome
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/gorilla/websocket"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+func newPoolTestServer(heapSize *int64) (*httptest.Server, *Flags) {
+	var host string
+	var tabs int64
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/new", func(w http.ResponseWriter, r *http.Request) {
+		id := fmt.Sprintf("page-%d", atomic.AddInt64(&tabs, 1))
+		json.NewEncoder(w).Encode(&TabData{ID: id, Type: "page", WebSocketDebuggerURL: "ws://" + host + "/devtools/page/" + id})
+	})
+	mux.HandleFunc("/json/close/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("Target is closing"))
+	})
+	mux.HandleFunc("/json/version", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&Version{WebSocketDebuggerURL: "ws://" + host + "/devtools/browser/browser-1"})
+	})
+	mux.HandleFunc("/devtools/", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if nil != err {
+			return
+		}
+		defer conn.Close()
+		for {
+			payload := &socket.Payload{}
+			if err := conn.ReadJSON(payload); nil != err {
+				return
+			}
+			result := `{}`
+			switch payload.Method {
+			case "Performance.getMetrics":
+				result = fmt.Sprintf(`{"metrics":[{"name":"JSHeapUsedSize","value":%d}]}`, atomic.LoadInt64(heapSize))
+			case "Runtime.evaluate":
+				result = `{"result":{"type":"number","value":1}}`
+			}
+			conn.WriteJSON(&socket.Response{ID: payload.ID, Result: []byte(result)})
+		}
+	})
+	server := httptest.NewServer(mux)
+
+	host = strings.TrimPrefix(server.URL, "http://")
+	addr, port, _ := net.SplitHostPort(host)
+	portNum, _ := strconv.Atoi(port)
+	flags := &Flags{}
+	flags.Set("addr", addr)
+	flags.Set("port", portNum)
+	return server, flags
+}
+
+func TestPool(t *testing.T) {
+	var heapSize int64 = 1000
+	server, flags := newPoolTestServer(&heapSize)
+	defer server.Close()
+
+	pool, err := NewPool(PoolOptions{
+		Browsers:       2,
+		TabsPerBrowser: 1,
+		MaxUses:        2,
+		MaxHeapSize:    5000,
+		NewBrowser: func() (*Chrome, error) {
+			return New(flags, "", "", "", ""), nil
+		},
+	})
+	if nil != err {
+		t.Fatalf("Expected nil, got error %s", err)
+	}
+
+	first, err := pool.Acquire(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, got error %s", err)
+	}
+	second, err := pool.Acquire(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, got error %s", err)
+	}
+	if first.Browser() == second.Browser() {
+		t.Errorf("Expected the tabs to be spread across the browsers")
+	}
+
+	// The pool is at capacity.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	_, err = pool.Acquire(ctx)
+	cancel()
+	if nil == err || codes.ChromePoolFailed != err.(errs.Err).Code() {
+		t.Errorf("Expected a ChromePoolFailed error, got %v", err)
+	}
+	if stats := pool.Stats(); 2 != stats.Tabs || 2 != stats.Leased || 0 != stats.Idle {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+
+	// Released tabs are reused.
+	id := first.Tab().Data().ID
+	first.Release()
+	first.Release()
+	lease, err := pool.Acquire(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, got error %s", err)
+	}
+	if id != lease.Tab().Data().ID {
+		t.Errorf("Expected tab '%s' to be reused, got '%s'", id, lease.Tab().Data().ID)
+	}
+
+	// Tabs are recycled after MaxUses.
+	lease.Release()
+	if stats := pool.Stats(); 1 != stats.Tabs || 1 != stats.Recycled {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+
+	// Tabs are recycled above MaxHeapSize.
+	atomic.StoreInt64(&heapSize, 10000)
+	second.Release()
+	if stats := pool.Stats(); 0 != stats.Tabs || 2 != stats.Recycled || 3 != stats.Leases {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+
+	lease, err = pool.Acquire(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, got error %s", err)
+	}
+	released := make(chan bool)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		lease.Discard()
+		close(released)
+	}()
+	if err := pool.Close(context.Background()); nil != err {
+		t.Errorf("Expected nil, got error %s", err)
+	}
+	select {
+	case <-released:
+	default:
+		t.Errorf("Expected Close to wait for the leased tab")
+	}
+	if stats := pool.Stats(); 0 != stats.Browsers || 0 != stats.Tabs {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+
+	_, err = pool.Acquire(context.Background())
+	if nil == err || codes.ChromePoolClosed != err.(errs.Err).Code() {
+		t.Errorf("Expected a ChromePoolClosed error, got %v", err)
+	}
+}
+
+func TestPoolHealthCheck(t *testing.T) {
+	var heapSize int64
+	server, flags := newPoolTestServer(&heapSize)
+	defer server.Close()
+
+	checks := 0
+	pool, err := NewPool(PoolOptions{
+		NewBrowser: func() (*Chrome, error) {
+			return New(flags, "", "", "", ""), nil
+		},
+		HealthCheck: func(ctx context.Context, tab *Tab) error {
+			checks++
+			if 1 == checks {
+				return errors.New("unhealthy")
+			}
+			return checkPoolTab(ctx, tab)
+		},
+	})
+	if nil != err {
+		t.Fatalf("Expected nil, got error %s", err)
+	}
+	defer pool.Close(context.Background())
+
+	lease, err := pool.Acquire(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, got error %s", err)
+	}
+	if "page-2" != lease.Tab().Data().ID {
+		t.Errorf("Expected the unhealthy tab to be replaced, got '%s'", lease.Tab().Data().ID)
+	}
+	if stats := pool.Stats(); 1 != stats.Tabs || 1 != stats.Recycled {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+	lease.Release()
+}
+
+func TestCheckPoolTabException(t *testing.T) {
+	browser, _ := newPipeBrowser(map[string]string{
+		"Runtime.evaluate": `{"exceptionDetails":{"text":"Uncaught 100%d"}}`,
+	})
+	defer browser.Stop()
+	tab := &Tab{data: &TabData{}, protocol: browser, socket: browser}
+
+	err := checkPoolTab(context.Background(), tab)
+	if nil == err {
+		t.Fatalf("Expected error, got nil")
+	}
+	if codes.ChromePoolFailed != err.(errs.Err).Code() {
+		t.Errorf("Expected ChromePoolFailed, got %s", err)
+	}
+	if "Uncaught 100%d" != err.Error() {
+		t.Errorf("Expected 'Uncaught 100%%d', got '%s'", err.Error())
+	}
+}
@@ -0,0 +1,35 @@
+//go:build !windows
+// +build !windows
+
+package chrome
+
+import (
+	"os"
+	"syscall"
+)
+
+/*
+processAttributes starts Chromium in its own process group, so its child
+processes can be killed with it.
+*/
+func processAttributes() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setpgid: true}
+}
+
+/*
+terminateProcess asks the Chromium process to exit.
+*/
+func terminateProcess(process *os.Process) error {
+	return process.Signal(syscall.SIGTERM)
+}
+
+/*
+killProcessGroup kills the process group of the Chromium process. A process
+group without processes left is not an error.
+*/
+func killProcessGroup(process *os.Process) error {
+	if err := syscall.Kill(-process.Pid, syscall.SIGKILL); nil != err && syscall.ESRCH != err {
+		return err
+	}
+	return nil
+}
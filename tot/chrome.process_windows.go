@@ -0,0 +1,34 @@
+//go:build windows
+// +build windows
+
+package chrome
+
+import (
+	"os"
+	"syscall"
+)
+
+/*
+processAttributes returns the default process attributes, Windows has no
+process groups to start Chromium in.
+*/
+func processAttributes() *syscall.SysProcAttr {
+	return nil
+}
+
+/*
+terminateProcess stops the Chromium process, Windows does not support SIGTERM.
+*/
+func terminateProcess(process *os.Process) error {
+	return process.Kill()
+}
+
+/*
+killProcessGroup stops the Chromium process. Kill fails once the process has
+exited, which is not an error here, a process that does not exit is reported
+by the shutdown timeout.
+*/
+func killProcessGroup(process *os.Process) error {
+	process.Kill()
+	return nil
+}
@@ -0,0 +1,163 @@
+package chrome
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+profileSkipFiles lists the profile files that belong to a running Chromium
+process and are not copied from a template profile.
+*/
+var profileSkipFiles = map[string]bool{
+	"DevToolsActivePort": true,
+	"SingletonCookie":    true,
+	"SingletonLock":      true,
+	"SingletonSocket":    true,
+}
+
+/*
+ProfileTemplate returns the path of the template profile directory, empty if
+temporary profiles start empty.
+*/
+func (chrome *Chrome) ProfileTemplate() string {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	return chrome.profileTemplate
+}
+
+/*
+SetProfileTemplate sets a profile directory that is copied into each temporary
+profile, so launches start with its cookies, preferences and extensions. The
+template is not used when the 'user-data-dir' flag is set.
+*/
+func (chrome *Chrome) SetProfileTemplate(dir string) {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	chrome.profileTemplate = dir
+}
+
+/*
+UserDataDir returns the profile directory, either the 'user-data-dir' flag or
+the temporary profile created by Launch.
+*/
+func (chrome *Chrome) UserDataDir() string {
+	if value, err := chrome.Flags().Get("user-data-dir"); nil == err {
+		if dir, ok := value.(string); ok {
+			return dir
+		}
+	}
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	return chrome.profileDir
+}
+
+/*
+createProfile creates a temporary profile directory in the working directory,
+copying the template profile if one is set. The profile is kept across
+relaunches by the supervisor and removed by Close.
+*/
+func (chrome *Chrome) createProfile() (string, error) {
+	chrome.mux.Lock()
+	dir, template := chrome.profileDir, chrome.profileTemplate
+	chrome.mux.Unlock()
+	if "" != dir {
+		return dir, nil
+	}
+
+	dir, err := ioutil.TempDir(chrome.Workdir(), "profile-")
+	if nil != err {
+		return "", errs.Wrap(err, codes.ChromeProfileFailed, fmt.Sprintf("cannot create a profile directory in '%s'", chrome.Workdir()))
+	}
+	if "" != template {
+		if err := copyProfile(template, dir); nil != err {
+			os.RemoveAll(dir)
+			return "", errs.Wrap(err, codes.ChromeProfileFailed, fmt.Sprintf("cannot copy the template profile '%s'", template))
+		}
+	}
+	log.WithFields(log.Fields{"path": dir, "template": template}).
+		Debug("Created profile")
+
+	chrome.mux.Lock()
+	chrome.profileDir = dir
+	chrome.mux.Unlock()
+	return dir, nil
+}
+
+/*
+removeProfile removes the temporary profile directory.
+*/
+func (chrome *Chrome) removeProfile() {
+	chrome.mux.Lock()
+	dir := chrome.profileDir
+	chrome.profileDir = ""
+	chrome.mux.Unlock()
+	if "" == dir {
+		return
+	}
+	if err := os.RemoveAll(dir); nil != err {
+		log.WithFields(log.Fields{"error": err, "path": dir}).
+			Warn("Cannot remove the profile directory")
+	}
+}
+
+/*
+copyProfile copies the contents of a template profile directory into dst.
+*/
+func copyProfile(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if nil != err {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if nil != err {
+			return err
+		}
+		if profileSkipFiles[info.Name()] {
+			return nil
+		}
+		target := filepath.Join(dst, rel)
+
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(target, info.Mode().Perm()|0700)
+		case 0 != info.Mode()&os.ModeSymlink:
+			link, err := os.Readlink(path)
+			if nil != err {
+				return err
+			}
+			return os.Symlink(link, target)
+		case info.Mode().IsRegular():
+			return copyFile(path, target, info.Mode().Perm())
+		}
+		// Sockets, pipes and devices are not part of a profile.
+		return nil
+	})
+}
+
+/*
+copyFile copies a regular file.
+*/
+func copyFile(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if nil != err {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if nil != err {
+		return err
+	}
+	if _, err = io.Copy(out, in); nil != err {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
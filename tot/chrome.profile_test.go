@@ -0,0 +1,51 @@
+package chrome
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestChromiumProfile(t *testing.T) {
+	template, err := ioutil.TempDir("", "go-chrome-template")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	defer os.RemoveAll(template)
+	os.MkdirAll(filepath.Join(template, "Default"), 0700)
+	ioutil.WriteFile(filepath.Join(template, "Default", "Preferences"), []byte(`{"homepage":"about:blank"}`), 0600)
+	os.Symlink("host-1234", filepath.Join(template, "SingletonLock"))
+
+	chrome, _, cleanup := testDynamicPort(t, `
+echo "DevTools listening on ws://127.0.0.1:$GO_CHROME_TEST_PORT/devtools/browser/profile-id" >&2
+exec sleep 5`)
+	defer cleanup()
+	chrome.SetProfileTemplate(template)
+
+	if err := chrome.Launch(); nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	profile := chrome.UserDataDir()
+	if !strings.HasPrefix(profile, chrome.Workdir()) {
+		t.Errorf("Expected a profile in '%s', got '%s'", chrome.Workdir(), profile)
+	}
+	preferences, err := ioutil.ReadFile(filepath.Join(profile, "Default", "Preferences"))
+	if nil != err || `{"homepage":"about:blank"}` != string(preferences) {
+		t.Errorf("Expected the template preferences, got '%s' (%v)", preferences, err)
+	}
+	if _, err := os.Lstat(filepath.Join(profile, "SingletonLock")); nil == err {
+		t.Errorf("Expected the process lock not to be copied")
+	}
+
+	if err := chrome.Close(); nil != err {
+		t.Errorf("Expected nil, got error: '%v'", err)
+	}
+	if _, err := os.Stat(profile); !os.IsNotExist(err) {
+		t.Errorf("Expected the profile to be removed, got '%v'", err)
+	}
+	if "" != chrome.UserDataDir() {
+		t.Errorf("Expected no profile, got '%s'", chrome.UserDataDir())
+	}
+}
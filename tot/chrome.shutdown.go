@@ -0,0 +1,193 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+ShutdownStage identifies the step of the shutdown sequence that stopped the
+Chromium process.
+*/
+type ShutdownStage int
+
+const (
+	// ShutdownNone - Close has not stopped a process.
+	ShutdownNone ShutdownStage = iota
+	// ShutdownExited - The process had already exited.
+	ShutdownExited
+	// ShutdownBrowserClose - The process exited after a Browser.close command.
+	ShutdownBrowserClose
+	// ShutdownTerminate - The process exited after a SIGTERM signal.
+	ShutdownTerminate
+	// ShutdownKill - The process group was killed.
+	ShutdownKill
+	// ShutdownFailed - The process did not exit.
+	ShutdownFailed
+)
+
+/*
+String implements Stringer.
+*/
+func (stage ShutdownStage) String() string {
+	switch stage {
+	case ShutdownExited:
+		return "exited"
+	case ShutdownBrowserClose:
+		return "Browser.close"
+	case ShutdownTerminate:
+		return "SIGTERM"
+	case ShutdownKill:
+		return "SIGKILL"
+	case ShutdownFailed:
+		return "failed"
+	}
+	return "none"
+}
+
+/*
+ShutdownTimeouts defines how long Close waits for the Chromium process to exit
+after each step of the shutdown sequence.
+*/
+type ShutdownTimeouts struct {
+	// Optional. BrowserClose is the time to wait after sending the
+	// Browser.close command. Defaults to 5 seconds.
+	BrowserClose time.Duration
+
+	// Optional. Terminate is the time to wait after sending SIGTERM. Defaults
+	// to 5 seconds.
+	Terminate time.Duration
+
+	// Optional. Kill is the time to wait after killing the process group.
+	// Defaults to 5 seconds.
+	Kill time.Duration
+}
+
+/*
+ShutdownStage returns the step of the shutdown sequence that stopped the
+Chromium process during the last Close.
+*/
+func (chrome *Chrome) ShutdownStage() ShutdownStage {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	return chrome.shutdownStage
+}
+
+/*
+ShutdownTimeouts returns the shutdown timeouts, with the defaults applied.
+*/
+func (chrome *Chrome) ShutdownTimeouts() ShutdownTimeouts {
+	chrome.mux.Lock()
+	timeouts := chrome.shutdownTimeouts
+	chrome.mux.Unlock()
+	if timeouts.BrowserClose <= 0 {
+		timeouts.BrowserClose = 5 * time.Second
+	}
+	if timeouts.Terminate <= 0 {
+		timeouts.Terminate = 5 * time.Second
+	}
+	if timeouts.Kill <= 0 {
+		timeouts.Kill = 5 * time.Second
+	}
+	return timeouts
+}
+
+/*
+SetShutdownTimeouts sets how long Close waits for the Chromium process to exit
+after each step of the shutdown sequence.
+*/
+func (chrome *Chrome) SetShutdownTimeouts(timeouts ShutdownTimeouts) {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	chrome.shutdownTimeouts = timeouts
+}
+
+/*
+shutdown stops the Chromium process. It first sends the Browser.close command,
+then SIGTERM to the process, and finally SIGKILL to the process group, waiting
+for the process to exit after each step. Processes left in the process group,
+such as orphaned renderers, are killed once the browser process has exited.
+*/
+func (chrome *Chrome) shutdown(process *os.Process, exited chan bool) (ShutdownStage, error) {
+	timeouts := chrome.ShutdownTimeouts()
+	wait := func(timeout time.Duration) bool {
+		select {
+		case <-exited:
+			return true
+		case <-time.After(timeout):
+			return false
+		}
+	}
+
+	stage := ShutdownExited
+	select {
+	case <-exited:
+	default:
+		stage = ShutdownBrowserClose
+		chrome.mux.Lock()
+		browser := chrome.browser
+		chrome.mux.Unlock()
+		go chrome.closeBrowser(browser, timeouts.BrowserClose)
+		if wait(timeouts.BrowserClose) {
+			break
+		}
+
+		stage = ShutdownTerminate
+		log.WithFields(log.Fields{"pid": process.Pid}).
+			Warn("Chromium did not exit after Browser.close, sending SIGTERM")
+		if err := terminateProcess(process); nil != err {
+			log.WithFields(log.Fields{"error": err, "pid": process.Pid}).
+				Warn(err)
+		}
+		if wait(timeouts.Terminate) {
+			break
+		}
+
+		stage = ShutdownKill
+		log.WithFields(log.Fields{"pid": process.Pid}).
+			Warn("Chromium did not exit after SIGTERM, killing the process group")
+		if err := killProcessGroup(process); nil != err {
+			return ShutdownFailed, errs.Wrap(err, codes.ChromeSigintFailed, fmt.Sprintf("could not kill chromium process %d", process.Pid))
+		}
+		if !wait(timeouts.Kill) {
+			return ShutdownFailed, errs.New(codes.ChromeExitTimeout, fmt.Sprintf("chromium process %d did not exit", process.Pid))
+		}
+	}
+
+	if err := killProcessGroup(process); nil != err {
+		log.WithFields(log.Fields{"error": err, "pid": process.Pid}).
+			Warn("Cannot kill the remaining Chromium processes")
+	}
+	return stage, nil
+}
+
+/*
+closeBrowser sends the Browser.close command. Without a browser-level socket
+connection a temporary one is opened, which may block on an unresponsive
+browser.
+*/
+func (chrome *Chrome) closeBrowser(conn *socket.Socket, timeout time.Duration) {
+	if nil == conn {
+		debuggingURL := chrome.DebuggingURL()
+		if "" == debuggingURL {
+			return
+		}
+		endpoint := chrome.Endpoint()
+		websocketURL, err := endpoint.WebsocketURL(debuggingURL)
+		if nil != err {
+			return
+		}
+		conn = socket.NewWithOptions(websocketURL, endpoint.WebsocketOptions())
+		defer conn.Stop()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	<-conn.Browser().CloseContext(ctx)
+}
@@ -0,0 +1,134 @@
+//go:build !windows
+// +build !windows
+
+package chrome
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+startShutdownTestProcess emulates a running browser process.
+*/
+func startShutdownTestProcess(t *testing.T, chrome *Chrome, script string) *os.Process {
+	process, err := os.StartProcess("/bin/sh", []string{"sh", "-c", script}, &os.ProcAttr{
+		Sys: processAttributes(),
+	})
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	exited := make(chan bool)
+	chrome.mux.Lock()
+	chrome.process = process
+	chrome.exited = exited
+	chrome.mux.Unlock()
+	go chrome.supervise(process, exited)
+	return process
+}
+
+func TestChromiumShutdownBrowserClose(t *testing.T) {
+	var host string
+	var process *os.Process
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/version", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&Version{WebSocketDebuggerURL: "ws://" + host + "/devtools/browser/browser-1"})
+	})
+	mux.HandleFunc("/devtools/", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if nil != err {
+			return
+		}
+		defer conn.Close()
+		for {
+			payload := &socket.Payload{}
+			if err := conn.ReadJSON(payload); nil != err {
+				return
+			}
+			if "Browser.close" == payload.Method {
+				process.Kill()
+			}
+			conn.WriteJSON(&socket.Response{ID: payload.ID, Result: []byte(`{}`)})
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	host = strings.TrimPrefix(server.URL, "http://")
+	addr, port, _ := net.SplitHostPort(host)
+	portNum, _ := strconv.Atoi(port)
+
+	chrome := New(&Flags{"addr": addr, "port": portNum}, "", "", "", "")
+	process = startShutdownTestProcess(t, chrome, "sleep 30")
+	if err := chrome.Close(); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if ShutdownBrowserClose != chrome.ShutdownStage() {
+		t.Errorf("Expected stage '%s', got '%s'", ShutdownBrowserClose, chrome.ShutdownStage())
+	}
+}
+
+func TestChromiumShutdownTerminate(t *testing.T) {
+	// Nothing listens on the debugging port, Browser.close is not delivered.
+	chrome := New(&Flags{"addr": "127.0.0.1", "port": 1}, "", "", "", "")
+	chrome.SetShutdownTimeouts(ShutdownTimeouts{BrowserClose: 100 * time.Millisecond})
+	startShutdownTestProcess(t, chrome, "sleep 30")
+
+	if err := chrome.Close(); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if ShutdownTerminate != chrome.ShutdownStage() {
+		t.Errorf("Expected stage '%s', got '%s'", ShutdownTerminate, chrome.ShutdownStage())
+	}
+}
+
+func TestChromiumShutdownKill(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-chrome-shutdown-")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	defer os.RemoveAll(dir)
+	pidFile := filepath.Join(dir, "child.pid")
+
+	chrome := New(&Flags{"addr": "127.0.0.1", "port": 1}, "", "", "", "")
+	chrome.SetShutdownTimeouts(ShutdownTimeouts{
+		BrowserClose: 100 * time.Millisecond,
+		Terminate:    100 * time.Millisecond,
+	})
+	// The process ignores SIGTERM and starts a child process, e.g. a renderer.
+	startShutdownTestProcess(t, chrome, "trap '' TERM; sleep 30 & echo $! > "+pidFile+"; wait")
+	var content []byte
+	for a := 0; a < 50 && 0 == len(content); a++ {
+		time.Sleep(20 * time.Millisecond)
+		content, _ = ioutil.ReadFile(pidFile)
+	}
+
+	if err := chrome.Close(); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if ShutdownKill != chrome.ShutdownStage() {
+		t.Errorf("Expected stage '%s', got '%s'", ShutdownKill, chrome.ShutdownStage())
+	}
+
+	// The orphaned child is killed with the process group. It may remain as a
+	// zombie until it is reaped.
+	pid := strings.TrimSpace(string(content))
+	if "" == pid {
+		t.Fatalf("Expected the child process ID")
+	}
+	time.Sleep(100 * time.Millisecond)
+	if stat, err := ioutil.ReadFile("/proc/" + pid + "/stat"); nil == err && !strings.Contains(string(stat), ") Z ") {
+		t.Errorf("Expected child process %s to be killed: %s", pid, stat)
+	}
+}
@@ -0,0 +1,388 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/page"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+RestartPolicy defines how the supervisor relaunches a Chromium process that
+exits unexpectedly. The process is relaunched with the same Flags.
+*/
+type RestartPolicy struct {
+	// Optional. MaxRestarts is the maximum number of relaunches. Defaults to
+	// 3, a negative value allows unlimited relaunches.
+	MaxRestarts int
+
+	// Optional. ReopenTabs reopens the open tabs at their last URL after a
+	// relaunch. The existing Tab values are connected to the new targets,
+	// event handlers must be added again.
+	ReopenTabs bool
+}
+
+/*
+ProcessWatcher defines the interface for receiving Chromium process lifecycle
+notifications from the supervisor.
+*/
+type ProcessWatcher interface {
+	// ProcessExited is called when the Chromium process exits unexpectedly,
+	// or when a relaunch fails.
+	ProcessExited(err error)
+
+	// ProcessRestarted is called after the Chromium process has been
+	// relaunched.
+	ProcessRestarted()
+}
+
+/*
+SupervisedTab adapts the tabs of each protocol version to the process
+supervisor, see ReportExit and ReopenTabs.
+*/
+type SupervisedTab interface {
+	// Disconnect stops the socket connection of the tab.
+	Disconnect()
+
+	// OpenSocket returns the socket of the tab, nil if it is not connected.
+	// Tabs adopted by Connect that were never used are not connected.
+	OpenSocket() socket.Socketer
+
+	// Reopen connects the tab to a new target opened at uri.
+	Reopen(uri string) error
+
+	// URL returns the last URL of the tab.
+	URL() *url.URL
+}
+
+/*
+ReportExit reports err on the Errors() channel of each connected tab. Tabs that
+are not connected are not connected to report it.
+*/
+func ReportExit(tabs []SupervisedTab, err error) {
+	for _, tab := range tabs {
+		SendError(tab.OpenSocket(), err)
+	}
+}
+
+/*
+ReopenTabs disconnects the tabs of a relaunched process and, if reopen is set,
+reopens each of them at its last URL. A ChromeRestartFailed error is reported
+on the Errors() channel of a tab that could not be reopened.
+*/
+func ReopenTabs(tabs []SupervisedTab, reopen bool) {
+	for _, tab := range tabs {
+		tab.Disconnect()
+	}
+	if !reopen {
+		return
+	}
+
+	for _, tab := range tabs {
+		uri := ""
+		if nil != tab.URL() {
+			uri = tab.URL().String()
+		}
+		if err := tab.Reopen(uri); nil != err {
+			err = errs.Wrap(err, codes.ChromeRestartFailed, fmt.Sprintf("could not reopen tab '%s'", uri))
+			log.WithFields(log.Fields{"error": err}).
+				Warn(err)
+			SendError(tab.OpenSocket(), err)
+		}
+	}
+}
+
+/*
+MainFrameURL returns the URL of a frame navigation, nil if the frame is not a
+main frame or the URL is invalid.
+*/
+func MainFrameURL(parentID, frameURL string) *url.URL {
+	if "" != parentID {
+		return nil
+	}
+	uri, err := url.Parse(frameURL)
+	if nil != err {
+		return nil
+	}
+	return uri
+}
+
+/*
+WatchCrashes enables the Inspector domain on conn so renderer crashes are
+reported on its Errors() channel.
+*/
+func WatchCrashes(conn socket.Socketer) {
+	go conn.SendCommandContext(
+		context.Background(),
+		socket.NewCommand(conn, "Inspector.enable", nil),
+	)
+}
+
+/*
+RestartPolicy returns the restart policy, nil if the process is not relaunched
+when it exits.
+*/
+func (chrome *Chrome) RestartPolicy() *RestartPolicy {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	return chrome.restartPolicy
+}
+
+/*
+SetRestartPolicy sets the restart policy. A nil policy disables relaunches, an
+unexpected exit is still reported on the Errors() channel of each tab.
+*/
+func (chrome *Chrome) SetRestartPolicy(policy *RestartPolicy) {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	chrome.restartPolicy = policy
+	chrome.restarts = 0
+}
+
+/*
+Watch adds a watcher to be notified when the Chromium process exits
+unexpectedly or is relaunched.
+*/
+func (chrome *Chrome) Watch(watcher ProcessWatcher) {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	chrome.watchers = append(chrome.watchers, watcher)
+}
+
+/*
+SendError sends err to the Errors() channel of a socket if the channel has room.
+*/
+func SendError(conn socket.Socketer, err error) {
+	if nil == conn || nil == conn.Errors() {
+		return
+	}
+	select {
+	case conn.Errors() <- err:
+	default:
+	}
+}
+
+/*
+supervise waits for the Chromium process to exit. Unless the process is being
+closed, the exit is reported to the tabs and watchers and the restart policy is
+applied.
+*/
+func (chrome *Chrome) supervise(process *os.Process, exited chan bool) {
+	state, err := process.Wait()
+
+	chrome.mux.Lock()
+	chrome.exitState = state
+	chrome.exitErr = err
+	closing := chrome.closing
+	chrome.mux.Unlock()
+	close(exited)
+	if closing {
+		return
+	}
+
+	status := "unknown status"
+	if nil != state {
+		status = state.String()
+	}
+	exitErr := errs.New(codes.ChromeProcessExited, fmt.Sprintf("chromium process %d exited unexpectedly: %s%s", process.Pid, status, chrome.outputTail()))
+	log.WithFields(log.Fields{"error": exitErr, "pid": process.Pid}).
+		Error(exitErr)
+	chrome.processExited(exitErr)
+
+	chrome.mux.Lock()
+	policy := chrome.restartPolicy
+	max := 3
+	if nil != policy && 0 != policy.MaxRestarts {
+		max = policy.MaxRestarts
+	}
+	if nil == policy || (max >= 0 && chrome.restarts >= max) {
+		chrome.mux.Unlock()
+		return
+	}
+	chrome.restarts++
+	attempt := chrome.restarts
+	chrome.mux.Unlock()
+
+	log.WithFields(log.Fields{"attempt": attempt, "path": chrome.Binary()}).
+		Info("Relaunching Chromium")
+	if err := chrome.restart(policy); nil != err {
+		log.WithFields(log.Fields{"error": err}).
+			Error(err)
+		chrome.processExited(err)
+		return
+	}
+	chrome.mux.Lock()
+	watchers := append([]ProcessWatcher{}, chrome.watchers...)
+	chrome.mux.Unlock()
+	for _, watcher := range watchers {
+		watcher.ProcessRestarted()
+	}
+}
+
+/*
+processExited reports err to the tabs and watchers.
+*/
+func (chrome *Chrome) processExited(err error) {
+	chrome.mux.Lock()
+	tabs := supervisedTabs(chrome, chrome.tabs)
+	watchers := append([]ProcessWatcher{}, chrome.watchers...)
+	chrome.mux.Unlock()
+	ReportExit(tabs, err)
+	for _, watcher := range watchers {
+		watcher.ProcessExited(err)
+	}
+}
+
+/*
+restart relaunches the Chromium process and reopens the tabs if the policy
+requires it.
+*/
+func (chrome *Chrome) restart(policy *RestartPolicy) error {
+	chrome.mux.Lock()
+	tabs := chrome.tabs
+	chrome.tabs = nil
+	browser := chrome.browser
+	chrome.browser = nil
+	chrome.process = nil
+	chrome.version = nil
+	chrome.mux.Unlock()
+
+	if nil != browser {
+		browser.Stop()
+	}
+	for _, tab := range tabs {
+		tab.disconnect()
+	}
+	chrome.closeOutput()
+
+	if err := chrome.Launch(); nil != err {
+		return errs.Wrap(err, codes.ChromeRestartFailed, "could not relaunch chromium")
+	}
+	ReopenTabs(supervisedTabs(chrome, tabs), policy.ReopenTabs)
+	return nil
+}
+
+/*
+supervisedTab is a SupervisedTab implementation.
+*/
+type supervisedTab struct {
+	chrome *Chrome
+	tab    *Tab
+}
+
+/*
+supervisedTabs adapts tabs to the process supervisor.
+*/
+func supervisedTabs(chrome *Chrome, tabs []*Tab) []SupervisedTab {
+	supervised := make([]SupervisedTab, 0, len(tabs))
+	for _, tab := range tabs {
+		supervised = append(supervised, &supervisedTab{chrome: chrome, tab: tab})
+	}
+	return supervised
+}
+
+/*
+Disconnect is a SupervisedTab implementation.
+*/
+func (supervised *supervisedTab) Disconnect() {
+	supervised.tab.disconnect()
+}
+
+/*
+OpenSocket is a SupervisedTab implementation.
+*/
+func (supervised *supervisedTab) OpenSocket() socket.Socketer {
+	return supervised.tab.openSocket()
+}
+
+/*
+Reopen opens a new tab at uri and moves its connection to the tab, which takes
+its place in the tab list.
+
+Reopen is a SupervisedTab implementation.
+*/
+func (supervised *supervisedTab) Reopen(uri string) error {
+	chrome, tab := supervised.chrome, supervised.tab
+	reopened, err := chrome.NewTab(uri)
+	if nil != err {
+		return err
+	}
+	reopened.navigated.Unsubscribe()
+	chrome.mux.Lock()
+	tab.mux.Lock()
+	tab.browser = reopened.browser
+	tab.data = reopened.data
+	tab.protocol = reopened.protocol
+	tab.socket = reopened.socket
+	tab.mux.Unlock()
+	for k, t := range chrome.tabs {
+		if t == reopened {
+			chrome.tabs[k] = tab
+		}
+	}
+	chrome.mux.Unlock()
+	tab.watch()
+	return nil
+}
+
+/*
+URL is a SupervisedTab implementation.
+*/
+func (supervised *supervisedTab) URL() *url.URL {
+	return supervised.tab.URL()
+}
+
+/*
+watch enables the Page domain to track the last URL of a tab and, when the
+process is supervised, enables the Inspector domain so renderer crashes are
+reported on the Errors() channel.
+*/
+func (tab *Tab) watch() {
+	tab.navigated = tab.Protocol().Page().OnFrameNavigated(func(event *page.FrameNavigatedEvent) {
+		tab.frameNavigated(event.Frame)
+	})
+	go func(enabled <-chan *page.EnableResult) {
+		if result := <-enabled; nil != result.Err {
+			log.WithFields(log.Fields{"error": result.Err}).
+				Warn("Cannot enable the Page domain, the tab URL is not tracked")
+		}
+	}(tab.Protocol().Page().Enable())
+
+	if chrome, ok := tab.chrome.(*Chrome); ok && chrome.Supervised() {
+		WatchCrashes(tab.Socket())
+	}
+}
+
+/*
+frameNavigated records the URL of the main frame.
+*/
+func (tab *Tab) frameNavigated(frame *page.Frame) {
+	if nil == frame {
+		return
+	}
+	uri := MainFrameURL(string(frame.ParentID), frame.URL)
+	if nil == uri {
+		return
+	}
+	tab.mux.Lock()
+	tab.url = uri
+	updated := *tab.data
+	updated.URL = frame.URL
+	tab.data = &updated
+	tab.mux.Unlock()
+}
+
+/*
+Supervised returns whether a launched Chromium process is being supervised.
+*/
+func (chrome *Chrome) Supervised() bool {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	return nil != chrome.exited
+}
@@ -0,0 +1,198 @@
+package chrome
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/gorilla/websocket"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+type testWatcher struct {
+	exited    chan error
+	restarted chan bool
+}
+
+func (watcher *testWatcher) ProcessExited(err error) {
+	watcher.exited <- err
+}
+
+func (watcher *testWatcher) ProcessRestarted() {
+	watcher.restarted <- true
+}
+
+func newSupervisorTestServer() (*httptest.Server, *Flags) {
+	var host string
+	var count int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/new", func(w http.ResponseWriter, r *http.Request) {
+		count++
+		id := "tab-" + strconv.Itoa(count)
+		json.NewEncoder(w).Encode(&TabData{
+			ID:                   id,
+			URL:                  r.URL.RawQuery,
+			WebSocketDebuggerURL: "ws://" + host + "/devtools/page/" + id,
+		})
+	})
+	mux.HandleFunc("/json/version", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&Version{Browser: "HeadlessChrome/70.0.3538.77"})
+	})
+	mux.HandleFunc("/json/close/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("Target is closing"))
+	})
+	mux.HandleFunc("/devtools/page/", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if nil != err {
+			return
+		}
+		defer conn.Close()
+		for {
+			payload := &socket.Payload{}
+			if err := conn.ReadJSON(payload); nil != err {
+				return
+			}
+			conn.WriteJSON(&socket.Response{ID: payload.ID, Result: []byte(`{}`)})
+		}
+	})
+	server := httptest.NewServer(mux)
+
+	host = strings.TrimPrefix(server.URL, "http://")
+	addr, port, _ := net.SplitHostPort(host)
+	portNum, _ := strconv.Atoi(port)
+	flags := &Flags{}
+	flags.Set("addr", addr)
+	flags.Set("port", portNum)
+	return server, flags
+}
+
+func TestChromiumSupervisorRestart(t *testing.T) {
+	server, flags := newSupervisorTestServer()
+	defer server.Close()
+	chrome := New(flags, "/bin/true", "", "", "")
+	watcher := &testWatcher{exited: make(chan error, 10), restarted: make(chan bool, 10)}
+	chrome.Watch(watcher)
+	chrome.SetRestartPolicy(&RestartPolicy{MaxRestarts: 1, ReopenTabs: true})
+
+	tab, err := chrome.NewTab("https://www.example.com/")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	oldSocket := tab.Socket()
+
+	// Emulate a browser process that exits unexpectedly.
+	process, err := os.StartProcess("/bin/sh", []string{"sh", "-c", "sleep 0.1"}, &os.ProcAttr{})
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	exited := make(chan bool)
+	chrome.mux.Lock()
+	chrome.process = process
+	chrome.exited = exited
+	chrome.mux.Unlock()
+	go chrome.supervise(process, exited)
+
+	select {
+	case err := <-watcher.exited:
+		if codes.ChromeProcessExited != err.(errs.Err).Code() {
+			t.Errorf("Expected code %d, got %d", codes.ChromeProcessExited, err.(errs.Err).Code())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for the process exit")
+	}
+	found := false
+	for !found {
+		select {
+		case err := <-oldSocket.Errors():
+			if e, ok := err.(errs.Err); ok && codes.ChromeProcessExited == e.Code() {
+				found = true
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Expected a process exit error on the tab Errors() channel")
+		}
+	}
+
+	select {
+	case <-watcher.restarted:
+	case <-time.After(10 * time.Second):
+		t.Fatalf("Timed out waiting for the relaunch")
+	}
+	if "tab-2" != tab.Data().ID {
+		t.Errorf("Expected the tab to be reopened as 'tab-2', got '%s'", tab.Data().ID)
+	}
+	if "https://www.example.com/" != tab.URL().String() {
+		t.Errorf("Expected the tab URL to be kept, got '%s'", tab.URL().String())
+	}
+	if 1 != len(chrome.Tabs()) || tab != chrome.Tabs()[0] {
+		t.Errorf("Expected the reopened tab in the tab list")
+	}
+
+	chrome.SetRestartPolicy(nil)
+	if err := chrome.Close(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+}
+
+func TestChromiumSupervisorNoRestart(t *testing.T) {
+	chrome := New(&Flags{}, "", "", "", "")
+	watcher := &testWatcher{exited: make(chan error, 10), restarted: make(chan bool, 10)}
+	chrome.Watch(watcher)
+
+	process, err := os.StartProcess("/bin/true", []string{"true"}, &os.ProcAttr{})
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	exited := make(chan bool)
+	chrome.mux.Lock()
+	chrome.process = process
+	chrome.exited = exited
+	chrome.mux.Unlock()
+	go chrome.supervise(process, exited)
+
+	select {
+	case <-watcher.exited:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for the process exit")
+	}
+	select {
+	case <-watcher.restarted:
+		t.Errorf("Expected no relaunch without a restart policy")
+	case <-time.After(100 * time.Millisecond):
+	}
+	if err := chrome.Close(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+}
+
+func TestChromiumSupervisorAdoptedTab(t *testing.T) {
+	chrome := New(&Flags{}, "", "", "", "")
+	watcher := &testWatcher{exited: make(chan error, 10), restarted: make(chan bool, 10)}
+	chrome.Watch(watcher)
+
+	// Adopted tabs that were never used are not connected to report an exit.
+	tab := &Tab{chrome: chrome, data: &TabData{
+		ID:                   "tab-1",
+		WebSocketDebuggerURL: "ws://127.0.0.1:1/devtools/page/tab-1",
+	}}
+	chrome.mux.Lock()
+	chrome.tabs = []*Tab{tab}
+	chrome.mux.Unlock()
+	chrome.processExited(errs.New(codes.ChromeProcessExited, "chromium process exited unexpectedly"))
+	select {
+	case <-watcher.exited:
+	case <-time.After(time.Second):
+		t.Errorf("Expected the watcher to be notified")
+	}
+	ReopenTabs(supervisedTabs(chrome, []*Tab{tab}), false)
+	if nil != tab.openSocket() {
+		t.Errorf("Expected the adopted tab not to be connected")
+	}
+}
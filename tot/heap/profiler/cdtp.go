@@ -1,7 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
-Package profiler provides type definitions for use with the Chrome HeapProfiler protocol
+Package profiler provides type definitions for use with the Chrome HeapProfiler
+protocol.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/
+EXPERIMENTAL.
 */
 package profiler
 
@@ -10,14 +14,28 @@ import (
 )
 
 /*
-HeapSnapshotObjectID is the heap snapshot object id.
+HeapSnapshotObjectID represents the HeapProfiler.HeapSnapshotObjectId type. Heap
+snapshot object id.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#type-HeapSnapshotObjectId
 */
 type HeapSnapshotObjectID string
 
 /*
-SamplingHeapProfileNode is the sampling Heap Profile node. Holds callsite information, allocation
+SamplingHeapProfile represents the HeapProfiler.SamplingHeapProfile type.
+Sampling profile.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#type-SamplingHeapProfile
+*/
+type SamplingHeapProfile struct {
+	Head *SamplingHeapProfileNode `json:"head"`
+
+	Samples []*SamplingHeapProfileSample `json:"samples"`
+}
+
+/*
+SamplingHeapProfileNode represents the HeapProfiler.SamplingHeapProfileNode
+type. Sampling Heap Profile node. Holds callsite information, allocation
 statistics and child nodes.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#type-SamplingHeapProfileNode
@@ -27,17 +45,30 @@ type SamplingHeapProfileNode struct {
 	CallFrame *runtime.CallFrame `json:"callFrame"`
 
 	// Allocations size in bytes for the node excluding children.
-	SelfSize int `json:"selfSize"`
+	SelfSize float64 `json:"selfSize"`
+
+	// Node id. Ids are unique across all profiles collected between
+	// startSampling and stopSampling.
+	ID int `json:"id"`
 
 	// Child nodes.
 	Children []*SamplingHeapProfileNode `json:"children"`
 }
 
 /*
-SamplingHeapProfile represents a heap sample profile
+SamplingHeapProfileSample represents the HeapProfiler.SamplingHeapProfileSample
+type. A single sample from a sampling profile.
 
-https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#type-SamplingHeapProfile
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#type-SamplingHeapProfileSample
 */
-type SamplingHeapProfile struct {
-	Head *SamplingHeapProfileNode `json:"head"`
+type SamplingHeapProfileSample struct {
+	// Allocation size in bytes attributed to the sample.
+	Size float64 `json:"size"`
+
+	// Id of the corresponding profile tree node.
+	NodeID int `json:"nodeId"`
+
+	// Time-ordered sample ordinal number. It is unique across all profiles
+	// retrieved between startSampling and stopSampling.
+	Ordinal float64 `json:"ordinal"`
 }
@@ -1,6 +1,10 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package profiler
 
-import "github.com/mkenney/go-chrome/tot/runtime"
+import (
+	"github.com/mkenney/go-chrome/tot/runtime"
+)
 
 /*
 AddInspectedHeapObjectParams represents HeapProfiler.addInspectedHeapObject parameters.
@@ -8,13 +12,13 @@ AddInspectedHeapObjectParams represents HeapProfiler.addInspectedHeapObject para
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-addInspectedHeapObject
 */
 type AddInspectedHeapObjectParams struct {
-	// Heap snapshot object ID to be accessible by means of $x command line API.
+	// Heap snapshot object id to be accessible by means of $x command line
+	// API.
 	HeapObjectID HeapSnapshotObjectID `json:"heapObjectId"`
 }
 
 /*
-AddInspectedHeapObjectResult represents the result of calls to
-HeapProfiler.AddInspectedHeapObject.
+AddInspectedHeapObjectResult represents the result of calls to HeapProfiler.addInspectedHeapObject.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-addInspectedHeapObject
 */
@@ -59,7 +63,7 @@ GetHeapObjectIDParams represents HeapProfiler.getHeapObjectId parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-getHeapObjectId
 */
 type GetHeapObjectIDParams struct {
-	// Identifier of the object to get heap object ID for.
+	// Identifier of the object to get heap object id for.
 	ObjectID runtime.RemoteObjectID `json:"objectId"`
 }
 
@@ -69,7 +73,7 @@ GetHeapObjectIDResult represents the result of calls to HeapProfiler.getHeapObje
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-getHeapObjectId
 */
 type GetHeapObjectIDResult struct {
-	// ID of the heap snapshot object corresponding to the passed remote object
+	// Id of the heap snapshot object corresponding to the passed remote object
 	// id.
 	HeapSnapshotObjectID HeapSnapshotObjectID `json:"heapSnapshotObjectId"`
 
@@ -83,7 +87,6 @@ GetObjectByHeapObjectIDParams represents HeapProfiler.getObjectByHeapObjectId pa
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-getObjectByHeapObjectId
 */
 type GetObjectByHeapObjectIDParams struct {
-	// desc.
 	ObjectID HeapSnapshotObjectID `json:"objectId"`
 
 	// Optional. Symbolic group name that can be used to release multiple
@@ -92,8 +95,7 @@ type GetObjectByHeapObjectIDParams struct {
 }
 
 /*
-GetObjectByHeapObjectIDResult represents the result of calls to
-HeapProfiler.getObjectByHeapObjectId.
+GetObjectByHeapObjectIDResult represents the result of calls to HeapProfiler.getObjectByHeapObjectId.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-getObjectByHeapObjectId
 */
@@ -106,21 +108,14 @@ type GetObjectByHeapObjectIDResult struct {
 }
 
 /*
-GetSamplingProfileParams represents HeapProfiler.getSamplingProfile parameters.
+GetSamplingProfileResult represents the result of calls to HeapProfiler.getSamplingProfile.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-getSamplingProfile
 */
-type GetSamplingProfileParams struct {
+type GetSamplingProfileResult struct {
 	// Return the sampling profile being collected.
 	Profile *SamplingHeapProfile `json:"profile"`
-}
-
-/*
-GetSamplingProfileResult represents the result of calls to HeapProfiler.getSamplingProfile.
 
-https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-getSamplingProfile
-*/
-type GetSamplingProfileResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -133,7 +128,25 @@ https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-star
 type StartSamplingParams struct {
 	// Optional. Average sample interval in bytes. Poisson distribution is used
 	// for the intervals. The default value is 32768 bytes.
-	SamplingInterval int `json:"samplingInterval,omitempty"`
+	SamplingInterval float64 `json:"samplingInterval,omitempty"`
+
+	// Optional. By default, the sampling heap profiler reports only objects
+	// which are still alive when the profile is returned via
+	// getSamplingProfile or stopSampling, which is useful for determining what
+	// functions contribute the most to steady-state memory usage. This flag
+	// instructs the sampling heap profiler to also include information about
+	// objects discarded by major GC, which will show which functions cause
+	// large temporary memory usage or long GC pauses.
+	IncludeObjectsCollectedByMajorGC bool `json:"includeObjectsCollectedByMajorGC,omitempty"`
+
+	// Optional. By default, the sampling heap profiler reports only objects
+	// which are still alive when the profile is returned via
+	// getSamplingProfile or stopSampling, which is useful for determining what
+	// functions contribute the most to steady-state memory usage. This flag
+	// instructs the sampling heap profiler to also include information about
+	// objects discarded by minor GC, which is useful when tuning a
+	// latency-sensitive application for minimal GC activity.
+	IncludeObjectsCollectedByMinorGC bool `json:"includeObjectsCollectedByMinorGC,omitempty"`
 }
 
 /*
@@ -157,8 +170,7 @@ type StartTrackingHeapObjectsParams struct {
 }
 
 /*
-StartTrackingHeapObjectsResult represents the result of calls to
-HeapProfiler.startTrackingHeapObjects.
+StartTrackingHeapObjectsResult represents the result of calls to HeapProfiler.startTrackingHeapObjects.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-startTrackingHeapObjects
 */
@@ -168,21 +180,14 @@ type StartTrackingHeapObjectsResult struct {
 }
 
 /*
-StopSamplingParams represents HeapProfiler.stopSampling parameters.
+StopSamplingResult represents the result of calls to HeapProfiler.stopSampling.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-stopSampling
 */
-type StopSamplingParams struct {
+type StopSamplingResult struct {
 	// Recorded sampling heap profile.
 	Profile *SamplingHeapProfile `json:"profile"`
-}
-
-/*
-StopSamplingResult represents the result of calls to HeapProfiler.stopSampling.
 
-https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-stopSampling
-*/
-type StopSamplingResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -196,11 +201,19 @@ type StopTrackingHeapObjectsParams struct {
 	// Optional. If true 'reportHeapSnapshotProgress' events will be generated
 	// while snapshot is being taken when the tracking is stopped.
 	ReportProgress bool `json:"reportProgress,omitempty"`
+
+	// Optional. Deprecated in favor of `exposeInternals`. DEPRECATED.
+	TreatGlobalObjectsAsRoots bool `json:"treatGlobalObjectsAsRoots,omitempty"`
+
+	// Optional. If true, numerical values are included in the snapshot.
+	CaptureNumericValue bool `json:"captureNumericValue,omitempty"`
+
+	// Optional. If true, exposes internals of the snapshot. EXPERIMENTAL.
+	ExposeInternals bool `json:"exposeInternals,omitempty"`
 }
 
 /*
-StopTrackingHeapObjectsResult represents the result of calls to
-HeapProfiler.stopTrackingHeapObjects.
+StopTrackingHeapObjectsResult represents the result of calls to HeapProfiler.stopTrackingHeapObjects.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-stopTrackingHeapObjects
 */
@@ -218,6 +231,16 @@ type TakeHeapSnapshotParams struct {
 	// Optional. If true 'reportHeapSnapshotProgress' events will be generated
 	// while snapshot is being taken.
 	ReportProgress bool `json:"reportProgress,omitempty"`
+
+	// Optional. If true, a raw snapshot without artificial roots will be
+	// generated. Deprecated in favor of `exposeInternals`. DEPRECATED.
+	TreatGlobalObjectsAsRoots bool `json:"treatGlobalObjectsAsRoots,omitempty"`
+
+	// Optional. If true, numerical values are included in the snapshot.
+	CaptureNumericValue bool `json:"captureNumericValue,omitempty"`
+
+	// Optional. If true, exposes internals of the snapshot. EXPERIMENTAL.
+	ExposeInternals bool `json:"exposeInternals,omitempty"`
 }
 
 /*
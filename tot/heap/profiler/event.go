@@ -1,7 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package profiler
 
 /*
-AddHeapSnapshotChunkEvent represents DOM.addHeapSnapshotChunk event data.
+AddHeapSnapshotChunkEvent represents HeapProfiler.addHeapSnapshotChunk event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-addHeapSnapshotChunk
 */
@@ -13,7 +15,7 @@ type AddHeapSnapshotChunkEvent struct {
 }
 
 /*
-HeapStatsUpdateEvent represents DOM.heapStatsUpdate event data.
+HeapStatsUpdateEvent represents HeapProfiler.heapStatsUpdate event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-heapStatsUpdate
 */
@@ -29,31 +31,27 @@ type HeapStatsUpdateEvent struct {
 }
 
 /*
-LastSeenObjectIDEvent represents DOM.lastSeenObjectId event data.
+LastSeenObjectIDEvent represents HeapProfiler.lastSeenObjectId event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-lastSeenObjectId
 */
 type LastSeenObjectIDEvent struct {
-	//
 	LastSeenObjectID int `json:"lastSeenObjectId"`
 
-	//
-	Timestamp int `json:"timestamp"`
+	Timestamp float64 `json:"timestamp"`
 
 	// Error information related to this event
 	Err error `json:"-"`
 }
 
 /*
-ReportHeapSnapshotProgressEvent represents DOM.reportHeapSnapshotProgress event data.
+ReportHeapSnapshotProgressEvent represents HeapProfiler.reportHeapSnapshotProgress event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-reportHeapSnapshotProgress
 */
 type ReportHeapSnapshotProgressEvent struct {
-	//
 	Done int `json:"done"`
 
-	//
 	Total int `json:"total"`
 
 	// Optional.
@@ -64,7 +62,7 @@ type ReportHeapSnapshotProgressEvent struct {
 }
 
 /*
-ResetProfilesEvent represents DOM.resetProfiles event data.
+ResetProfilesEvent represents HeapProfiler.resetProfiles event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-resetProfiles
 */
@@ -13,30 +13,69 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/#type-TouchPoint
 type TouchPoint struct {
 	// X coordinate of the event relative to the main frame's viewport in CSS
 	// pixels.
-	X int `json:"x"`
+	X float64 `json:"x"`
 
 	// Y coordinate of the event relative to the main frame's viewport in CSS
 	// pixels. 0 refers to the top of the viewport and Y increases as it
 	// proceeds towards the bottom of the viewport.
-	Y int `json:"y"`
+	Y float64 `json:"y"`
 
 	// Optional. X radius of the touch area (default: 1.0).
-	RadiusX int `json:"radiusX,omitempty"`
+	RadiusX float64 `json:"radiusX,omitempty"`
 
 	// Optional. Y radius of the touch area (default: 1.0).
-	RadiusY int `json:"radiusY,omitempty"`
+	RadiusY float64 `json:"radiusY,omitempty"`
 
 	// Optional. Rotation angle (default: 0.0).
-	RotationAngle int `json:"rotationAngle,omitempty"`
+	RotationAngle float64 `json:"rotationAngle,omitempty"`
 
 	// Optional. Force (default: 1.0).
-	Force int `json:"force,omitempty"`
+	Force float64 `json:"force,omitempty"`
 
 	// Optional. Identifier used to track touch sources between events, must be
 	// unique within an event.
 	ID int `json:"id,omitempty"`
 }
 
+/*
+DragData is the data of an intercepted drag. EXPERIMENTAL
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#type-DragData
+*/
+type DragData struct {
+	// The dragged items.
+	Items []*DragDataItem `json:"items"`
+
+	// Optional. List of filenames that should be included when dropping.
+	Files []string `json:"files,omitempty"`
+
+	// Bit field representing allowed drag operations. Copy = 1, Link = 2,
+	// Move = 16.
+	DragOperationsMask int `json:"dragOperationsMask"`
+}
+
+/*
+DragDataItem is an item of the dragged data. EXPERIMENTAL
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#type-DragDataItem
+*/
+type DragDataItem struct {
+	// Mime type of the dragged data.
+	MimeType string `json:"mimeType"`
+
+	// Depending of the value of `mimeType`, it contains the dragged link,
+	// text, HTML markup or any other data.
+	Data string `json:"data"`
+
+	// Optional. Title associated with a link. Only valid when `mimeType` ==
+	// "text/uri-list".
+	Title string `json:"title,omitempty"`
+
+	// Optional. Stores the base URL for the contained markup. Only valid when
+	// `mimeType` == "text/html".
+	BaseURL string `json:"baseURL,omitempty"`
+}
+
 /*
 GestureSourceType is a gesture source type. EXPERIMENTAL
 
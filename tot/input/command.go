@@ -1,5 +1,47 @@
 package input
 
+/*
+DispatchDragEventParams represents Input.dispatchDragEvent parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchDragEvent
+EXPERIMENTAL.
+*/
+type DispatchDragEventParams struct {
+	// Type of the drag event. Allowed values:
+	//	- DragEvent.DragEnter
+	//	- DragEvent.DragOver
+	//	- DragEvent.Drop
+	//	- DragEvent.DragCancel
+	Type DragEventEnum `json:"type"`
+
+	// X coordinate of the event relative to the main frame's viewport in CSS
+	// pixels.
+	X float64 `json:"x"`
+
+	// Y coordinate of the event relative to the main frame's viewport in CSS
+	// pixels. 0 refers to the top of the viewport and Y increases as it
+	// proceeds towards the bottom of the viewport.
+	Y float64 `json:"y"`
+
+	// The dragged data.
+	Data *DragData `json:"data"`
+
+	// Optional. Bit field representing pressed modifier keys. Alt=1, Ctrl=2,
+	// Meta/Command=4, Shift=8 (default: 0).
+	Modifiers int `json:"modifiers,omitempty"`
+}
+
+/*
+DispatchDragEventResult represents the result of calls to Input.dispatchDragEvent.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchDragEvent
+EXPERIMENTAL.
+*/
+type DispatchDragEventResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 DispatchKeyEventParams represents Input.dispatchKeyEvent parameters.
 
@@ -89,12 +131,12 @@ type DispatchMouseEventParams struct {
 
 	// X coordinate of the event relative to the main frame's viewport in CSS
 	// pixels.
-	X int `json:"x"`
+	X float64 `json:"x"`
 
 	// Y coordinate of the event relative to the main frame's viewport in CSS
 	// pixels. 0 refers to the top of the viewport and Y increases as it
 	// proceeds towards the bottom of the viewport.
-	Y int `json:"y"`
+	Y float64 `json:"y"`
 
 	// Optional. Bit field representing pressed modifier keys. Alt=1, Ctrl=2,
 	// Meta/Command=4, Shift=8 (default: 0).
@@ -110,14 +152,19 @@ type DispatchMouseEventParams struct {
 	//	- ButtonEvent.Right
 	Button ButtonEventEnum `json:"button,omitempty"`
 
+	// Optional. A number indicating which buttons are pressed on the mouse
+	// when a mouse event is triggered. Left=1, Right=2, Middle=4, Back=8,
+	// Forward=16, None=0.
+	Buttons int `json:"buttons,omitempty"`
+
 	// Optional. Number of times the mouse button was clicked (default: 0).
 	ClickCount int `json:"clickCount,omitempty"`
 
 	// Optional. X delta in CSS pixels for mouse wheel event (default: 0).
-	DeltaX int `json:"deltaX,omitempty"`
+	DeltaX float64 `json:"deltaX,omitempty"`
 
 	// Optional. Y delta in CSS pixels for mouse wheel event (default: 0).
-	DeltaY int `json:"deltaY,omitempty"`
+	DeltaY float64 `json:"deltaY,omitempty"`
 }
 
 /*
@@ -198,10 +245,10 @@ type EmulateTouchFromMouseEventParams struct {
 	Button ButtonEventEnum `json:"button"`
 
 	// Optional. X delta in DIP for mouse wheel event (default: 0).
-	DeltaX int `json:"deltaX,omitempty"`
+	DeltaX float64 `json:"deltaX,omitempty"`
 
 	// Optional. Y delta in DIP for mouse wheel event (default: 0).
-	DeltaY int `json:"deltaY,omitempty"`
+	DeltaY float64 `json:"deltaY,omitempty"`
 
 	// Optional. Bit field representing pressed modifier keys. Alt=1, Ctrl=2,
 	// Meta/Command=4, Shift=8 (default: 0).
@@ -222,6 +269,26 @@ type EmulateTouchFromMouseEventResult struct {
 	Err error `json:"-"`
 }
 
+/*
+InsertTextParams represents Input.insertText parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-insertText
+*/
+type InsertTextParams struct {
+	// The text to insert.
+	Text string `json:"text"`
+}
+
+/*
+InsertTextResult represents the result of calls to Input.insertText.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-insertText
+*/
+type InsertTextResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetIgnoreEventsParams represents Input.setIgnoreInputEvents parameters.
 
@@ -242,6 +309,30 @@ type SetIgnoreEventsResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetInterceptDragsParams represents Input.setInterceptDrags parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-setInterceptDrags
+EXPERIMENTAL.
+*/
+type SetInterceptDragsParams struct {
+	// Whether drags are intercepted. Intercepted drags emit
+	// Input.dragIntercepted events instead of the default drag and drop
+	// behavior.
+	Enabled bool `json:"enabled"`
+}
+
+/*
+SetInterceptDragsResult represents the result of calls to Input.setInterceptDrags.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-setInterceptDrags
+EXPERIMENTAL.
+*/
+type SetInterceptDragsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SynthesizePinchGestureParams represents Input.synthesizePinchGesture parameters.
 
@@ -255,7 +346,7 @@ type SynthesizePinchGestureParams struct {
 	Y float64 `json:"y"`
 
 	// Relative scale factor after zooming (>1.0 zooms in, <1.0 zooms out).
-	ScaleFactor int `json:"scaleFactor"`
+	ScaleFactor float64 `json:"scaleFactor"`
 
 	// Optional. Relative pointer speed in pixels per second (default: 800).
 	RelativeSpeed int `json:"relativeSpeed,omitempty"`
@@ -282,25 +373,25 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-synthesizeS
 */
 type SynthesizeScrollGestureParams struct {
 	// X coordinate of the start of the gesture in CSS pixels.
-	X int `json:"x"`
+	X float64 `json:"x"`
 
 	// Y coordinate of the start of the gesture in CSS pixels.
-	Y int `json:"y"`
+	Y float64 `json:"y"`
 
 	// Optional. The distance to scroll along the X axis (positive to scroll
 	// left).
-	XDistance int `json:"xDistance,omitempty"`
+	XDistance float64 `json:"xDistance,omitempty"`
 
 	// Optional. The distance to scroll along the Y axis (positive to scroll up).
-	YDistance int `json:"yDistance,omitempty"`
+	YDistance float64 `json:"yDistance,omitempty"`
 
 	// Optional. The number of additional pixels to scroll back along the X axis,
 	// in addition to the given distance.
-	XOverscroll int `json:"xOverscroll,omitempty"`
+	XOverscroll float64 `json:"xOverscroll,omitempty"`
 
 	// Optional. The number of additional pixels to scroll back along the Y axis,
 	// in addition to the given distance.
-	YOverscroll int `json:"yOverscroll,omitempty"`
+	YOverscroll float64 `json:"yOverscroll,omitempty"`
 
 	// Optional. Prevent fling (default: true).
 	PreventFling bool `json:"preventFling,omitempty"`
@@ -341,10 +432,10 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-synthesizeT
 */
 type SynthesizeTapGestureParams struct {
 	// X coordinate of the start of the gesture in CSS pixels.
-	X int `json:"x"`
+	X float64 `json:"x"`
 
 	// Y coordinate of the start of the gesture in CSS pixels.
-	Y int `json:"y"`
+	Y float64 `json:"y"`
 
 	// Optional. Duration between touchdown and touchup events in ms (default:
 	// 50).
@@ -0,0 +1,89 @@
+package input
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type dragEventEnum struct {
+	DragEnter  DragEventEnum
+	DragOver   DragEventEnum
+	Drop       DragEventEnum
+	DragCancel DragEventEnum
+}
+
+/*
+DragEvent provides named acces to the DragEventEnum values.
+*/
+var DragEvent = dragEventEnum{
+	DragEnter:  dragEventDragEnter,
+	DragOver:   dragEventDragOver,
+	Drop:       dragEventDrop,
+	DragCancel: dragEventDragCancel,
+}
+
+/*
+DragEventEnum represents the type of the drag event. Allowed values:
+	- DragEvent.DragEnter  "dragEnter"
+	- DragEvent.DragOver   "dragOver"
+	- DragEvent.Drop       "drop"
+	- DragEvent.DragCancel "dragCancel"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchDragEvent
+EXPERIMENTAL.
+*/
+type DragEventEnum int
+
+/*
+String implements Stringer
+*/
+func (enum DragEventEnum) String() string {
+	return _dragEventEnums[enum]
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum DragEventEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler
+*/
+func (enum *DragEventEnum) UnmarshalJSON(bytes []byte) error {
+	var err error
+	var val string
+
+	err = json.Unmarshal(bytes, &val)
+	if nil != err {
+		return err
+	}
+
+	for k, v := range _dragEventEnums {
+		if v == val {
+			*enum = k
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s is not a valid type value", bytes)
+}
+
+const (
+	// dragEventDragEnter represents the "dragEnter" value.
+	dragEventDragEnter DragEventEnum = iota + 1
+	// dragEventDragOver represents the "dragOver" value.
+	dragEventDragOver
+	// dragEventDrop represents the "drop" value.
+	dragEventDrop
+	// dragEventDragCancel represents the "dragCancel" value.
+	dragEventDragCancel
+)
+
+var _dragEventEnums = map[DragEventEnum]string{
+	dragEventDragEnter:  "dragEnter",
+	dragEventDragOver:   "dragOver",
+	dragEventDrop:       "drop",
+	dragEventDragCancel: "dragCancel",
+}
@@ -0,0 +1,83 @@
+package input
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumDragEvent(t *testing.T) {
+	var enum DragEventEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = DragEvent.DragEnter
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"dragEnter"` != string(result) {
+		t.Errorf("Expected '\"dragEnter\"', got '%s'", result)
+	}
+	json.Unmarshal([]byte(`"dragEnter"`), &enum)
+	if DragEvent.DragEnter != enum {
+		t.Errorf("Expcected %d, got %d", DragEvent.DragEnter, enum)
+	}
+
+	enum = DragEvent.DragOver
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"dragOver"` != string(result) {
+		t.Errorf("Expected '\"dragOver\"', got '%s'", result)
+	}
+	json.Unmarshal([]byte(`"dragOver"`), &enum)
+	if DragEvent.DragOver != enum {
+		t.Errorf("Expcected %d, got %d", DragEvent.DragOver, enum)
+	}
+
+	enum = DragEvent.Drop
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"drop"` != string(result) {
+		t.Errorf("Expected '\"drop\"', got '%s'", result)
+	}
+	json.Unmarshal([]byte(`"drop"`), &enum)
+	if DragEvent.Drop != enum {
+		t.Errorf("Expcected %d, got %d", DragEvent.Drop, enum)
+	}
+}
+
+func TestEnumDragEvent2(t *testing.T) {
+	var enum DragEventEnum
+	var err error
+	var result []byte
+
+	enum = DragEvent.DragCancel
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"dragCancel"` != string(result) {
+		t.Errorf("Expected '\"dragCancel\"', got '%s'", result)
+	}
+	json.Unmarshal([]byte(`"dragCancel"`), &enum)
+	if DragEvent.DragCancel != enum {
+		t.Errorf("Expcected %d, got %d", DragEvent.DragCancel, enum)
+	}
+}
@@ -0,0 +1,17 @@
+package input
+
+/*
+DragInterceptedEvent represents Input.dragIntercepted event data. It is emitted
+only when Input.setInterceptDrags is enabled, the data is used with
+Input.dispatchDragEvent to restore the drag and drop behavior.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#event-dragIntercepted
+EXPERIMENTAL.
+*/
+type DragInterceptedEvent struct {
+	// The dragged data.
+	Data *DragData `json:"data"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
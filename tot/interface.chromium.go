@@ -21,7 +21,8 @@ type Chromium interface {
 	// does not exist.
 	GetTab(tabID string) (tab Tabber, err error)
 
-	// RemoveTab removes tab reference from chrome tabs list.
+	// RemoveTab removes tab reference from chrome tabs list. The other tabs
+	// are kept.
 	RemoveTab(tab *Tab)
 
 	// DebuggingAddress returns the address that the remote debugging protocol
@@ -32,6 +33,10 @@ type Chromium interface {
 	// available on. Should return a sane default value such as 9222.
 	DebuggingPort() int
 
+	// DebuggingURL returns the browser-level websocket URL of the remote
+	// debugging protocol.
+	DebuggingURL() string
+
 	// Args returns a ChromiumFlags interface used to define and manage CLI
 	// arguments to the Chromium binary. Only used when starting the chromium
 	// system process.
@@ -133,6 +133,13 @@ func (chrome *MockChrome) DebuggingPort() int {
 	return value.(int)
 }
 
+/*
+DebuggingURL implements Chromium.
+*/
+func (chrome *MockChrome) DebuggingURL() string {
+	return ""
+}
+
 /*
 GetTab implements Chromium.
 */
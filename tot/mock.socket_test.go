@@ -1,6 +1,7 @@
 package chrome
 
 import (
+	"context"
 	"net/url"
 
 	"github.com/mkenney/go-chrome/tot/socket"
@@ -156,6 +157,18 @@ func (socket *MockSocket) SendCommand(command socket.Commander) chan *socket.Res
 	return command.Response()
 }
 
+/*
+SendCommandContext is a Socketer implementation.
+*/
+func (socket *MockSocket) SendCommandContext(ctx context.Context, command socket.Commander) (*socket.Response, error) {
+	select {
+	case response := <-command.Response():
+		return response, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 /*
 Stop is a Socketer implementation.
 */
@@ -14,6 +14,6 @@ type Metric struct {
 	// Metric name.
 	Name string `json:"name"`
 
-	// Metric value.
-	Value int `json:"value"`
+	// Metric value. Durations and timestamps are fractional numbers.
+	Value float64 `json:"value"`
 }
@@ -1,5 +1,8 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
-Package profiler provides type definitions for use with the Chrome Profiler protocol
+Package profiler provides type definitions for use with the Chrome Profiler
+protocol.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/
 */
@@ -10,69 +13,8 @@ import (
 )
 
 /*
-ProfileNode holds callsite information, execution statistics and child nodes.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-ProfileNode
-*/
-type ProfileNode struct {
-	// Unique ID of the node.
-	ID int `json:"id"`
-
-	// Function location.
-	CallFrame *runtime.CallFrame `json:"callFrame"`
-
-	// Optional. Number of samples where this node was on top of the call stack.
-	HitCount int `json:"hitCount,omitempty"`
-
-	// Optional. Child node ids.
-	Children []int `json:"children,omitempty"`
-
-	// Optional. The reason of being not optimized. The function may be
-	// deoptimized or marked as don't optimize.
-	DeoptReason string `json:"deoptReason,omitempty"`
-
-	// Optional. An array of source position ticks.
-	PositionTicks []*PositionTickInfo `json:"positionTicks,omitempty"`
-}
-
-/*
-Profile defines a profile
-
-https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-Profile
-*/
-type Profile struct {
-	// The list of profile nodes. First item is the root node.
-	Nodes []*ProfileNode `json:"nodes"`
-
-	// Profiling start timestamp in microseconds.
-	StartTime int `json:"startTime"`
-
-	// Profiling end timestamp in microseconds.
-	EndTime int `json:"endTime"`
-
-	// Optional. Ids of samples top nodes.
-	Samples []int `json:"samples,omitempty"`
-
-	// Optional. Time intervals between adjacent samples in microseconds. The
-	// first delta is relative to the profile startTime.
-	TimeDeltas []int `json:"timeDeltas,omitempty"`
-}
-
-/*
-PositionTickInfo specifies a number of samples attributed to a certain source position.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-PositionTickInfo
-*/
-type PositionTickInfo struct {
-	// Source line number (1-based).
-	Line int `json:"line"`
-
-	// Number of samples attributed to the source line.
-	Ticks int `json:"ticks"`
-}
-
-/*
-CoverageRange defines coverage data for a source range.
+CoverageRange represents the Profiler.CoverageRange type. Coverage data for a
+source range.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-CoverageRange
 */
@@ -88,7 +30,8 @@ type CoverageRange struct {
 }
 
 /*
-FunctionCoverage defines coverage data for a JavaScript function.
+FunctionCoverage represents the Profiler.FunctionCoverage type. Coverage data
+for a JavaScript function.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-FunctionCoverage
 */
@@ -104,58 +47,83 @@ type FunctionCoverage struct {
 }
 
 /*
-ScriptCoverage defines coverage data for a JavaScript script.
+PositionTickInfo represents the Profiler.PositionTickInfo type. Specifies a
+number of samples attributed to a certain source position.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-ScriptCoverage
+https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-PositionTickInfo
 */
-type ScriptCoverage struct {
-	// JavaScript script ID.
-	ScriptID runtime.ScriptID `json:"scriptId"`
-
-	// JavaScript script name or url.
-	URL string `json:"url"`
+type PositionTickInfo struct {
+	// Source line number (1-based).
+	Line int `json:"line"`
 
-	// Functions contained in the script that has coverage data.
-	Functions []*FunctionCoverage `json:"functions"`
+	// Number of samples attributed to the source line.
+	Ticks int `json:"ticks"`
 }
 
 /*
-TypeObject describes a type collected during runtime. EXPERIMENTAL
+Profile represents the Profiler.Profile type. Profile.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-TypeObject
+https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-Profile
 */
-type TypeObject struct {
-	// Name of a type collected with type profiling.
-	Name string `json:"name"`
+type Profile struct {
+	// The list of profile nodes. First item is the root node.
+	Nodes []*ProfileNode `json:"nodes"`
+
+	// Profiling start timestamp in microseconds.
+	StartTime float64 `json:"startTime"`
+
+	// Profiling end timestamp in microseconds.
+	EndTime float64 `json:"endTime"`
+
+	// Optional. Ids of samples top nodes.
+	Samples []int `json:"samples,omitempty"`
+
+	// Optional. Time intervals between adjacent samples in microseconds. The
+	// first delta is relative to the profile startTime.
+	TimeDeltas []int `json:"timeDeltas,omitempty"`
 }
 
 /*
-TypeProfileEntry is the source offset and types for a parameter or return value. EXPERIMENTAL.
+ProfileNode represents the Profiler.ProfileNode type. Profile node. Holds
+callsite information, execution statistics and child nodes.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-TypeProfileEntry
+https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-ProfileNode
 */
-type TypeProfileEntry struct {
-	// Source offset of the parameter or end of function for return values.
-	Offset int `json:"offset"`
+type ProfileNode struct {
+	// Unique id of the node.
+	ID int `json:"id"`
+
+	// Function location.
+	CallFrame *runtime.CallFrame `json:"callFrame"`
+
+	// Optional. Number of samples where this node was on top of the call
+	// stack.
+	HitCount int `json:"hitCount,omitempty"`
 
-	// The types for this parameter or return value.
-	Types []*TypeObject `json:"types"`
+	// Optional. Child node ids.
+	Children []int `json:"children,omitempty"`
+
+	// Optional. The reason of being not optimized. The function may be
+	// deoptimized or marked as don't optimize.
+	DeoptReason string `json:"deoptReason,omitempty"`
+
+	// Optional. An array of source position ticks.
+	PositionTicks []*PositionTickInfo `json:"positionTicks,omitempty"`
 }
 
 /*
-ScriptTypeProfile is type profile data collected during runtime for a JavaScript script.
-EXPERIMENTAL.
+ScriptCoverage represents the Profiler.ScriptCoverage type. Coverage data for a
+JavaScript script.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-ScriptTypeProfile
+https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-ScriptCoverage
 */
-type ScriptTypeProfile struct {
-	// JavaScript script ID.
+type ScriptCoverage struct {
+	// JavaScript script id.
 	ScriptID runtime.ScriptID `json:"scriptId"`
 
 	// JavaScript script name or url.
 	URL string `json:"url"`
 
-	// Type profile entries for parameters and return values of the functions in
-	// the script.
-	Entries []*TypeProfileEntry `json:"entries"`
+	// Functions contained in the script that has coverage data.
+	Functions []*FunctionCoverage `json:"functions"`
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/accessibility"
@@ -26,13 +27,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-get
 func (protocol *AccessibilityProtocol) GetPartialAXTree(
 	params *accessibility.PartialAXTreeParams,
 ) <-chan *accessibility.PartialAXTreeResult {
-	resultChan := make(chan *accessibility.PartialAXTreeResult)
+	return protocol.GetPartialAXTreeContext(context.Background(), params)
+}
+
+/*
+GetPartialAXTreeContext performs GetPartialAXTree and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-getPartialAXTree
+*/
+func (protocol *AccessibilityProtocol) GetPartialAXTreeContext(
+	ctx context.Context,
+	params *accessibility.PartialAXTreeParams,
+) <-chan *accessibility.PartialAXTreeResult {
+	resultChan := make(chan *accessibility.PartialAXTreeResult, 1)
 	command := NewCommand(protocol.Socket, "Accessibility.getPartialAXTree", params)
 	result := &accessibility.PartialAXTreeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/animation"
@@ -21,13 +22,25 @@ Disable animation domain notifications.
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-disable
 */
 func (protocol *AnimationProtocol) Disable() <-chan *animation.DisableResult {
-	resultChan := make(chan *animation.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-disable
+*/
+func (protocol *AnimationProtocol) DisableContext(ctx context.Context) <-chan *animation.DisableResult {
+	resultChan := make(chan *animation.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.disable", nil)
 	result := &animation.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -43,13 +56,25 @@ Enable animation domain notifications.
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-enable
 */
 func (protocol *AnimationProtocol) Enable() <-chan *animation.EnableResult {
-	resultChan := make(chan *animation.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-enable
+*/
+func (protocol *AnimationProtocol) EnableContext(ctx context.Context) <-chan *animation.EnableResult {
+	resultChan := make(chan *animation.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.enable", nil)
 	result := &animation.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -67,13 +92,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-getCurr
 func (protocol *AnimationProtocol) GetCurrentTime(
 	params *animation.GetCurrentTimeParams,
 ) <-chan *animation.GetCurrentTimeResult {
-	resultChan := make(chan *animation.GetCurrentTimeResult)
+	return protocol.GetCurrentTimeContext(context.Background(), params)
+}
+
+/*
+GetCurrentTimeContext performs GetCurrentTime and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-getCurrentTime
+*/
+func (protocol *AnimationProtocol) GetCurrentTimeContext(
+	ctx context.Context,
+	params *animation.GetCurrentTimeParams,
+) <-chan *animation.GetCurrentTimeResult {
+	resultChan := make(chan *animation.GetCurrentTimeResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.getCurrentTime", params)
 	result := &animation.GetCurrentTimeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -91,13 +131,26 @@ GetPlaybackRate gets the playback rate of the document timeline.
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-getPlaybackRate
 */
 func (protocol *AnimationProtocol) GetPlaybackRate() <-chan *animation.GetPlaybackRateResult {
-	resultChan := make(chan *animation.GetPlaybackRateResult)
+	return protocol.GetPlaybackRateContext(context.Background())
+}
+
+/*
+GetPlaybackRateContext performs GetPlaybackRate and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-getPlaybackRate
+*/
+func (protocol *AnimationProtocol) GetPlaybackRateContext(ctx context.Context) <-chan *animation.GetPlaybackRateResult {
+	resultChan := make(chan *animation.GetPlaybackRateResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.getPlaybackRate", nil)
 	result := &animation.GetPlaybackRateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -117,13 +170,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-release
 func (protocol *AnimationProtocol) ReleaseAnimations(
 	params *animation.ReleaseAnimationsParams,
 ) <-chan *animation.ReleaseAnimationsResult {
-	resultChan := make(chan *animation.ReleaseAnimationsResult)
+	return protocol.ReleaseAnimationsContext(context.Background(), params)
+}
+
+/*
+ReleaseAnimationsContext performs ReleaseAnimations and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-releaseAnimations
+*/
+func (protocol *AnimationProtocol) ReleaseAnimationsContext(
+	ctx context.Context,
+	params *animation.ReleaseAnimationsParams,
+) <-chan *animation.ReleaseAnimationsResult {
+	resultChan := make(chan *animation.ReleaseAnimationsResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.releaseAnimations", params)
 	result := &animation.ReleaseAnimationsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -141,13 +210,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-resolve
 func (protocol *AnimationProtocol) ResolveAnimation(
 	params *animation.ResolveAnimationParams,
 ) <-chan *animation.ResolveAnimationResult {
-	resultChan := make(chan *animation.ResolveAnimationResult)
+	return protocol.ResolveAnimationContext(context.Background(), params)
+}
+
+/*
+ResolveAnimationContext performs ResolveAnimation and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-resolveAnimation
+*/
+func (protocol *AnimationProtocol) ResolveAnimationContext(
+	ctx context.Context,
+	params *animation.ResolveAnimationParams,
+) <-chan *animation.ResolveAnimationResult {
+	resultChan := make(chan *animation.ResolveAnimationResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.resolveAnimation", params)
 	result := &animation.ResolveAnimationResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -168,13 +253,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-seekAni
 func (protocol *AnimationProtocol) SeekAnimations(
 	params *animation.SeekAnimationsParams,
 ) <-chan *animation.SeekAnimationsResult {
-	resultChan := make(chan *animation.SeekAnimationsResult)
+	return protocol.SeekAnimationsContext(context.Background(), params)
+}
+
+/*
+SeekAnimationsContext performs SeekAnimations and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-seekAnimations
+*/
+func (protocol *AnimationProtocol) SeekAnimationsContext(
+	ctx context.Context,
+	params *animation.SeekAnimationsParams,
+) <-chan *animation.SeekAnimationsResult {
+	resultChan := make(chan *animation.SeekAnimationsResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.seekAnimations", params)
 	result := &animation.SeekAnimationsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -192,13 +292,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-setPaus
 func (protocol *AnimationProtocol) SetPaused(
 	params *animation.SetPausedParams,
 ) <-chan *animation.SetPausedResult {
-	resultChan := make(chan *animation.SetPausedResult)
+	return protocol.SetPausedContext(context.Background(), params)
+}
+
+/*
+SetPausedContext performs SetPaused and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-setPaused
+*/
+func (protocol *AnimationProtocol) SetPausedContext(
+	ctx context.Context,
+	params *animation.SetPausedParams,
+) <-chan *animation.SetPausedResult {
+	resultChan := make(chan *animation.SetPausedResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.setPaused", params)
 	result := &animation.SetPausedResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -216,13 +331,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-setPlay
 func (protocol *AnimationProtocol) SetPlaybackRate(
 	params *animation.SetPlaybackRateParams,
 ) <-chan *animation.SetPlaybackRateResult {
-	resultChan := make(chan *animation.SetPlaybackRateResult)
+	return protocol.SetPlaybackRateContext(context.Background(), params)
+}
+
+/*
+SetPlaybackRateContext performs SetPlaybackRate and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-setPlaybackRate
+*/
+func (protocol *AnimationProtocol) SetPlaybackRateContext(
+	ctx context.Context,
+	params *animation.SetPlaybackRateParams,
+) <-chan *animation.SetPlaybackRateResult {
+	resultChan := make(chan *animation.SetPlaybackRateResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.setPlaybackRate", params)
 	result := &animation.SetPlaybackRateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -240,13 +371,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-setTimi
 func (protocol *AnimationProtocol) SetTiming(
 	params *animation.SetTimingParams,
 ) <-chan *animation.SetTimingResult {
-	resultChan := make(chan *animation.SetTimingResult)
+	return protocol.SetTimingContext(context.Background(), params)
+}
+
+/*
+SetTimingContext performs SetTiming and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-setTiming
+*/
+func (protocol *AnimationProtocol) SetTimingContext(
+	ctx context.Context,
+	params *animation.SetTimingParams,
+) <-chan *animation.SetTimingResult {
+	resultChan := make(chan *animation.SetTimingResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.setTiming", params)
 	result := &animation.SetTimingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -264,7 +410,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#event-animatio
 */
 func (protocol *AnimationProtocol) OnAnimationCanceled(
 	callback func(event *animation.CanceledEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Animation.animationCanceled",
 		func(response *Response) {
@@ -276,7 +422,29 @@ func (protocol *AnimationProtocol) OnAnimationCanceled(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnAnimationCanceledChan returns a channel of Animation.animationCanceled events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/#event-animationCanceled
+*/
+func (protocol *AnimationProtocol) OnAnimationCanceledChan(
+	ctx context.Context,
+) <-chan *animation.CanceledEvent {
+	eventChan := make(chan *animation.CanceledEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnAnimationCanceled(func(event *animation.CanceledEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -287,7 +455,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#event-animatio
 */
 func (protocol *AnimationProtocol) OnAnimationCreated(
 	callback func(event *animation.CreatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Animation.animationCreated",
 		func(response *Response) {
@@ -299,7 +467,29 @@ func (protocol *AnimationProtocol) OnAnimationCreated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnAnimationCreatedChan returns a channel of Animation.animationCreated events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/#event-animationCreated
+*/
+func (protocol *AnimationProtocol) OnAnimationCreatedChan(
+	ctx context.Context,
+) <-chan *animation.CreatedEvent {
+	eventChan := make(chan *animation.CreatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnAnimationCreated(func(event *animation.CreatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -310,7 +500,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Animation/#event-animatio
 */
 func (protocol *AnimationProtocol) OnAnimationStarted(
 	callback func(event *animation.StartedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Animation.animationStarted",
 		func(response *Response) {
@@ -322,5 +512,27 @@ func (protocol *AnimationProtocol) OnAnimationStarted(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnAnimationStartedChan returns a channel of Animation.animationStarted events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/#event-animationStarted
+*/
+func (protocol *AnimationProtocol) OnAnimationStartedChan(
+	ctx context.Context,
+) <-chan *animation.StartedEvent {
+	eventChan := make(chan *animation.StartedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnAnimationStarted(func(event *animation.StartedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
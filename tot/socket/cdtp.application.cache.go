@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/application/cache"
@@ -22,13 +23,25 @@ Enable enables application cache domain notifications.
 https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#method-enable
 */
 func (protocol *ApplicationCacheProtocol) Enable() <-chan *cache.EnableResult {
-	resultChan := make(chan *cache.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#method-enable
+*/
+func (protocol *ApplicationCacheProtocol) EnableContext(ctx context.Context) <-chan *cache.EnableResult {
+	resultChan := make(chan *cache.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "ApplicationCache.enable", nil)
 	result := &cache.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -47,13 +60,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#method-
 func (protocol *ApplicationCacheProtocol) GetForFrame(
 	params *cache.GetForFrameParams,
 ) <-chan *cache.GetForFrameResult {
-	resultChan := make(chan *cache.GetForFrameResult)
+	return protocol.GetForFrameContext(context.Background(), params)
+}
+
+/*
+GetForFrameContext performs GetForFrame and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#method-getApplicationCacheForFrame
+*/
+func (protocol *ApplicationCacheProtocol) GetForFrameContext(
+	ctx context.Context,
+	params *cache.GetForFrameParams,
+) <-chan *cache.GetForFrameResult {
+	resultChan := make(chan *cache.GetForFrameResult, 1)
 	command := NewCommand(protocol.Socket, "ApplicationCache.getApplicationCacheForFrame", params)
 	result := &cache.GetForFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -72,13 +100,26 @@ each frame containing a document associated with some application cache.
 https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#method-getFramesWithManifests
 */
 func (protocol *ApplicationCacheProtocol) GetFramesWithManifests() <-chan *cache.GetFramesWithManifestsResult {
-	resultChan := make(chan *cache.GetFramesWithManifestsResult)
+	return protocol.GetFramesWithManifestsContext(context.Background())
+}
+
+/*
+GetFramesWithManifestsContext performs GetFramesWithManifests and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#method-getFramesWithManifests
+*/
+func (protocol *ApplicationCacheProtocol) GetFramesWithManifestsContext(ctx context.Context) <-chan *cache.GetFramesWithManifestsResult {
+	resultChan := make(chan *cache.GetFramesWithManifestsResult, 1)
 	command := NewCommand(protocol.Socket, "ApplicationCache.getFramesWithManifests", nil)
 	result := &cache.GetFramesWithManifestsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -98,13 +139,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#method-
 func (protocol *ApplicationCacheProtocol) GetManifestForFrame(
 	params *cache.GetManifestForFrameParams,
 ) <-chan *cache.GetManifestForFrameResult {
-	resultChan := make(chan *cache.GetManifestForFrameResult)
+	return protocol.GetManifestForFrameContext(context.Background(), params)
+}
+
+/*
+GetManifestForFrameContext performs GetManifestForFrame and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#method-getManifestForFrame
+*/
+func (protocol *ApplicationCacheProtocol) GetManifestForFrameContext(
+	ctx context.Context,
+	params *cache.GetManifestForFrameParams,
+) <-chan *cache.GetManifestForFrameResult {
+	resultChan := make(chan *cache.GetManifestForFrameResult, 1)
 	command := NewCommand(protocol.Socket, "ApplicationCache.getManifestForFrame", params)
 	result := &cache.GetManifestForFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -124,7 +181,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#event-a
 */
 func (protocol *ApplicationCacheProtocol) OnApplicationCacheStatusUpdated(
 	callback func(event *cache.StatusUpdatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"ApplicationCache.applicationCacheStatusUpdated",
 		func(response *Response) {
@@ -136,7 +193,30 @@ func (protocol *ApplicationCacheProtocol) OnApplicationCacheStatusUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnApplicationCacheStatusUpdatedChan returns a channel of
+ApplicationCache.applicationCacheStatusUpdated events. The event handler is
+removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#event-applicationCacheStatusUpdated
+*/
+func (protocol *ApplicationCacheProtocol) OnApplicationCacheStatusUpdatedChan(
+	ctx context.Context,
+) <-chan *cache.StatusUpdatedEvent {
+	eventChan := make(chan *cache.StatusUpdatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnApplicationCacheStatusUpdated(func(event *cache.StatusUpdatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -146,7 +226,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#event-n
 */
 func (protocol *ApplicationCacheProtocol) OnNetworkStateUpdated(
 	callback func(event *cache.NetworkStateUpdatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"ApplicationCache.networkStateUpdated",
 		func(response *Response) {
@@ -158,5 +238,28 @@ func (protocol *ApplicationCacheProtocol) OnNetworkStateUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnNetworkStateUpdatedChan returns a channel of
+ApplicationCache.networkStateUpdated events. The event handler is removed and
+the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#event-networkStateUpdated
+*/
+func (protocol *ApplicationCacheProtocol) OnNetworkStateUpdatedChan(
+	ctx context.Context,
+) <-chan *cache.NetworkStateUpdatedEvent {
+	eventChan := make(chan *cache.NetworkStateUpdatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnNetworkStateUpdated(func(event *cache.NetworkStateUpdatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/audits"
@@ -26,13 +27,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Audits/#method-getEncoded
 func (protocol *AuditsProtocol) GetEncodedResponse(
 	params *audits.GetEncodedResponseParams,
 ) <-chan *audits.GetEncodedResponseResult {
-	resultChan := make(chan *audits.GetEncodedResponseResult)
+	return protocol.GetEncodedResponseContext(context.Background(), params)
+}
+
+/*
+GetEncodedResponseContext performs GetEncodedResponse and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#method-getEncodedResponse
+*/
+func (protocol *AuditsProtocol) GetEncodedResponseContext(
+	ctx context.Context,
+	params *audits.GetEncodedResponseParams,
+) <-chan *audits.GetEncodedResponseResult {
+	resultChan := make(chan *audits.GetEncodedResponseResult, 1)
 	command := NewCommand(protocol.Socket, "Audits.getEncodedResponse", params)
 	result := &audits.GetEncodedResponseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/browser"
@@ -22,13 +23,25 @@ Close closes the browser gracefully.
 https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-close
 */
 func (protocol *BrowserProtocol) Close() <-chan *browser.CloseResult {
-	resultChan := make(chan *browser.CloseResult)
+	return protocol.CloseContext(context.Background())
+}
+
+/*
+CloseContext performs Close and returns its result, or returns the context error
+if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-close
+*/
+func (protocol *BrowserProtocol) CloseContext(ctx context.Context) <-chan *browser.CloseResult {
+	resultChan := make(chan *browser.CloseResult, 1)
 	command := NewCommand(protocol.Socket, "Browser.close", nil)
 	result := &browser.CloseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -44,13 +57,25 @@ GetVersion returns version information.
 https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getVersion
 */
 func (protocol *BrowserProtocol) GetVersion() <-chan *browser.GetVersionResult {
-	resultChan := make(chan *browser.GetVersionResult)
+	return protocol.GetVersionContext(context.Background())
+}
+
+/*
+GetVersionContext performs GetVersion and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getVersion
+*/
+func (protocol *BrowserProtocol) GetVersionContext(ctx context.Context) <-chan *browser.GetVersionResult {
+	resultChan := make(chan *browser.GetVersionResult, 1)
 	command := NewCommand(protocol.Socket, "Browser.getVersion", nil)
 	result := &browser.GetVersionResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -70,13 +95,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getWindow
 func (protocol *BrowserProtocol) GetWindowBounds(
 	params *browser.GetWindowBoundsParams,
 ) <-chan *browser.GetWindowBoundsResult {
-	resultChan := make(chan *browser.GetWindowBoundsResult)
+	return protocol.GetWindowBoundsContext(context.Background(), params)
+}
+
+/*
+GetWindowBoundsContext performs GetWindowBounds and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getWindowBounds EXPERIMENTAL.
+*/
+func (protocol *BrowserProtocol) GetWindowBoundsContext(
+	ctx context.Context,
+	params *browser.GetWindowBoundsParams,
+) <-chan *browser.GetWindowBoundsResult {
+	resultChan := make(chan *browser.GetWindowBoundsResult, 1)
 	command := NewCommand(protocol.Socket, "Browser.getWindowBounds", params)
 	result := &browser.GetWindowBoundsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -97,13 +138,30 @@ EXPERIMENTAL.
 func (protocol *BrowserProtocol) GetWindowForTarget(
 	params *browser.GetWindowForTargetParams,
 ) <-chan *browser.GetWindowForTargetResult {
-	resultChan := make(chan *browser.GetWindowForTargetResult)
+	return protocol.GetWindowForTargetContext(context.Background(), params)
+}
+
+/*
+GetWindowForTargetContext performs GetWindowForTarget and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getWindowForTarget
+EXPERIMENTAL.
+*/
+func (protocol *BrowserProtocol) GetWindowForTargetContext(
+	ctx context.Context,
+	params *browser.GetWindowForTargetParams,
+) <-chan *browser.GetWindowForTargetResult {
+	resultChan := make(chan *browser.GetWindowForTargetResult, 1)
 	command := NewCommand(protocol.Socket, "Browser.getWindowForTarget", params)
 	result := &browser.GetWindowForTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -123,13 +181,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setWindow
 func (protocol *BrowserProtocol) SetWindowBounds(
 	params *browser.SetWindowBoundsParams,
 ) <-chan *browser.SetWindowBoundsResult {
-	resultChan := make(chan *browser.SetWindowBoundsResult)
+	return protocol.SetWindowBoundsContext(context.Background(), params)
+}
+
+/*
+SetWindowBoundsContext performs SetWindowBounds and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setWindowBounds EXPERIMENTAL.
+*/
+func (protocol *BrowserProtocol) SetWindowBoundsContext(
+	ctx context.Context,
+	params *browser.SetWindowBoundsParams,
+) <-chan *browser.SetWindowBoundsResult {
+	resultChan := make(chan *browser.SetWindowBoundsResult, 1)
 	command := NewCommand(protocol.Socket, "Browser.setWindowBounds", params)
 	result := &browser.SetWindowBoundsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
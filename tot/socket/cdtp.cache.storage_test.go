@@ -217,7 +217,7 @@ func TestCacheStorageRequestEntries(t *testing.T) {
 				Value: "Value",
 			}},
 		}},
-		HasMore: true,
+		ReturnCount: 1,
 	}
 	mockResultBytes, _ := json.Marshal(mockResult)
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
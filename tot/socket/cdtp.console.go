@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/console"
@@ -22,13 +23,25 @@ ClearMessages does nothing.
 https://chromedevtools.github.io/devtools-protocol/tot/Console/#method-clearMessages
 */
 func (protocol *ConsoleProtocol) ClearMessages() <-chan *console.ClearMessagesResult {
-	resultChan := make(chan *console.ClearMessagesResult)
+	return protocol.ClearMessagesContext(context.Background())
+}
+
+/*
+ClearMessagesContext performs ClearMessages and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Console/#method-clearMessages
+*/
+func (protocol *ConsoleProtocol) ClearMessagesContext(ctx context.Context) <-chan *console.ClearMessagesResult {
+	resultChan := make(chan *console.ClearMessagesResult, 1)
 	command := NewCommand(protocol.Socket, "Console.clearMessages", nil)
 	result := &console.ClearMessagesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -45,13 +58,25 @@ reported to the client.
 https://chromedevtools.github.io/devtools-protocol/tot/Console/#method-disable
 */
 func (protocol *ConsoleProtocol) Disable() <-chan *console.DisableResult {
-	resultChan := make(chan *console.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Console/#method-disable
+*/
+func (protocol *ConsoleProtocol) DisableContext(ctx context.Context) <-chan *console.DisableResult {
+	resultChan := make(chan *console.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Console.disable", nil)
 	result := &console.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -68,13 +93,25 @@ by means of the messageAdded notification.
 https://chromedevtools.github.io/devtools-protocol/tot/Console/#method-enable
 */
 func (protocol *ConsoleProtocol) Enable() <-chan *console.EnableResult {
-	resultChan := make(chan *console.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Console/#method-enable
+*/
+func (protocol *ConsoleProtocol) EnableContext(ctx context.Context) <-chan *console.EnableResult {
+	resultChan := make(chan *console.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Console.enable", nil)
 	result := &console.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -92,7 +129,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Console/#event-messageAdd
 */
 func (protocol *ConsoleProtocol) OnMessageAdded(
 	callback func(event *console.MessageAddedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Console.messageAdded",
 		func(response *Response) {
@@ -104,5 +141,27 @@ func (protocol *ConsoleProtocol) OnMessageAdded(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnMessageAddedChan returns a channel of Console.messageAdded events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Console/#event-messageAdded
+*/
+func (protocol *ConsoleProtocol) OnMessageAddedChan(
+	ctx context.Context,
+) <-chan *console.MessageAddedEvent {
+	eventChan := make(chan *console.MessageAddedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnMessageAdded(func(event *console.MessageAddedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
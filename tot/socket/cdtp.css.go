@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/css"
@@ -32,13 +33,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-addRule
 func (protocol *CSSProtocol) AddRule(
 	params *css.AddRuleParams,
 ) <-chan *css.AddRuleResult {
-	resultChan := make(chan *css.AddRuleResult)
+	return protocol.AddRuleContext(context.Background(), params)
+}
+
+/*
+AddRuleContext performs AddRule and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-addRule
+*/
+func (protocol *CSSProtocol) AddRuleContext(
+	ctx context.Context,
+	params *css.AddRuleParams,
+) <-chan *css.AddRuleResult {
+	resultChan := make(chan *css.AddRuleResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.addRule", params)
 	result := &css.AddRuleResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -58,13 +74,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-collectClassN
 func (protocol *CSSProtocol) CollectClassNames(
 	params *css.CollectClassNamesParams,
 ) <-chan *css.CollectClassNamesResult {
-	resultChan := make(chan *css.CollectClassNamesResult)
+	return protocol.CollectClassNamesContext(context.Background(), params)
+}
+
+/*
+CollectClassNamesContext performs CollectClassNames and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-collectClassNames
+*/
+func (protocol *CSSProtocol) CollectClassNamesContext(
+	ctx context.Context,
+	params *css.CollectClassNamesParams,
+) <-chan *css.CollectClassNamesResult {
+	resultChan := make(chan *css.CollectClassNamesResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.collectClassNames", params)
 	result := &css.CollectClassNamesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -85,13 +117,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-createStyleSh
 func (protocol *CSSProtocol) CreateStyleSheet(
 	params *css.CreateStyleSheetParams,
 ) <-chan *css.CreateStyleSheetResult {
-	resultChan := make(chan *css.CreateStyleSheetResult)
+	return protocol.CreateStyleSheetContext(context.Background(), params)
+}
+
+/*
+CreateStyleSheetContext performs CreateStyleSheet and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-createStyleSheet
+*/
+func (protocol *CSSProtocol) CreateStyleSheetContext(
+	ctx context.Context,
+	params *css.CreateStyleSheetParams,
+) <-chan *css.CreateStyleSheetResult {
+	resultChan := make(chan *css.CreateStyleSheetResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.createStyleSheet", params)
 	result := &css.CreateStyleSheetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -109,13 +157,25 @@ Disable disables the CSS agent for the given page.
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-disable
 */
 func (protocol *CSSProtocol) Disable() <-chan *css.DisableResult {
-	resultChan := make(chan *css.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-disable
+*/
+func (protocol *CSSProtocol) DisableContext(ctx context.Context) <-chan *css.DisableResult {
+	resultChan := make(chan *css.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.disable", nil)
 	result := &css.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -132,13 +192,25 @@ the CSS agent has been enabled until the result of this command is received.
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-enable
 */
 func (protocol *CSSProtocol) Enable() <-chan *css.EnableResult {
-	resultChan := make(chan *css.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-enable
+*/
+func (protocol *CSSProtocol) EnableContext(ctx context.Context) <-chan *css.EnableResult {
+	resultChan := make(chan *css.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.enable", nil)
 	result := &css.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -157,13 +229,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-forcePseudoSt
 func (protocol *CSSProtocol) ForcePseudoState(
 	params *css.ForcePseudoStateParams,
 ) <-chan *css.ForcePseudoStateResult {
-	resultChan := make(chan *css.ForcePseudoStateResult)
+	return protocol.ForcePseudoStateContext(context.Background(), params)
+}
+
+/*
+ForcePseudoStateContext performs ForcePseudoState and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-forcePseudoState
+*/
+func (protocol *CSSProtocol) ForcePseudoStateContext(
+	ctx context.Context,
+	params *css.ForcePseudoStateParams,
+) <-chan *css.ForcePseudoStateResult {
+	resultChan := make(chan *css.ForcePseudoStateResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.forcePseudoState", params)
 	result := &css.ForcePseudoStateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -181,13 +269,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getBackground
 func (protocol *CSSProtocol) GetBackgroundColors(
 	params *css.GetBackgroundColorsParams,
 ) <-chan *css.GetBackgroundColorsResult {
-	resultChan := make(chan *css.GetBackgroundColorsResult)
+	return protocol.GetBackgroundColorsContext(context.Background(), params)
+}
+
+/*
+GetBackgroundColorsContext performs GetBackgroundColors and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getBackgroundColors
+*/
+func (protocol *CSSProtocol) GetBackgroundColorsContext(
+	ctx context.Context,
+	params *css.GetBackgroundColorsParams,
+) <-chan *css.GetBackgroundColorsResult {
+	resultChan := make(chan *css.GetBackgroundColorsResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.getBackgroundColors", params)
 	result := &css.GetBackgroundColorsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -208,13 +312,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getComputedSt
 func (protocol *CSSProtocol) GetComputedStyleForNode(
 	params *css.GetComputedStyleForNodeParams,
 ) <-chan *css.GetComputedStyleForNodeResult {
-	resultChan := make(chan *css.GetComputedStyleForNodeResult)
+	return protocol.GetComputedStyleForNodeContext(context.Background(), params)
+}
+
+/*
+GetComputedStyleForNodeContext performs GetComputedStyleForNode and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getComputedStyleForNode
+*/
+func (protocol *CSSProtocol) GetComputedStyleForNodeContext(
+	ctx context.Context,
+	params *css.GetComputedStyleForNodeParams,
+) <-chan *css.GetComputedStyleForNodeResult {
+	resultChan := make(chan *css.GetComputedStyleForNodeResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.getComputedStyleForNode", params)
 	result := &css.GetComputedStyleForNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -236,13 +356,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getInlineStyl
 func (protocol *CSSProtocol) GetInlineStylesForNode(
 	params *css.GetInlineStylesForNodeParams,
 ) <-chan *css.GetInlineStylesForNodeResult {
-	resultChan := make(chan *css.GetInlineStylesForNodeResult)
+	return protocol.GetInlineStylesForNodeContext(context.Background(), params)
+}
+
+/*
+GetInlineStylesForNodeContext performs GetInlineStylesForNode and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getInlineStylesForNode
+*/
+func (protocol *CSSProtocol) GetInlineStylesForNodeContext(
+	ctx context.Context,
+	params *css.GetInlineStylesForNodeParams,
+) <-chan *css.GetInlineStylesForNodeResult {
+	resultChan := make(chan *css.GetInlineStylesForNodeResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.getInlineStylesForNode", params)
 	result := &css.GetInlineStylesForNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -263,13 +399,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getMatchedSty
 func (protocol *CSSProtocol) GetMatchedStylesForNode(
 	params *css.GetMatchedStylesForNodeParams,
 ) <-chan *css.GetMatchedStylesForNodeResult {
-	resultChan := make(chan *css.GetMatchedStylesForNodeResult)
+	return protocol.GetMatchedStylesForNodeContext(context.Background(), params)
+}
+
+/*
+GetMatchedStylesForNodeContext performs GetMatchedStylesForNode and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getMatchedStylesForNode
+*/
+func (protocol *CSSProtocol) GetMatchedStylesForNodeContext(
+	ctx context.Context,
+	params *css.GetMatchedStylesForNodeParams,
+) <-chan *css.GetMatchedStylesForNodeResult {
+	resultChan := make(chan *css.GetMatchedStylesForNodeResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.getMatchedStylesForNode", params)
 	result := &css.GetMatchedStylesForNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -287,13 +439,26 @@ GetMediaQueries returns all media queries parsed by the rendering engine.
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getMediaQueries
 */
 func (protocol *CSSProtocol) GetMediaQueries() <-chan *css.GetMediaQueriesResult {
-	resultChan := make(chan *css.GetMediaQueriesResult)
+	return protocol.GetMediaQueriesContext(context.Background())
+}
+
+/*
+GetMediaQueriesContext performs GetMediaQueries and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getMediaQueries
+*/
+func (protocol *CSSProtocol) GetMediaQueriesContext(ctx context.Context) <-chan *css.GetMediaQueriesResult {
+	resultChan := make(chan *css.GetMediaQueriesResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.getMediaQueries", nil)
 	result := &css.GetMediaQueriesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -314,13 +479,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getPlatformFo
 func (protocol *CSSProtocol) GetPlatformFontsForNode(
 	params *css.GetPlatformFontsForNodeParams,
 ) <-chan *css.GetPlatformFontsForNodeResult {
-	resultChan := make(chan *css.GetPlatformFontsForNodeResult)
+	return protocol.GetPlatformFontsForNodeContext(context.Background(), params)
+}
+
+/*
+GetPlatformFontsForNodeContext performs GetPlatformFontsForNode and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getPlatformFontsForNode
+*/
+func (protocol *CSSProtocol) GetPlatformFontsForNodeContext(
+	ctx context.Context,
+	params *css.GetPlatformFontsForNodeParams,
+) <-chan *css.GetPlatformFontsForNodeResult {
+	resultChan := make(chan *css.GetPlatformFontsForNodeResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.getPlatformFontsForNode", params)
 	result := &css.GetPlatformFontsForNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -341,13 +522,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getStyleSheet
 func (protocol *CSSProtocol) GetStyleSheetText(
 	params *css.GetStyleSheetTextParams,
 ) <-chan *css.GetStyleSheetTextResult {
-	resultChan := make(chan *css.GetStyleSheetTextResult)
+	return protocol.GetStyleSheetTextContext(context.Background(), params)
+}
+
+/*
+GetStyleSheetTextContext performs GetStyleSheetText and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getStyleSheetText
+*/
+func (protocol *CSSProtocol) GetStyleSheetTextContext(
+	ctx context.Context,
+	params *css.GetStyleSheetTextParams,
+) <-chan *css.GetStyleSheetTextResult {
+	resultChan := make(chan *css.GetStyleSheetTextResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.getStyleSheetText", params)
 	result := &css.GetStyleSheetTextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -368,13 +565,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setEffectiveP
 func (protocol *CSSProtocol) SetEffectivePropertyValueForNode(
 	params *css.SetEffectivePropertyValueForNodeParams,
 ) <-chan *css.SetEffectivePropertyValueForNodeResult {
-	resultChan := make(chan *css.SetEffectivePropertyValueForNodeResult)
+	return protocol.SetEffectivePropertyValueForNodeContext(context.Background(), params)
+}
+
+/*
+SetEffectivePropertyValueForNodeContext performs
+SetEffectivePropertyValueForNode and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setEffectivePropertyValueForNode
+*/
+func (protocol *CSSProtocol) SetEffectivePropertyValueForNodeContext(
+	ctx context.Context,
+	params *css.SetEffectivePropertyValueForNodeParams,
+) <-chan *css.SetEffectivePropertyValueForNodeResult {
+	resultChan := make(chan *css.SetEffectivePropertyValueForNodeResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.setEffectivePropertyValueForNode", params)
 	result := &css.SetEffectivePropertyValueForNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -392,13 +605,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setKeyframeKe
 func (protocol *CSSProtocol) SetKeyframeKey(
 	params *css.SetKeyframeKeyParams,
 ) <-chan *css.SetKeyframeKeyResult {
-	resultChan := make(chan *css.SetKeyframeKeyResult)
+	return protocol.SetKeyframeKeyContext(context.Background(), params)
+}
+
+/*
+SetKeyframeKeyContext performs SetKeyframeKey and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setKeyframeKey
+*/
+func (protocol *CSSProtocol) SetKeyframeKeyContext(
+	ctx context.Context,
+	params *css.SetKeyframeKeyParams,
+) <-chan *css.SetKeyframeKeyResult {
+	resultChan := make(chan *css.SetKeyframeKeyResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.setKeyframeKey", params)
 	result := &css.SetKeyframeKeyResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -418,13 +646,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setMediaText
 func (protocol *CSSProtocol) SetMediaText(
 	params *css.SetMediaTextParams,
 ) <-chan *css.SetMediaTextResult {
-	resultChan := make(chan *css.SetMediaTextResult)
+	return protocol.SetMediaTextContext(context.Background(), params)
+}
+
+/*
+SetMediaTextContext performs SetMediaText and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setMediaText
+*/
+func (protocol *CSSProtocol) SetMediaTextContext(
+	ctx context.Context,
+	params *css.SetMediaTextParams,
+) <-chan *css.SetMediaTextResult {
+	resultChan := make(chan *css.SetMediaTextResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.setMediaText", params)
 	result := &css.SetMediaTextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -444,13 +687,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setRuleSelect
 func (protocol *CSSProtocol) SetRuleSelector(
 	params *css.SetRuleSelectorParams,
 ) <-chan *css.SetRuleSelectorResult {
-	resultChan := make(chan *css.SetRuleSelectorResult)
+	return protocol.SetRuleSelectorContext(context.Background(), params)
+}
+
+/*
+SetRuleSelectorContext performs SetRuleSelector and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setRuleSelector
+*/
+func (protocol *CSSProtocol) SetRuleSelectorContext(
+	ctx context.Context,
+	params *css.SetRuleSelectorParams,
+) <-chan *css.SetRuleSelectorResult {
+	resultChan := make(chan *css.SetRuleSelectorResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.setRuleSelector", params)
 	result := &css.SetRuleSelectorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -470,13 +729,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setStyleSheet
 func (protocol *CSSProtocol) SetStyleSheetText(
 	params *css.SetStyleSheetTextParams,
 ) <-chan *css.SetStyleSheetTextResult {
-	resultChan := make(chan *css.SetStyleSheetTextResult)
+	return protocol.SetStyleSheetTextContext(context.Background(), params)
+}
+
+/*
+SetStyleSheetTextContext performs SetStyleSheetText and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setStyleSheetText
+*/
+func (protocol *CSSProtocol) SetStyleSheetTextContext(
+	ctx context.Context,
+	params *css.SetStyleSheetTextParams,
+) <-chan *css.SetStyleSheetTextResult {
+	resultChan := make(chan *css.SetStyleSheetTextResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.setStyleSheetText", params)
 	result := &css.SetStyleSheetTextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -496,13 +771,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setStyleTexts
 func (protocol *CSSProtocol) SetStyleTexts(
 	params *css.SetStyleTextsParams,
 ) <-chan *css.SetStyleTextsResult {
-	resultChan := make(chan *css.SetStyleTextsResult)
+	return protocol.SetStyleTextsContext(context.Background(), params)
+}
+
+/*
+SetStyleTextsContext performs SetStyleTexts and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setStyleTexts
+*/
+func (protocol *CSSProtocol) SetStyleTextsContext(
+	ctx context.Context,
+	params *css.SetStyleTextsParams,
+) <-chan *css.SetStyleTextsResult {
+	resultChan := make(chan *css.SetStyleTextsResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.setStyleTexts", params)
 	result := &css.SetStyleTextsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -520,13 +810,26 @@ StartRuleUsageTracking enables the selector recording.
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-startRuleUsageTracking
 */
 func (protocol *CSSProtocol) StartRuleUsageTracking() <-chan *css.StartRuleUsageTrackingResult {
-	resultChan := make(chan *css.StartRuleUsageTrackingResult)
+	return protocol.StartRuleUsageTrackingContext(context.Background())
+}
+
+/*
+StartRuleUsageTrackingContext performs StartRuleUsageTracking and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-startRuleUsageTracking
+*/
+func (protocol *CSSProtocol) StartRuleUsageTrackingContext(ctx context.Context) <-chan *css.StartRuleUsageTrackingResult {
+	resultChan := make(chan *css.StartRuleUsageTrackingResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.startRuleUsageTracking", nil)
 	result := &css.StartRuleUsageTrackingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -543,13 +846,26 @@ they were used.
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-stopRuleUsageTracking
 */
 func (protocol *CSSProtocol) StopRuleUsageTracking() <-chan *css.StopRuleUsageTrackingResult {
-	resultChan := make(chan *css.StopRuleUsageTrackingResult)
+	return protocol.StopRuleUsageTrackingContext(context.Background())
+}
+
+/*
+StopRuleUsageTrackingContext performs StopRuleUsageTracking and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-stopRuleUsageTracking
+*/
+func (protocol *CSSProtocol) StopRuleUsageTrackingContext(ctx context.Context) <-chan *css.StopRuleUsageTrackingResult {
+	resultChan := make(chan *css.StopRuleUsageTrackingResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.stopRuleUsageTracking", nil)
 	result := &css.StopRuleUsageTrackingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -568,13 +884,26 @@ this method (or since start of coverage instrumentation).
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-takeCoverageDelta
 */
 func (protocol *CSSProtocol) TakeCoverageDelta() <-chan *css.TakeCoverageDeltaResult {
-	resultChan := make(chan *css.TakeCoverageDeltaResult)
+	return protocol.TakeCoverageDeltaContext(context.Background())
+}
+
+/*
+TakeCoverageDeltaContext performs TakeCoverageDelta and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-takeCoverageDelta
+*/
+func (protocol *CSSProtocol) TakeCoverageDeltaContext(ctx context.Context) <-chan *css.TakeCoverageDeltaResult {
+	resultChan := make(chan *css.TakeCoverageDeltaResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.takeCoverageDelta", nil)
 	result := &css.TakeCoverageDeltaResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -594,7 +923,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-fontsUpdated
 */
 func (protocol *CSSProtocol) OnFontsUpdated(
 	callback func(event *css.FontsUpdatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"CSS.fontsUpdated",
 		func(response *Response) {
@@ -606,7 +935,29 @@ func (protocol *CSSProtocol) OnFontsUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnFontsUpdatedChan returns a channel of CSS.fontsUpdated events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-fontsUpdated
+*/
+func (protocol *CSSProtocol) OnFontsUpdatedChan(
+	ctx context.Context,
+) <-chan *css.FontsUpdatedEvent {
+	eventChan := make(chan *css.FontsUpdatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnFontsUpdated(func(event *css.FontsUpdatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -619,7 +970,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-mediaQueryResu
 */
 func (protocol *CSSProtocol) OnMediaQueryResultChanged(
 	callback func(event *css.MediaQueryResultChangedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"CSS.mediaQueryResultChanged",
 		func(response *Response) {
@@ -631,7 +982,29 @@ func (protocol *CSSProtocol) OnMediaQueryResultChanged(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnMediaQueryResultChangedChan returns a channel of CSS.mediaQueryResultChanged
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-mediaQueryResultChanged
+*/
+func (protocol *CSSProtocol) OnMediaQueryResultChangedChan(
+	ctx context.Context,
+) <-chan *css.MediaQueryResultChangedEvent {
+	eventChan := make(chan *css.MediaQueryResultChangedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnMediaQueryResultChanged(func(event *css.MediaQueryResultChangedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -642,7 +1015,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-styleSheetAdde
 */
 func (protocol *CSSProtocol) OnStyleSheetAdded(
 	callback func(event *css.StyleSheetAddedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"CSS.styleSheetAdded",
 		func(response *Response) {
@@ -654,7 +1027,29 @@ func (protocol *CSSProtocol) OnStyleSheetAdded(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnStyleSheetAddedChan returns a channel of CSS.styleSheetAdded events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-styleSheetAdded
+*/
+func (protocol *CSSProtocol) OnStyleSheetAddedChan(
+	ctx context.Context,
+) <-chan *css.StyleSheetAddedEvent {
+	eventChan := make(chan *css.StyleSheetAddedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnStyleSheetAdded(func(event *css.StyleSheetAddedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -666,7 +1061,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-styleSheetChan
 */
 func (protocol *CSSProtocol) OnStyleSheetChanged(
 	callback func(event *css.StyleSheetChangedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"CSS.styleSheetChanged",
 		func(response *Response) {
@@ -678,7 +1073,29 @@ func (protocol *CSSProtocol) OnStyleSheetChanged(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnStyleSheetChangedChan returns a channel of CSS.styleSheetChanged events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-styleSheetChanged
+*/
+func (protocol *CSSProtocol) OnStyleSheetChangedChan(
+	ctx context.Context,
+) <-chan *css.StyleSheetChangedEvent {
+	eventChan := make(chan *css.StyleSheetChangedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnStyleSheetChanged(func(event *css.StyleSheetChangedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -689,7 +1106,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-styleSheetRemo
 */
 func (protocol *CSSProtocol) OnStyleSheetRemoved(
 	callback func(event *css.StyleSheetRemovedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"CSS.styleSheetRemoved",
 		func(response *Response) {
@@ -701,5 +1118,27 @@ func (protocol *CSSProtocol) OnStyleSheetRemoved(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnStyleSheetRemovedChan returns a channel of CSS.styleSheetRemoved events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-styleSheetRemoved
+*/
+func (protocol *CSSProtocol) OnStyleSheetRemovedChan(
+	ctx context.Context,
+) <-chan *css.StyleSheetRemovedEvent {
+	eventChan := make(chan *css.StyleSheetRemovedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnStyleSheetRemoved(func(event *css.StyleSheetRemovedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/database"
@@ -22,13 +23,25 @@ the client.
 https://chromedevtools.github.io/devtools-protocol/tot/Database/#method-disable
 */
 func (protocol *DatabaseProtocol) Disable() <-chan *database.DisableResult {
-	resultChan := make(chan *database.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Database/#method-disable
+*/
+func (protocol *DatabaseProtocol) DisableContext(ctx context.Context) <-chan *database.DisableResult {
+	resultChan := make(chan *database.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Database.disable", nil)
 	result := &database.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -45,13 +58,25 @@ client.
 https://chromedevtools.github.io/devtools-protocol/tot/Database/#method-enable
 */
 func (protocol *DatabaseProtocol) Enable() <-chan *database.EnableResult {
-	resultChan := make(chan *database.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Database/#method-enable
+*/
+func (protocol *DatabaseProtocol) EnableContext(ctx context.Context) <-chan *database.EnableResult {
+	resultChan := make(chan *database.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Database.enable", nil)
 	result := &database.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -69,13 +94,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Database/#method-executeS
 func (protocol *DatabaseProtocol) ExecuteSQL(
 	params *database.ExecuteSQLParams,
 ) <-chan *database.ExecuteSQLResult {
-	resultChan := make(chan *database.ExecuteSQLResult)
+	return protocol.ExecuteSQLContext(context.Background(), params)
+}
+
+/*
+ExecuteSQLContext performs ExecuteSQL and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Database/#method-executeSQL
+*/
+func (protocol *DatabaseProtocol) ExecuteSQLContext(
+	ctx context.Context,
+	params *database.ExecuteSQLParams,
+) <-chan *database.ExecuteSQLResult {
+	resultChan := make(chan *database.ExecuteSQLResult, 1)
 	command := NewCommand(protocol.Socket, "Database.executeSQL", params)
 	result := &database.ExecuteSQLResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -95,13 +135,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Database/#method-getDatab
 func (protocol *DatabaseProtocol) GetTableNames(
 	params *database.GetTableNamesParams,
 ) <-chan *database.GetTableNamesResult {
-	resultChan := make(chan *database.GetTableNamesResult)
+	return protocol.GetTableNamesContext(context.Background(), params)
+}
+
+/*
+GetTableNamesContext performs GetTableNames and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Database/#method-getDatabaseTableNames
+*/
+func (protocol *DatabaseProtocol) GetTableNamesContext(
+	ctx context.Context,
+	params *database.GetTableNamesParams,
+) <-chan *database.GetTableNamesResult {
+	resultChan := make(chan *database.GetTableNamesResult, 1)
 	command := NewCommand(protocol.Socket, "Database.executeSQL", params)
 	result := &database.GetTableNamesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -121,7 +176,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Database/#event-addDataba
 */
 func (protocol *DatabaseProtocol) OnAdd(
 	callback func(event *database.AddEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Database.addDatabase",
 		func(response *Response) {
@@ -133,5 +188,27 @@ func (protocol *DatabaseProtocol) OnAdd(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnAddChan returns a channel of Database.addDatabase events. The event handler is
+removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Database/#event-addDatabase
+*/
+func (protocol *DatabaseProtocol) OnAddChan(
+	ctx context.Context,
+) <-chan *database.AddEvent {
+	eventChan := make(chan *database.AddEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnAdd(func(event *database.AddEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/debugger"
@@ -26,13 +27,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-continue
 func (protocol *DebuggerProtocol) ContinueToLocation(
 	params *debugger.ContinueToLocationParams,
 ) <-chan *debugger.ContinueToLocationResult {
-	resultChan := make(chan *debugger.ContinueToLocationResult)
+	return protocol.ContinueToLocationContext(context.Background(), params)
+}
+
+/*
+ContinueToLocationContext performs ContinueToLocation and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-continueToLocation
+*/
+func (protocol *DebuggerProtocol) ContinueToLocationContext(
+	ctx context.Context,
+	params *debugger.ContinueToLocationParams,
+) <-chan *debugger.ContinueToLocationResult {
+	resultChan := make(chan *debugger.ContinueToLocationResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.continueToLocation", params)
 	result := &debugger.ContinueToLocationResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -48,13 +65,25 @@ Disable disables debugger for given page.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-disable
 */
 func (protocol *DebuggerProtocol) Disable() <-chan *debugger.DisableResult {
-	resultChan := make(chan *debugger.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-disable
+*/
+func (protocol *DebuggerProtocol) DisableContext(ctx context.Context) <-chan *debugger.DisableResult {
+	resultChan := make(chan *debugger.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.disable", nil)
 	result := &debugger.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -71,13 +100,25 @@ debugging has been enabled until the result for this command is received.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-enable
 */
 func (protocol *DebuggerProtocol) Enable() <-chan *debugger.EnableResult {
-	resultChan := make(chan *debugger.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-enable
+*/
+func (protocol *DebuggerProtocol) EnableContext(ctx context.Context) <-chan *debugger.EnableResult {
+	resultChan := make(chan *debugger.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.enable", nil)
 	result := &debugger.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -97,13 +138,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-evaluate
 func (protocol *DebuggerProtocol) EvaluateOnCallFrame(
 	params *debugger.EvaluateOnCallFrameParams,
 ) <-chan *debugger.EvaluateOnCallFrameResult {
-	resultChan := make(chan *debugger.EvaluateOnCallFrameResult)
+	return protocol.EvaluateOnCallFrameContext(context.Background(), params)
+}
+
+/*
+EvaluateOnCallFrameContext performs EvaluateOnCallFrame and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-evaluateOnCallFrame
+*/
+func (protocol *DebuggerProtocol) EvaluateOnCallFrameContext(
+	ctx context.Context,
+	params *debugger.EvaluateOnCallFrameParams,
+) <-chan *debugger.EvaluateOnCallFrameResult {
+	resultChan := make(chan *debugger.EvaluateOnCallFrameResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.evaluateOnCallFrame", params)
 	result := &debugger.EvaluateOnCallFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -124,13 +181,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getPossi
 func (protocol *DebuggerProtocol) GetPossibleBreakpoints(
 	params *debugger.GetPossibleBreakpointsParams,
 ) <-chan *debugger.GetPossibleBreakpointsResult {
-	resultChan := make(chan *debugger.GetPossibleBreakpointsResult)
+	return protocol.GetPossibleBreakpointsContext(context.Background(), params)
+}
+
+/*
+GetPossibleBreakpointsContext performs GetPossibleBreakpoints and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getPossibleBreakpoints
+*/
+func (protocol *DebuggerProtocol) GetPossibleBreakpointsContext(
+	ctx context.Context,
+	params *debugger.GetPossibleBreakpointsParams,
+) <-chan *debugger.GetPossibleBreakpointsResult {
+	resultChan := make(chan *debugger.GetPossibleBreakpointsResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.getPossibleBreakpoints", params)
 	result := &debugger.GetPossibleBreakpointsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -150,13 +223,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getScrip
 func (protocol *DebuggerProtocol) GetScriptSource(
 	params *debugger.GetScriptSourceParams,
 ) <-chan *debugger.GetScriptSourceResult {
-	resultChan := make(chan *debugger.GetScriptSourceResult)
+	return protocol.GetScriptSourceContext(context.Background(), params)
+}
+
+/*
+GetScriptSourceContext performs GetScriptSource and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getScriptSource
+*/
+func (protocol *DebuggerProtocol) GetScriptSourceContext(
+	ctx context.Context,
+	params *debugger.GetScriptSourceParams,
+) <-chan *debugger.GetScriptSourceResult {
+	resultChan := make(chan *debugger.GetScriptSourceResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.getScriptSource", params)
 	result := &debugger.GetScriptSourceResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -176,13 +265,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getStack
 func (protocol *DebuggerProtocol) GetStackTrace(
 	params *debugger.GetStackTraceParams,
 ) <-chan *debugger.GetStackTraceResult {
-	resultChan := make(chan *debugger.GetStackTraceResult)
+	return protocol.GetStackTraceContext(context.Background(), params)
+}
+
+/*
+GetStackTraceContext performs GetStackTrace and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getStackTrace EXPERIMENTAL.
+*/
+func (protocol *DebuggerProtocol) GetStackTraceContext(
+	ctx context.Context,
+	params *debugger.GetStackTraceParams,
+) <-chan *debugger.GetStackTraceResult {
+	resultChan := make(chan *debugger.GetStackTraceResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.getStackTrace", params)
 	result := &debugger.GetStackTraceResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -200,13 +304,25 @@ Pause stops on the next JavaScript statement.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-pause
 */
 func (protocol *DebuggerProtocol) Pause() <-chan *debugger.PauseResult {
-	resultChan := make(chan *debugger.PauseResult)
+	return protocol.PauseContext(context.Background())
+}
+
+/*
+PauseContext performs Pause and returns its result, or returns the context error
+if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-pause
+*/
+func (protocol *DebuggerProtocol) PauseContext(ctx context.Context) <-chan *debugger.PauseResult {
+	resultChan := make(chan *debugger.PauseResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.pause", nil)
 	result := &debugger.PauseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -224,13 +340,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-pauseOnA
 func (protocol *DebuggerProtocol) PauseOnAsyncCall(
 	params *debugger.PauseOnAsyncCallParams,
 ) <-chan *debugger.PauseOnAsyncCallResult {
-	resultChan := make(chan *debugger.PauseOnAsyncCallResult)
+	return protocol.PauseOnAsyncCallContext(context.Background(), params)
+}
+
+/*
+PauseOnAsyncCallContext performs PauseOnAsyncCall and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-pauseOnAsyncCall EXPERIMENTAL.
+*/
+func (protocol *DebuggerProtocol) PauseOnAsyncCallContext(
+	ctx context.Context,
+	params *debugger.PauseOnAsyncCallParams,
+) <-chan *debugger.PauseOnAsyncCallResult {
+	resultChan := make(chan *debugger.PauseOnAsyncCallResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.pauseOnAsyncCall", params)
 	result := &debugger.PauseOnAsyncCallResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -248,13 +380,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-removeBr
 func (protocol *DebuggerProtocol) RemoveBreakpoint(
 	params *debugger.RemoveBreakpointParams,
 ) <-chan *debugger.RemoveBreakpointResult {
-	resultChan := make(chan *debugger.RemoveBreakpointResult)
+	return protocol.RemoveBreakpointContext(context.Background(), params)
+}
+
+/*
+RemoveBreakpointContext performs RemoveBreakpoint and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-removeBreakpoint
+*/
+func (protocol *DebuggerProtocol) RemoveBreakpointContext(
+	ctx context.Context,
+	params *debugger.RemoveBreakpointParams,
+) <-chan *debugger.RemoveBreakpointResult {
+	resultChan := make(chan *debugger.RemoveBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.removeBreakpoint", params)
 	result := &debugger.RemoveBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -272,13 +420,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-restartF
 func (protocol *DebuggerProtocol) RestartFrame(
 	params *debugger.RestartFrameParams,
 ) <-chan *debugger.RestartFrameResult {
-	resultChan := make(chan *debugger.RestartFrameResult)
+	return protocol.RestartFrameContext(context.Background(), params)
+}
+
+/*
+RestartFrameContext performs RestartFrame and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-restartFrame
+*/
+func (protocol *DebuggerProtocol) RestartFrameContext(
+	ctx context.Context,
+	params *debugger.RestartFrameParams,
+) <-chan *debugger.RestartFrameResult {
+	resultChan := make(chan *debugger.RestartFrameResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.restartFrame", params)
 	result := &debugger.RestartFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -296,13 +459,25 @@ Resume resumes JavaScript execution.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-resume
 */
 func (protocol *DebuggerProtocol) Resume() <-chan *debugger.ResumeResult {
-	resultChan := make(chan *debugger.ResumeResult)
+	return protocol.ResumeContext(context.Background())
+}
+
+/*
+ResumeContext performs Resume and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-resume
+*/
+func (protocol *DebuggerProtocol) ResumeContext(ctx context.Context) <-chan *debugger.ResumeResult {
+	resultChan := make(chan *debugger.ResumeResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.resume", nil)
 	result := &debugger.ResumeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -323,13 +498,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-schedule
 EXPERIMENTAL. DEPRECATED.
 */
 func (protocol *DebuggerProtocol) ScheduleStepIntoAsync() <-chan *debugger.ScheduleStepIntoAsyncResult {
-	resultChan := make(chan *debugger.ScheduleStepIntoAsyncResult)
+	return protocol.ScheduleStepIntoAsyncContext(context.Background())
+}
+
+/*
+ScheduleStepIntoAsyncContext performs ScheduleStepIntoAsync and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-scheduleStepIntoAsync
+EXPERIMENTAL. DEPRECATED.
+*/
+func (protocol *DebuggerProtocol) ScheduleStepIntoAsyncContext(ctx context.Context) <-chan *debugger.ScheduleStepIntoAsyncResult {
+	resultChan := make(chan *debugger.ScheduleStepIntoAsyncResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.scheduleStepIntoAsync", nil)
 	result := &debugger.ScheduleStepIntoAsyncResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -347,13 +536,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-searchIn
 func (protocol *DebuggerProtocol) SearchInContent(
 	params *debugger.SearchInContentParams,
 ) <-chan *debugger.SearchInContentResult {
-	resultChan := make(chan *debugger.SearchInContentResult)
+	return protocol.SearchInContentContext(context.Background(), params)
+}
+
+/*
+SearchInContentContext performs SearchInContent and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-searchInContent
+*/
+func (protocol *DebuggerProtocol) SearchInContentContext(
+	ctx context.Context,
+	params *debugger.SearchInContentParams,
+) <-chan *debugger.SearchInContentResult {
+	resultChan := make(chan *debugger.SearchInContentResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.searchInContent", params)
 	result := &debugger.SearchInContentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -373,13 +578,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setAsync
 func (protocol *DebuggerProtocol) SetAsyncCallStackDepth(
 	params *debugger.SetAsyncCallStackDepthParams,
 ) <-chan *debugger.SetAsyncCallStackDepthResult {
-	resultChan := make(chan *debugger.SetAsyncCallStackDepthResult)
+	return protocol.SetAsyncCallStackDepthContext(context.Background(), params)
+}
+
+/*
+SetAsyncCallStackDepthContext performs SetAsyncCallStackDepth and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setAsyncCallStackDepth
+*/
+func (protocol *DebuggerProtocol) SetAsyncCallStackDepthContext(
+	ctx context.Context,
+	params *debugger.SetAsyncCallStackDepthParams,
+) <-chan *debugger.SetAsyncCallStackDepthResult {
+	resultChan := make(chan *debugger.SetAsyncCallStackDepthResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setAsyncCallStackDepth", params)
 	result := &debugger.SetAsyncCallStackDepthResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -402,13 +623,30 @@ EXPERIMENTAL.
 func (protocol *DebuggerProtocol) SetBlackboxPatterns(
 	params *debugger.SetBlackboxPatternsParams,
 ) <-chan *debugger.SetBlackboxPatternsResult {
-	resultChan := make(chan *debugger.SetBlackboxPatternsResult)
+	return protocol.SetBlackboxPatternsContext(context.Background(), params)
+}
+
+/*
+SetBlackboxPatternsContext performs SetBlackboxPatterns and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBlackboxPatterns
+EXPERIMENTAL.
+*/
+func (protocol *DebuggerProtocol) SetBlackboxPatternsContext(
+	ctx context.Context,
+	params *debugger.SetBlackboxPatternsParams,
+) <-chan *debugger.SetBlackboxPatternsResult {
+	resultChan := make(chan *debugger.SetBlackboxPatternsResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setBlackboxPatterns", params)
 	result := &debugger.SetBlackboxPatternsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -429,13 +667,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBlack
 func (protocol *DebuggerProtocol) SetBlackboxedRanges(
 	params *debugger.SetBlackboxedRangesParams,
 ) <-chan *debugger.SetBlackboxedRangesResult {
-	resultChan := make(chan *debugger.SetBlackboxedRangesResult)
+	return protocol.SetBlackboxedRangesContext(context.Background(), params)
+}
+
+/*
+SetBlackboxedRangesContext performs SetBlackboxedRanges and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBlackboxedRanges
+*/
+func (protocol *DebuggerProtocol) SetBlackboxedRangesContext(
+	ctx context.Context,
+	params *debugger.SetBlackboxedRangesParams,
+) <-chan *debugger.SetBlackboxedRangesResult {
+	resultChan := make(chan *debugger.SetBlackboxedRangesResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setBlackboxedRanges", params)
 	result := &debugger.SetBlackboxedRangesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -453,13 +707,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreak
 func (protocol *DebuggerProtocol) SetBreakpoint(
 	params *debugger.SetBreakpointParams,
 ) <-chan *debugger.SetBreakpointResult {
-	resultChan := make(chan *debugger.SetBreakpointResult)
+	return protocol.SetBreakpointContext(context.Background(), params)
+}
+
+/*
+SetBreakpointContext performs SetBreakpoint and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreakpoint
+*/
+func (protocol *DebuggerProtocol) SetBreakpointContext(
+	ctx context.Context,
+	params *debugger.SetBreakpointParams,
+) <-chan *debugger.SetBreakpointResult {
+	resultChan := make(chan *debugger.SetBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setBreakpoint", params)
 	result := &debugger.SetBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -482,13 +751,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreak
 func (protocol *DebuggerProtocol) SetBreakpointByURL(
 	params *debugger.SetBreakpointByURLParams,
 ) <-chan *debugger.SetBreakpointByURLResult {
-	resultChan := make(chan *debugger.SetBreakpointByURLResult)
+	return protocol.SetBreakpointByURLContext(context.Background(), params)
+}
+
+/*
+SetBreakpointByURLContext performs SetBreakpointByURL and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreakpointByUrl
+*/
+func (protocol *DebuggerProtocol) SetBreakpointByURLContext(
+	ctx context.Context,
+	params *debugger.SetBreakpointByURLParams,
+) <-chan *debugger.SetBreakpointByURLResult {
+	resultChan := make(chan *debugger.SetBreakpointByURLResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setBreakpointByUrl", params)
 	result := &debugger.SetBreakpointByURLResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -508,13 +793,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreak
 func (protocol *DebuggerProtocol) SetBreakpointsActive(
 	params *debugger.SetBreakpointsActiveParams,
 ) <-chan *debugger.SetBreakpointsActiveResult {
-	resultChan := make(chan *debugger.SetBreakpointsActiveResult)
+	return protocol.SetBreakpointsActiveContext(context.Background(), params)
+}
+
+/*
+SetBreakpointsActiveContext performs SetBreakpointsActive and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreakpointsActive
+*/
+func (protocol *DebuggerProtocol) SetBreakpointsActiveContext(
+	ctx context.Context,
+	params *debugger.SetBreakpointsActiveParams,
+) <-chan *debugger.SetBreakpointsActiveResult {
+	resultChan := make(chan *debugger.SetBreakpointsActiveResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setBreakpointsActive", params)
 	result := &debugger.SetBreakpointsActiveResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -533,13 +834,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setPause
 func (protocol *DebuggerProtocol) SetPauseOnExceptions(
 	params *debugger.SetPauseOnExceptionsParams,
 ) <-chan *debugger.SetPauseOnExceptionsResult {
-	resultChan := make(chan *debugger.SetPauseOnExceptionsResult)
+	return protocol.SetPauseOnExceptionsContext(context.Background(), params)
+}
+
+/*
+SetPauseOnExceptionsContext performs SetPauseOnExceptions and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setPauseOnExceptions
+*/
+func (protocol *DebuggerProtocol) SetPauseOnExceptionsContext(
+	ctx context.Context,
+	params *debugger.SetPauseOnExceptionsParams,
+) <-chan *debugger.SetPauseOnExceptionsResult {
+	resultChan := make(chan *debugger.SetPauseOnExceptionsResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setPauseOnExceptions", params)
 	result := &debugger.SetPauseOnExceptionsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -558,13 +875,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setRetur
 func (protocol *DebuggerProtocol) SetReturnValue(
 	params *debugger.SetReturnValueParams,
 ) <-chan *debugger.SetReturnValueResult {
-	resultChan := make(chan *debugger.SetReturnValueResult)
+	return protocol.SetReturnValueContext(context.Background(), params)
+}
+
+/*
+SetReturnValueContext performs SetReturnValue and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setReturnValue EXPERIMENTAL.
+*/
+func (protocol *DebuggerProtocol) SetReturnValueContext(
+	ctx context.Context,
+	params *debugger.SetReturnValueParams,
+) <-chan *debugger.SetReturnValueResult {
+	resultChan := make(chan *debugger.SetReturnValueResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setReturnValue", params)
 	result := &debugger.SetReturnValueResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -582,13 +914,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setScrip
 func (protocol *DebuggerProtocol) SetScriptSource(
 	params *debugger.SetScriptSourceParams,
 ) <-chan *debugger.SetScriptSourceResult {
-	resultChan := make(chan *debugger.SetScriptSourceResult)
+	return protocol.SetScriptSourceContext(context.Background(), params)
+}
+
+/*
+SetScriptSourceContext performs SetScriptSource and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setScriptSource
+*/
+func (protocol *DebuggerProtocol) SetScriptSourceContext(
+	ctx context.Context,
+	params *debugger.SetScriptSourceParams,
+) <-chan *debugger.SetScriptSourceResult {
+	resultChan := make(chan *debugger.SetScriptSourceResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setScriptSource", params)
 	result := &debugger.SetScriptSourceResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -608,13 +956,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setSkipA
 func (protocol *DebuggerProtocol) SetSkipAllPauses(
 	params *debugger.SetSkipAllPausesParams,
 ) <-chan *debugger.SetSkipAllPausesResult {
-	resultChan := make(chan *debugger.SetSkipAllPausesResult)
+	return protocol.SetSkipAllPausesContext(context.Background(), params)
+}
+
+/*
+SetSkipAllPausesContext performs SetSkipAllPauses and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setSkipAllPauses
+*/
+func (protocol *DebuggerProtocol) SetSkipAllPausesContext(
+	ctx context.Context,
+	params *debugger.SetSkipAllPausesParams,
+) <-chan *debugger.SetSkipAllPausesResult {
+	resultChan := make(chan *debugger.SetSkipAllPausesResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setSkipAllPauses", params)
 	result := &debugger.SetSkipAllPausesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -633,13 +997,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setVaria
 func (protocol *DebuggerProtocol) SetVariableValue(
 	params *debugger.SetVariableValueParams,
 ) <-chan *debugger.SetVariableValueResult {
-	resultChan := make(chan *debugger.SetVariableValueResult)
+	return protocol.SetVariableValueContext(context.Background(), params)
+}
+
+/*
+SetVariableValueContext performs SetVariableValue and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setVariableValue
+*/
+func (protocol *DebuggerProtocol) SetVariableValueContext(
+	ctx context.Context,
+	params *debugger.SetVariableValueParams,
+) <-chan *debugger.SetVariableValueResult {
+	resultChan := make(chan *debugger.SetVariableValueResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setVariableValue", params)
 	result := &debugger.SetVariableValueResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -657,13 +1037,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-stepInto
 func (protocol *DebuggerProtocol) StepInto(
 	params *debugger.StepIntoParams,
 ) <-chan *debugger.StepIntoResult {
-	resultChan := make(chan *debugger.StepIntoResult)
+	return protocol.StepIntoContext(context.Background(), params)
+}
+
+/*
+StepIntoContext performs StepInto and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-stepInto
+*/
+func (protocol *DebuggerProtocol) StepIntoContext(
+	ctx context.Context,
+	params *debugger.StepIntoParams,
+) <-chan *debugger.StepIntoResult {
+	resultChan := make(chan *debugger.StepIntoResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.stepInto", params)
 	result := &debugger.StepIntoResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -679,13 +1074,25 @@ StepOut steps out of the function call.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-stepOut
 */
 func (protocol *DebuggerProtocol) StepOut() <-chan *debugger.StepOutResult {
-	resultChan := make(chan *debugger.StepOutResult)
+	return protocol.StepOutContext(context.Background())
+}
+
+/*
+StepOutContext performs StepOut and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-stepOut
+*/
+func (protocol *DebuggerProtocol) StepOutContext(ctx context.Context) <-chan *debugger.StepOutResult {
+	resultChan := make(chan *debugger.StepOutResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.stepOut", nil)
 	result := &debugger.StepOutResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -701,13 +1108,25 @@ StepOver steps over the statement.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-stepOver
 */
 func (protocol *DebuggerProtocol) StepOver() <-chan *debugger.StepOverResult {
-	resultChan := make(chan *debugger.StepOverResult)
+	return protocol.StepOverContext(context.Background())
+}
+
+/*
+StepOverContext performs StepOver and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-stepOver
+*/
+func (protocol *DebuggerProtocol) StepOverContext(ctx context.Context) <-chan *debugger.StepOverResult {
+	resultChan := make(chan *debugger.StepOverResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.stepOver", nil)
 	result := &debugger.StepOverResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -725,7 +1144,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-breakpoin
 */
 func (protocol *DebuggerProtocol) OnBreakpointResolved(
 	callback func(event *debugger.BreakpointResolvedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Debugger.breakpointResolved",
 		func(response *Response) {
@@ -737,7 +1156,29 @@ func (protocol *DebuggerProtocol) OnBreakpointResolved(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnBreakpointResolvedChan returns a channel of Debugger.breakpointResolved
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-breakpointResolved
+*/
+func (protocol *DebuggerProtocol) OnBreakpointResolvedChan(
+	ctx context.Context,
+) <-chan *debugger.BreakpointResolvedEvent {
+	eventChan := make(chan *debugger.BreakpointResolvedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnBreakpointResolved(func(event *debugger.BreakpointResolvedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -748,7 +1189,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-paused
 */
 func (protocol *DebuggerProtocol) OnPaused(
 	callback func(event *debugger.PausedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Debugger.paused",
 		func(response *Response) {
@@ -760,7 +1201,29 @@ func (protocol *DebuggerProtocol) OnPaused(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnPausedChan returns a channel of Debugger.paused events. The event handler is
+removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-paused
+*/
+func (protocol *DebuggerProtocol) OnPausedChan(
+	ctx context.Context,
+) <-chan *debugger.PausedEvent {
+	eventChan := make(chan *debugger.PausedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnPaused(func(event *debugger.PausedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -771,7 +1234,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-resumed
 */
 func (protocol *DebuggerProtocol) OnResumed(
 	callback func(event *debugger.ResumedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Debugger.resumed",
 		func(response *Response) {
@@ -783,7 +1246,29 @@ func (protocol *DebuggerProtocol) OnResumed(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnResumedChan returns a channel of Debugger.resumed events. The event handler is
+removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-resumed
+*/
+func (protocol *DebuggerProtocol) OnResumedChan(
+	ctx context.Context,
+) <-chan *debugger.ResumedEvent {
+	eventChan := make(chan *debugger.ResumedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnResumed(func(event *debugger.ResumedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -794,7 +1279,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-scriptFai
 */
 func (protocol *DebuggerProtocol) OnScriptFailedToParse(
 	callback func(event *debugger.ScriptFailedToParseEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Debugger.scriptFailedToParse",
 		func(response *Response) {
@@ -806,7 +1291,29 @@ func (protocol *DebuggerProtocol) OnScriptFailedToParse(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnScriptFailedToParseChan returns a channel of Debugger.scriptFailedToParse
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-scriptFailedToParse
+*/
+func (protocol *DebuggerProtocol) OnScriptFailedToParseChan(
+	ctx context.Context,
+) <-chan *debugger.ScriptFailedToParseEvent {
+	eventChan := make(chan *debugger.ScriptFailedToParseEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnScriptFailedToParse(func(event *debugger.ScriptFailedToParseEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -818,7 +1325,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-scriptPar
 */
 func (protocol *DebuggerProtocol) OnScriptParsed(
 	callback func(event *debugger.ScriptParsedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Debugger.scriptParsed",
 		func(response *Response) {
@@ -830,5 +1337,27 @@ func (protocol *DebuggerProtocol) OnScriptParsed(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnScriptParsedChan returns a channel of Debugger.scriptParsed events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-scriptParsed
+*/
+func (protocol *DebuggerProtocol) OnScriptParsedChan(
+	ctx context.Context,
+) <-chan *debugger.ScriptParsedEvent {
+	eventChan := make(chan *debugger.ScriptParsedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnScriptParsed(func(event *debugger.ScriptParsedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
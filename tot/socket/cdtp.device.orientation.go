@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"github.com/mkenney/go-chrome/tot/device/orientation"
 )
 
@@ -21,13 +22,25 @@ ClearOverride clears the overridden Device Orientation.
 https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation/#method-clearDeviceOrientationOverride
 */
 func (protocol *DeviceOrientationProtocol) ClearOverride() <-chan *orientation.ClearOverrideResult {
-	resultChan := make(chan *orientation.ClearOverrideResult)
+	return protocol.ClearOverrideContext(context.Background())
+}
+
+/*
+ClearOverrideContext performs ClearOverride and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation/#method-clearDeviceOrientationOverride
+*/
+func (protocol *DeviceOrientationProtocol) ClearOverrideContext(ctx context.Context) <-chan *orientation.ClearOverrideResult {
+	resultChan := make(chan *orientation.ClearOverrideResult, 1)
 	command := NewCommand(protocol.Socket, "DeviceOrientation.clearDeviceOrientationOverride", nil)
 	result := &orientation.ClearOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -45,13 +58,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation/#method
 func (protocol *DeviceOrientationProtocol) SetOverride(
 	params *orientation.SetOverrideParams,
 ) <-chan *orientation.SetOverrideResult {
-	resultChan := make(chan *orientation.SetOverrideResult)
+	return protocol.SetOverrideContext(context.Background(), params)
+}
+
+/*
+SetOverrideContext performs SetOverride and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation/#method-setDeviceOrientationOverride
+*/
+func (protocol *DeviceOrientationProtocol) SetOverrideContext(
+	ctx context.Context,
+	params *orientation.SetOverrideParams,
+) <-chan *orientation.SetOverrideResult {
+	resultChan := make(chan *orientation.SetOverrideResult, 1)
 	command := NewCommand(protocol.Socket, "DeviceOrientation.setDeviceOrientationOverride", params)
 	result := &orientation.SetOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/dom/debugger"
@@ -26,13 +27,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-getEv
 func (protocol *DOMDebuggerProtocol) GetEventListeners(
 	params *debugger.GetEventListenersParams,
 ) <-chan *debugger.GetEventListenersResult {
-	resultChan := make(chan *debugger.GetEventListenersResult)
+	return protocol.GetEventListenersContext(context.Background(), params)
+}
+
+/*
+GetEventListenersContext performs GetEventListeners and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-getEventListeners
+*/
+func (protocol *DOMDebuggerProtocol) GetEventListenersContext(
+	ctx context.Context,
+	params *debugger.GetEventListenersParams,
+) <-chan *debugger.GetEventListenersResult {
+	resultChan := make(chan *debugger.GetEventListenersResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.getEventListeners", params)
 	result := &debugger.GetEventListenersResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -53,13 +70,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-remov
 func (protocol *DOMDebuggerProtocol) RemoveDOMBreakpoint(
 	params *debugger.RemoveDOMBreakpointParams,
 ) <-chan *debugger.RemoveDOMBreakpointResult {
-	resultChan := make(chan *debugger.RemoveDOMBreakpointResult)
+	return protocol.RemoveDOMBreakpointContext(context.Background(), params)
+}
+
+/*
+RemoveDOMBreakpointContext performs RemoveDOMBreakpoint and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-removeDOMBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) RemoveDOMBreakpointContext(
+	ctx context.Context,
+	params *debugger.RemoveDOMBreakpointParams,
+) <-chan *debugger.RemoveDOMBreakpointResult {
+	resultChan := make(chan *debugger.RemoveDOMBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.removeDOMBreakpoint", params)
 	result := &debugger.RemoveDOMBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -77,13 +110,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-remov
 func (protocol *DOMDebuggerProtocol) RemoveEventListenerBreakpoint(
 	params *debugger.RemoveEventListenerBreakpointParams,
 ) <-chan *debugger.RemoveEventListenerBreakpointResult {
-	resultChan := make(chan *debugger.RemoveEventListenerBreakpointResult)
+	return protocol.RemoveEventListenerBreakpointContext(context.Background(), params)
+}
+
+/*
+RemoveEventListenerBreakpointContext performs RemoveEventListenerBreakpoint and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-removeEventListenerBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) RemoveEventListenerBreakpointContext(
+	ctx context.Context,
+	params *debugger.RemoveEventListenerBreakpointParams,
+) <-chan *debugger.RemoveEventListenerBreakpointResult {
+	resultChan := make(chan *debugger.RemoveEventListenerBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.removeEventListenerBreakpoint", params)
 	result := &debugger.RemoveEventListenerBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -102,13 +151,30 @@ EXPERIMENTAL.
 func (protocol *DOMDebuggerProtocol) RemoveInstrumentationBreakpoint(
 	params *debugger.RemoveInstrumentationBreakpointParams,
 ) <-chan *debugger.RemoveInstrumentationBreakpointResult {
-	resultChan := make(chan *debugger.RemoveInstrumentationBreakpointResult)
+	return protocol.RemoveInstrumentationBreakpointContext(context.Background(), params)
+}
+
+/*
+RemoveInstrumentationBreakpointContext performs RemoveInstrumentationBreakpoint
+and returns its result, or returns the context error if ctx is cancelled or
+times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-removeInstrumentationBreakpoint
+EXPERIMENTAL.
+*/
+func (protocol *DOMDebuggerProtocol) RemoveInstrumentationBreakpointContext(
+	ctx context.Context,
+	params *debugger.RemoveInstrumentationBreakpointParams,
+) <-chan *debugger.RemoveInstrumentationBreakpointResult {
+	resultChan := make(chan *debugger.RemoveInstrumentationBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.removeInstrumentationBreakpoint", params)
 	result := &debugger.RemoveInstrumentationBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -126,13 +192,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-remov
 func (protocol *DOMDebuggerProtocol) RemoveXHRBreakpoint(
 	params *debugger.RemoveXHRBreakpointParams,
 ) <-chan *debugger.RemoveXHRBreakpointResult {
-	resultChan := make(chan *debugger.RemoveXHRBreakpointResult)
+	return protocol.RemoveXHRBreakpointContext(context.Background(), params)
+}
+
+/*
+RemoveXHRBreakpointContext performs RemoveXHRBreakpoint and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-removeXHRBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) RemoveXHRBreakpointContext(
+	ctx context.Context,
+	params *debugger.RemoveXHRBreakpointParams,
+) <-chan *debugger.RemoveXHRBreakpointResult {
+	resultChan := make(chan *debugger.RemoveXHRBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.removeXHRBreakpoint", params)
 	result := &debugger.RemoveXHRBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -150,13 +232,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setDO
 func (protocol *DOMDebuggerProtocol) SetDOMBreakpoint(
 	params *debugger.SetDOMBreakpointParams,
 ) <-chan *debugger.SetDOMBreakpointResult {
-	resultChan := make(chan *debugger.SetDOMBreakpointResult)
+	return protocol.SetDOMBreakpointContext(context.Background(), params)
+}
+
+/*
+SetDOMBreakpointContext performs SetDOMBreakpoint and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setDOMBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) SetDOMBreakpointContext(
+	ctx context.Context,
+	params *debugger.SetDOMBreakpointParams,
+) <-chan *debugger.SetDOMBreakpointResult {
+	resultChan := make(chan *debugger.SetDOMBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.setDOMBreakpoint", params)
 	result := &debugger.SetDOMBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -174,13 +272,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setEv
 func (protocol *DOMDebuggerProtocol) SetEventListenerBreakpoint(
 	params *debugger.SetEventListenerBreakpointParams,
 ) <-chan *debugger.SetEventListenerBreakpointResult {
-	resultChan := make(chan *debugger.SetEventListenerBreakpointResult)
+	return protocol.SetEventListenerBreakpointContext(context.Background(), params)
+}
+
+/*
+SetEventListenerBreakpointContext performs SetEventListenerBreakpoint and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setEventListenerBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) SetEventListenerBreakpointContext(
+	ctx context.Context,
+	params *debugger.SetEventListenerBreakpointParams,
+) <-chan *debugger.SetEventListenerBreakpointResult {
+	resultChan := make(chan *debugger.SetEventListenerBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.setEventListenerBreakpoint", params)
 	result := &debugger.SetEventListenerBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -199,13 +313,30 @@ EXPERIMENTAL.
 func (protocol *DOMDebuggerProtocol) SetInstrumentationBreakpoint(
 	params *debugger.SetInstrumentationBreakpointParams,
 ) <-chan *debugger.SetInstrumentationBreakpointResult {
-	resultChan := make(chan *debugger.SetInstrumentationBreakpointResult)
+	return protocol.SetInstrumentationBreakpointContext(context.Background(), params)
+}
+
+/*
+SetInstrumentationBreakpointContext performs SetInstrumentationBreakpoint and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setInstrumentationBreakpoint
+EXPERIMENTAL.
+*/
+func (protocol *DOMDebuggerProtocol) SetInstrumentationBreakpointContext(
+	ctx context.Context,
+	params *debugger.SetInstrumentationBreakpointParams,
+) <-chan *debugger.SetInstrumentationBreakpointResult {
+	resultChan := make(chan *debugger.SetInstrumentationBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.setInstrumentationBreakpoint", params)
 	result := &debugger.SetInstrumentationBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -223,13 +354,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setXH
 func (protocol *DOMDebuggerProtocol) SetXHRBreakpoint(
 	params *debugger.SetXHRBreakpointParams,
 ) <-chan *debugger.SetXHRBreakpointResult {
-	resultChan := make(chan *debugger.SetXHRBreakpointResult)
+	return protocol.SetXHRBreakpointContext(context.Background(), params)
+}
+
+/*
+SetXHRBreakpointContext performs SetXHRBreakpoint and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setXHRBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) SetXHRBreakpointContext(
+	ctx context.Context,
+	params *debugger.SetXHRBreakpointParams,
+) <-chan *debugger.SetXHRBreakpointResult {
+	resultChan := make(chan *debugger.SetXHRBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.setXHRBreakpoint", params)
 	result := &debugger.SetXHRBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/dom"
@@ -34,13 +35,30 @@ EXPERIMENTAL.
 func (protocol *DOMProtocol) CollectClassNamesFromSubtree(
 	params *dom.CollectClassNamesFromSubtreeParams,
 ) <-chan *dom.CollectClassNamesFromSubtreeResult {
-	resultChan := make(chan *dom.CollectClassNamesFromSubtreeResult)
+	return protocol.CollectClassNamesFromSubtreeContext(context.Background(), params)
+}
+
+/*
+CollectClassNamesFromSubtreeContext performs CollectClassNamesFromSubtree and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-collectClassNamesFromSubtree
+EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) CollectClassNamesFromSubtreeContext(
+	ctx context.Context,
+	params *dom.CollectClassNamesFromSubtreeParams,
+) <-chan *dom.CollectClassNamesFromSubtreeResult {
+	resultChan := make(chan *dom.CollectClassNamesFromSubtreeResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.collectClassNamesFromSubtree", params)
 	result := &dom.CollectClassNamesFromSubtreeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -61,13 +79,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-copyTo EXPERI
 func (protocol *DOMProtocol) CopyTo(
 	params *dom.CopyToParams,
 ) <-chan *dom.CopyToResult {
-	resultChan := make(chan *dom.CopyToResult)
+	return protocol.CopyToContext(context.Background(), params)
+}
+
+/*
+CopyToContext performs CopyTo and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-copyTo EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) CopyToContext(
+	ctx context.Context,
+	params *dom.CopyToParams,
+) <-chan *dom.CopyToResult {
+	resultChan := make(chan *dom.CopyToResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.copyTo", params)
 	result := &dom.CopyToResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -88,13 +121,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-describeNode
 func (protocol *DOMProtocol) DescribeNode(
 	params *dom.DescribeNodeParams,
 ) <-chan *dom.DescribeNodeResult {
-	resultChan := make(chan *dom.DescribeNodeResult)
+	return protocol.DescribeNodeContext(context.Background(), params)
+}
+
+/*
+DescribeNodeContext performs DescribeNode and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-describeNode
+*/
+func (protocol *DOMProtocol) DescribeNodeContext(
+	ctx context.Context,
+	params *dom.DescribeNodeParams,
+) <-chan *dom.DescribeNodeResult {
+	resultChan := make(chan *dom.DescribeNodeResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.describeNode", params)
 	result := &dom.DescribeNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -112,13 +160,25 @@ Disable disables the DOM agent for the given page.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-disable
 */
 func (protocol *DOMProtocol) Disable() <-chan *dom.DisableResult {
-	resultChan := make(chan *dom.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-disable
+*/
+func (protocol *DOMProtocol) DisableContext(ctx context.Context) <-chan *dom.DisableResult {
+	resultChan := make(chan *dom.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.disable", nil)
 	result := &dom.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -138,13 +198,30 @@ EXPERIMENTAL.
 func (protocol *DOMProtocol) DiscardSearchResults(
 	params *dom.DiscardSearchResultsParams,
 ) <-chan *dom.DiscardSearchResultsResult {
-	resultChan := make(chan *dom.DiscardSearchResultsResult)
+	return protocol.DiscardSearchResultsContext(context.Background(), params)
+}
+
+/*
+DiscardSearchResultsContext performs DiscardSearchResults and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-discardSearchResults
+EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) DiscardSearchResultsContext(
+	ctx context.Context,
+	params *dom.DiscardSearchResultsParams,
+) <-chan *dom.DiscardSearchResultsResult {
+	resultChan := make(chan *dom.DiscardSearchResultsResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.discardSearchResults", params)
 	result := &dom.DiscardSearchResultsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -160,13 +237,25 @@ Enable enables the DOM agent for the given page.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-enable
 */
 func (protocol *DOMProtocol) Enable() <-chan *dom.EnableResult {
-	resultChan := make(chan *dom.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-enable
+*/
+func (protocol *DOMProtocol) EnableContext(ctx context.Context) <-chan *dom.EnableResult {
+	resultChan := make(chan *dom.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.enable", nil)
 	result := &dom.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -184,13 +273,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-focus
 func (protocol *DOMProtocol) Focus(
 	params *dom.FocusParams,
 ) <-chan *dom.FocusResult {
-	resultChan := make(chan *dom.FocusResult)
+	return protocol.FocusContext(context.Background(), params)
+}
+
+/*
+FocusContext performs Focus and returns its result, or returns the context error
+if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-focus
+*/
+func (protocol *DOMProtocol) FocusContext(
+	ctx context.Context,
+	params *dom.FocusParams,
+) <-chan *dom.FocusResult {
+	resultChan := make(chan *dom.FocusResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.focus", params)
 	result := &dom.FocusResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -208,13 +312,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getAttributes
 func (protocol *DOMProtocol) GetAttributes(
 	params *dom.GetAttributesParams,
 ) <-chan *dom.GetAttributesResult {
-	resultChan := make(chan *dom.GetAttributesResult)
+	return protocol.GetAttributesContext(context.Background(), params)
+}
+
+/*
+GetAttributesContext performs GetAttributes and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getAttributes
+*/
+func (protocol *DOMProtocol) GetAttributesContext(
+	ctx context.Context,
+	params *dom.GetAttributesParams,
+) <-chan *dom.GetAttributesResult {
+	resultChan := make(chan *dom.GetAttributesResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.getAttributes", params)
 	result := &dom.GetAttributesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -234,13 +353,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getBoxModel
 func (protocol *DOMProtocol) GetBoxModel(
 	params *dom.GetBoxModelParams,
 ) <-chan *dom.GetBoxModelResult {
-	resultChan := make(chan *dom.GetBoxModelResult)
+	return protocol.GetBoxModelContext(context.Background(), params)
+}
+
+/*
+GetBoxModelContext performs GetBoxModel and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getBoxModel
+*/
+func (protocol *DOMProtocol) GetBoxModelContext(
+	ctx context.Context,
+	params *dom.GetBoxModelParams,
+) <-chan *dom.GetBoxModelResult {
+	resultChan := make(chan *dom.GetBoxModelResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.getBoxModel", params)
 	result := &dom.GetBoxModelResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -261,13 +395,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getDocument
 func (protocol *DOMProtocol) GetDocument(
 	params *dom.GetDocumentParams,
 ) <-chan *dom.GetDocumentResult {
-	resultChan := make(chan *dom.GetDocumentResult)
+	return protocol.GetDocumentContext(context.Background(), params)
+}
+
+/*
+GetDocumentContext performs GetDocument and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getDocument
+*/
+func (protocol *DOMProtocol) GetDocumentContext(
+	ctx context.Context,
+	params *dom.GetDocumentParams,
+) <-chan *dom.GetDocumentResult {
+	resultChan := make(chan *dom.GetDocumentResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.getDocument", params)
 	result := &dom.GetDocumentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -288,13 +437,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getFlattenedD
 func (protocol *DOMProtocol) GetFlattenedDocument(
 	params *dom.GetFlattenedDocumentParams,
 ) <-chan *dom.GetFlattenedDocumentResult {
-	resultChan := make(chan *dom.GetFlattenedDocumentResult)
+	return protocol.GetFlattenedDocumentContext(context.Background(), params)
+}
+
+/*
+GetFlattenedDocumentContext performs GetFlattenedDocument and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getFlattenedDocument
+*/
+func (protocol *DOMProtocol) GetFlattenedDocumentContext(
+	ctx context.Context,
+	params *dom.GetFlattenedDocumentParams,
+) <-chan *dom.GetFlattenedDocumentResult {
+	resultChan := make(chan *dom.GetFlattenedDocumentResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.getFlattenedDocument", params)
 	result := &dom.GetFlattenedDocumentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -314,13 +479,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getNodeForLoc
 func (protocol *DOMProtocol) GetNodeForLocation(
 	params *dom.GetNodeForLocationParams,
 ) <-chan *dom.GetNodeForLocationResult {
-	resultChan := make(chan *dom.GetNodeForLocationResult)
+	return protocol.GetNodeForLocationContext(context.Background(), params)
+}
+
+/*
+GetNodeForLocationContext performs GetNodeForLocation and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getNodeForLocation EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) GetNodeForLocationContext(
+	ctx context.Context,
+	params *dom.GetNodeForLocationParams,
+) <-chan *dom.GetNodeForLocationResult {
+	resultChan := make(chan *dom.GetNodeForLocationResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.getNodeForLocation", params)
 	result := &dom.GetNodeForLocationResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -340,13 +521,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getOuterHTML
 func (protocol *DOMProtocol) GetOuterHTML(
 	params *dom.GetOuterHTMLParams,
 ) <-chan *dom.GetOuterHTMLResult {
-	resultChan := make(chan *dom.GetOuterHTMLResult)
+	return protocol.GetOuterHTMLContext(context.Background(), params)
+}
+
+/*
+GetOuterHTMLContext performs GetOuterHTML and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getOuterHTML
+*/
+func (protocol *DOMProtocol) GetOuterHTMLContext(
+	ctx context.Context,
+	params *dom.GetOuterHTMLParams,
+) <-chan *dom.GetOuterHTMLResult {
+	resultChan := make(chan *dom.GetOuterHTMLResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.getOuterHTML", params)
 	result := &dom.GetOuterHTMLResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -367,13 +563,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getRelayoutBo
 func (protocol *DOMProtocol) GetRelayoutBoundary(
 	params *dom.GetRelayoutBoundaryParams,
 ) <-chan *dom.GetRelayoutBoundaryResult {
-	resultChan := make(chan *dom.GetRelayoutBoundaryResult)
+	return protocol.GetRelayoutBoundaryContext(context.Background(), params)
+}
+
+/*
+GetRelayoutBoundaryContext performs GetRelayoutBoundary and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getRelayoutBoundary EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) GetRelayoutBoundaryContext(
+	ctx context.Context,
+	params *dom.GetRelayoutBoundaryParams,
+) <-chan *dom.GetRelayoutBoundaryResult {
+	resultChan := make(chan *dom.GetRelayoutBoundaryResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.getRelayoutBoundary", params)
 	result := &dom.GetRelayoutBoundaryResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -395,13 +607,30 @@ EXPERIMENTAL.
 func (protocol *DOMProtocol) GetSearchResults(
 	params *dom.GetSearchResultsParams,
 ) <-chan *dom.GetSearchResultsResult {
-	resultChan := make(chan *dom.GetSearchResultsResult)
+	return protocol.GetSearchResultsContext(context.Background(), params)
+}
+
+/*
+GetSearchResultsContext performs GetSearchResults and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getSearchResults
+EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) GetSearchResultsContext(
+	ctx context.Context,
+	params *dom.GetSearchResultsParams,
+) <-chan *dom.GetSearchResultsResult {
+	resultChan := make(chan *dom.GetSearchResultsResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.getSearchResults", params)
 	result := &dom.GetSearchResultsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -420,13 +649,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-markUndoableS
 EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) MarkUndoableState() <-chan *dom.MarkUndoableStateResult {
-	resultChan := make(chan *dom.MarkUndoableStateResult)
+	return protocol.MarkUndoableStateContext(context.Background())
+}
+
+/*
+MarkUndoableStateContext performs MarkUndoableState and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-markUndoableState
+EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) MarkUndoableStateContext(ctx context.Context) <-chan *dom.MarkUndoableStateResult {
+	resultChan := make(chan *dom.MarkUndoableStateResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.markUndoableState", nil)
 	result := &dom.MarkUndoableStateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -444,13 +687,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-moveTo
 func (protocol *DOMProtocol) MoveTo(
 	params *dom.MoveToParams,
 ) <-chan *dom.MoveToResult {
-	resultChan := make(chan *dom.MoveToResult)
+	return protocol.MoveToContext(context.Background(), params)
+}
+
+/*
+MoveToContext performs MoveTo and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-moveTo
+*/
+func (protocol *DOMProtocol) MoveToContext(
+	ctx context.Context,
+	params *dom.MoveToParams,
+) <-chan *dom.MoveToResult {
+	resultChan := make(chan *dom.MoveToResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.moveTo", params)
 	result := &dom.MoveToResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -472,13 +730,29 @@ EXPERIMENTAL.
 func (protocol *DOMProtocol) PerformSearch(
 	params *dom.PerformSearchParams,
 ) <-chan *dom.PerformSearchResult {
-	resultChan := make(chan *dom.PerformSearchResult)
+	return protocol.PerformSearchContext(context.Background(), params)
+}
+
+/*
+PerformSearchContext performs PerformSearch and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-performSearch
+EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) PerformSearchContext(
+	ctx context.Context,
+	params *dom.PerformSearchParams,
+) <-chan *dom.PerformSearchResult {
+	resultChan := make(chan *dom.PerformSearchResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.performSearch", params)
 	result := &dom.PerformSearchResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -500,13 +774,30 @@ EXPERIMENTAL. @TODO, use XPath.
 func (protocol *DOMProtocol) PushNodeByPathToFrontend(
 	params *dom.PushNodeByPathToFrontendParams,
 ) <-chan *dom.PushNodeByPathToFrontendResult {
-	resultChan := make(chan *dom.PushNodeByPathToFrontendResult)
+	return protocol.PushNodeByPathToFrontendContext(context.Background(), params)
+}
+
+/*
+PushNodeByPathToFrontendContext performs PushNodeByPathToFrontend and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-pushNodeByPathToFrontend
+EXPERIMENTAL. @TODO, use XPath.
+*/
+func (protocol *DOMProtocol) PushNodeByPathToFrontendContext(
+	ctx context.Context,
+	params *dom.PushNodeByPathToFrontendParams,
+) <-chan *dom.PushNodeByPathToFrontendResult {
+	resultChan := make(chan *dom.PushNodeByPathToFrontendResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.pushNodeByPathToFrontend", params)
 	result := &dom.PushNodeByPathToFrontendResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -528,13 +819,30 @@ EXPERIMENTAL.
 func (protocol *DOMProtocol) PushNodesByBackendIDsToFrontend(
 	params *dom.PushNodesByBackendIDsToFrontendParams,
 ) <-chan *dom.PushNodesByBackendIDsToFrontendResult {
-	resultChan := make(chan *dom.PushNodesByBackendIDsToFrontendResult)
+	return protocol.PushNodesByBackendIDsToFrontendContext(context.Background(), params)
+}
+
+/*
+PushNodesByBackendIDsToFrontendContext performs PushNodesByBackendIDsToFrontend
+and returns its result, or returns the context error if ctx is cancelled or
+times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-pushNodesByBackendIdsToFrontend
+EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) PushNodesByBackendIDsToFrontendContext(
+	ctx context.Context,
+	params *dom.PushNodesByBackendIDsToFrontendParams,
+) <-chan *dom.PushNodesByBackendIDsToFrontendResult {
+	resultChan := make(chan *dom.PushNodesByBackendIDsToFrontendResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.pushNodesByBackendIdsToFrontend", params)
 	result := &dom.PushNodesByBackendIDsToFrontendResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -554,13 +862,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-querySelector
 func (protocol *DOMProtocol) QuerySelector(
 	params *dom.QuerySelectorParams,
 ) <-chan *dom.QuerySelectorResult {
-	resultChan := make(chan *dom.QuerySelectorResult)
+	return protocol.QuerySelectorContext(context.Background(), params)
+}
+
+/*
+QuerySelectorContext performs QuerySelector and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-querySelector
+*/
+func (protocol *DOMProtocol) QuerySelectorContext(
+	ctx context.Context,
+	params *dom.QuerySelectorParams,
+) <-chan *dom.QuerySelectorResult {
+	resultChan := make(chan *dom.QuerySelectorResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.querySelector", params)
 	result := &dom.QuerySelectorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -580,13 +903,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-querySelector
 func (protocol *DOMProtocol) QuerySelectorAll(
 	params *dom.QuerySelectorAllParams,
 ) <-chan *dom.QuerySelectorAllResult {
-	resultChan := make(chan *dom.QuerySelectorAllResult)
+	return protocol.QuerySelectorAllContext(context.Background(), params)
+}
+
+/*
+QuerySelectorAllContext performs QuerySelectorAll and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-querySelectorAll
+*/
+func (protocol *DOMProtocol) QuerySelectorAllContext(
+	ctx context.Context,
+	params *dom.QuerySelectorAllParams,
+) <-chan *dom.QuerySelectorAllResult {
+	resultChan := make(chan *dom.QuerySelectorAllResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.querySelectorAll", params)
 	result := &dom.QuerySelectorAllResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -604,13 +943,25 @@ Redo re-does the last undone action.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-redo EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) Redo() <-chan *dom.RedoResult {
-	resultChan := make(chan *dom.RedoResult)
+	return protocol.RedoContext(context.Background())
+}
+
+/*
+RedoContext performs Redo and returns its result, or returns the context error
+if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-redo EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) RedoContext(ctx context.Context) <-chan *dom.RedoResult {
+	resultChan := make(chan *dom.RedoResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.redo", nil)
 	result := &dom.RedoResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -628,13 +979,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-removeAttribu
 func (protocol *DOMProtocol) RemoveAttribute(
 	params *dom.RemoveAttributeParams,
 ) <-chan *dom.RemoveAttributeResult {
-	resultChan := make(chan *dom.RemoveAttributeResult)
+	return protocol.RemoveAttributeContext(context.Background(), params)
+}
+
+/*
+RemoveAttributeContext performs RemoveAttribute and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-removeAttribute
+*/
+func (protocol *DOMProtocol) RemoveAttributeContext(
+	ctx context.Context,
+	params *dom.RemoveAttributeParams,
+) <-chan *dom.RemoveAttributeResult {
+	resultChan := make(chan *dom.RemoveAttributeResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.removeAttribute", params)
 	result := &dom.RemoveAttributeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -652,13 +1019,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-removeNode
 func (protocol *DOMProtocol) RemoveNode(
 	params *dom.RemoveNodeParams,
 ) <-chan *dom.RemoveNodeResult {
-	resultChan := make(chan *dom.RemoveNodeResult)
+	return protocol.RemoveNodeContext(context.Background(), params)
+}
+
+/*
+RemoveNodeContext performs RemoveNode and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-removeNode
+*/
+func (protocol *DOMProtocol) RemoveNodeContext(
+	ctx context.Context,
+	params *dom.RemoveNodeParams,
+) <-chan *dom.RemoveNodeResult {
+	resultChan := make(chan *dom.RemoveNodeResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.removeNode", params)
 	result := &dom.RemoveNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -678,13 +1060,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-requestChildN
 func (protocol *DOMProtocol) RequestChildNodes(
 	params *dom.RequestChildNodesParams,
 ) <-chan *dom.RequestChildNodesResult {
-	resultChan := make(chan *dom.RequestChildNodesResult)
+	return protocol.RequestChildNodesContext(context.Background(), params)
+}
+
+/*
+RequestChildNodesContext performs RequestChildNodes and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-requestChildNodes
+*/
+func (protocol *DOMProtocol) RequestChildNodesContext(
+	ctx context.Context,
+	params *dom.RequestChildNodesParams,
+) <-chan *dom.RequestChildNodesResult {
+	resultChan := make(chan *dom.RequestChildNodesResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.requestChildNodes", params)
 	result := &dom.RequestChildNodesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -704,13 +1102,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-requestNode
 func (protocol *DOMProtocol) RequestNode(
 	params *dom.RequestNodeParams,
 ) <-chan *dom.RequestNodeResult {
-	resultChan := make(chan *dom.RequestNodeResult)
+	return protocol.RequestNodeContext(context.Background(), params)
+}
+
+/*
+RequestNodeContext performs RequestNode and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-requestNode
+*/
+func (protocol *DOMProtocol) RequestNodeContext(
+	ctx context.Context,
+	params *dom.RequestNodeParams,
+) <-chan *dom.RequestNodeResult {
+	resultChan := make(chan *dom.RequestNodeResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.requestNode", params)
 	result := &dom.RequestNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -731,13 +1144,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-resolveNode
 func (protocol *DOMProtocol) ResolveNode(
 	params *dom.ResolveNodeParams,
 ) <-chan *dom.ResolveNodeResult {
-	resultChan := make(chan *dom.ResolveNodeResult)
+	return protocol.ResolveNodeContext(context.Background(), params)
+}
+
+/*
+ResolveNodeContext performs ResolveNode and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-resolveNode
+*/
+func (protocol *DOMProtocol) ResolveNodeContext(
+	ctx context.Context,
+	params *dom.ResolveNodeParams,
+) <-chan *dom.ResolveNodeResult {
+	resultChan := make(chan *dom.ResolveNodeResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.resolveNode", params)
 	result := &dom.ResolveNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -757,13 +1185,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setAttributeV
 func (protocol *DOMProtocol) SetAttributeValue(
 	params *dom.SetAttributeValueParams,
 ) <-chan *dom.SetAttributeValueResult {
-	resultChan := make(chan *dom.SetAttributeValueResult)
+	return protocol.SetAttributeValueContext(context.Background(), params)
+}
+
+/*
+SetAttributeValueContext performs SetAttributeValue and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setAttributeValue
+*/
+func (protocol *DOMProtocol) SetAttributeValueContext(
+	ctx context.Context,
+	params *dom.SetAttributeValueParams,
+) <-chan *dom.SetAttributeValueResult {
+	resultChan := make(chan *dom.SetAttributeValueResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.setAttributeValue", params)
 	result := &dom.SetAttributeValueResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -783,13 +1227,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setAttributes
 func (protocol *DOMProtocol) SetAttributesAsText(
 	params *dom.SetAttributesAsTextParams,
 ) <-chan *dom.SetAttributesAsTextResult {
-	resultChan := make(chan *dom.SetAttributesAsTextResult)
+	return protocol.SetAttributesAsTextContext(context.Background(), params)
+}
+
+/*
+SetAttributesAsTextContext performs SetAttributesAsText and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setAttributesAsText
+*/
+func (protocol *DOMProtocol) SetAttributesAsTextContext(
+	ctx context.Context,
+	params *dom.SetAttributesAsTextParams,
+) <-chan *dom.SetAttributesAsTextResult {
+	resultChan := make(chan *dom.SetAttributesAsTextResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.setAttributesAsText", params)
 	result := &dom.SetAttributesAsTextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -807,13 +1267,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setFileInputF
 func (protocol *DOMProtocol) SetFileInputFiles(
 	params *dom.SetFileInputFilesParams,
 ) <-chan *dom.SetFileInputFilesResult {
-	resultChan := make(chan *dom.SetFileInputFilesResult)
+	return protocol.SetFileInputFilesContext(context.Background(), params)
+}
+
+/*
+SetFileInputFilesContext performs SetFileInputFiles and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setFileInputFiles
+*/
+func (protocol *DOMProtocol) SetFileInputFilesContext(
+	ctx context.Context,
+	params *dom.SetFileInputFilesParams,
+) <-chan *dom.SetFileInputFilesResult {
+	resultChan := make(chan *dom.SetFileInputFilesResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.setFileInputFiles", params)
 	result := &dom.SetFileInputFilesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -832,13 +1308,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setInspectedN
 func (protocol *DOMProtocol) SetInspectedNode(
 	params *dom.SetInspectedNodeParams,
 ) <-chan *dom.SetInspectedNodeResult {
-	resultChan := make(chan *dom.SetInspectedNodeResult)
+	return protocol.SetInspectedNodeContext(context.Background(), params)
+}
+
+/*
+SetInspectedNodeContext performs SetInspectedNode and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setInspectedNode EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) SetInspectedNodeContext(
+	ctx context.Context,
+	params *dom.SetInspectedNodeParams,
+) <-chan *dom.SetInspectedNodeResult {
+	resultChan := make(chan *dom.SetInspectedNodeResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.setInspectedNode", params)
 	result := &dom.SetInspectedNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -856,13 +1348,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setNodeName
 func (protocol *DOMProtocol) SetNodeName(
 	params *dom.SetNodeNameParams,
 ) <-chan *dom.SetNodeNameResult {
-	resultChan := make(chan *dom.SetNodeNameResult)
+	return protocol.SetNodeNameContext(context.Background(), params)
+}
+
+/*
+SetNodeNameContext performs SetNodeName and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setNodeName
+*/
+func (protocol *DOMProtocol) SetNodeNameContext(
+	ctx context.Context,
+	params *dom.SetNodeNameParams,
+) <-chan *dom.SetNodeNameResult {
+	resultChan := make(chan *dom.SetNodeNameResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.setNodeName", params)
 	result := &dom.SetNodeNameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -882,13 +1389,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setNodeValue
 func (protocol *DOMProtocol) SetNodeValue(
 	params *dom.SetNodeValueParams,
 ) <-chan *dom.SetNodeValueResult {
-	resultChan := make(chan *dom.SetNodeValueResult)
+	return protocol.SetNodeValueContext(context.Background(), params)
+}
+
+/*
+SetNodeValueContext performs SetNodeValue and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setNodeValue
+*/
+func (protocol *DOMProtocol) SetNodeValueContext(
+	ctx context.Context,
+	params *dom.SetNodeValueParams,
+) <-chan *dom.SetNodeValueResult {
+	resultChan := make(chan *dom.SetNodeValueResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.setNodeValue", params)
 	result := &dom.SetNodeValueResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -906,13 +1428,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setOuterHTML
 func (protocol *DOMProtocol) SetOuterHTML(
 	params *dom.SetOuterHTMLParams,
 ) <-chan *dom.SetOuterHTMLResult {
-	resultChan := make(chan *dom.SetOuterHTMLResult)
+	return protocol.SetOuterHTMLContext(context.Background(), params)
+}
+
+/*
+SetOuterHTMLContext performs SetOuterHTML and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setOuterHTML
+*/
+func (protocol *DOMProtocol) SetOuterHTMLContext(
+	ctx context.Context,
+	params *dom.SetOuterHTMLParams,
+) <-chan *dom.SetOuterHTMLResult {
+	resultChan := make(chan *dom.SetOuterHTMLResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.setOuterHTML", params)
 	result := &dom.SetOuterHTMLResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -929,13 +1466,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-undo
 EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) Undo() <-chan *dom.UndoResult {
-	resultChan := make(chan *dom.UndoResult)
+	return protocol.UndoContext(context.Background())
+}
+
+/*
+UndoContext performs Undo and returns its result, or returns the context error
+if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-undo
+EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) UndoContext(ctx context.Context) <-chan *dom.UndoResult {
+	resultChan := make(chan *dom.UndoResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.undo", nil)
 	result := &dom.UndoResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -953,7 +1503,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-attributeModif
 */
 func (protocol *DOMProtocol) OnAttributeModified(
 	callback func(event *dom.AttributeModifiedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.attributeModified",
 		func(response *Response) {
@@ -965,7 +1515,29 @@ func (protocol *DOMProtocol) OnAttributeModified(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnAttributeModifiedChan returns a channel of DOM.attributeModified events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-attributeModified
+*/
+func (protocol *DOMProtocol) OnAttributeModifiedChan(
+	ctx context.Context,
+) <-chan *dom.AttributeModifiedEvent {
+	eventChan := make(chan *dom.AttributeModifiedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnAttributeModified(func(event *dom.AttributeModifiedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -976,7 +1548,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-attributeRemov
 */
 func (protocol *DOMProtocol) OnAttributeRemoved(
 	callback func(event *dom.AttributeRemovedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.attributeRemoved",
 		func(response *Response) {
@@ -988,7 +1560,29 @@ func (protocol *DOMProtocol) OnAttributeRemoved(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnAttributeRemovedChan returns a channel of DOM.attributeRemoved events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-attributeRemoved
+*/
+func (protocol *DOMProtocol) OnAttributeRemovedChan(
+	ctx context.Context,
+) <-chan *dom.AttributeRemovedEvent {
+	eventChan := make(chan *dom.AttributeRemovedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnAttributeRemoved(func(event *dom.AttributeRemovedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -999,7 +1593,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-characterDataM
 */
 func (protocol *DOMProtocol) OnCharacterDataModified(
 	callback func(event *dom.CharacterDataModifiedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.characterDataModified",
 		func(response *Response) {
@@ -1011,7 +1605,29 @@ func (protocol *DOMProtocol) OnCharacterDataModified(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnCharacterDataModifiedChan returns a channel of DOM.characterDataModified
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-characterDataModified
+*/
+func (protocol *DOMProtocol) OnCharacterDataModifiedChan(
+	ctx context.Context,
+) <-chan *dom.CharacterDataModifiedEvent {
+	eventChan := make(chan *dom.CharacterDataModifiedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnCharacterDataModified(func(event *dom.CharacterDataModifiedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1022,7 +1638,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeCount
 */
 func (protocol *DOMProtocol) OnChildNodeCountUpdated(
 	callback func(event *dom.ChildNodeCountUpdatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.childNodeCountUpdated",
 		func(response *Response) {
@@ -1034,7 +1650,29 @@ func (protocol *DOMProtocol) OnChildNodeCountUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnChildNodeCountUpdatedChan returns a channel of DOM.childNodeCountUpdated
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeCountUpdated
+*/
+func (protocol *DOMProtocol) OnChildNodeCountUpdatedChan(
+	ctx context.Context,
+) <-chan *dom.ChildNodeCountUpdatedEvent {
+	eventChan := make(chan *dom.ChildNodeCountUpdatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnChildNodeCountUpdated(func(event *dom.ChildNodeCountUpdatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1045,7 +1683,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeInser
 */
 func (protocol *DOMProtocol) OnChildNodeInserted(
 	callback func(event *dom.ChildNodeInsertedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.childNodeInserted",
 		func(response *Response) {
@@ -1057,7 +1695,29 @@ func (protocol *DOMProtocol) OnChildNodeInserted(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnChildNodeInsertedChan returns a channel of DOM.childNodeInserted events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeInserted
+*/
+func (protocol *DOMProtocol) OnChildNodeInsertedChan(
+	ctx context.Context,
+) <-chan *dom.ChildNodeInsertedEvent {
+	eventChan := make(chan *dom.ChildNodeInsertedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnChildNodeInserted(func(event *dom.ChildNodeInsertedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1068,7 +1728,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeRemov
 */
 func (protocol *DOMProtocol) OnChildNodeRemoved(
 	callback func(event *dom.ChildNodeRemovedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.childNodeRemoved",
 		func(response *Response) {
@@ -1080,7 +1740,29 @@ func (protocol *DOMProtocol) OnChildNodeRemoved(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnChildNodeRemovedChan returns a channel of DOM.childNodeRemoved events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeRemoved
+*/
+func (protocol *DOMProtocol) OnChildNodeRemovedChan(
+	ctx context.Context,
+) <-chan *dom.ChildNodeRemovedEvent {
+	eventChan := make(chan *dom.ChildNodeRemovedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnChildNodeRemoved(func(event *dom.ChildNodeRemovedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1092,7 +1774,7 @@ EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) OnDistributedNodesUpdated(
 	callback func(event *dom.DistributedNodesUpdatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.distributedNodesUpdated",
 		func(response *Response) {
@@ -1104,7 +1786,30 @@ func (protocol *DOMProtocol) OnDistributedNodesUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnDistributedNodesUpdatedChan returns a channel of DOM.distributedNodesUpdated
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-distributedNodesUpdated
+EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) OnDistributedNodesUpdatedChan(
+	ctx context.Context,
+) <-chan *dom.DistributedNodesUpdatedEvent {
+	eventChan := make(chan *dom.DistributedNodesUpdatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnDistributedNodesUpdated(func(event *dom.DistributedNodesUpdatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1116,7 +1821,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-documentUpdate
 */
 func (protocol *DOMProtocol) OnDocumentUpdated(
 	callback func(event *dom.DocumentUpdatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.documentUpdated",
 		func(response *Response) {
@@ -1128,7 +1833,29 @@ func (protocol *DOMProtocol) OnDocumentUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnDocumentUpdatedChan returns a channel of DOM.documentUpdated events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-documentUpdated
+*/
+func (protocol *DOMProtocol) OnDocumentUpdatedChan(
+	ctx context.Context,
+) <-chan *dom.DocumentUpdatedEvent {
+	eventChan := make(chan *dom.DocumentUpdatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnDocumentUpdated(func(event *dom.DocumentUpdatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1139,7 +1866,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-inlineStyleInv
 */
 func (protocol *DOMProtocol) OnInlineStyleInvalidated(
 	callback func(event *dom.InlineStyleInvalidatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.inlineStyleInvalidated",
 		func(response *Response) {
@@ -1151,7 +1878,29 @@ func (protocol *DOMProtocol) OnInlineStyleInvalidated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnInlineStyleInvalidatedChan returns a channel of DOM.inlineStyleInvalidated
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-inlineStyleInvalidated
+*/
+func (protocol *DOMProtocol) OnInlineStyleInvalidatedChan(
+	ctx context.Context,
+) <-chan *dom.InlineStyleInvalidatedEvent {
+	eventChan := make(chan *dom.InlineStyleInvalidatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnInlineStyleInvalidated(func(event *dom.InlineStyleInvalidatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1162,7 +1911,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-pseudoElementA
 */
 func (protocol *DOMProtocol) OnPseudoElementAdded(
 	callback func(event *dom.PseudoElementAddedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.pseudoElementAdded",
 		func(response *Response) {
@@ -1174,7 +1923,29 @@ func (protocol *DOMProtocol) OnPseudoElementAdded(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnPseudoElementAddedChan returns a channel of DOM.pseudoElementAdded events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-pseudoElementAdded EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) OnPseudoElementAddedChan(
+	ctx context.Context,
+) <-chan *dom.PseudoElementAddedEvent {
+	eventChan := make(chan *dom.PseudoElementAddedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnPseudoElementAdded(func(event *dom.PseudoElementAddedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1185,7 +1956,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-pseudoElementR
 */
 func (protocol *DOMProtocol) OnPseudoElementRemoved(
 	callback func(event *dom.PseudoElementRemovedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.pseudoElementRemoved",
 		func(response *Response) {
@@ -1197,7 +1968,29 @@ func (protocol *DOMProtocol) OnPseudoElementRemoved(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnPseudoElementRemovedChan returns a channel of DOM.pseudoElementRemoved events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-pseudoElementRemoved EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) OnPseudoElementRemovedChan(
+	ctx context.Context,
+) <-chan *dom.PseudoElementRemovedEvent {
+	eventChan := make(chan *dom.PseudoElementRemovedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnPseudoElementRemoved(func(event *dom.PseudoElementRemovedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1209,7 +2002,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-setChildNodes
 */
 func (protocol *DOMProtocol) OnSetChildNodes(
 	callback func(event *dom.SetChildNodesEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.setChildNodes",
 		func(response *Response) {
@@ -1221,7 +2014,29 @@ func (protocol *DOMProtocol) OnSetChildNodes(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnSetChildNodesChan returns a channel of DOM.setChildNodes events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-setChildNodes
+*/
+func (protocol *DOMProtocol) OnSetChildNodesChan(
+	ctx context.Context,
+) <-chan *dom.SetChildNodesEvent {
+	eventChan := make(chan *dom.SetChildNodesEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnSetChildNodes(func(event *dom.SetChildNodesEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1232,7 +2047,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-shadowRootPopp
 */
 func (protocol *DOMProtocol) OnShadowRootPopped(
 	callback func(event *dom.ShadowRootPoppedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.shadowRootPopped",
 		func(response *Response) {
@@ -1244,7 +2059,29 @@ func (protocol *DOMProtocol) OnShadowRootPopped(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnShadowRootPoppedChan returns a channel of DOM.shadowRootPopped events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-shadowRootPopped EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) OnShadowRootPoppedChan(
+	ctx context.Context,
+) <-chan *dom.ShadowRootPoppedEvent {
+	eventChan := make(chan *dom.ShadowRootPoppedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnShadowRootPopped(func(event *dom.ShadowRootPoppedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1255,7 +2092,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-shadowRootPush
 */
 func (protocol *DOMProtocol) OnShadowRootPushed(
 	callback func(event *dom.ShadowRootPushedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOM.shadowRootPushed",
 		func(response *Response) {
@@ -1267,5 +2104,27 @@ func (protocol *DOMProtocol) OnShadowRootPushed(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnShadowRootPushedChan returns a channel of DOM.shadowRootPushed events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-shadowRootPushed EXPERIMENTAL.
+*/
+func (protocol *DOMProtocol) OnShadowRootPushedChan(
+	ctx context.Context,
+) <-chan *dom.ShadowRootPushedEvent {
+	eventChan := make(chan *dom.ShadowRootPushedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnShadowRootPushed(func(event *dom.ShadowRootPushedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/dom/snapshot"
@@ -23,13 +24,25 @@ Disable disables the DOM snapshot functionality for the given page.
 https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#method-disable
 */
 func (protocol *DOMSnapshotProtocol) Disable() <-chan *snapshot.DisableResult {
-	resultChan := make(chan *snapshot.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#method-disable
+*/
+func (protocol *DOMSnapshotProtocol) DisableContext(ctx context.Context) <-chan *snapshot.DisableResult {
+	resultChan := make(chan *snapshot.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "DOMSnapshot.disable", nil)
 	result := &snapshot.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -45,13 +58,25 @@ Enable enables the DOM snapshot functionality for the given page.
 https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#method-enable
 */
 func (protocol *DOMSnapshotProtocol) Enable() <-chan *snapshot.EnableResult {
-	resultChan := make(chan *snapshot.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#method-enable
+*/
+func (protocol *DOMSnapshotProtocol) EnableContext(ctx context.Context) <-chan *snapshot.EnableResult {
+	resultChan := make(chan *snapshot.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "DOMSnapshot.enable", nil)
 	result := &snapshot.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -72,13 +97,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#method-getSn
 func (protocol *DOMSnapshotProtocol) Get(
 	params *snapshot.GetParams,
 ) <-chan *snapshot.GetResult {
-	resultChan := make(chan *snapshot.GetResult)
+	return protocol.GetContext(context.Background(), params)
+}
+
+/*
+GetContext performs Get and returns its result, or returns the context error if
+ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#method-getSnapshot
+*/
+func (protocol *DOMSnapshotProtocol) GetContext(
+	ctx context.Context,
+	params *snapshot.GetParams,
+) <-chan *snapshot.GetResult {
+	resultChan := make(chan *snapshot.GetResult, 1)
 	command := NewCommand(protocol.Socket, "DOMSnapshot.getSnapshot", params)
 	result := &snapshot.GetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
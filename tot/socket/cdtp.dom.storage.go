@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/dom/storage"
@@ -24,13 +25,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-clear
 func (protocol *DOMStorageProtocol) Clear(
 	params *storage.ClearParams,
 ) <-chan *storage.ClearResult {
-	resultChan := make(chan *storage.ClearResult)
+	return protocol.ClearContext(context.Background(), params)
+}
+
+/*
+ClearContext performs Clear and returns its result, or returns the context error
+if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-clear
+*/
+func (protocol *DOMStorageProtocol) ClearContext(
+	ctx context.Context,
+	params *storage.ClearParams,
+) <-chan *storage.ClearResult {
+	resultChan := make(chan *storage.ClearResult, 1)
 	command := NewCommand(protocol.Socket, "DOMStorage.clear", params)
 	result := &storage.ClearResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -47,13 +63,25 @@ the client.
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-disable
 */
 func (protocol *DOMStorageProtocol) Disable() <-chan *storage.DisableResult {
-	resultChan := make(chan *storage.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-disable
+*/
+func (protocol *DOMStorageProtocol) DisableContext(ctx context.Context) <-chan *storage.DisableResult {
+	resultChan := make(chan *storage.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "DOMStorage.disable", nil)
 	result := &storage.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -70,13 +98,25 @@ client.
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-enable
 */
 func (protocol *DOMStorageProtocol) Enable() <-chan *storage.EnableResult {
-	resultChan := make(chan *storage.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-enable
+*/
+func (protocol *DOMStorageProtocol) EnableContext(ctx context.Context) <-chan *storage.EnableResult {
+	resultChan := make(chan *storage.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "DOMStorage.enable", nil)
 	result := &storage.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -94,13 +134,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-getDOM
 func (protocol *DOMStorageProtocol) GetItems(
 	params *storage.GetItemsParams,
 ) <-chan *storage.GetItemsResult {
-	resultChan := make(chan *storage.GetItemsResult)
+	return protocol.GetItemsContext(context.Background(), params)
+}
+
+/*
+GetItemsContext performs GetItems and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-getDOMStorageItems
+*/
+func (protocol *DOMStorageProtocol) GetItemsContext(
+	ctx context.Context,
+	params *storage.GetItemsParams,
+) <-chan *storage.GetItemsResult {
+	resultChan := make(chan *storage.GetItemsResult, 1)
 	command := NewCommand(protocol.Socket, "DOMStorage.getDOMStorageItems", params)
 	result := &storage.GetItemsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -120,13 +175,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-remove
 func (protocol *DOMStorageProtocol) RemoveItem(
 	params *storage.RemoveItemParams,
 ) <-chan *storage.RemoveItemResult {
-	resultChan := make(chan *storage.RemoveItemResult)
+	return protocol.RemoveItemContext(context.Background(), params)
+}
+
+/*
+RemoveItemContext performs RemoveItem and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-removeDOMStorageItem
+*/
+func (protocol *DOMStorageProtocol) RemoveItemContext(
+	ctx context.Context,
+	params *storage.RemoveItemParams,
+) <-chan *storage.RemoveItemResult {
+	resultChan := make(chan *storage.RemoveItemResult, 1)
 	command := NewCommand(protocol.Socket, "DOMStorage.removeDOMStorageItem", params)
 	result := &storage.RemoveItemResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -144,13 +214,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-setDOM
 func (protocol *DOMStorageProtocol) SetItem(
 	params *storage.SetItemParams,
 ) <-chan *storage.SetItemResult {
-	resultChan := make(chan *storage.SetItemResult)
+	return protocol.SetItemContext(context.Background(), params)
+}
+
+/*
+SetItemContext performs SetItem and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-setDOMStorageItem
+*/
+func (protocol *DOMStorageProtocol) SetItemContext(
+	ctx context.Context,
+	params *storage.SetItemParams,
+) <-chan *storage.SetItemResult {
+	resultChan := make(chan *storage.SetItemResult, 1)
 	command := NewCommand(protocol.Socket, "DOMStorage.setDOMStorageItem", params)
 	result := &storage.SetItemResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -168,7 +253,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#event-domStor
 */
 func (protocol *DOMStorageProtocol) OnItemAdded(
 	callback func(event *storage.ItemAddedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOMStorage.domStorageItemAdded",
 		func(response *Response) {
@@ -180,7 +265,29 @@ func (protocol *DOMStorageProtocol) OnItemAdded(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnItemAddedChan returns a channel of DOMStorage.domStorageItemAdded events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#event-domStorageItemAdded
+*/
+func (protocol *DOMStorageProtocol) OnItemAddedChan(
+	ctx context.Context,
+) <-chan *storage.ItemAddedEvent {
+	eventChan := make(chan *storage.ItemAddedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnItemAdded(func(event *storage.ItemAddedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -191,7 +298,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#event-domStor
 */
 func (protocol *DOMStorageProtocol) OnItemRemoved(
 	callback func(event *storage.ItemRemovedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOMStorage.domStorageItemRemoved",
 		func(response *Response) {
@@ -203,7 +310,29 @@ func (protocol *DOMStorageProtocol) OnItemRemoved(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnItemRemovedChan returns a channel of DOMStorage.domStorageItemRemoved events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#event-domStorageItemRemoved
+*/
+func (protocol *DOMStorageProtocol) OnItemRemovedChan(
+	ctx context.Context,
+) <-chan *storage.ItemRemovedEvent {
+	eventChan := make(chan *storage.ItemRemovedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnItemRemoved(func(event *storage.ItemRemovedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -214,7 +343,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#event-domStor
 */
 func (protocol *DOMStorageProtocol) OnItemUpdated(
 	callback func(event *storage.ItemUpdatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOMStorage.domStorageItemUpdated",
 		func(response *Response) {
@@ -226,7 +355,29 @@ func (protocol *DOMStorageProtocol) OnItemUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnItemUpdatedChan returns a channel of DOMStorage.domStorageItemUpdated events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#event-domStorageItemUpdated
+*/
+func (protocol *DOMStorageProtocol) OnItemUpdatedChan(
+	ctx context.Context,
+) <-chan *storage.ItemUpdatedEvent {
+	eventChan := make(chan *storage.ItemUpdatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnItemUpdated(func(event *storage.ItemUpdatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -237,7 +388,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#event-domStor
 */
 func (protocol *DOMStorageProtocol) OnItemsCleared(
 	callback func(event *storage.ItemsClearedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"DOMStorage.domStorageItemsCleared",
 		func(response *Response) {
@@ -249,5 +400,27 @@ func (protocol *DOMStorageProtocol) OnItemsCleared(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnItemsClearedChan returns a channel of DOMStorage.domStorageItemsCleared
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#event-domStorageItemsCleared
+*/
+func (protocol *DOMStorageProtocol) OnItemsClearedChan(
+	ctx context.Context,
+) <-chan *storage.ItemsClearedEvent {
+	eventChan := make(chan *storage.ItemsClearedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnItemsCleared(func(event *storage.ItemsClearedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
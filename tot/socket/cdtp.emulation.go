@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/emulation"
@@ -22,13 +23,25 @@ CanEmulate tells whether emulation is supported.
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-canEmulate
 */
 func (protocol *EmulationProtocol) CanEmulate() <-chan *emulation.CanEmulateResult {
-	resultChan := make(chan *emulation.CanEmulateResult)
+	return protocol.CanEmulateContext(context.Background())
+}
+
+/*
+CanEmulateContext performs CanEmulate and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-canEmulate
+*/
+func (protocol *EmulationProtocol) CanEmulateContext(ctx context.Context) <-chan *emulation.CanEmulateResult {
+	resultChan := make(chan *emulation.CanEmulateResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.canEmulate", nil)
 	result := &emulation.CanEmulateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -46,13 +59,26 @@ ClearDeviceMetricsOverride clears the overridden device metrics.
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-clearDeviceMetricsOverride
 */
 func (protocol *EmulationProtocol) ClearDeviceMetricsOverride() <-chan *emulation.ClearDeviceMetricsOverrideResult {
-	resultChan := make(chan *emulation.ClearDeviceMetricsOverrideResult)
+	return protocol.ClearDeviceMetricsOverrideContext(context.Background())
+}
+
+/*
+ClearDeviceMetricsOverrideContext performs ClearDeviceMetricsOverride and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-clearDeviceMetricsOverride
+*/
+func (protocol *EmulationProtocol) ClearDeviceMetricsOverrideContext(ctx context.Context) <-chan *emulation.ClearDeviceMetricsOverrideResult {
+	resultChan := make(chan *emulation.ClearDeviceMetricsOverrideResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.clearDeviceMetricsOverride", nil)
 	result := &emulation.ClearDeviceMetricsOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -68,13 +94,26 @@ ClearGeolocationOverride clears the overridden Geolocation Position and Error.
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-clearGeolocationOverride
 */
 func (protocol *EmulationProtocol) ClearGeolocationOverride() <-chan *emulation.ClearGeolocationOverrideResult {
-	resultChan := make(chan *emulation.ClearGeolocationOverrideResult)
+	return protocol.ClearGeolocationOverrideContext(context.Background())
+}
+
+/*
+ClearGeolocationOverrideContext performs ClearGeolocationOverride and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-clearGeolocationOverride
+*/
+func (protocol *EmulationProtocol) ClearGeolocationOverrideContext(ctx context.Context) <-chan *emulation.ClearGeolocationOverrideResult {
+	resultChan := make(chan *emulation.ClearGeolocationOverrideResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.clearGeolocationOverride", nil)
 	result := &emulation.ClearGeolocationOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -91,13 +130,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-resetPa
 EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) ResetPageScaleFactor() <-chan *emulation.ResetPageScaleFactorResult {
-	resultChan := make(chan *emulation.ResetPageScaleFactorResult)
+	return protocol.ResetPageScaleFactorContext(context.Background())
+}
+
+/*
+ResetPageScaleFactorContext performs ResetPageScaleFactor and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-resetPageScaleFactor
+EXPERIMENTAL.
+*/
+func (protocol *EmulationProtocol) ResetPageScaleFactorContext(ctx context.Context) <-chan *emulation.ResetPageScaleFactorResult {
+	resultChan := make(chan *emulation.ResetPageScaleFactorResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.resetPageScaleFactor", nil)
 	result := &emulation.ResetPageScaleFactorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -116,13 +169,30 @@ EXPERIMENTAL.
 func (protocol *EmulationProtocol) SetCPUThrottlingRate(
 	params *emulation.SetCPUThrottlingRateParams,
 ) <-chan *emulation.SetCPUThrottlingRateResult {
-	resultChan := make(chan *emulation.SetCPUThrottlingRateResult)
+	return protocol.SetCPUThrottlingRateContext(context.Background(), params)
+}
+
+/*
+SetCPUThrottlingRateContext performs SetCPUThrottlingRate and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setCPUThrottlingRate
+EXPERIMENTAL.
+*/
+func (protocol *EmulationProtocol) SetCPUThrottlingRateContext(
+	ctx context.Context,
+	params *emulation.SetCPUThrottlingRateParams,
+) <-chan *emulation.SetCPUThrottlingRateResult {
+	resultChan := make(chan *emulation.SetCPUThrottlingRateResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setCPUThrottlingRate", params)
 	result := &emulation.SetCPUThrottlingRateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -142,13 +212,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDefa
 func (protocol *EmulationProtocol) SetDefaultBackgroundColorOverride(
 	params *emulation.SetDefaultBackgroundColorOverrideParams,
 ) <-chan *emulation.SetDefaultBackgroundColorOverrideResult {
-	resultChan := make(chan *emulation.SetDefaultBackgroundColorOverrideResult)
+	return protocol.SetDefaultBackgroundColorOverrideContext(context.Background(), params)
+}
+
+/*
+SetDefaultBackgroundColorOverrideContext performs
+SetDefaultBackgroundColorOverride and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDefaultBackgroundColorOverride
+*/
+func (protocol *EmulationProtocol) SetDefaultBackgroundColorOverrideContext(
+	ctx context.Context,
+	params *emulation.SetDefaultBackgroundColorOverrideParams,
+) <-chan *emulation.SetDefaultBackgroundColorOverrideResult {
+	resultChan := make(chan *emulation.SetDefaultBackgroundColorOverrideResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setDefaultBackgroundColorOverride", params)
 	result := &emulation.SetDefaultBackgroundColorOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -169,13 +255,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDevi
 func (protocol *EmulationProtocol) SetDeviceMetricsOverride(
 	params *emulation.SetDeviceMetricsOverrideParams,
 ) <-chan *emulation.SetDeviceMetricsOverrideResult {
-	resultChan := make(chan *emulation.SetDeviceMetricsOverrideResult)
+	return protocol.SetDeviceMetricsOverrideContext(context.Background(), params)
+}
+
+/*
+SetDeviceMetricsOverrideContext performs SetDeviceMetricsOverride and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDeviceMetricsOverride
+*/
+func (protocol *EmulationProtocol) SetDeviceMetricsOverrideContext(
+	ctx context.Context,
+	params *emulation.SetDeviceMetricsOverrideParams,
+) <-chan *emulation.SetDeviceMetricsOverrideResult {
+	resultChan := make(chan *emulation.SetDeviceMetricsOverrideResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setDeviceMetricsOverride", params)
 	result := &emulation.SetDeviceMetricsOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -194,13 +296,30 @@ EXPERIMENTAL.
 func (protocol *EmulationProtocol) SetEmitTouchEventsForMouse(
 	params *emulation.SetEmitTouchEventsForMouseParams,
 ) <-chan *emulation.SetEmitTouchEventsForMouseResult {
-	resultChan := make(chan *emulation.SetEmitTouchEventsForMouseResult)
+	return protocol.SetEmitTouchEventsForMouseContext(context.Background(), params)
+}
+
+/*
+SetEmitTouchEventsForMouseContext performs SetEmitTouchEventsForMouse and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmitTouchEventsForMouse
+EXPERIMENTAL.
+*/
+func (protocol *EmulationProtocol) SetEmitTouchEventsForMouseContext(
+	ctx context.Context,
+	params *emulation.SetEmitTouchEventsForMouseParams,
+) <-chan *emulation.SetEmitTouchEventsForMouseResult {
+	resultChan := make(chan *emulation.SetEmitTouchEventsForMouseResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setEmitTouchEventsForMouse", params)
 	result := &emulation.SetEmitTouchEventsForMouseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -218,13 +337,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmul
 func (protocol *EmulationProtocol) SetEmulatedMedia(
 	params *emulation.SetEmulatedMediaParams,
 ) <-chan *emulation.SetEmulatedMediaResult {
-	resultChan := make(chan *emulation.SetEmulatedMediaResult)
+	return protocol.SetEmulatedMediaContext(context.Background(), params)
+}
+
+/*
+SetEmulatedMediaContext performs SetEmulatedMedia and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmulatedMedia
+*/
+func (protocol *EmulationProtocol) SetEmulatedMediaContext(
+	ctx context.Context,
+	params *emulation.SetEmulatedMediaParams,
+) <-chan *emulation.SetEmulatedMediaResult {
+	resultChan := make(chan *emulation.SetEmulatedMediaResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setEmulatedMedia", params)
 	result := &emulation.SetEmulatedMediaResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -243,13 +378,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setGeol
 func (protocol *EmulationProtocol) SetGeolocationOverride(
 	params *emulation.SetGeolocationOverrideParams,
 ) <-chan *emulation.SetGeolocationOverrideResult {
-	resultChan := make(chan *emulation.SetGeolocationOverrideResult)
+	return protocol.SetGeolocationOverrideContext(context.Background(), params)
+}
+
+/*
+SetGeolocationOverrideContext performs SetGeolocationOverride and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setGeolocationOverride
+*/
+func (protocol *EmulationProtocol) SetGeolocationOverrideContext(
+	ctx context.Context,
+	params *emulation.SetGeolocationOverrideParams,
+) <-chan *emulation.SetGeolocationOverrideResult {
+	resultChan := make(chan *emulation.SetGeolocationOverrideResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setGeolocationOverride", params)
 	result := &emulation.SetGeolocationOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -269,13 +420,30 @@ EXPERIMENTAL.
 func (protocol *EmulationProtocol) SetNavigatorOverrides(
 	params *emulation.SetNavigatorOverridesParams,
 ) <-chan *emulation.SetNavigatorOverridesResult {
-	resultChan := make(chan *emulation.SetNavigatorOverridesResult)
+	return protocol.SetNavigatorOverridesContext(context.Background(), params)
+}
+
+/*
+SetNavigatorOverridesContext performs SetNavigatorOverrides and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setNavigatorOverrides
+EXPERIMENTAL.
+*/
+func (protocol *EmulationProtocol) SetNavigatorOverridesContext(
+	ctx context.Context,
+	params *emulation.SetNavigatorOverridesParams,
+) <-chan *emulation.SetNavigatorOverridesResult {
+	resultChan := make(chan *emulation.SetNavigatorOverridesResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setNavigatorOverrides", params)
 	result := &emulation.SetNavigatorOverridesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -294,13 +462,30 @@ EXPERIMENTAL.
 func (protocol *EmulationProtocol) SetPageScaleFactor(
 	params *emulation.SetPageScaleFactorParams,
 ) <-chan *emulation.SetPageScaleFactorResult {
-	resultChan := make(chan *emulation.SetPageScaleFactorResult)
+	return protocol.SetPageScaleFactorContext(context.Background(), params)
+}
+
+/*
+SetPageScaleFactorContext performs SetPageScaleFactor and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setPageScaleFactor
+EXPERIMENTAL.
+*/
+func (protocol *EmulationProtocol) SetPageScaleFactorContext(
+	ctx context.Context,
+	params *emulation.SetPageScaleFactorParams,
+) <-chan *emulation.SetPageScaleFactorResult {
+	resultChan := make(chan *emulation.SetPageScaleFactorResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setPageScaleFactor", params)
 	result := &emulation.SetPageScaleFactorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -318,13 +503,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setScri
 func (protocol *EmulationProtocol) SetScriptExecutionDisabled(
 	params *emulation.SetScriptExecutionDisabledParams,
 ) <-chan *emulation.SetScriptExecutionDisabledResult {
-	resultChan := make(chan *emulation.SetScriptExecutionDisabledResult)
+	return protocol.SetScriptExecutionDisabledContext(context.Background(), params)
+}
+
+/*
+SetScriptExecutionDisabledContext performs SetScriptExecutionDisabled and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setScriptExecutionDisabled
+*/
+func (protocol *EmulationProtocol) SetScriptExecutionDisabledContext(
+	ctx context.Context,
+	params *emulation.SetScriptExecutionDisabledParams,
+) <-chan *emulation.SetScriptExecutionDisabledResult {
+	resultChan := make(chan *emulation.SetScriptExecutionDisabledResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setScriptExecutionDisabled", params)
 	result := &emulation.SetScriptExecutionDisabledResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -342,13 +543,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setTouc
 func (protocol *EmulationProtocol) SetTouchEmulationEnabled(
 	params *emulation.SetTouchEmulationEnabledParams,
 ) <-chan *emulation.SetTouchEmulationEnabledResult {
-	resultChan := make(chan *emulation.SetTouchEmulationEnabledResult)
+	return protocol.SetTouchEmulationEnabledContext(context.Background(), params)
+}
+
+/*
+SetTouchEmulationEnabledContext performs SetTouchEmulationEnabled and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setTouchEmulationEnabled
+*/
+func (protocol *EmulationProtocol) SetTouchEmulationEnabledContext(
+	ctx context.Context,
+	params *emulation.SetTouchEmulationEnabledParams,
+) <-chan *emulation.SetTouchEmulationEnabledResult {
+	resultChan := make(chan *emulation.SetTouchEmulationEnabledResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setTouchEmulationEnabled", params)
 	result := &emulation.SetTouchEmulationEnabledResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -369,13 +586,30 @@ EXPERIMENTAL.
 func (protocol *EmulationProtocol) SetVirtualTimePolicy(
 	params *emulation.SetVirtualTimePolicyParams,
 ) <-chan *emulation.SetVirtualTimePolicyResult {
-	resultChan := make(chan *emulation.SetVirtualTimePolicyResult)
+	return protocol.SetVirtualTimePolicyContext(context.Background(), params)
+}
+
+/*
+SetVirtualTimePolicyContext performs SetVirtualTimePolicy and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setVirtualTimePolicy
+EXPERIMENTAL.
+*/
+func (protocol *EmulationProtocol) SetVirtualTimePolicyContext(
+	ctx context.Context,
+	params *emulation.SetVirtualTimePolicyParams,
+) <-chan *emulation.SetVirtualTimePolicyResult {
+	resultChan := make(chan *emulation.SetVirtualTimePolicyResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.SetVirtualTimePolicy", nil)
 	result := &emulation.SetVirtualTimePolicyResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -398,13 +632,29 @@ EXPERIMENTAL. DEPRECATED.
 func (protocol *EmulationProtocol) SetVisibleSize(
 	params *emulation.SetVisibleSizeParams,
 ) <-chan *emulation.SetVisibleSizeResult {
-	resultChan := make(chan *emulation.SetVisibleSizeResult)
+	return protocol.SetVisibleSizeContext(context.Background(), params)
+}
+
+/*
+SetVisibleSizeContext performs SetVisibleSize and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setVisibleSize
+EXPERIMENTAL. DEPRECATED.
+*/
+func (protocol *EmulationProtocol) SetVisibleSizeContext(
+	ctx context.Context,
+	params *emulation.SetVisibleSizeParams,
+) <-chan *emulation.SetVisibleSizeResult {
+	resultChan := make(chan *emulation.SetVisibleSizeResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setVisibleSize", params)
 	result := &emulation.SetVisibleSizeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -423,7 +673,7 @@ EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) OnVirtualTimeAdvanced(
 	callback func(event *emulation.VirtualTimeAdvancedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Emulation.virtualTimeAdvanced",
 		func(response *Response) {
@@ -435,7 +685,30 @@ func (protocol *EmulationProtocol) OnVirtualTimeAdvanced(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnVirtualTimeAdvancedChan returns a channel of Emulation.virtualTimeAdvanced
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#event-virtualTimeAdvanced
+EXPERIMENTAL.
+*/
+func (protocol *EmulationProtocol) OnVirtualTimeAdvancedChan(
+	ctx context.Context,
+) <-chan *emulation.VirtualTimeAdvancedEvent {
+	eventChan := make(chan *emulation.VirtualTimeAdvancedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnVirtualTimeAdvanced(func(event *emulation.VirtualTimeAdvancedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -448,7 +721,7 @@ EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) OnVirtualTimeBudgetExpired(
 	callback func(event *emulation.VirtualTimeBudgetExpiredEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Emulation.virtualTimeBudgetExpired",
 		func(response *Response) {
@@ -460,7 +733,31 @@ func (protocol *EmulationProtocol) OnVirtualTimeBudgetExpired(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnVirtualTimeBudgetExpiredChan returns a channel of
+Emulation.virtualTimeBudgetExpired events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#event-virtualTimeBudgetExpired
+EXPERIMENTAL.
+*/
+func (protocol *EmulationProtocol) OnVirtualTimeBudgetExpiredChan(
+	ctx context.Context,
+) <-chan *emulation.VirtualTimeBudgetExpiredEvent {
+	eventChan := make(chan *emulation.VirtualTimeBudgetExpiredEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnVirtualTimeBudgetExpired(func(event *emulation.VirtualTimeBudgetExpiredEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -472,7 +769,7 @@ EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) OnVirtualTimePaused(
 	callback func(event *emulation.VirtualTimePausedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Emulation.virtualTimePaused",
 		func(response *Response) {
@@ -484,5 +781,28 @@ func (protocol *EmulationProtocol) OnVirtualTimePaused(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnVirtualTimePausedChan returns a channel of Emulation.virtualTimePaused events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#event-virtualTimePaused
+EXPERIMENTAL.
+*/
+func (protocol *EmulationProtocol) OnVirtualTimePausedChan(
+	ctx context.Context,
+) <-chan *emulation.VirtualTimePausedEvent {
+	eventChan := make(chan *emulation.VirtualTimePausedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnVirtualTimePaused(func(event *emulation.VirtualTimePausedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
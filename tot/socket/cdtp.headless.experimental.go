@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/headless/experimental"
@@ -27,13 +28,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#met
 func (protocol *HeadlessExperimentalProtocol) BeginFrame(
 	params *experimental.BeginFrameParams,
 ) <-chan *experimental.BeginFrameResult {
-	resultChan := make(chan *experimental.BeginFrameResult)
+	return protocol.BeginFrameContext(context.Background(), params)
+}
+
+/*
+BeginFrameContext performs BeginFrame and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#method-beginFrame
+*/
+func (protocol *HeadlessExperimentalProtocol) BeginFrameContext(
+	ctx context.Context,
+	params *experimental.BeginFrameParams,
+) <-chan *experimental.BeginFrameResult {
+	resultChan := make(chan *experimental.BeginFrameResult, 1)
 	command := NewCommand(protocol.Socket, "HeadlessExperimental.beginFrame", params)
 	result := &experimental.BeginFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -51,13 +67,25 @@ Disable disables headless events for the target.
 https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#method-disable
 */
 func (protocol *HeadlessExperimentalProtocol) Disable() <-chan *experimental.DisableResult {
-	resultChan := make(chan *experimental.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#method-disable
+*/
+func (protocol *HeadlessExperimentalProtocol) DisableContext(ctx context.Context) <-chan *experimental.DisableResult {
+	resultChan := make(chan *experimental.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "HeadlessExperimental.disable", nil)
 	result := &experimental.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -73,13 +101,25 @@ Enable enables headless events for the target.
 https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#method-enable
 */
 func (protocol *HeadlessExperimentalProtocol) Enable() <-chan *experimental.EnableResult {
-	resultChan := make(chan *experimental.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#method-enable
+*/
+func (protocol *HeadlessExperimentalProtocol) EnableContext(ctx context.Context) <-chan *experimental.EnableResult {
+	resultChan := make(chan *experimental.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "HeadlessExperimental.enable", nil)
 	result := &experimental.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -99,7 +139,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#eve
 */
 func (protocol *HeadlessExperimentalProtocol) OnMainFrameReadyForScreenshots(
 	callback func(event *experimental.MainFrameReadyForScreenshotsEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"HeadlessExperimental.mainFrameReadyForScreenshots",
 		func(response *Response) {
@@ -111,7 +151,30 @@ func (protocol *HeadlessExperimentalProtocol) OnMainFrameReadyForScreenshots(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnMainFrameReadyForScreenshotsChan returns a channel of
+HeadlessExperimental.mainFrameReadyForScreenshots events. The event handler is
+removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#event-mainFrameReadyForScreenshots
+*/
+func (protocol *HeadlessExperimentalProtocol) OnMainFrameReadyForScreenshotsChan(
+	ctx context.Context,
+) <-chan *experimental.MainFrameReadyForScreenshotsEvent {
+	eventChan := make(chan *experimental.MainFrameReadyForScreenshotsEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnMainFrameReadyForScreenshots(func(event *experimental.MainFrameReadyForScreenshotsEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -123,7 +186,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#eve
 */
 func (protocol *HeadlessExperimentalProtocol) OnNeedsBeginFramesChanged(
 	callback func(event *experimental.NeedsBeginFramesChangedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"HeadlessExperimental.needsBeginFramesChanged",
 		func(response *Response) {
@@ -135,5 +198,28 @@ func (protocol *HeadlessExperimentalProtocol) OnNeedsBeginFramesChanged(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnNeedsBeginFramesChangedChan returns a channel of
+HeadlessExperimental.needsBeginFramesChanged events. The event handler is
+removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#event-needsBeginFramesChanged
+*/
+func (protocol *HeadlessExperimentalProtocol) OnNeedsBeginFramesChangedChan(
+	ctx context.Context,
+) <-chan *experimental.NeedsBeginFramesChangedEvent {
+	eventChan := make(chan *experimental.NeedsBeginFramesChangedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnNeedsBeginFramesChanged(func(event *experimental.NeedsBeginFramesChangedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
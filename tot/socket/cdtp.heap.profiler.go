@@ -1,16 +1,20 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
-	"github.com/mkenney/go-chrome/tot/heap/profiler"
+	heapProfiler "github.com/mkenney/go-chrome/tot/heap/profiler"
 )
 
 /*
 HeapProfilerProtocol provides a namespace for the Chrome HeapProfiler protocol
 methods.
 
-https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/ EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/
+EXPERIMENTAL.
 */
 type HeapProfilerProtocol struct {
 	Socket Socketer
@@ -23,15 +27,31 @@ AddInspectedHeapObject enables console to refer to the node with given id via $x
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-addInspectedHeapObject
 */
 func (protocol *HeapProfilerProtocol) AddInspectedHeapObject(
-	params *profiler.AddInspectedHeapObjectParams,
-) <-chan *profiler.AddInspectedHeapObjectResult {
-	resultChan := make(chan *profiler.AddInspectedHeapObjectResult)
+	params *heapProfiler.AddInspectedHeapObjectParams,
+) <-chan *heapProfiler.AddInspectedHeapObjectResult {
+	return protocol.AddInspectedHeapObjectContext(context.Background(), params)
+}
+
+/*
+AddInspectedHeapObjectContext performs AddInspectedHeapObject and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-addInspectedHeapObject
+*/
+func (protocol *HeapProfilerProtocol) AddInspectedHeapObjectContext(
+	ctx context.Context,
+	params *heapProfiler.AddInspectedHeapObjectParams,
+) <-chan *heapProfiler.AddInspectedHeapObjectResult {
+	resultChan := make(chan *heapProfiler.AddInspectedHeapObjectResult, 1)
 	command := NewCommand(protocol.Socket, "HeapProfiler.addInspectedHeapObject", params)
-	result := &profiler.AddInspectedHeapObjectResult{}
+	result := &heapProfiler.AddInspectedHeapObjectResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -42,19 +62,32 @@ func (protocol *HeapProfilerProtocol) AddInspectedHeapObject(
 }
 
 /*
-CollectGarbage is experimental.
+CollectGarbage sends the HeapProfiler.collectGarbage command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-collectGarbage
+*/
+func (protocol *HeapProfilerProtocol) CollectGarbage() <-chan *heapProfiler.CollectGarbageResult {
+	return protocol.CollectGarbageContext(context.Background())
+}
+
+/*
+CollectGarbageContext performs CollectGarbage and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-collectGarbage
-EXPERIMENTAL.
 */
-func (protocol *HeapProfilerProtocol) CollectGarbage() <-chan *profiler.CollectGarbageResult {
-	resultChan := make(chan *profiler.CollectGarbageResult)
+func (protocol *HeapProfilerProtocol) CollectGarbageContext(
+	ctx context.Context,
+) <-chan *heapProfiler.CollectGarbageResult {
+	resultChan := make(chan *heapProfiler.CollectGarbageResult, 1)
 	command := NewCommand(protocol.Socket, "HeapProfiler.collectGarbage", nil)
-	result := &profiler.CollectGarbageResult{}
+	result := &heapProfiler.CollectGarbageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -65,18 +98,32 @@ func (protocol *HeapProfilerProtocol) CollectGarbage() <-chan *profiler.CollectG
 }
 
 /*
-Disable disables the HeapProfiler.
+Disable sends the HeapProfiler.disable command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-disable
+*/
+func (protocol *HeapProfilerProtocol) Disable() <-chan *heapProfiler.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-disable
 */
-func (protocol *HeapProfilerProtocol) Disable() <-chan *profiler.DisableResult {
-	resultChan := make(chan *profiler.DisableResult)
+func (protocol *HeapProfilerProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *heapProfiler.DisableResult {
+	resultChan := make(chan *heapProfiler.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "HeapProfiler.disable", nil)
-	result := &profiler.DisableResult{}
+	result := &heapProfiler.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -87,18 +134,32 @@ func (protocol *HeapProfilerProtocol) Disable() <-chan *profiler.DisableResult {
 }
 
 /*
-Enable enables the HeapProfiler.
+Enable sends the HeapProfiler.enable command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-enable
+*/
+func (protocol *HeapProfilerProtocol) Enable() <-chan *heapProfiler.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-enable
 */
-func (protocol *HeapProfilerProtocol) Enable() <-chan *profiler.EnableResult {
-	resultChan := make(chan *profiler.EnableResult)
+func (protocol *HeapProfilerProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *heapProfiler.EnableResult {
+	resultChan := make(chan *heapProfiler.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "HeapProfiler.enable", nil)
-	result := &profiler.EnableResult{}
+	result := &heapProfiler.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -109,21 +170,36 @@ func (protocol *HeapProfilerProtocol) Enable() <-chan *profiler.EnableResult {
 }
 
 /*
-GetHeapObjectID is experimental.
+GetHeapObjectID sends the HeapProfiler.getHeapObjectId command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-getHeapObjectId
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) GetHeapObjectID(
-	params *profiler.GetHeapObjectIDParams,
-) <-chan *profiler.GetHeapObjectIDResult {
-	resultChan := make(chan *profiler.GetHeapObjectIDResult)
-	command := NewCommand(protocol.Socket, "HeapProfiler.getHeapObjectID", params)
-	result := &profiler.GetHeapObjectIDResult{}
+	params *heapProfiler.GetHeapObjectIDParams,
+) <-chan *heapProfiler.GetHeapObjectIDResult {
+	return protocol.GetHeapObjectIDContext(context.Background(), params)
+}
+
+/*
+GetHeapObjectIDContext performs GetHeapObjectID and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-getHeapObjectId
+*/
+func (protocol *HeapProfilerProtocol) GetHeapObjectIDContext(
+	ctx context.Context,
+	params *heapProfiler.GetHeapObjectIDParams,
+) <-chan *heapProfiler.GetHeapObjectIDResult {
+	resultChan := make(chan *heapProfiler.GetHeapObjectIDResult, 1)
+	command := NewCommand(protocol.Socket, "HeapProfiler.getHeapObjectId", params)
+	result := &heapProfiler.GetHeapObjectIDResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -136,21 +212,36 @@ func (protocol *HeapProfilerProtocol) GetHeapObjectID(
 }
 
 /*
-GetObjectByHeapObjectID is experimental.
+GetObjectByHeapObjectID sends the HeapProfiler.getObjectByHeapObjectId command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-getObjectByHeapObjectId
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) GetObjectByHeapObjectID(
-	params *profiler.GetObjectByHeapObjectIDParams,
-) <-chan *profiler.GetObjectByHeapObjectIDResult {
-	resultChan := make(chan *profiler.GetObjectByHeapObjectIDResult)
+	params *heapProfiler.GetObjectByHeapObjectIDParams,
+) <-chan *heapProfiler.GetObjectByHeapObjectIDResult {
+	return protocol.GetObjectByHeapObjectIDContext(context.Background(), params)
+}
+
+/*
+GetObjectByHeapObjectIDContext performs GetObjectByHeapObjectID and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-getObjectByHeapObjectId
+*/
+func (protocol *HeapProfilerProtocol) GetObjectByHeapObjectIDContext(
+	ctx context.Context,
+	params *heapProfiler.GetObjectByHeapObjectIDParams,
+) <-chan *heapProfiler.GetObjectByHeapObjectIDResult {
+	resultChan := make(chan *heapProfiler.GetObjectByHeapObjectIDResult, 1)
 	command := NewCommand(protocol.Socket, "HeapProfiler.getObjectByHeapObjectId", params)
-	result := &profiler.GetObjectByHeapObjectIDResult{}
+	result := &heapProfiler.GetObjectByHeapObjectIDResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -163,22 +254,36 @@ func (protocol *HeapProfilerProtocol) GetObjectByHeapObjectID(
 }
 
 /*
-GetSamplingProfile is experimental.
+GetSamplingProfile sends the HeapProfiler.getSamplingProfile command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-getSamplingProfile
+*/
+func (protocol *HeapProfilerProtocol) GetSamplingProfile() <-chan *heapProfiler.GetSamplingProfileResult {
+	return protocol.GetSamplingProfileContext(context.Background())
+}
+
+/*
+GetSamplingProfileContext performs GetSamplingProfile and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-getSamplingProfile
-EXPERIMENTAL.
 */
-func (protocol *HeapProfilerProtocol) GetSamplingProfile(
-	params *profiler.GetSamplingProfileParams,
-) <-chan *profiler.GetSamplingProfileResult {
-	resultChan := make(chan *profiler.GetSamplingProfileResult)
-	command := NewCommand(protocol.Socket, "HeapProfiler.getSamplingProfile", params)
-	result := &profiler.GetSamplingProfileResult{}
+func (protocol *HeapProfilerProtocol) GetSamplingProfileContext(
+	ctx context.Context,
+) <-chan *heapProfiler.GetSamplingProfileResult {
+	resultChan := make(chan *heapProfiler.GetSamplingProfileResult, 1)
+	command := NewCommand(protocol.Socket, "HeapProfiler.getSamplingProfile", nil)
+	result := &heapProfiler.GetSamplingProfileResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -188,21 +293,35 @@ func (protocol *HeapProfilerProtocol) GetSamplingProfile(
 }
 
 /*
-StartSampling is experimental.
+StartSampling sends the HeapProfiler.startSampling command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-startSampling
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) StartSampling(
-	params *profiler.StartSamplingParams,
-) <-chan *profiler.StartSamplingResult {
-	resultChan := make(chan *profiler.StartSamplingResult)
+	params *heapProfiler.StartSamplingParams,
+) <-chan *heapProfiler.StartSamplingResult {
+	return protocol.StartSamplingContext(context.Background(), params)
+}
+
+/*
+StartSamplingContext performs StartSampling and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-startSampling
+*/
+func (protocol *HeapProfilerProtocol) StartSamplingContext(
+	ctx context.Context,
+	params *heapProfiler.StartSamplingParams,
+) <-chan *heapProfiler.StartSamplingResult {
+	resultChan := make(chan *heapProfiler.StartSamplingResult, 1)
 	command := NewCommand(protocol.Socket, "HeapProfiler.startSampling", params)
-	result := &profiler.StartSamplingResult{}
+	result := &heapProfiler.StartSamplingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -213,21 +332,37 @@ func (protocol *HeapProfilerProtocol) StartSampling(
 }
 
 /*
-StartTrackingHeapObjects is experimental.
+StartTrackingHeapObjects sends the HeapProfiler.startTrackingHeapObjects
+command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-startTrackingHeapObjects
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) StartTrackingHeapObjects(
-	params *profiler.StartTrackingHeapObjectsParams,
-) <-chan *profiler.StartTrackingHeapObjectsResult {
-	resultChan := make(chan *profiler.StartTrackingHeapObjectsResult)
+	params *heapProfiler.StartTrackingHeapObjectsParams,
+) <-chan *heapProfiler.StartTrackingHeapObjectsResult {
+	return protocol.StartTrackingHeapObjectsContext(context.Background(), params)
+}
+
+/*
+StartTrackingHeapObjectsContext performs StartTrackingHeapObjects and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-startTrackingHeapObjects
+*/
+func (protocol *HeapProfilerProtocol) StartTrackingHeapObjectsContext(
+	ctx context.Context,
+	params *heapProfiler.StartTrackingHeapObjectsParams,
+) <-chan *heapProfiler.StartTrackingHeapObjectsResult {
+	resultChan := make(chan *heapProfiler.StartTrackingHeapObjectsResult, 1)
 	command := NewCommand(protocol.Socket, "HeapProfiler.startTrackingHeapObjects", params)
-	result := &profiler.StartTrackingHeapObjectsResult{}
+	result := &heapProfiler.StartTrackingHeapObjectsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -238,22 +373,35 @@ func (protocol *HeapProfilerProtocol) StartTrackingHeapObjects(
 }
 
 /*
-StopSampling is experimental.
+StopSampling sends the HeapProfiler.stopSampling command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-stopSampling
+*/
+func (protocol *HeapProfilerProtocol) StopSampling() <-chan *heapProfiler.StopSamplingResult {
+	return protocol.StopSamplingContext(context.Background())
+}
+
+/*
+StopSamplingContext performs StopSampling and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-stopSampling
-EXPERIMENTAL.
 */
-func (protocol *HeapProfilerProtocol) StopSampling(
-	params *profiler.StopSamplingParams,
-) <-chan *profiler.StopSamplingResult {
-	resultChan := make(chan *profiler.StopSamplingResult)
-	command := NewCommand(protocol.Socket, "HeapProfiler.stopSampling", params)
-	result := &profiler.StopSamplingResult{}
+func (protocol *HeapProfilerProtocol) StopSamplingContext(
+	ctx context.Context,
+) <-chan *heapProfiler.StopSamplingResult {
+	resultChan := make(chan *heapProfiler.StopSamplingResult, 1)
+	command := NewCommand(protocol.Socket, "HeapProfiler.stopSampling", nil)
+	result := &heapProfiler.StopSamplingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -263,21 +411,36 @@ func (protocol *HeapProfilerProtocol) StopSampling(
 }
 
 /*
-StopTrackingHeapObjects is experimental.
+StopTrackingHeapObjects sends the HeapProfiler.stopTrackingHeapObjects command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-stopTrackingHeapObjects
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) StopTrackingHeapObjects(
-	params *profiler.StopTrackingHeapObjectsParams,
-) <-chan *profiler.StopTrackingHeapObjectsResult {
-	resultChan := make(chan *profiler.StopTrackingHeapObjectsResult)
+	params *heapProfiler.StopTrackingHeapObjectsParams,
+) <-chan *heapProfiler.StopTrackingHeapObjectsResult {
+	return protocol.StopTrackingHeapObjectsContext(context.Background(), params)
+}
+
+/*
+StopTrackingHeapObjectsContext performs StopTrackingHeapObjects and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-stopTrackingHeapObjects
+*/
+func (protocol *HeapProfilerProtocol) StopTrackingHeapObjectsContext(
+	ctx context.Context,
+	params *heapProfiler.StopTrackingHeapObjectsParams,
+) <-chan *heapProfiler.StopTrackingHeapObjectsResult {
+	resultChan := make(chan *heapProfiler.StopTrackingHeapObjectsResult, 1)
 	command := NewCommand(protocol.Socket, "HeapProfiler.stopTrackingHeapObjects", params)
-	result := &profiler.StopTrackingHeapObjectsResult{}
+	result := &heapProfiler.StopTrackingHeapObjectsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -288,21 +451,36 @@ func (protocol *HeapProfilerProtocol) StopTrackingHeapObjects(
 }
 
 /*
-TakeHeapSnapshot is experimental.
+TakeHeapSnapshot sends the HeapProfiler.takeHeapSnapshot command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-takeHeapSnapshot
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) TakeHeapSnapshot(
-	params *profiler.TakeHeapSnapshotParams,
-) <-chan *profiler.TakeHeapSnapshotResult {
-	resultChan := make(chan *profiler.TakeHeapSnapshotResult)
+	params *heapProfiler.TakeHeapSnapshotParams,
+) <-chan *heapProfiler.TakeHeapSnapshotResult {
+	return protocol.TakeHeapSnapshotContext(context.Background(), params)
+}
+
+/*
+TakeHeapSnapshotContext performs TakeHeapSnapshot and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-takeHeapSnapshot
+*/
+func (protocol *HeapProfilerProtocol) TakeHeapSnapshotContext(
+	ctx context.Context,
+	params *heapProfiler.TakeHeapSnapshotParams,
+) <-chan *heapProfiler.TakeHeapSnapshotResult {
+	resultChan := make(chan *heapProfiler.TakeHeapSnapshotResult, 1)
 	command := NewCommand(protocol.Socket, "HeapProfiler.takeHeapSnapshot", params)
-	result := &profiler.TakeHeapSnapshotResult{}
+	result := &heapProfiler.TakeHeapSnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -313,19 +491,18 @@ func (protocol *HeapProfilerProtocol) TakeHeapSnapshot(
 }
 
 /*
-OnAddHeapSnapshotChunk adds a handler to the HeapProfiler.AddHeapSnapshotChunk
+OnAddHeapSnapshotChunk adds a handler to the HeapProfiler.addHeapSnapshotChunk
 event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-addHeapSnapshotChunk
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) OnAddHeapSnapshotChunk(
-	callback func(event *profiler.AddHeapSnapshotChunkEvent),
-) {
+	callback func(event *heapProfiler.AddHeapSnapshotChunkEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"HeapProfiler.addHeapSnapshotChunk",
 		func(response *Response) {
-			event := &profiler.AddHeapSnapshotChunkEvent{}
+			event := &heapProfiler.AddHeapSnapshotChunkEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -333,23 +510,46 @@ func (protocol *HeapProfilerProtocol) OnAddHeapSnapshotChunk(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnAddHeapSnapshotChunkChan returns a channel of
+HeapProfiler.addHeapSnapshotChunk events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-addHeapSnapshotChunk
+*/
+func (protocol *HeapProfilerProtocol) OnAddHeapSnapshotChunkChan(
+	ctx context.Context,
+) <-chan *heapProfiler.AddHeapSnapshotChunkEvent {
+	eventChan := make(chan *heapProfiler.AddHeapSnapshotChunkEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnAddHeapSnapshotChunk(func(event *heapProfiler.AddHeapSnapshotChunkEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
-OnHeapStatsUpdate adds a handler to the DOM.heapStatsUpdate event. DOM.heapStatsUpdate
-fires if heap objects tracking has been started then backend may send update for
-one or more fragments.
+OnHeapStatsUpdate adds a handler to the HeapProfiler.heapStatsUpdate event. If
+heap objects tracking has been started then backend may send update for one or
+more fragments.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-heapStatsUpdate
 */
 func (protocol *HeapProfilerProtocol) OnHeapStatsUpdate(
-	callback func(event *profiler.HeapStatsUpdateEvent),
-) {
+	callback func(event *heapProfiler.HeapStatsUpdateEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"HeapProfiler.heapStatsUpdate",
 		func(response *Response) {
-			event := &profiler.HeapStatsUpdateEvent{}
+			event := &heapProfiler.HeapStatsUpdateEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -357,25 +557,47 @@ func (protocol *HeapProfilerProtocol) OnHeapStatsUpdate(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnHeapStatsUpdateChan returns a channel of HeapProfiler.heapStatsUpdate events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-heapStatsUpdate
+*/
+func (protocol *HeapProfilerProtocol) OnHeapStatsUpdateChan(
+	ctx context.Context,
+) <-chan *heapProfiler.HeapStatsUpdateEvent {
+	eventChan := make(chan *heapProfiler.HeapStatsUpdateEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnHeapStatsUpdate(func(event *heapProfiler.HeapStatsUpdateEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
-OnLastSeenObjectID adds a handler to the DOM.LastSeenObjectID event. DOM.LastSeenObjectID
-fires if heap objects tracking has been started then backend regularly sends a
-current value for last seen object id and corresponding timestamp. If the were
-changes in the heap since last event then one or more heapStatsUpdate events
-will be sent before a new lastSeenObjectId event.
+OnLastSeenObjectID adds a handler to the HeapProfiler.lastSeenObjectId event. If
+heap objects tracking has been started then backend regularly sends a current
+value for last seen object id and corresponding timestamp. If the were changes
+in the heap since last event then one or more heapStatsUpdate events will be
+sent before a new lastSeenObjectId event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-lastSeenObjectId
 */
 func (protocol *HeapProfilerProtocol) OnLastSeenObjectID(
-	callback func(event *profiler.LastSeenObjectIDEvent),
-) {
+	callback func(event *heapProfiler.LastSeenObjectIDEvent),
+) *Subscription {
 	handler := NewEventHandler(
-		"HeapProfiler.lastSeenObjectID",
+		"HeapProfiler.lastSeenObjectId",
 		func(response *Response) {
-			event := &profiler.LastSeenObjectIDEvent{}
+			event := &heapProfiler.LastSeenObjectIDEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -383,24 +605,44 @@ func (protocol *HeapProfilerProtocol) OnLastSeenObjectID(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
 }
 
 /*
-OnReportHeapSnapshotProgress adds a handler to the DOM.ReportHeapSnapshotProgress
-event.
+OnLastSeenObjectIDChan returns a channel of HeapProfiler.lastSeenObjectId
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-lastSeenObjectId
+*/
+func (protocol *HeapProfilerProtocol) OnLastSeenObjectIDChan(
+	ctx context.Context,
+) <-chan *heapProfiler.LastSeenObjectIDEvent {
+	eventChan := make(chan *heapProfiler.LastSeenObjectIDEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnLastSeenObjectID(func(event *heapProfiler.LastSeenObjectIDEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
 
+/*
+OnReportHeapSnapshotProgress adds a handler to the
+HeapProfiler.reportHeapSnapshotProgress event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-reportHeapSnapshotProgress
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) OnReportHeapSnapshotProgress(
-	callback func(event *profiler.ReportHeapSnapshotProgressEvent),
-) {
+	callback func(event *heapProfiler.ReportHeapSnapshotProgressEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"HeapProfiler.reportHeapSnapshotProgress",
 		func(response *Response) {
-			event := &profiler.ReportHeapSnapshotProgressEvent{}
+			event := &heapProfiler.ReportHeapSnapshotProgressEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -408,22 +650,44 @@ func (protocol *HeapProfilerProtocol) OnReportHeapSnapshotProgress(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
 }
 
 /*
-OnResetProfiles adds a handler to the HeapProfiler.ResetProfiles event.
+OnReportHeapSnapshotProgressChan returns a channel of
+HeapProfiler.reportHeapSnapshotProgress events. The event handler is removed and
+the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-reportHeapSnapshotProgress
+*/
+func (protocol *HeapProfilerProtocol) OnReportHeapSnapshotProgressChan(
+	ctx context.Context,
+) <-chan *heapProfiler.ReportHeapSnapshotProgressEvent {
+	eventChan := make(chan *heapProfiler.ReportHeapSnapshotProgressEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnReportHeapSnapshotProgress(func(event *heapProfiler.ReportHeapSnapshotProgressEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnResetProfiles adds a handler to the HeapProfiler.resetProfiles event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-resetProfiles
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) OnResetProfiles(
-	callback func(event *profiler.ResetProfilesEvent),
-) {
+	callback func(event *heapProfiler.ResetProfilesEvent),
+) *Subscription {
 	handler := NewEventHandler(
 		"HeapProfiler.resetProfiles",
 		func(response *Response) {
-			event := &profiler.ResetProfilesEvent{}
+			event := &heapProfiler.ResetProfilesEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -431,5 +695,27 @@ func (protocol *HeapProfilerProtocol) OnResetProfiles(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnResetProfilesChan returns a channel of HeapProfiler.resetProfiles events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-resetProfiles
+*/
+func (protocol *HeapProfilerProtocol) OnResetProfilesChan(
+	ctx context.Context,
+) <-chan *heapProfiler.ResetProfilesEvent {
+	eventChan := make(chan *heapProfiler.ResetProfilesEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnResetProfiles(func(event *heapProfiler.ResetProfilesEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
@@ -250,7 +250,8 @@ func TestHeapProfilerGetSamplingProfile(t *testing.T) {
 	mockSocket.Listen()
 	defer mockSocket.Stop()
 
-	params := &profiler.GetSamplingProfileParams{
+	resultChan := mockSocket.HeapProfiler().GetSamplingProfile()
+	mockResult := &profiler.GetSamplingProfileResult{
 		Profile: &profiler.SamplingHeapProfile{
 			Head: &profiler.SamplingHeapProfileNode{
 				CallFrame: &runtime.CallFrame{
@@ -265,8 +266,6 @@ func TestHeapProfilerGetSamplingProfile(t *testing.T) {
 			},
 		},
 	}
-	resultChan := mockSocket.HeapProfiler().GetSamplingProfile(params)
-	mockResult := &profiler.GetSamplingProfileResult{}
 	mockResultBytes, _ := json.Marshal(mockResult)
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID:     mockSocket.CurCommandID(),
@@ -278,7 +277,7 @@ func TestHeapProfilerGetSamplingProfile(t *testing.T) {
 		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
 	}
 
-	resultChan = mockSocket.HeapProfiler().GetSamplingProfile(params)
+	resultChan = mockSocket.HeapProfiler().GetSamplingProfile()
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID: mockSocket.CurCommandID(),
 		Error: &Error{
@@ -372,7 +371,8 @@ func TestHeapProfilerStopSampling(t *testing.T) {
 	mockSocket.Listen()
 	defer mockSocket.Stop()
 
-	params := &profiler.StopSamplingParams{
+	resultChan := mockSocket.HeapProfiler().StopSampling()
+	mockResult := &profiler.StopSamplingResult{
 		Profile: &profiler.SamplingHeapProfile{
 			Head: &profiler.SamplingHeapProfileNode{
 				CallFrame: &runtime.CallFrame{
@@ -387,8 +387,6 @@ func TestHeapProfilerStopSampling(t *testing.T) {
 			},
 		},
 	}
-	resultChan := mockSocket.HeapProfiler().StopSampling(params)
-	mockResult := &profiler.StopSamplingResult{}
 	mockResultBytes, _ := json.Marshal(mockResult)
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID:     mockSocket.CurCommandID(),
@@ -400,7 +398,7 @@ func TestHeapProfilerStopSampling(t *testing.T) {
 		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
 	}
 
-	resultChan = mockSocket.HeapProfiler().StopSampling(params)
+	resultChan = mockSocket.HeapProfiler().StopSampling()
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID: mockSocket.CurCommandID(),
 		Error: &Error{
@@ -595,7 +593,7 @@ func TestHeapProfilerOnLastSeenObjectID(t *testing.T) {
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID:     0,
 		Error:  &Error{},
-		Method: "HeapProfiler.lastSeenObjectID",
+		Method: "HeapProfiler.lastSeenObjectId",
 		Params: mockResultBytes,
 	})
 	result := <-resultChan
@@ -614,7 +612,7 @@ func TestHeapProfilerOnLastSeenObjectID(t *testing.T) {
 			Data:    []byte(`"error data"`),
 			Message: "error message",
 		},
-		Method: "HeapProfiler.lastSeenObjectID",
+		Method: "HeapProfiler.lastSeenObjectId",
 	})
 	result = <-resultChan
 	if nil == result.Err {
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/indexed/db"
@@ -24,13 +25,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-clearOb
 func (protocol *IndexedDBProtocol) ClearObjectStore(
 	params *db.ClearObjectStoreParams,
 ) <-chan *db.ClearObjectStoreResult {
-	resultChan := make(chan *db.ClearObjectStoreResult)
+	return protocol.ClearObjectStoreContext(context.Background(), params)
+}
+
+/*
+ClearObjectStoreContext performs ClearObjectStore and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-clearObjectStore
+*/
+func (protocol *IndexedDBProtocol) ClearObjectStoreContext(
+	ctx context.Context,
+	params *db.ClearObjectStoreParams,
+) <-chan *db.ClearObjectStoreResult {
+	resultChan := make(chan *db.ClearObjectStoreResult, 1)
 	command := NewCommand(protocol.Socket, "IndexedDB.clearObjectStore", params)
 	result := &db.ClearObjectStoreResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -48,13 +65,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-deleteD
 func (protocol *IndexedDBProtocol) DeleteDatabase(
 	params *db.DeleteDatabaseParams,
 ) <-chan *db.DeleteDatabaseResult {
-	resultChan := make(chan *db.DeleteDatabaseResult)
+	return protocol.DeleteDatabaseContext(context.Background(), params)
+}
+
+/*
+DeleteDatabaseContext performs DeleteDatabase and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-deleteDatabase
+*/
+func (protocol *IndexedDBProtocol) DeleteDatabaseContext(
+	ctx context.Context,
+	params *db.DeleteDatabaseParams,
+) <-chan *db.DeleteDatabaseResult {
+	resultChan := make(chan *db.DeleteDatabaseResult, 1)
 	command := NewCommand(protocol.Socket, "IndexedDB.deleteDatabase", params)
 	result := &db.DeleteDatabaseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -72,13 +104,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-deleteO
 func (protocol *IndexedDBProtocol) DeleteObjectStoreEntries(
 	params *db.DeleteObjectStoreEntriesParams,
 ) <-chan *db.DeleteObjectStoreEntriesResult {
-	resultChan := make(chan *db.DeleteObjectStoreEntriesResult)
+	return protocol.DeleteObjectStoreEntriesContext(context.Background(), params)
+}
+
+/*
+DeleteObjectStoreEntriesContext performs DeleteObjectStoreEntries and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-deleteObjectStoreEntries
+*/
+func (protocol *IndexedDBProtocol) DeleteObjectStoreEntriesContext(
+	ctx context.Context,
+	params *db.DeleteObjectStoreEntriesParams,
+) <-chan *db.DeleteObjectStoreEntriesResult {
+	resultChan := make(chan *db.DeleteObjectStoreEntriesResult, 1)
 	command := NewCommand(protocol.Socket, "IndexedDB.deleteObjectStoreEntries", params)
 	result := &db.DeleteObjectStoreEntriesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -94,13 +142,25 @@ Disable disables events from backend.
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-disable
 */
 func (protocol *IndexedDBProtocol) Disable() <-chan *db.DisableResult {
-	resultChan := make(chan *db.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-disable
+*/
+func (protocol *IndexedDBProtocol) DisableContext(ctx context.Context) <-chan *db.DisableResult {
+	resultChan := make(chan *db.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "IndexedDB.disable", nil)
 	result := &db.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -116,13 +176,25 @@ Enable enables events from backend.
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-enable
 */
 func (protocol *IndexedDBProtocol) Enable() <-chan *db.EnableResult {
-	resultChan := make(chan *db.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-enable
+*/
+func (protocol *IndexedDBProtocol) EnableContext(ctx context.Context) <-chan *db.EnableResult {
+	resultChan := make(chan *db.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "IndexedDB.enable", nil)
 	result := &db.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -140,13 +212,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-request
 func (protocol *IndexedDBProtocol) RequestData(
 	params *db.RequestDataParams,
 ) <-chan *db.RequestDataResult {
-	resultChan := make(chan *db.RequestDataResult)
+	return protocol.RequestDataContext(context.Background(), params)
+}
+
+/*
+RequestDataContext performs RequestData and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-requestData
+*/
+func (protocol *IndexedDBProtocol) RequestDataContext(
+	ctx context.Context,
+	params *db.RequestDataParams,
+) <-chan *db.RequestDataResult {
+	resultChan := make(chan *db.RequestDataResult, 1)
 	command := NewCommand(protocol.Socket, "IndexedDB.requestData", params)
 	result := &db.RequestDataResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -166,13 +253,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-request
 func (protocol *IndexedDBProtocol) RequestDatabase(
 	params *db.RequestDatabaseParams,
 ) <-chan *db.RequestDatabaseResult {
-	resultChan := make(chan *db.RequestDatabaseResult)
+	return protocol.RequestDatabaseContext(context.Background(), params)
+}
+
+/*
+RequestDatabaseContext performs RequestDatabase and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-requestDatabase
+*/
+func (protocol *IndexedDBProtocol) RequestDatabaseContext(
+	ctx context.Context,
+	params *db.RequestDatabaseParams,
+) <-chan *db.RequestDatabaseResult {
+	resultChan := make(chan *db.RequestDatabaseResult, 1)
 	command := NewCommand(protocol.Socket, "IndexedDB.requestDatabase", params)
 	result := &db.RequestDatabaseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -192,13 +295,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-request
 func (protocol *IndexedDBProtocol) RequestDatabaseNames(
 	params *db.RequestDatabaseNamesParams,
 ) <-chan *db.RequestDatabaseNamesResult {
-	resultChan := make(chan *db.RequestDatabaseNamesResult)
+	return protocol.RequestDatabaseNamesContext(context.Background(), params)
+}
+
+/*
+RequestDatabaseNamesContext performs RequestDatabaseNames and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-requestDatabaseNames
+*/
+func (protocol *IndexedDBProtocol) RequestDatabaseNamesContext(
+	ctx context.Context,
+	params *db.RequestDatabaseNamesParams,
+) <-chan *db.RequestDatabaseNamesResult {
+	resultChan := make(chan *db.RequestDatabaseNamesResult, 1)
 	command := NewCommand(protocol.Socket, "IndexedDB.requestDatabaseNames", params)
 	result := &db.RequestDatabaseNamesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -1,6 +1,9 @@
 package socket
 
 import (
+	"context"
+	"encoding/json"
+
 	"github.com/mkenney/go-chrome/tot/input"
 )
 
@@ -13,6 +16,48 @@ type InputProtocol struct {
 	Socket Socketer
 }
 
+/*
+DispatchDragEvent dispatches a drag event into the page.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchDragEvent
+EXPERIMENTAL.
+*/
+func (protocol *InputProtocol) DispatchDragEvent(
+	params *input.DispatchDragEventParams,
+) <-chan *input.DispatchDragEventResult {
+	return protocol.DispatchDragEventContext(context.Background(), params)
+}
+
+/*
+DispatchDragEventContext performs DispatchDragEvent and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchDragEvent
+EXPERIMENTAL.
+*/
+func (protocol *InputProtocol) DispatchDragEventContext(
+	ctx context.Context,
+	params *input.DispatchDragEventParams,
+) <-chan *input.DispatchDragEventResult {
+	resultChan := make(chan *input.DispatchDragEventResult, 1)
+	command := NewCommand(protocol.Socket, "Input.dispatchDragEvent", params)
+	result := &input.DispatchDragEventResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
 /*
 DispatchKeyEvent dispatches a key event to the page.
 
@@ -21,13 +66,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchKey
 func (protocol *InputProtocol) DispatchKeyEvent(
 	params *input.DispatchKeyEventParams,
 ) <-chan *input.DispatchKeyEventResult {
-	resultChan := make(chan *input.DispatchKeyEventResult)
+	return protocol.DispatchKeyEventContext(context.Background(), params)
+}
+
+/*
+DispatchKeyEventContext performs DispatchKeyEvent and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchKeyEvent
+*/
+func (protocol *InputProtocol) DispatchKeyEventContext(
+	ctx context.Context,
+	params *input.DispatchKeyEventParams,
+) <-chan *input.DispatchKeyEventResult {
+	resultChan := make(chan *input.DispatchKeyEventResult, 1)
 	command := NewCommand(protocol.Socket, "Input.dispatchKeyEvent", params)
 	result := &input.DispatchKeyEventResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -45,13 +106,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchMou
 func (protocol *InputProtocol) DispatchMouseEvent(
 	params *input.DispatchMouseEventParams,
 ) <-chan *input.DispatchMouseEventResult {
-	resultChan := make(chan *input.DispatchMouseEventResult)
+	return protocol.DispatchMouseEventContext(context.Background(), params)
+}
+
+/*
+DispatchMouseEventContext performs DispatchMouseEvent and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchMouseEvent
+*/
+func (protocol *InputProtocol) DispatchMouseEventContext(
+	ctx context.Context,
+	params *input.DispatchMouseEventParams,
+) <-chan *input.DispatchMouseEventResult {
+	resultChan := make(chan *input.DispatchMouseEventResult, 1)
 	command := NewCommand(protocol.Socket, "Input.dispatchMouseEvent", params)
 	result := &input.DispatchMouseEventResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -69,13 +146,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchTou
 func (protocol *InputProtocol) DispatchTouchEvent(
 	params *input.DispatchTouchEventParams,
 ) <-chan *input.DispatchTouchEventResult {
-	resultChan := make(chan *input.DispatchTouchEventResult)
+	return protocol.DispatchTouchEventContext(context.Background(), params)
+}
+
+/*
+DispatchTouchEventContext performs DispatchTouchEvent and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchTouchEvent
+*/
+func (protocol *InputProtocol) DispatchTouchEventContext(
+	ctx context.Context,
+	params *input.DispatchTouchEventParams,
+) <-chan *input.DispatchTouchEventResult {
+	resultChan := make(chan *input.DispatchTouchEventResult, 1)
 	command := NewCommand(protocol.Socket, "Input.dispatchTouchEvent", params)
 	result := &input.DispatchTouchEventResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -94,13 +187,72 @@ EXPERIMENTAL.
 func (protocol *InputProtocol) EmulateTouchFromMouseEvent(
 	params *input.EmulateTouchFromMouseEventParams,
 ) <-chan *input.EmulateTouchFromMouseEventResult {
-	resultChan := make(chan *input.EmulateTouchFromMouseEventResult)
+	return protocol.EmulateTouchFromMouseEventContext(context.Background(), params)
+}
+
+/*
+EmulateTouchFromMouseEventContext performs EmulateTouchFromMouseEvent and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-emulateTouchFromMouseEvent
+EXPERIMENTAL.
+*/
+func (protocol *InputProtocol) EmulateTouchFromMouseEventContext(
+	ctx context.Context,
+	params *input.EmulateTouchFromMouseEventParams,
+) <-chan *input.EmulateTouchFromMouseEventResult {
+	resultChan := make(chan *input.EmulateTouchFromMouseEventResult, 1)
 	command := NewCommand(protocol.Socket, "Input.emulateTouchFromMouseEvent", params)
 	result := &input.EmulateTouchFromMouseEventResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+InsertText emulates inserting text that doesn't come from a key press, for
+example an emoji keyboard or an IME.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-insertText
+EXPERIMENTAL.
+*/
+func (protocol *InputProtocol) InsertText(
+	params *input.InsertTextParams,
+) <-chan *input.InsertTextResult {
+	return protocol.InsertTextContext(context.Background(), params)
+}
+
+/*
+InsertTextContext performs InsertText and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-insertText
+EXPERIMENTAL.
+*/
+func (protocol *InputProtocol) InsertTextContext(
+	ctx context.Context,
+	params *input.InsertTextParams,
+) <-chan *input.InsertTextResult {
+	resultChan := make(chan *input.InsertTextResult, 1)
+	command := NewCommand(protocol.Socket, "Input.insertText", params)
+	result := &input.InsertTextResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -118,13 +270,73 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-setIgnoreIn
 func (protocol *InputProtocol) SetIgnoreEvents(
 	params *input.SetIgnoreEventsParams,
 ) <-chan *input.SetIgnoreEventsResult {
-	resultChan := make(chan *input.SetIgnoreEventsResult)
+	return protocol.SetIgnoreEventsContext(context.Background(), params)
+}
+
+/*
+SetIgnoreEventsContext performs SetIgnoreEvents and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-setIgnoreInputEvents
+*/
+func (protocol *InputProtocol) SetIgnoreEventsContext(
+	ctx context.Context,
+	params *input.SetIgnoreEventsParams,
+) <-chan *input.SetIgnoreEventsResult {
+	resultChan := make(chan *input.SetIgnoreEventsResult, 1)
 	command := NewCommand(protocol.Socket, "Input.setIgnoreInputEvents", params)
 	result := &input.SetIgnoreEventsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetInterceptDrags prevents default drag and drop behavior and instead emits
+Input.dragIntercepted events. Drag and drop behavior can be directly controlled
+via Input.dispatchDragEvent.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-setInterceptDrags
+EXPERIMENTAL.
+*/
+func (protocol *InputProtocol) SetInterceptDrags(
+	params *input.SetInterceptDragsParams,
+) <-chan *input.SetInterceptDragsResult {
+	return protocol.SetInterceptDragsContext(context.Background(), params)
+}
+
+/*
+SetInterceptDragsContext performs SetInterceptDrags and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-setInterceptDrags
+EXPERIMENTAL.
+*/
+func (protocol *InputProtocol) SetInterceptDragsContext(
+	ctx context.Context,
+	params *input.SetInterceptDragsParams,
+) <-chan *input.SetInterceptDragsResult {
+	resultChan := make(chan *input.SetInterceptDragsResult, 1)
+	command := NewCommand(protocol.Socket, "Input.setInterceptDrags", params)
+	result := &input.SetInterceptDragsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -144,13 +356,30 @@ EXPERIMENTAL.
 func (protocol *InputProtocol) SynthesizePinchGesture(
 	params *input.SynthesizePinchGestureParams,
 ) <-chan *input.SynthesizePinchGestureResult {
-	resultChan := make(chan *input.SynthesizePinchGestureResult)
+	return protocol.SynthesizePinchGestureContext(context.Background(), params)
+}
+
+/*
+SynthesizePinchGestureContext performs SynthesizePinchGesture and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-synthesizePinchGesture
+EXPERIMENTAL.
+*/
+func (protocol *InputProtocol) SynthesizePinchGestureContext(
+	ctx context.Context,
+	params *input.SynthesizePinchGestureParams,
+) <-chan *input.SynthesizePinchGestureResult {
+	resultChan := make(chan *input.SynthesizePinchGestureResult, 1)
 	command := NewCommand(protocol.Socket, "Input.synthesizePinchGesture", params)
 	result := &input.SynthesizePinchGestureResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -170,13 +399,30 @@ EXPERIMENTAL.
 func (protocol *InputProtocol) SynthesizeScrollGesture(
 	params *input.SynthesizeScrollGestureParams,
 ) <-chan *input.SynthesizeScrollGestureResult {
-	resultChan := make(chan *input.SynthesizeScrollGestureResult)
+	return protocol.SynthesizeScrollGestureContext(context.Background(), params)
+}
+
+/*
+SynthesizeScrollGestureContext performs SynthesizeScrollGesture and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-synthesizeScrollGesture
+EXPERIMENTAL.
+*/
+func (protocol *InputProtocol) SynthesizeScrollGestureContext(
+	ctx context.Context,
+	params *input.SynthesizeScrollGestureParams,
+) <-chan *input.SynthesizeScrollGestureResult {
+	resultChan := make(chan *input.SynthesizeScrollGestureResult, 1)
 	command := NewCommand(protocol.Socket, "Input.synthesizeScrollGesture", params)
 	result := &input.SynthesizeScrollGestureResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -196,13 +442,30 @@ EXPERIMENTAL.
 func (protocol *InputProtocol) SynthesizeTapGesture(
 	params *input.SynthesizeTapGestureParams,
 ) <-chan *input.SynthesizeTapGestureResult {
-	resultChan := make(chan *input.SynthesizeTapGestureResult)
+	return protocol.SynthesizeTapGestureContext(context.Background(), params)
+}
+
+/*
+SynthesizeTapGestureContext performs SynthesizeTapGesture and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-synthesizeTapGesture
+EXPERIMENTAL.
+*/
+func (protocol *InputProtocol) SynthesizeTapGestureContext(
+	ctx context.Context,
+	params *input.SynthesizeTapGestureParams,
+) <-chan *input.SynthesizeTapGestureResult {
+	resultChan := make(chan *input.SynthesizeTapGestureResult, 1)
 	command := NewCommand(protocol.Socket, "Input.synthesizeTapGesture", params)
 	result := &input.SynthesizeTapGestureResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -211,3 +474,51 @@ func (protocol *InputProtocol) SynthesizeTapGesture(
 
 	return resultChan
 }
+
+/*
+OnDragIntercepted adds a handler to the Input.dragIntercepted event.
+Input.dragIntercepted fires only when Input.setInterceptDrags is enabled, use
+the data with Input.dispatchDragEvent to restore normal drag and drop behavior.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#event-dragIntercepted
+EXPERIMENTAL.
+*/
+func (protocol *InputProtocol) OnDragIntercepted(
+	callback func(event *input.DragInterceptedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Input.dragIntercepted",
+		func(response *Response) {
+			event := &input.DragInterceptedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnDragInterceptedChan returns a channel of Input.dragIntercepted events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#event-dragIntercepted
+EXPERIMENTAL.
+*/
+func (protocol *InputProtocol) OnDragInterceptedChan(
+	ctx context.Context,
+) <-chan *input.DragInterceptedEvent {
+	eventChan := make(chan *input.DragInterceptedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnDragIntercepted(func(event *input.DragInterceptedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
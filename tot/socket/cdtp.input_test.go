@@ -9,6 +9,52 @@ import (
 	"github.com/mkenney/go-chrome/tot/input"
 )
 
+func TestInputDispatchDragEvent(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestInputDispatchDragEvent")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	params := &input.DispatchDragEventParams{
+		Type: input.DragEvent.Drop,
+		X:    1.5,
+		Y:    2.5,
+		Data: &input.DragData{
+			Items: []*input.DragDataItem{{
+				MimeType: "text/plain",
+				Data:     "data",
+			}},
+			DragOperationsMask: 1,
+		},
+	}
+	resultChan := mockSocket.Input().DispatchDragEvent(params)
+	mockResult := &input.DispatchDragEventResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Input().DispatchDragEvent(params)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
 func TestInputDispatchKeyEvent(t *testing.T) {
 	socketURL, _ := url.Parse("https://test:9222/TestInputDispatchKeyEvent")
 	mockSocket := NewMock(socketURL)
@@ -197,6 +243,43 @@ func TestInputEmulateTouchFromMouseEvent(t *testing.T) {
 	}
 }
 
+func TestInputInsertText(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestInputInsertText")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	params := &input.InsertTextParams{
+		Text: "é",
+	}
+	resultChan := mockSocket.Input().InsertText(params)
+	mockResult := &input.InsertTextResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Input().InsertText(params)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
 func TestInputSetIgnoreEvents(t *testing.T) {
 	socketURL, _ := url.Parse("https://test:9222/TestInputSetIgnoreEvents")
 	mockSocket := NewMock(socketURL)
@@ -234,6 +317,43 @@ func TestInputSetIgnoreEvents(t *testing.T) {
 	}
 }
 
+func TestInputSetInterceptDrags(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestInputSetInterceptDrags")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	params := &input.SetInterceptDragsParams{
+		Enabled: true,
+	}
+	resultChan := mockSocket.Input().SetInterceptDrags(params)
+	mockResult := &input.SetInterceptDragsResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Input().SetInterceptDrags(params)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
 func TestInputSynthesizePinchGesture(t *testing.T) {
 	socketURL, _ := url.Parse("https://test:9222/TestInputSynthesizePinchGesture")
 	mockSocket := NewMock(socketURL)
@@ -363,3 +483,56 @@ func TestInputSynthesizeTapGesture(t *testing.T) {
 		t.Errorf("Expected error, got success")
 	}
 }
+
+func TestInputOnDragIntercepted(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestInputOnDragIntercepted")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *input.DragInterceptedEvent)
+	mockSocket.Input().OnDragIntercepted(func(eventData *input.DragInterceptedEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &input.DragInterceptedEvent{
+		Data: &input.DragData{
+			Items: []*input.DragDataItem{{
+				MimeType: "text/plain",
+				Data:     "data",
+			}},
+			DragOperationsMask: 1,
+		},
+	}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Input.dragIntercepted",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if mockResult.Err != result.Err {
+		t.Errorf("Expected '%v', got: '%v'", mockResult, result)
+	}
+	if 1 != len(result.Data.Items) || "data" != result.Data.Items[0].Data {
+		t.Errorf("Expected the drag data, got %v", result.Data)
+	}
+
+	resultChan = make(chan *input.DragInterceptedEvent)
+	mockSocket.Input().OnDragIntercepted(func(eventData *input.DragInterceptedEvent) {
+		resultChan <- eventData
+	})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "Input.dragIntercepted",
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
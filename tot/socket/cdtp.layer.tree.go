@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/layer/tree"
@@ -24,13 +25,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-composi
 func (protocol *LayerTreeProtocol) CompositingReasons(
 	params *tree.CompositingReasonsParams,
 ) <-chan *tree.CompositingReasonsResult {
-	resultChan := make(chan *tree.CompositingReasonsResult)
+	return protocol.CompositingReasonsContext(context.Background(), params)
+}
+
+/*
+CompositingReasonsContext performs CompositingReasons and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-compositingReasons
+*/
+func (protocol *LayerTreeProtocol) CompositingReasonsContext(
+	ctx context.Context,
+	params *tree.CompositingReasonsParams,
+) <-chan *tree.CompositingReasonsResult {
+	resultChan := make(chan *tree.CompositingReasonsResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.compositingReasons", params)
 	result := &tree.CompositingReasonsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -48,13 +65,25 @@ Disable disables compositing tree inspection.
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-disable
 */
 func (protocol *LayerTreeProtocol) Disable() <-chan *tree.DisableResult {
-	resultChan := make(chan *tree.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-disable
+*/
+func (protocol *LayerTreeProtocol) DisableContext(ctx context.Context) <-chan *tree.DisableResult {
+	resultChan := make(chan *tree.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.disable", nil)
 	result := &tree.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -70,13 +99,25 @@ Enable enables compositing tree inspection.
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-enable
 */
 func (protocol *LayerTreeProtocol) Enable() <-chan *tree.EnableResult {
-	resultChan := make(chan *tree.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-enable
+*/
+func (protocol *LayerTreeProtocol) EnableContext(ctx context.Context) <-chan *tree.EnableResult {
+	resultChan := make(chan *tree.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.enable", nil)
 	result := &tree.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -94,13 +135,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-loadSna
 func (protocol *LayerTreeProtocol) LoadSnapshot(
 	params *tree.LoadSnapshotParams,
 ) <-chan *tree.LoadSnapshotResult {
-	resultChan := make(chan *tree.LoadSnapshotResult)
+	return protocol.LoadSnapshotContext(context.Background(), params)
+}
+
+/*
+LoadSnapshotContext performs LoadSnapshot and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-loadSnapshot
+*/
+func (protocol *LayerTreeProtocol) LoadSnapshotContext(
+	ctx context.Context,
+	params *tree.LoadSnapshotParams,
+) <-chan *tree.LoadSnapshotResult {
+	resultChan := make(chan *tree.LoadSnapshotResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.loadSnapshot", params)
 	result := &tree.LoadSnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -120,13 +176,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-makeSna
 func (protocol *LayerTreeProtocol) MakeSnapshot(
 	params *tree.MakeSnapshotParams,
 ) <-chan *tree.MakeSnapshotResult {
-	resultChan := make(chan *tree.MakeSnapshotResult)
+	return protocol.MakeSnapshotContext(context.Background(), params)
+}
+
+/*
+MakeSnapshotContext performs MakeSnapshot and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-makeSnapshot
+*/
+func (protocol *LayerTreeProtocol) MakeSnapshotContext(
+	ctx context.Context,
+	params *tree.MakeSnapshotParams,
+) <-chan *tree.MakeSnapshotResult {
+	resultChan := make(chan *tree.MakeSnapshotResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.makeSnapshot", params)
 	result := &tree.MakeSnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -146,13 +217,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-profile
 func (protocol *LayerTreeProtocol) ProfileSnapshot(
 	params *tree.ProfileSnapshotParams,
 ) <-chan *tree.ProfileSnapshotResult {
-	resultChan := make(chan *tree.ProfileSnapshotResult)
+	return protocol.ProfileSnapshotContext(context.Background(), params)
+}
+
+/*
+ProfileSnapshotContext performs ProfileSnapshot and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-profileSnapshot
+*/
+func (protocol *LayerTreeProtocol) ProfileSnapshotContext(
+	ctx context.Context,
+	params *tree.ProfileSnapshotParams,
+) <-chan *tree.ProfileSnapshotResult {
+	resultChan := make(chan *tree.ProfileSnapshotResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.profileSnapshot", params)
 	result := &tree.ProfileSnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -172,13 +259,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-release
 func (protocol *LayerTreeProtocol) ReleaseSnapshot(
 	params *tree.ReleaseSnapshotParams,
 ) <-chan *tree.ReleaseSnapshotResult {
-	resultChan := make(chan *tree.ReleaseSnapshotResult)
+	return protocol.ReleaseSnapshotContext(context.Background(), params)
+}
+
+/*
+ReleaseSnapshotContext performs ReleaseSnapshot and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-releaseSnapshot
+*/
+func (protocol *LayerTreeProtocol) ReleaseSnapshotContext(
+	ctx context.Context,
+	params *tree.ReleaseSnapshotParams,
+) <-chan *tree.ReleaseSnapshotResult {
+	resultChan := make(chan *tree.ReleaseSnapshotResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.releaseSnapshot", params)
 	result := &tree.ReleaseSnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -196,13 +299,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-replayS
 func (protocol *LayerTreeProtocol) ReplaySnapshot(
 	params *tree.ReplaySnapshotParams,
 ) <-chan *tree.ReplaySnapshotResult {
-	resultChan := make(chan *tree.ReplaySnapshotResult)
+	return protocol.ReplaySnapshotContext(context.Background(), params)
+}
+
+/*
+ReplaySnapshotContext performs ReplaySnapshot and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-replaySnapshot
+*/
+func (protocol *LayerTreeProtocol) ReplaySnapshotContext(
+	ctx context.Context,
+	params *tree.ReplaySnapshotParams,
+) <-chan *tree.ReplaySnapshotResult {
+	resultChan := make(chan *tree.ReplaySnapshotResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.replaySnapshot", params)
 	result := &tree.ReplaySnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -222,13 +340,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-snapsho
 func (protocol *LayerTreeProtocol) SnapshotCommandLog(
 	params *tree.SnapshotCommandLogParams,
 ) <-chan *tree.SnapshotCommandLogResult {
-	resultChan := make(chan *tree.SnapshotCommandLogResult)
+	return protocol.SnapshotCommandLogContext(context.Background(), params)
+}
+
+/*
+SnapshotCommandLogContext performs SnapshotCommandLog and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-snapshotCommandLog
+*/
+func (protocol *LayerTreeProtocol) SnapshotCommandLogContext(
+	ctx context.Context,
+	params *tree.SnapshotCommandLogParams,
+) <-chan *tree.SnapshotCommandLogResult {
+	resultChan := make(chan *tree.SnapshotCommandLogResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.snapshotCommandLog", params)
 	result := &tree.SnapshotCommandLogResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -248,7 +382,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#event-layerPai
 */
 func (protocol *LayerTreeProtocol) OnLayerPainted(
 	callback func(event *tree.LayerPaintedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"LayerTree.layerPainted",
 		func(response *Response) {
@@ -260,7 +394,29 @@ func (protocol *LayerTreeProtocol) OnLayerPainted(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnLayerPaintedChan returns a channel of LayerTree.layerPainted events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#event-layerPainted
+*/
+func (protocol *LayerTreeProtocol) OnLayerPaintedChan(
+	ctx context.Context,
+) <-chan *tree.LayerPaintedEvent {
+	eventChan := make(chan *tree.LayerPaintedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnLayerPainted(func(event *tree.LayerPaintedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -271,7 +427,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#event-layerTre
 */
 func (protocol *LayerTreeProtocol) OnLayerTreeDidChange(
 	callback func(event *tree.DidChangeEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"LayerTree.layerTreeDidChange",
 		func(response *Response) {
@@ -283,5 +439,27 @@ func (protocol *LayerTreeProtocol) OnLayerTreeDidChange(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnLayerTreeDidChangeChan returns a channel of LayerTree.layerTreeDidChange
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#event-layerTreeDidChange
+*/
+func (protocol *LayerTreeProtocol) OnLayerTreeDidChangeChan(
+	ctx context.Context,
+) <-chan *tree.DidChangeEvent {
+	eventChan := make(chan *tree.DidChangeEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnLayerTreeDidChange(func(event *tree.DidChangeEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/log"
@@ -22,13 +23,25 @@ Clear clears the log.
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-clear
 */
 func (protocol *LogProtocol) Clear() <-chan *log.ClearResult {
-	resultChan := make(chan *log.ClearResult)
+	return protocol.ClearContext(context.Background())
+}
+
+/*
+ClearContext performs Clear and returns its result, or returns the context error
+if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-clear
+*/
+func (protocol *LogProtocol) ClearContext(ctx context.Context) <-chan *log.ClearResult {
+	resultChan := make(chan *log.ClearResult, 1)
 	command := NewCommand(protocol.Socket, "Log.clear", nil)
 	result := &log.ClearResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -45,13 +58,25 @@ the client.
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-disable
 */
 func (protocol *LogProtocol) Disable() <-chan *log.DisableResult {
-	resultChan := make(chan *log.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-disable
+*/
+func (protocol *LogProtocol) DisableContext(ctx context.Context) <-chan *log.DisableResult {
+	resultChan := make(chan *log.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Log.disable", nil)
 	result := &log.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -68,13 +93,25 @@ means of the `entryAdded` notification.
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-enable
 */
 func (protocol *LogProtocol) Enable() <-chan *log.EnableResult {
-	resultChan := make(chan *log.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-enable
+*/
+func (protocol *LogProtocol) EnableContext(ctx context.Context) <-chan *log.EnableResult {
+	resultChan := make(chan *log.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Log.enable", nil)
 	result := &log.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -92,13 +129,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-startViolatio
 func (protocol *LogProtocol) StartViolationsReport(
 	params *log.StartViolationsReportParams,
 ) <-chan *log.StartViolationsReportResult {
-	resultChan := make(chan *log.StartViolationsReportResult)
+	return protocol.StartViolationsReportContext(context.Background(), params)
+}
+
+/*
+StartViolationsReportContext performs StartViolationsReport and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-startViolationsReport
+*/
+func (protocol *LogProtocol) StartViolationsReportContext(
+	ctx context.Context,
+	params *log.StartViolationsReportParams,
+) <-chan *log.StartViolationsReportResult {
+	resultChan := make(chan *log.StartViolationsReportResult, 1)
 	command := NewCommand(protocol.Socket, "Log.startViolationsReport", params)
 	result := &log.StartViolationsReportResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -114,13 +167,26 @@ StopViolationsReport stops violation reporting.
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-stopViolationsReport
 */
 func (protocol *LogProtocol) StopViolationsReport() <-chan *log.StopViolationsReportResult {
-	resultChan := make(chan *log.StopViolationsReportResult)
+	return protocol.StopViolationsReportContext(context.Background())
+}
+
+/*
+StopViolationsReportContext performs StopViolationsReport and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-stopViolationsReport
+*/
+func (protocol *LogProtocol) StopViolationsReportContext(ctx context.Context) <-chan *log.StopViolationsReportResult {
+	resultChan := make(chan *log.StopViolationsReportResult, 1)
 	command := NewCommand(protocol.Socket, "Log.stopViolationsReport", nil)
 	result := &log.StopViolationsReportResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -138,7 +204,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Log/#event-entryAdded
 */
 func (protocol *LogProtocol) OnEntryAdded(
 	callback func(event *log.EntryAddedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Log.entryAdded",
 		func(response *Response) {
@@ -150,5 +216,27 @@ func (protocol *LogProtocol) OnEntryAdded(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnEntryAddedChan returns a channel of Log.entryAdded events. The event handler
+is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Log/#event-entryAdded
+*/
+func (protocol *LogProtocol) OnEntryAddedChan(
+	ctx context.Context,
+) <-chan *log.EntryAddedEvent {
+	eventChan := make(chan *log.EntryAddedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnEntryAdded(func(event *log.EntryAddedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
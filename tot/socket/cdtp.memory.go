@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"github.com/mkenney/go-chrome/tot/memory"
 )
 
@@ -22,13 +23,29 @@ EXPERIMENTAL.
 func (protocol *MemoryProtocol) GetDOMCounters(
 	params *memory.GetDOMCountersParams,
 ) <-chan *memory.GetDOMCountersResult {
-	resultChan := make(chan *memory.GetDOMCountersResult)
+	return protocol.GetDOMCountersContext(context.Background(), params)
+}
+
+/*
+GetDOMCountersContext performs GetDOMCounters and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-getDOMCounters
+EXPERIMENTAL.
+*/
+func (protocol *MemoryProtocol) GetDOMCountersContext(
+	ctx context.Context,
+	params *memory.GetDOMCountersParams,
+) <-chan *memory.GetDOMCountersResult {
+	resultChan := make(chan *memory.GetDOMCountersResult, 1)
 	command := NewCommand(protocol.Socket, "Memory.getDOMCounters", params)
 	result := &memory.GetDOMCountersResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -45,13 +62,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-prepareFor
 EXPERIMENTAL.
 */
 func (protocol *MemoryProtocol) PrepareForLeakDetection() <-chan *memory.PrepareForLeakDetectionResult {
-	resultChan := make(chan *memory.PrepareForLeakDetectionResult)
+	return protocol.PrepareForLeakDetectionContext(context.Background())
+}
+
+/*
+PrepareForLeakDetectionContext performs PrepareForLeakDetection and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-prepareForLeakDetection
+EXPERIMENTAL.
+*/
+func (protocol *MemoryProtocol) PrepareForLeakDetectionContext(ctx context.Context) <-chan *memory.PrepareForLeakDetectionResult {
+	resultChan := make(chan *memory.PrepareForLeakDetectionResult, 1)
 	command := NewCommand(protocol.Socket, "Memory.prepareForLeakDetection", nil)
 	result := &memory.PrepareForLeakDetectionResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -70,13 +101,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-setPressur
 func (protocol *MemoryProtocol) SetPressureNotificationsSuppressed(
 	params *memory.SetPressureNotificationsSuppressedParams,
 ) <-chan *memory.SetPressureNotificationsSuppressedResult {
-	resultChan := make(chan *memory.SetPressureNotificationsSuppressedResult)
+	return protocol.SetPressureNotificationsSuppressedContext(context.Background(), params)
+}
+
+/*
+SetPressureNotificationsSuppressedContext performs
+SetPressureNotificationsSuppressed and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-setPressureNotificationsSuppressed
+*/
+func (protocol *MemoryProtocol) SetPressureNotificationsSuppressedContext(
+	ctx context.Context,
+	params *memory.SetPressureNotificationsSuppressedParams,
+) <-chan *memory.SetPressureNotificationsSuppressedResult {
+	resultChan := make(chan *memory.SetPressureNotificationsSuppressedResult, 1)
 	command := NewCommand(protocol.Socket, "Memory.setPressureNotificationsSuppressed", params)
 	result := &memory.SetPressureNotificationsSuppressedResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -95,13 +142,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-simulatePr
 func (protocol *MemoryProtocol) SimulatePressureNotification(
 	params *memory.SimulatePressureNotificationParams,
 ) <-chan *memory.SimulatePressureNotificationResult {
-	resultChan := make(chan *memory.SimulatePressureNotificationResult)
+	return protocol.SimulatePressureNotificationContext(context.Background(), params)
+}
+
+/*
+SimulatePressureNotificationContext performs SimulatePressureNotification and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-simulatePressureNotification
+*/
+func (protocol *MemoryProtocol) SimulatePressureNotificationContext(
+	ctx context.Context,
+	params *memory.SimulatePressureNotificationParams,
+) <-chan *memory.SimulatePressureNotificationResult {
+	resultChan := make(chan *memory.SimulatePressureNotificationResult, 1)
 	command := NewCommand(protocol.Socket, "Memory.simulatePressureNotification", params)
 	result := &memory.SimulatePressureNotificationResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
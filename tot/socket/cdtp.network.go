@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/network"
@@ -25,13 +26,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-canClearB
 DEPRECATED.
 */
 func (protocol *NetworkProtocol) CanClearBrowserCache() <-chan *network.CanClearBrowserCacheResult {
-	resultChan := make(chan *network.CanClearBrowserCacheResult)
+	return protocol.CanClearBrowserCacheContext(context.Background())
+}
+
+/*
+CanClearBrowserCacheContext performs CanClearBrowserCache and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-canClearBrowserCache
+DEPRECATED.
+*/
+func (protocol *NetworkProtocol) CanClearBrowserCacheContext(ctx context.Context) <-chan *network.CanClearBrowserCacheResult {
+	resultChan := make(chan *network.CanClearBrowserCacheResult, 1)
 	command := NewCommand(protocol.Socket, "Network.canClearBrowserCache", nil)
 	result := &network.CanClearBrowserCacheResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -50,13 +65,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-canClearB
 DEPRECATED.
 */
 func (protocol *NetworkProtocol) CanClearBrowserCookies() <-chan *network.CanClearBrowserCookiesResult {
-	resultChan := make(chan *network.CanClearBrowserCookiesResult)
+	return protocol.CanClearBrowserCookiesContext(context.Background())
+}
+
+/*
+CanClearBrowserCookiesContext performs CanClearBrowserCookies and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-canClearBrowserCookies
+DEPRECATED.
+*/
+func (protocol *NetworkProtocol) CanClearBrowserCookiesContext(ctx context.Context) <-chan *network.CanClearBrowserCookiesResult {
+	resultChan := make(chan *network.CanClearBrowserCookiesResult, 1)
 	command := NewCommand(protocol.Socket, "Network.canClearBrowserCookies", nil)
 	result := &network.CanClearBrowserCookiesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -75,13 +104,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-canEmulat
 DEPRECATED.
 */
 func (protocol *NetworkProtocol) CanEmulateConditions() <-chan *network.CanEmulateConditionsResult {
-	resultChan := make(chan *network.CanEmulateConditionsResult)
+	return protocol.CanEmulateConditionsContext(context.Background())
+}
+
+/*
+CanEmulateConditionsContext performs CanEmulateConditions and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-canEmulateNetworkConditions
+DEPRECATED.
+*/
+func (protocol *NetworkProtocol) CanEmulateConditionsContext(ctx context.Context) <-chan *network.CanEmulateConditionsResult {
+	resultChan := make(chan *network.CanEmulateConditionsResult, 1)
 	command := NewCommand(protocol.Socket, "Network.canEmulateNetworkConditions", nil)
 	result := &network.CanEmulateConditionsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -99,13 +142,26 @@ ClearBrowserCache clears browser cache.
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-clearBrowserCache
 */
 func (protocol *NetworkProtocol) ClearBrowserCache() <-chan *network.ClearBrowserCacheResult {
-	resultChan := make(chan *network.ClearBrowserCacheResult)
+	return protocol.ClearBrowserCacheContext(context.Background())
+}
+
+/*
+ClearBrowserCacheContext performs ClearBrowserCache and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-clearBrowserCache
+*/
+func (protocol *NetworkProtocol) ClearBrowserCacheContext(ctx context.Context) <-chan *network.ClearBrowserCacheResult {
+	resultChan := make(chan *network.ClearBrowserCacheResult, 1)
 	command := NewCommand(protocol.Socket, "Network.clearBrowserCache", nil)
 	result := &network.ClearBrowserCacheResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -121,13 +177,26 @@ ClearBrowserCookies clears browser cookies.
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-clearBrowserCookies
 */
 func (protocol *NetworkProtocol) ClearBrowserCookies() <-chan *network.ClearBrowserCookiesResult {
-	resultChan := make(chan *network.ClearBrowserCookiesResult)
+	return protocol.ClearBrowserCookiesContext(context.Background())
+}
+
+/*
+ClearBrowserCookiesContext performs ClearBrowserCookies and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-clearBrowserCookies
+*/
+func (protocol *NetworkProtocol) ClearBrowserCookiesContext(ctx context.Context) <-chan *network.ClearBrowserCookiesResult {
+	resultChan := make(chan *network.ClearBrowserCookiesResult, 1)
 	result := &network.ClearBrowserCookiesResult{}
 	command := NewCommand(protocol.Socket, "Network.clearBrowserCookies", nil)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -150,13 +219,30 @@ EXPERIMENTAL.
 func (protocol *NetworkProtocol) ContinueInterceptedRequest(
 	params *network.ContinueInterceptedRequestParams,
 ) <-chan *network.ContinueInterceptedRequestResult {
-	resultChan := make(chan *network.ContinueInterceptedRequestResult)
+	return protocol.ContinueInterceptedRequestContext(context.Background(), params)
+}
+
+/*
+ContinueInterceptedRequestContext performs ContinueInterceptedRequest and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-continueInterceptedRequest
+EXPERIMENTAL.
+*/
+func (protocol *NetworkProtocol) ContinueInterceptedRequestContext(
+	ctx context.Context,
+	params *network.ContinueInterceptedRequestParams,
+) <-chan *network.ContinueInterceptedRequestResult {
+	resultChan := make(chan *network.ContinueInterceptedRequestResult, 1)
 	result := &network.ContinueInterceptedRequestResult{}
 	command := NewCommand(protocol.Socket, "Network.continueInterceptedRequest", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -175,13 +261,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-deleteCoo
 func (protocol *NetworkProtocol) DeleteCookies(
 	params *network.DeleteCookiesParams,
 ) <-chan *network.DeleteCookiesResult {
-	resultChan := make(chan *network.DeleteCookiesResult)
+	return protocol.DeleteCookiesContext(context.Background(), params)
+}
+
+/*
+DeleteCookiesContext performs DeleteCookies and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-deleteCookies
+*/
+func (protocol *NetworkProtocol) DeleteCookiesContext(
+	ctx context.Context,
+	params *network.DeleteCookiesParams,
+) <-chan *network.DeleteCookiesResult {
+	resultChan := make(chan *network.DeleteCookiesResult, 1)
 	result := &network.DeleteCookiesResult{}
 	command := NewCommand(protocol.Socket, "Network.deleteCookies", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -198,13 +299,25 @@ the client.
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-disable
 */
 func (protocol *NetworkProtocol) Disable() <-chan *network.DisableResult {
-	resultChan := make(chan *network.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-disable
+*/
+func (protocol *NetworkProtocol) DisableContext(ctx context.Context) <-chan *network.DisableResult {
+	resultChan := make(chan *network.DisableResult, 1)
 	result := &network.DisableResult{}
 	command := NewCommand(protocol.Socket, "Network.disable", nil)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -222,13 +335,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-emulateNe
 func (protocol *NetworkProtocol) EmulateConditions(
 	params *network.EmulateConditionsParams,
 ) <-chan *network.EmulateConditionsResult {
-	resultChan := make(chan *network.EmulateConditionsResult)
+	return protocol.EmulateConditionsContext(context.Background(), params)
+}
+
+/*
+EmulateConditionsContext performs EmulateConditions and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-emulateNetworkConditions
+*/
+func (protocol *NetworkProtocol) EmulateConditionsContext(
+	ctx context.Context,
+	params *network.EmulateConditionsParams,
+) <-chan *network.EmulateConditionsResult {
+	resultChan := make(chan *network.EmulateConditionsResult, 1)
 	result := &network.EmulateConditionsResult{}
 	command := NewCommand(protocol.Socket, "Network.emulateNetworkConditions", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -247,13 +376,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-enable
 func (protocol *NetworkProtocol) Enable(
 	params *network.EnableParams,
 ) <-chan *network.EnableResult {
-	resultChan := make(chan *network.EnableResult)
+	return protocol.EnableContext(context.Background(), params)
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-enable
+*/
+func (protocol *NetworkProtocol) EnableContext(
+	ctx context.Context,
+	params *network.EnableParams,
+) <-chan *network.EnableResult {
+	resultChan := make(chan *network.EnableResult, 1)
 	result := &network.EnableResult{}
 	command := NewCommand(protocol.Socket, "Network.enable", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -270,13 +414,25 @@ will return detailed cookie information in the `cookies` field.
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getAllCookies
 */
 func (protocol *NetworkProtocol) GetAllCookies() <-chan *network.GetAllCookiesResult {
-	resultChan := make(chan *network.GetAllCookiesResult)
+	return protocol.GetAllCookiesContext(context.Background())
+}
+
+/*
+GetAllCookiesContext performs GetAllCookies and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getAllCookies
+*/
+func (protocol *NetworkProtocol) GetAllCookiesContext(ctx context.Context) <-chan *network.GetAllCookiesResult {
+	resultChan := make(chan *network.GetAllCookiesResult, 1)
 	command := NewCommand(protocol.Socket, "Network.getAllCookies", nil)
 	result := &network.GetAllCookiesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -297,13 +453,29 @@ EXPERIMENTAL.
 func (protocol *NetworkProtocol) GetCertificate(
 	params *network.GetCertificateParams,
 ) <-chan *network.GetCertificateResult {
-	resultChan := make(chan *network.GetCertificateResult)
+	return protocol.GetCertificateContext(context.Background(), params)
+}
+
+/*
+GetCertificateContext performs GetCertificate and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getCertificate
+EXPERIMENTAL.
+*/
+func (protocol *NetworkProtocol) GetCertificateContext(
+	ctx context.Context,
+	params *network.GetCertificateParams,
+) <-chan *network.GetCertificateResult {
+	resultChan := make(chan *network.GetCertificateResult, 1)
 	command := NewCommand(protocol.Socket, "Network.getCertificate", params)
 	result := &network.GetCertificateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -324,13 +496,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getCookie
 func (protocol *NetworkProtocol) GetCookies(
 	params *network.GetCookiesParams,
 ) <-chan *network.GetCookiesResult {
-	resultChan := make(chan *network.GetCookiesResult)
+	return protocol.GetCookiesContext(context.Background(), params)
+}
+
+/*
+GetCookiesContext performs GetCookies and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getCookies
+*/
+func (protocol *NetworkProtocol) GetCookiesContext(
+	ctx context.Context,
+	params *network.GetCookiesParams,
+) <-chan *network.GetCookiesResult {
+	resultChan := make(chan *network.GetCookiesResult, 1)
 	command := NewCommand(protocol.Socket, "Network.getCookies", params)
 	result := &network.GetCookiesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -350,13 +537,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getRespon
 func (protocol *NetworkProtocol) GetResponseBody(
 	params *network.GetResponseBodyParams,
 ) <-chan *network.GetResponseBodyResult {
-	resultChan := make(chan *network.GetResponseBodyResult)
+	return protocol.GetResponseBodyContext(context.Background(), params)
+}
+
+/*
+GetResponseBodyContext performs GetResponseBody and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getResponseBody
+*/
+func (protocol *NetworkProtocol) GetResponseBodyContext(
+	ctx context.Context,
+	params *network.GetResponseBodyParams,
+) <-chan *network.GetResponseBodyResult {
+	resultChan := make(chan *network.GetResponseBodyResult, 1)
 	command := NewCommand(protocol.Socket, "Network.getResponseBody", params)
 	result := &network.GetResponseBodyResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -378,13 +581,30 @@ EXPERIMENTAL.
 func (protocol *NetworkProtocol) GetResponseBodyForInterception(
 	params *network.GetResponseBodyForInterceptionParams,
 ) <-chan *network.GetResponseBodyForInterceptionResult {
-	resultChan := make(chan *network.GetResponseBodyForInterceptionResult)
+	return protocol.GetResponseBodyForInterceptionContext(context.Background(), params)
+}
+
+/*
+GetResponseBodyForInterceptionContext performs GetResponseBodyForInterception
+and returns its result, or returns the context error if ctx is cancelled or
+times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getResponseBodyForInterception
+EXPERIMENTAL.
+*/
+func (protocol *NetworkProtocol) GetResponseBodyForInterceptionContext(
+	ctx context.Context,
+	params *network.GetResponseBodyForInterceptionParams,
+) <-chan *network.GetResponseBodyForInterceptionResult {
+	resultChan := make(chan *network.GetResponseBodyForInterceptionResult, 1)
 	command := NewCommand(protocol.Socket, "Network.getResponseBodyForInterception", params)
 	result := &network.GetResponseBodyForInterceptionResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -406,13 +626,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-replayXHR
 func (protocol *NetworkProtocol) ReplayXHR(
 	params *network.ReplayXHRParams,
 ) <-chan *network.ReplayXHRResult {
-	resultChan := make(chan *network.ReplayXHRResult)
+	return protocol.ReplayXHRContext(context.Background(), params)
+}
+
+/*
+ReplayXHRContext performs ReplayXHR and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-replayXHR EXPERIMENTAL.
+*/
+func (protocol *NetworkProtocol) ReplayXHRContext(
+	ctx context.Context,
+	params *network.ReplayXHRParams,
+) <-chan *network.ReplayXHRResult {
+	resultChan := make(chan *network.ReplayXHRResult, 1)
 	result := &network.ReplayXHRResult{}
 	command := NewCommand(protocol.Socket, "Network.replayXHR", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -431,13 +666,30 @@ EXPERIMENTAL.
 func (protocol *NetworkProtocol) SearchInResponseBody(
 	params *network.SearchInResponseBodyParams,
 ) <-chan *network.SearchInResponseBodyResult {
-	resultChan := make(chan *network.SearchInResponseBodyResult)
+	return protocol.SearchInResponseBodyContext(context.Background(), params)
+}
+
+/*
+SearchInResponseBodyContext performs SearchInResponseBody and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-searchInResponseBody
+EXPERIMENTAL.
+*/
+func (protocol *NetworkProtocol) SearchInResponseBodyContext(
+	ctx context.Context,
+	params *network.SearchInResponseBodyParams,
+) <-chan *network.SearchInResponseBodyResult {
+	resultChan := make(chan *network.SearchInResponseBodyResult, 1)
 	command := NewCommand(protocol.Socket, "Network.searchInResponseBody", params)
 	result := &network.SearchInResponseBodyResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -458,13 +710,29 @@ EXPERIMENTAL.
 func (protocol *NetworkProtocol) SetBlockedURLs(
 	params *network.SetBlockedURLsParams,
 ) <-chan *network.SetBlockedURLsResult {
-	resultChan := make(chan *network.SetBlockedURLsResult)
+	return protocol.SetBlockedURLsContext(context.Background(), params)
+}
+
+/*
+SetBlockedURLsContext performs SetBlockedURLs and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setBlockedURLs
+EXPERIMENTAL.
+*/
+func (protocol *NetworkProtocol) SetBlockedURLsContext(
+	ctx context.Context,
+	params *network.SetBlockedURLsParams,
+) <-chan *network.SetBlockedURLsResult {
+	resultChan := make(chan *network.SetBlockedURLsResult, 1)
 	result := &network.SetBlockedURLsResult{}
 	command := NewCommand(protocol.Socket, "Network.setBlockedURLs", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -483,13 +751,30 @@ EXPERIMENTAL.
 func (protocol *NetworkProtocol) SetBypassServiceWorker(
 	params *network.SetBypassServiceWorkerParams,
 ) <-chan *network.SetBypassServiceWorkerResult {
-	resultChan := make(chan *network.SetBypassServiceWorkerResult)
+	return protocol.SetBypassServiceWorkerContext(context.Background(), params)
+}
+
+/*
+SetBypassServiceWorkerContext performs SetBypassServiceWorker and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setBypassServiceWorker
+EXPERIMENTAL.
+*/
+func (protocol *NetworkProtocol) SetBypassServiceWorkerContext(
+	ctx context.Context,
+	params *network.SetBypassServiceWorkerParams,
+) <-chan *network.SetBypassServiceWorkerResult {
+	resultChan := make(chan *network.SetBypassServiceWorkerResult, 1)
 	result := &network.SetBypassServiceWorkerResult{}
 	command := NewCommand(protocol.Socket, "Network.setBypassServiceWorker", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -508,13 +793,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCacheD
 func (protocol *NetworkProtocol) SetCacheDisabled(
 	params *network.SetCacheDisabledParams,
 ) <-chan *network.SetCacheDisabledResult {
-	resultChan := make(chan *network.SetCacheDisabledResult)
+	return protocol.SetCacheDisabledContext(context.Background(), params)
+}
+
+/*
+SetCacheDisabledContext performs SetCacheDisabled and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCacheDisabled
+*/
+func (protocol *NetworkProtocol) SetCacheDisabledContext(
+	ctx context.Context,
+	params *network.SetCacheDisabledParams,
+) <-chan *network.SetCacheDisabledResult {
+	resultChan := make(chan *network.SetCacheDisabledResult, 1)
 	result := &network.SetCacheDisabledResult{}
 	command := NewCommand(protocol.Socket, "Network.setCacheDisabled", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -533,13 +834,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCookie
 func (protocol *NetworkProtocol) SetCookie(
 	params *network.SetCookieParams,
 ) <-chan *network.SetCookieResult {
-	resultChan := make(chan *network.SetCookieResult)
+	return protocol.SetCookieContext(context.Background(), params)
+}
+
+/*
+SetCookieContext performs SetCookie and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCookie
+*/
+func (protocol *NetworkProtocol) SetCookieContext(
+	ctx context.Context,
+	params *network.SetCookieParams,
+) <-chan *network.SetCookieResult {
+	resultChan := make(chan *network.SetCookieResult, 1)
 	command := NewCommand(protocol.Socket, "Network.setCookie", params)
 	result := &network.SetCookieResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -559,13 +875,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCookie
 func (protocol *NetworkProtocol) SetCookies(
 	params *network.SetCookiesParams,
 ) <-chan *network.SetCookiesResult {
-	resultChan := make(chan *network.SetCookiesResult)
+	return protocol.SetCookiesContext(context.Background(), params)
+}
+
+/*
+SetCookiesContext performs SetCookies and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCookies
+*/
+func (protocol *NetworkProtocol) SetCookiesContext(
+	ctx context.Context,
+	params *network.SetCookiesParams,
+) <-chan *network.SetCookiesResult {
+	resultChan := make(chan *network.SetCookiesResult, 1)
 	result := &network.SetCookiesResult{}
 	command := NewCommand(protocol.Socket, "Network.setCookies", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -584,13 +915,30 @@ EXPERIMENTAL.
 func (protocol *NetworkProtocol) SetDataSizeLimitsForTest(
 	params *network.SetDataSizeLimitsForTestParams,
 ) <-chan *network.SetDataSizeLimitsForTestResult {
-	resultChan := make(chan *network.SetDataSizeLimitsForTestResult)
+	return protocol.SetDataSizeLimitsForTestContext(context.Background(), params)
+}
+
+/*
+SetDataSizeLimitsForTestContext performs SetDataSizeLimitsForTest and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setDataSizeLimitsForTest
+EXPERIMENTAL.
+*/
+func (protocol *NetworkProtocol) SetDataSizeLimitsForTestContext(
+	ctx context.Context,
+	params *network.SetDataSizeLimitsForTestParams,
+) <-chan *network.SetDataSizeLimitsForTestResult {
+	resultChan := make(chan *network.SetDataSizeLimitsForTestResult, 1)
 	result := &network.SetDataSizeLimitsForTestResult{}
 	command := NewCommand(protocol.Socket, "Network.setDataSizeLimitsForTest", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -609,13 +957,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setExtraH
 func (protocol *NetworkProtocol) SetExtraHTTPHeaders(
 	params *network.SetExtraHTTPHeadersParams,
 ) <-chan *network.SetExtraHTTPHeadersResult {
-	resultChan := make(chan *network.SetExtraHTTPHeadersResult)
+	return protocol.SetExtraHTTPHeadersContext(context.Background(), params)
+}
+
+/*
+SetExtraHTTPHeadersContext performs SetExtraHTTPHeaders and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setExtraHTTPHeaders
+*/
+func (protocol *NetworkProtocol) SetExtraHTTPHeadersContext(
+	ctx context.Context,
+	params *network.SetExtraHTTPHeadersParams,
+) <-chan *network.SetExtraHTTPHeadersResult {
+	resultChan := make(chan *network.SetExtraHTTPHeadersResult, 1)
 	result := &network.SetExtraHTTPHeadersResult{}
 	command := NewCommand(protocol.Socket, "Network.setExtraHTTPHeaders", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -635,13 +999,30 @@ EXPERIMENTAL.
 func (protocol *NetworkProtocol) SetRequestInterception(
 	params *network.SetRequestInterceptionParams,
 ) <-chan *network.SetRequestInterceptionResult {
-	resultChan := make(chan *network.SetRequestInterceptionResult)
+	return protocol.SetRequestInterceptionContext(context.Background(), params)
+}
+
+/*
+SetRequestInterceptionContext performs SetRequestInterception and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setRequestInterception
+EXPERIMENTAL.
+*/
+func (protocol *NetworkProtocol) SetRequestInterceptionContext(
+	ctx context.Context,
+	params *network.SetRequestInterceptionParams,
+) <-chan *network.SetRequestInterceptionResult {
+	resultChan := make(chan *network.SetRequestInterceptionResult, 1)
 	result := &network.SetRequestInterceptionResult{}
 	command := NewCommand(protocol.Socket, "Network.setRequestInterception", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -659,13 +1040,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setUserAg
 func (protocol *NetworkProtocol) SetUserAgentOverride(
 	params *network.SetUserAgentOverrideParams,
 ) <-chan *network.SetUserAgentOverrideResult {
-	resultChan := make(chan *network.SetUserAgentOverrideResult)
+	return protocol.SetUserAgentOverrideContext(context.Background(), params)
+}
+
+/*
+SetUserAgentOverrideContext performs SetUserAgentOverride and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setUserAgentOverride
+*/
+func (protocol *NetworkProtocol) SetUserAgentOverrideContext(
+	ctx context.Context,
+	params *network.SetUserAgentOverrideParams,
+) <-chan *network.SetUserAgentOverrideResult {
+	resultChan := make(chan *network.SetUserAgentOverrideResult, 1)
 	result := &network.SetUserAgentOverrideResult{}
 	command := NewCommand(protocol.Socket, "Network.setUserAgentOverride", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -683,7 +1080,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-dataReceiv
 */
 func (protocol *NetworkProtocol) OnDataReceived(
 	callback func(event *network.DataReceivedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.dataReceived",
 		func(response *Response) {
@@ -695,7 +1092,29 @@ func (protocol *NetworkProtocol) OnDataReceived(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnDataReceivedChan returns a channel of Network.dataReceived events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-dataReceived
+*/
+func (protocol *NetworkProtocol) OnDataReceivedChan(
+	ctx context.Context,
+) <-chan *network.DataReceivedEvent {
+	eventChan := make(chan *network.DataReceivedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnDataReceived(func(event *network.DataReceivedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -707,7 +1126,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-eventSourc
 */
 func (protocol *NetworkProtocol) OnEventSourceMessageReceived(
 	callback func(event *network.EventSourceMessageReceivedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.eventSourceMessageReceived",
 		func(response *Response) {
@@ -719,7 +1138,30 @@ func (protocol *NetworkProtocol) OnEventSourceMessageReceived(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnEventSourceMessageReceivedChan returns a channel of
+Network.eventSourceMessageReceived events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-eventSourceMessageReceived
+*/
+func (protocol *NetworkProtocol) OnEventSourceMessageReceivedChan(
+	ctx context.Context,
+) <-chan *network.EventSourceMessageReceivedEvent {
+	eventChan := make(chan *network.EventSourceMessageReceivedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnEventSourceMessageReceived(func(event *network.EventSourceMessageReceivedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -730,7 +1172,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-loadingFai
 */
 func (protocol *NetworkProtocol) OnLoadingFailed(
 	callback func(event *network.LoadingFailedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.loadingFailed",
 		func(response *Response) {
@@ -742,7 +1184,29 @@ func (protocol *NetworkProtocol) OnLoadingFailed(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnLoadingFailedChan returns a channel of Network.loadingFailed events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-loadingFailed
+*/
+func (protocol *NetworkProtocol) OnLoadingFailedChan(
+	ctx context.Context,
+) <-chan *network.LoadingFailedEvent {
+	eventChan := make(chan *network.LoadingFailedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnLoadingFailed(func(event *network.LoadingFailedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -753,7 +1217,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-loadingFin
 */
 func (protocol *NetworkProtocol) OnLoadingFinished(
 	callback func(event *network.LoadingFinishedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.loadingFinished",
 		func(response *Response) {
@@ -765,7 +1229,29 @@ func (protocol *NetworkProtocol) OnLoadingFinished(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnLoadingFinishedChan returns a channel of Network.loadingFinished events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-loadingFinished
+*/
+func (protocol *NetworkProtocol) OnLoadingFinishedChan(
+	ctx context.Context,
+) <-chan *network.LoadingFinishedEvent {
+	eventChan := make(chan *network.LoadingFinishedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnLoadingFinished(func(event *network.LoadingFinishedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -778,7 +1264,7 @@ EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) OnRequestIntercepted(
 	callback func(event *network.RequestInterceptedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.requestIntercepted",
 		func(response *Response) {
@@ -790,7 +1276,30 @@ func (protocol *NetworkProtocol) OnRequestIntercepted(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnRequestInterceptedChan returns a channel of Network.requestIntercepted events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestIntercepted
+EXPERIMENTAL.
+*/
+func (protocol *NetworkProtocol) OnRequestInterceptedChan(
+	ctx context.Context,
+) <-chan *network.RequestInterceptedEvent {
+	eventChan := make(chan *network.RequestInterceptedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnRequestIntercepted(func(event *network.RequestInterceptedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -802,7 +1311,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestSer
 */
 func (protocol *NetworkProtocol) OnRequestServedFromCache(
 	callback func(event *network.RequestServedFromCacheEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.requestServedFromCache",
 		func(response *Response) {
@@ -814,7 +1323,29 @@ func (protocol *NetworkProtocol) OnRequestServedFromCache(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnRequestServedFromCacheChan returns a channel of Network.requestServedFromCache
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestServedFromCache
+*/
+func (protocol *NetworkProtocol) OnRequestServedFromCacheChan(
+	ctx context.Context,
+) <-chan *network.RequestServedFromCacheEvent {
+	eventChan := make(chan *network.RequestServedFromCacheEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnRequestServedFromCache(func(event *network.RequestServedFromCacheEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -825,7 +1356,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestWil
 */
 func (protocol *NetworkProtocol) OnRequestWillBeSent(
 	callback func(event *network.RequestWillBeSentEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.requestWillBeSent",
 		func(response *Response) {
@@ -837,7 +1368,29 @@ func (protocol *NetworkProtocol) OnRequestWillBeSent(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnRequestWillBeSentChan returns a channel of Network.requestWillBeSent events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestWillBeSent
+*/
+func (protocol *NetworkProtocol) OnRequestWillBeSentChan(
+	ctx context.Context,
+) <-chan *network.RequestWillBeSentEvent {
+	eventChan := make(chan *network.RequestWillBeSentEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnRequestWillBeSent(func(event *network.RequestWillBeSentEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -850,7 +1403,7 @@ EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) OnResourceChangedPriority(
 	callback func(event *network.ResourceChangedPriorityEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.resourceChangedPriority",
 		func(response *Response) {
@@ -862,7 +1415,31 @@ func (protocol *NetworkProtocol) OnResourceChangedPriority(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnResourceChangedPriorityChan returns a channel of
+Network.resourceChangedPriority events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-resourceChangedPriority
+EXPERIMENTAL.
+*/
+func (protocol *NetworkProtocol) OnResourceChangedPriorityChan(
+	ctx context.Context,
+) <-chan *network.ResourceChangedPriorityEvent {
+	eventChan := make(chan *network.ResourceChangedPriorityEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnResourceChangedPriority(func(event *network.ResourceChangedPriorityEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -873,7 +1450,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-responseRe
 */
 func (protocol *NetworkProtocol) OnResponseReceived(
 	callback func(event *network.ResponseReceivedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.responseReceived",
 		func(response *Response) {
@@ -885,7 +1462,29 @@ func (protocol *NetworkProtocol) OnResponseReceived(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnResponseReceivedChan returns a channel of Network.responseReceived events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-responseReceived
+*/
+func (protocol *NetworkProtocol) OnResponseReceivedChan(
+	ctx context.Context,
+) <-chan *network.ResponseReceivedEvent {
+	eventChan := make(chan *network.ResponseReceivedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnResponseReceived(func(event *network.ResponseReceivedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -896,7 +1495,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketC
 */
 func (protocol *NetworkProtocol) OnWebSocketClosed(
 	callback func(event *network.WebSocketClosedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.webSocketClosed",
 		func(response *Response) {
@@ -908,7 +1507,29 @@ func (protocol *NetworkProtocol) OnWebSocketClosed(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketClosedChan returns a channel of Network.webSocketClosed events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketClosed
+*/
+func (protocol *NetworkProtocol) OnWebSocketClosedChan(
+	ctx context.Context,
+) <-chan *network.WebSocketClosedEvent {
+	eventChan := make(chan *network.WebSocketClosedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebSocketClosed(func(event *network.WebSocketClosedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -919,7 +1540,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketC
 */
 func (protocol *NetworkProtocol) OnWebSocketCreated(
 	callback func(event *network.WebSocketCreatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.webSocketCreated",
 		func(response *Response) {
@@ -931,7 +1552,29 @@ func (protocol *NetworkProtocol) OnWebSocketCreated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketCreatedChan returns a channel of Network.webSocketCreated events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketCreated
+*/
+func (protocol *NetworkProtocol) OnWebSocketCreatedChan(
+	ctx context.Context,
+) <-chan *network.WebSocketCreatedEvent {
+	eventChan := make(chan *network.WebSocketCreatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebSocketCreated(func(event *network.WebSocketCreatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -942,7 +1585,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketF
 */
 func (protocol *NetworkProtocol) OnWebSocketFrameError(
 	callback func(event *network.WebSocketFrameErrorEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.webSocketFrameError",
 		func(response *Response) {
@@ -954,7 +1597,29 @@ func (protocol *NetworkProtocol) OnWebSocketFrameError(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketFrameErrorChan returns a channel of Network.webSocketFrameError
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketFrameError
+*/
+func (protocol *NetworkProtocol) OnWebSocketFrameErrorChan(
+	ctx context.Context,
+) <-chan *network.WebSocketFrameErrorEvent {
+	eventChan := make(chan *network.WebSocketFrameErrorEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebSocketFrameError(func(event *network.WebSocketFrameErrorEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -965,7 +1630,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketF
 */
 func (protocol *NetworkProtocol) OnWebSocketFrameReceived(
 	callback func(event *network.WebSocketFrameReceivedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.webSocketFrameReceived",
 		func(response *Response) {
@@ -977,7 +1642,29 @@ func (protocol *NetworkProtocol) OnWebSocketFrameReceived(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketFrameReceivedChan returns a channel of Network.webSocketFrameReceived
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketFrameReceived
+*/
+func (protocol *NetworkProtocol) OnWebSocketFrameReceivedChan(
+	ctx context.Context,
+) <-chan *network.WebSocketFrameReceivedEvent {
+	eventChan := make(chan *network.WebSocketFrameReceivedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebSocketFrameReceived(func(event *network.WebSocketFrameReceivedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -988,7 +1675,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketF
 */
 func (protocol *NetworkProtocol) OnWebSocketFrameSent(
 	callback func(event *network.WebSocketFrameSentEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.webSocketFrameSent",
 		func(response *Response) {
@@ -1000,7 +1687,29 @@ func (protocol *NetworkProtocol) OnWebSocketFrameSent(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketFrameSentChan returns a channel of Network.webSocketFrameSent events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketFrameSent
+*/
+func (protocol *NetworkProtocol) OnWebSocketFrameSentChan(
+	ctx context.Context,
+) <-chan *network.WebSocketFrameSentEvent {
+	eventChan := make(chan *network.WebSocketFrameSentEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebSocketFrameSent(func(event *network.WebSocketFrameSentEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1012,7 +1721,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketH
 */
 func (protocol *NetworkProtocol) OnWebSocketHandshakeResponseReceived(
 	callback func(event *network.WebSocketHandshakeResponseReceivedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.webSocketHandshakeResponseReceived",
 		func(response *Response) {
@@ -1024,7 +1733,30 @@ func (protocol *NetworkProtocol) OnWebSocketHandshakeResponseReceived(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketHandshakeResponseReceivedChan returns a channel of
+Network.webSocketHandshakeResponseReceived events. The event handler is removed
+and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketHandshakeResponseReceived
+*/
+func (protocol *NetworkProtocol) OnWebSocketHandshakeResponseReceivedChan(
+	ctx context.Context,
+) <-chan *network.WebSocketHandshakeResponseReceivedEvent {
+	eventChan := make(chan *network.WebSocketHandshakeResponseReceivedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebSocketHandshakeResponseReceived(func(event *network.WebSocketHandshakeResponseReceivedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1036,7 +1768,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketW
 */
 func (protocol *NetworkProtocol) OnWebSocketWillSendHandshakeRequest(
 	callback func(event *network.WebSocketWillSendHandshakeRequestEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Network.webSocketWillSendHandshakeRequest",
 		func(response *Response) {
@@ -1048,5 +1780,28 @@ func (protocol *NetworkProtocol) OnWebSocketWillSendHandshakeRequest(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketWillSendHandshakeRequestChan returns a channel of
+Network.webSocketWillSendHandshakeRequest events. The event handler is removed
+and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketWillSendHandshakeRequest
+*/
+func (protocol *NetworkProtocol) OnWebSocketWillSendHandshakeRequestChan(
+	ctx context.Context,
+) <-chan *network.WebSocketWillSendHandshakeRequestEvent {
+	eventChan := make(chan *network.WebSocketWillSendHandshakeRequestEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebSocketWillSendHandshakeRequest(func(event *network.WebSocketWillSendHandshakeRequestEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/overlay"
@@ -23,13 +24,25 @@ Disable disables domain notifications.
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-disable
 */
 func (protocol *OverlayProtocol) Disable() <-chan *overlay.DisableResult {
-	resultChan := make(chan *overlay.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-disable
+*/
+func (protocol *OverlayProtocol) DisableContext(ctx context.Context) <-chan *overlay.DisableResult {
+	resultChan := make(chan *overlay.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.disable", nil)
 	result := &overlay.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -45,13 +58,25 @@ Enable enables domain notifications.
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-enable
 */
 func (protocol *OverlayProtocol) Enable() <-chan *overlay.EnableResult {
-	resultChan := make(chan *overlay.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-enable
+*/
+func (protocol *OverlayProtocol) EnableContext(ctx context.Context) <-chan *overlay.EnableResult {
+	resultChan := make(chan *overlay.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.enable", nil)
 	result := &overlay.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -69,13 +94,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-getHighli
 func (protocol *OverlayProtocol) GetHighlightObjectForTest(
 	params *overlay.GetHighlightObjectForTestParams,
 ) <-chan *overlay.GetHighlightObjectForTestResult {
-	resultChan := make(chan *overlay.GetHighlightObjectForTestResult)
+	return protocol.GetHighlightObjectForTestContext(context.Background(), params)
+}
+
+/*
+GetHighlightObjectForTestContext performs GetHighlightObjectForTest and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-getHighlightObjectForTest
+*/
+func (protocol *OverlayProtocol) GetHighlightObjectForTestContext(
+	ctx context.Context,
+	params *overlay.GetHighlightObjectForTestParams,
+) <-chan *overlay.GetHighlightObjectForTestResult {
+	resultChan := make(chan *overlay.GetHighlightObjectForTestResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.getHighlightObjectForTest", params)
 	result := &overlay.GetHighlightObjectForTestResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -93,13 +134,25 @@ HideHighlight hides any highlight.
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-hideHighlight
 */
 func (protocol *OverlayProtocol) HideHighlight() <-chan *overlay.HideHighlightResult {
-	resultChan := make(chan *overlay.HideHighlightResult)
+	return protocol.HideHighlightContext(context.Background())
+}
+
+/*
+HideHighlightContext performs HideHighlight and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-hideHighlight
+*/
+func (protocol *OverlayProtocol) HideHighlightContext(ctx context.Context) <-chan *overlay.HideHighlightResult {
+	resultChan := make(chan *overlay.HideHighlightResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.hideHighlight", nil)
 	result := &overlay.HideHighlightResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -117,13 +170,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlight
 func (protocol *OverlayProtocol) HighlightFrame(
 	params *overlay.HighlightFrameParams,
 ) <-chan *overlay.HighlightFrameResult {
-	resultChan := make(chan *overlay.HighlightFrameResult)
+	return protocol.HighlightFrameContext(context.Background(), params)
+}
+
+/*
+HighlightFrameContext performs HighlightFrame and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlightFrame
+*/
+func (protocol *OverlayProtocol) HighlightFrameContext(
+	ctx context.Context,
+	params *overlay.HighlightFrameParams,
+) <-chan *overlay.HighlightFrameResult {
+	resultChan := make(chan *overlay.HighlightFrameResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.highlightFrame", params)
 	result := &overlay.HighlightFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -142,13 +210,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlight
 func (protocol *OverlayProtocol) HighlightNode(
 	params *overlay.HighlightNodeParams,
 ) <-chan *overlay.HighlightNodeResult {
-	resultChan := make(chan *overlay.HighlightNodeResult)
+	return protocol.HighlightNodeContext(context.Background(), params)
+}
+
+/*
+HighlightNodeContext performs HighlightNode and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlightNode
+*/
+func (protocol *OverlayProtocol) HighlightNodeContext(
+	ctx context.Context,
+	params *overlay.HighlightNodeParams,
+) <-chan *overlay.HighlightNodeResult {
+	resultChan := make(chan *overlay.HighlightNodeResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.highlightNode", params)
 	result := &overlay.HighlightNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -167,13 +250,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlight
 func (protocol *OverlayProtocol) HighlightQuad(
 	params *overlay.HighlightQuadParams,
 ) <-chan *overlay.HighlightQuadResult {
-	resultChan := make(chan *overlay.HighlightQuadResult)
+	return protocol.HighlightQuadContext(context.Background(), params)
+}
+
+/*
+HighlightQuadContext performs HighlightQuad and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlightQuad
+*/
+func (protocol *OverlayProtocol) HighlightQuadContext(
+	ctx context.Context,
+	params *overlay.HighlightQuadParams,
+) <-chan *overlay.HighlightQuadResult {
+	resultChan := make(chan *overlay.HighlightQuadResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.highlightQuad", params)
 	result := &overlay.HighlightQuadResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -192,13 +290,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlight
 func (protocol *OverlayProtocol) HighlightRect(
 	params *overlay.HighlightRectParams,
 ) <-chan *overlay.HighlightRectResult {
-	resultChan := make(chan *overlay.HighlightRectResult)
+	return protocol.HighlightRectContext(context.Background(), params)
+}
+
+/*
+HighlightRectContext performs HighlightRect and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlightRect
+*/
+func (protocol *OverlayProtocol) HighlightRectContext(
+	ctx context.Context,
+	params *overlay.HighlightRectParams,
+) <-chan *overlay.HighlightRectResult {
+	resultChan := make(chan *overlay.HighlightRectResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.highlightRect", params)
 	result := &overlay.HighlightRectResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -218,13 +331,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setInspec
 func (protocol *OverlayProtocol) SetInspectMode(
 	params *overlay.SetInspectModeParams,
 ) <-chan *overlay.SetInspectModeResult {
-	resultChan := make(chan *overlay.SetInspectModeResult)
+	return protocol.SetInspectModeContext(context.Background(), params)
+}
+
+/*
+SetInspectModeContext performs SetInspectMode and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setInspectMode
+*/
+func (protocol *OverlayProtocol) SetInspectModeContext(
+	ctx context.Context,
+	params *overlay.SetInspectModeParams,
+) <-chan *overlay.SetInspectModeResult {
+	resultChan := make(chan *overlay.SetInspectModeResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.setInspectMode", params)
 	result := &overlay.SetInspectModeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -242,13 +370,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setPaused
 func (protocol *OverlayProtocol) SetPausedInDebuggerMessage(
 	params *overlay.SetPausedInDebuggerMessageParams,
 ) <-chan *overlay.SetPausedInDebuggerMessageResult {
-	resultChan := make(chan *overlay.SetPausedInDebuggerMessageResult)
+	return protocol.SetPausedInDebuggerMessageContext(context.Background(), params)
+}
+
+/*
+SetPausedInDebuggerMessageContext performs SetPausedInDebuggerMessage and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setPausedInDebuggerMessage
+*/
+func (protocol *OverlayProtocol) SetPausedInDebuggerMessageContext(
+	ctx context.Context,
+	params *overlay.SetPausedInDebuggerMessageParams,
+) <-chan *overlay.SetPausedInDebuggerMessageResult {
+	resultChan := make(chan *overlay.SetPausedInDebuggerMessageResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.setPausedInDebuggerMessage", params)
 	result := &overlay.SetPausedInDebuggerMessageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -266,13 +410,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowDe
 func (protocol *OverlayProtocol) SetShowDebugBorders(
 	params *overlay.SetShowDebugBordersParams,
 ) <-chan *overlay.SetShowDebugBordersResult {
-	resultChan := make(chan *overlay.SetShowDebugBordersResult)
+	return protocol.SetShowDebugBordersContext(context.Background(), params)
+}
+
+/*
+SetShowDebugBordersContext performs SetShowDebugBorders and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowDebugBorders
+*/
+func (protocol *OverlayProtocol) SetShowDebugBordersContext(
+	ctx context.Context,
+	params *overlay.SetShowDebugBordersParams,
+) <-chan *overlay.SetShowDebugBordersResult {
+	resultChan := make(chan *overlay.SetShowDebugBordersResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.setShowDebugBorders", params)
 	result := &overlay.SetShowDebugBordersResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -290,13 +450,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowFP
 func (protocol *OverlayProtocol) SetShowFPSCounter(
 	params *overlay.SetShowFPSCounterParams,
 ) <-chan *overlay.SetShowFPSCounterResult {
-	resultChan := make(chan *overlay.SetShowFPSCounterResult)
+	return protocol.SetShowFPSCounterContext(context.Background(), params)
+}
+
+/*
+SetShowFPSCounterContext performs SetShowFPSCounter and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowFPSCounter
+*/
+func (protocol *OverlayProtocol) SetShowFPSCounterContext(
+	ctx context.Context,
+	params *overlay.SetShowFPSCounterParams,
+) <-chan *overlay.SetShowFPSCounterResult {
+	resultChan := make(chan *overlay.SetShowFPSCounterResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.setShowFPSCounter", params)
 	result := &overlay.SetShowFPSCounterResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -314,13 +490,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowPa
 func (protocol *OverlayProtocol) SetShowPaintRects(
 	params *overlay.SetShowPaintRectsParams,
 ) <-chan *overlay.SetShowPaintRectsResult {
-	resultChan := make(chan *overlay.SetShowPaintRectsResult)
+	return protocol.SetShowPaintRectsContext(context.Background(), params)
+}
+
+/*
+SetShowPaintRectsContext performs SetShowPaintRects and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowPaintRects
+*/
+func (protocol *OverlayProtocol) SetShowPaintRectsContext(
+	ctx context.Context,
+	params *overlay.SetShowPaintRectsParams,
+) <-chan *overlay.SetShowPaintRectsResult {
+	resultChan := make(chan *overlay.SetShowPaintRectsResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.setShowPaintRects", params)
 	result := &overlay.SetShowPaintRectsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -338,13 +530,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowSc
 func (protocol *OverlayProtocol) SetShowScrollBottleneckRects(
 	params *overlay.SetShowScrollBottleneckRectsParams,
 ) <-chan *overlay.SetShowScrollBottleneckRectsResult {
-	resultChan := make(chan *overlay.SetShowScrollBottleneckRectsResult)
+	return protocol.SetShowScrollBottleneckRectsContext(context.Background(), params)
+}
+
+/*
+SetShowScrollBottleneckRectsContext performs SetShowScrollBottleneckRects and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowScrollBottleneckRects
+*/
+func (protocol *OverlayProtocol) SetShowScrollBottleneckRectsContext(
+	ctx context.Context,
+	params *overlay.SetShowScrollBottleneckRectsParams,
+) <-chan *overlay.SetShowScrollBottleneckRectsResult {
+	resultChan := make(chan *overlay.SetShowScrollBottleneckRectsResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.setShowScrollBottleneckRects", params)
 	result := &overlay.SetShowScrollBottleneckRectsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -362,13 +570,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowVi
 func (protocol *OverlayProtocol) SetShowViewportSizeOnResize(
 	params *overlay.SetShowViewportSizeOnResizeParams,
 ) <-chan *overlay.SetShowViewportSizeOnResizeResult {
-	resultChan := make(chan *overlay.SetShowViewportSizeOnResizeResult)
+	return protocol.SetShowViewportSizeOnResizeContext(context.Background(), params)
+}
+
+/*
+SetShowViewportSizeOnResizeContext performs SetShowViewportSizeOnResize and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowViewportSizeOnResize
+*/
+func (protocol *OverlayProtocol) SetShowViewportSizeOnResizeContext(
+	ctx context.Context,
+	params *overlay.SetShowViewportSizeOnResizeParams,
+) <-chan *overlay.SetShowViewportSizeOnResizeResult {
+	resultChan := make(chan *overlay.SetShowViewportSizeOnResizeResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.setShowViewportSizeOnResize", params)
 	result := &overlay.SetShowViewportSizeOnResizeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -386,13 +610,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setSuspen
 func (protocol *OverlayProtocol) SetSuspended(
 	params *overlay.SetSuspendedParams,
 ) <-chan *overlay.SetSuspendedResult {
-	resultChan := make(chan *overlay.SetSuspendedResult)
+	return protocol.SetSuspendedContext(context.Background(), params)
+}
+
+/*
+SetSuspendedContext performs SetSuspended and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setSuspended
+*/
+func (protocol *OverlayProtocol) SetSuspendedContext(
+	ctx context.Context,
+	params *overlay.SetSuspendedParams,
+) <-chan *overlay.SetSuspendedResult {
+	resultChan := make(chan *overlay.SetSuspendedResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.setSuspended", params)
 	result := &overlay.SetSuspendedResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -412,7 +651,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-inspectNod
 */
 func (protocol *OverlayProtocol) OnInspectNodeRequested(
 	callback func(event *overlay.InspectNodeRequestedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Overlay.inspectNodeRequested",
 		func(response *Response) {
@@ -424,7 +663,29 @@ func (protocol *OverlayProtocol) OnInspectNodeRequested(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnInspectNodeRequestedChan returns a channel of Overlay.inspectNodeRequested
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-inspectNodeRequested
+*/
+func (protocol *OverlayProtocol) OnInspectNodeRequestedChan(
+	ctx context.Context,
+) <-chan *overlay.InspectNodeRequestedEvent {
+	eventChan := make(chan *overlay.InspectNodeRequestedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnInspectNodeRequested(func(event *overlay.InspectNodeRequestedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -436,7 +697,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-nodeHighli
 */
 func (protocol *OverlayProtocol) OnNodeHighlightRequested(
 	callback func(event *overlay.NodeHighlightRequestedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Overlay.nodeHighlightRequested",
 		func(response *Response) {
@@ -448,7 +709,29 @@ func (protocol *OverlayProtocol) OnNodeHighlightRequested(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnNodeHighlightRequestedChan returns a channel of Overlay.nodeHighlightRequested
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-nodeHighlightRequested
+*/
+func (protocol *OverlayProtocol) OnNodeHighlightRequestedChan(
+	ctx context.Context,
+) <-chan *overlay.NodeHighlightRequestedEvent {
+	eventChan := make(chan *overlay.NodeHighlightRequestedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnNodeHighlightRequested(func(event *overlay.NodeHighlightRequestedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -460,7 +743,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-screenshot
 */
 func (protocol *OverlayProtocol) OnScreenshotRequested(
 	callback func(event *overlay.ScreenshotRequestedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Overlay.screenshotRequested",
 		func(response *Response) {
@@ -472,5 +755,27 @@ func (protocol *OverlayProtocol) OnScreenshotRequested(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnScreenshotRequestedChan returns a channel of Overlay.screenshotRequested
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-screenshotRequested
+*/
+func (protocol *OverlayProtocol) OnScreenshotRequestedChan(
+	ctx context.Context,
+) <-chan *overlay.ScreenshotRequestedEvent {
+	eventChan := make(chan *overlay.ScreenshotRequestedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnScreenshotRequested(func(event *overlay.ScreenshotRequestedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/page"
@@ -27,13 +28,30 @@ EXPERIMENTAL. DEPRECATED.
 func (protocol *PageProtocol) AddScriptToEvaluateOnLoad(
 	params *page.AddScriptToEvaluateOnLoadParams,
 ) <-chan *page.AddScriptToEvaluateOnLoadResult {
-	resultChan := make(chan *page.AddScriptToEvaluateOnLoadResult)
+	return protocol.AddScriptToEvaluateOnLoadContext(context.Background(), params)
+}
+
+/*
+AddScriptToEvaluateOnLoadContext performs AddScriptToEvaluateOnLoad and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-addScriptToEvaluateOnLoad
+EXPERIMENTAL. DEPRECATED.
+*/
+func (protocol *PageProtocol) AddScriptToEvaluateOnLoadContext(
+	ctx context.Context,
+	params *page.AddScriptToEvaluateOnLoadParams,
+) <-chan *page.AddScriptToEvaluateOnLoadResult {
+	resultChan := make(chan *page.AddScriptToEvaluateOnLoadResult, 1)
 	command := NewCommand(protocol.Socket, "Page.addScriptToEvaluateOnLoad", params)
 	result := &page.AddScriptToEvaluateOnLoadResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -54,13 +72,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-addScriptToE
 func (protocol *PageProtocol) AddScriptToEvaluateOnNewDocument(
 	params *page.AddScriptToEvaluateOnNewDocumentParams,
 ) <-chan *page.AddScriptToEvaluateOnNewDocumentResult {
-	resultChan := make(chan *page.AddScriptToEvaluateOnNewDocumentResult)
+	return protocol.AddScriptToEvaluateOnNewDocumentContext(context.Background(), params)
+}
+
+/*
+AddScriptToEvaluateOnNewDocumentContext performs
+AddScriptToEvaluateOnNewDocument and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-addScriptToEvaluateOnNewDocument
+*/
+func (protocol *PageProtocol) AddScriptToEvaluateOnNewDocumentContext(
+	ctx context.Context,
+	params *page.AddScriptToEvaluateOnNewDocumentParams,
+) <-chan *page.AddScriptToEvaluateOnNewDocumentResult {
+	resultChan := make(chan *page.AddScriptToEvaluateOnNewDocumentResult, 1)
 	command := NewCommand(protocol.Socket, "Page.addScriptToEvaluateOnNewDocument", params)
 	result := &page.AddScriptToEvaluateOnNewDocumentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -78,13 +112,25 @@ BringToFront brings page to front (activates tab).
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-bringToFront
 */
 func (protocol *PageProtocol) BringToFront() <-chan *page.BringToFrontResult {
-	resultChan := make(chan *page.BringToFrontResult)
+	return protocol.BringToFrontContext(context.Background())
+}
+
+/*
+BringToFrontContext performs BringToFront and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-bringToFront
+*/
+func (protocol *PageProtocol) BringToFrontContext(ctx context.Context) <-chan *page.BringToFrontResult {
+	resultChan := make(chan *page.BringToFrontResult, 1)
 	command := NewCommand(protocol.Socket, "Page.bringToFront", nil)
 	result := &page.BringToFrontResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -102,13 +148,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-captureScree
 func (protocol *PageProtocol) CaptureScreenshot(
 	params *page.CaptureScreenshotParams,
 ) <-chan *page.CaptureScreenshotResult {
-	resultChan := make(chan *page.CaptureScreenshotResult)
+	return protocol.CaptureScreenshotContext(context.Background(), params)
+}
+
+/*
+CaptureScreenshotContext performs CaptureScreenshot and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-captureScreenshot
+*/
+func (protocol *PageProtocol) CaptureScreenshotContext(
+	ctx context.Context,
+	params *page.CaptureScreenshotParams,
+) <-chan *page.CaptureScreenshotResult {
+	resultChan := make(chan *page.CaptureScreenshotResult, 1)
 	command := NewCommand(protocol.Socket, "Page.captureScreenshot", params)
 	result := &page.CaptureScreenshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -128,13 +190,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-createIsolat
 func (protocol *PageProtocol) CreateIsolatedWorld(
 	params *page.CreateIsolatedWorldParams,
 ) <-chan *page.CreateIsolatedWorldResult {
-	resultChan := make(chan *page.CreateIsolatedWorldResult)
+	return protocol.CreateIsolatedWorldContext(context.Background(), params)
+}
+
+/*
+CreateIsolatedWorldContext performs CreateIsolatedWorld and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-createIsolatedWorld
+*/
+func (protocol *PageProtocol) CreateIsolatedWorldContext(
+	ctx context.Context,
+	params *page.CreateIsolatedWorldParams,
+) <-chan *page.CreateIsolatedWorldResult {
+	resultChan := make(chan *page.CreateIsolatedWorldResult, 1)
 	command := NewCommand(protocol.Socket, "Page.createIsolatedWorld", params)
 	result := &page.CreateIsolatedWorldResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -152,13 +230,25 @@ Disable disables page domain notifications.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-disable
 */
 func (protocol *PageProtocol) Disable() <-chan *page.DisableResult {
-	resultChan := make(chan *page.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-disable
+*/
+func (protocol *PageProtocol) DisableContext(ctx context.Context) <-chan *page.DisableResult {
+	resultChan := make(chan *page.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Page.disable", nil)
 	result := &page.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -174,13 +264,25 @@ Enable Ennables page domain notifications.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-enable
 */
 func (protocol *PageProtocol) Enable() <-chan *page.EnableResult {
-	resultChan := make(chan *page.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-enable
+*/
+func (protocol *PageProtocol) EnableContext(ctx context.Context) <-chan *page.EnableResult {
+	resultChan := make(chan *page.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Page.enable", nil)
 	result := &page.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -198,13 +300,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getAppManife
 func (protocol *PageProtocol) GetAppManifest(
 	params *page.GetAppManifestParams,
 ) <-chan *page.GetAppManifestResult {
-	resultChan := make(chan *page.GetAppManifestResult)
+	return protocol.GetAppManifestContext(context.Background(), params)
+}
+
+/*
+GetAppManifestContext performs GetAppManifest and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getAppManifest
+*/
+func (protocol *PageProtocol) GetAppManifestContext(
+	ctx context.Context,
+	params *page.GetAppManifestParams,
+) <-chan *page.GetAppManifestResult {
+	resultChan := make(chan *page.GetAppManifestResult, 1)
 	command := NewCommand(protocol.Socket, "Page.getAppManifest", params)
 	result := &page.GetAppManifestResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -220,13 +337,25 @@ GetFrameTree returns present frame tree structure.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getFrameTree
 */
 func (protocol *PageProtocol) GetFrameTree() <-chan *page.GetFrameTreeResult {
-	resultChan := make(chan *page.GetFrameTreeResult)
+	return protocol.GetFrameTreeContext(context.Background())
+}
+
+/*
+GetFrameTreeContext performs GetFrameTree and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getFrameTree
+*/
+func (protocol *PageProtocol) GetFrameTreeContext(ctx context.Context) <-chan *page.GetFrameTreeResult {
+	resultChan := make(chan *page.GetFrameTreeResult, 1)
 	command := NewCommand(protocol.Socket, "Page.getFrameTree", nil)
 	result := &page.GetFrameTreeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -245,13 +374,26 @@ viewport bounds/scale.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getLayoutMetrics
 */
 func (protocol *PageProtocol) GetLayoutMetrics() <-chan *page.GetLayoutMetricsResult {
-	resultChan := make(chan *page.GetLayoutMetricsResult)
+	return protocol.GetLayoutMetricsContext(context.Background())
+}
+
+/*
+GetLayoutMetricsContext performs GetLayoutMetrics and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getLayoutMetrics
+*/
+func (protocol *PageProtocol) GetLayoutMetricsContext(ctx context.Context) <-chan *page.GetLayoutMetricsResult {
+	resultChan := make(chan *page.GetLayoutMetricsResult, 1)
 	command := NewCommand(protocol.Socket, "Page.getLayoutMetrics", nil)
 	result := &page.GetLayoutMetricsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -269,13 +411,26 @@ GetNavigationHistory returns navigation history for the current page.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getNavigationHistory
 */
 func (protocol *PageProtocol) GetNavigationHistory() <-chan *page.GetNavigationHistoryResult {
-	resultChan := make(chan *page.GetNavigationHistoryResult)
+	return protocol.GetNavigationHistoryContext(context.Background())
+}
+
+/*
+GetNavigationHistoryContext performs GetNavigationHistory and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getNavigationHistory
+*/
+func (protocol *PageProtocol) GetNavigationHistoryContext(ctx context.Context) <-chan *page.GetNavigationHistoryResult {
+	resultChan := make(chan *page.GetNavigationHistoryResult, 1)
 	command := NewCommand(protocol.Socket, "Page.getNavigationHistory", nil)
 	result := &page.GetNavigationHistoryResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -296,13 +451,30 @@ EXPERIMENTAL.
 func (protocol *PageProtocol) GetResourceContent(
 	params *page.GetResourceContentParams,
 ) <-chan *page.GetResourceContentResult {
-	resultChan := make(chan *page.GetResourceContentResult)
+	return protocol.GetResourceContentContext(context.Background(), params)
+}
+
+/*
+GetResourceContentContext performs GetResourceContent and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getResourceContent
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) GetResourceContentContext(
+	ctx context.Context,
+	params *page.GetResourceContentParams,
+) <-chan *page.GetResourceContentResult {
+	resultChan := make(chan *page.GetResourceContentResult, 1)
 	command := NewCommand(protocol.Socket, "Page.getResourceContent", params)
 	result := &page.GetResourceContentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -321,13 +493,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getResourceT
 EXPERIMENTAL.
 */
 func (protocol *PageProtocol) GetResourceTree() <-chan *page.GetResourceTreeResult {
-	resultChan := make(chan *page.GetResourceTreeResult)
+	return protocol.GetResourceTreeContext(context.Background())
+}
+
+/*
+GetResourceTreeContext performs GetResourceTree and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getResourceTree
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) GetResourceTreeContext(ctx context.Context) <-chan *page.GetResourceTreeResult {
+	resultChan := make(chan *page.GetResourceTreeResult, 1)
 	command := NewCommand(protocol.Socket, "Page.getResourceTree", nil)
 	result := &page.GetResourceTreeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -348,13 +534,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-handleJavaSc
 func (protocol *PageProtocol) HandleJavaScriptDialog(
 	params *page.HandleJavaScriptDialogParams,
 ) <-chan *page.HandleJavaScriptDialogResult {
-	resultChan := make(chan *page.HandleJavaScriptDialogResult)
+	return protocol.HandleJavaScriptDialogContext(context.Background(), params)
+}
+
+/*
+HandleJavaScriptDialogContext performs HandleJavaScriptDialog and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-handleJavaScriptDialog
+*/
+func (protocol *PageProtocol) HandleJavaScriptDialogContext(
+	ctx context.Context,
+	params *page.HandleJavaScriptDialogParams,
+) <-chan *page.HandleJavaScriptDialogResult {
+	resultChan := make(chan *page.HandleJavaScriptDialogResult, 1)
 	command := NewCommand(protocol.Socket, "Page.handleJavaScriptDialog", params)
 	result := &page.HandleJavaScriptDialogResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -372,13 +574,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-navigate
 func (protocol *PageProtocol) Navigate(
 	params *page.NavigateParams,
 ) <-chan *page.NavigateResult {
-	resultChan := make(chan *page.NavigateResult)
+	return protocol.NavigateContext(context.Background(), params)
+}
+
+/*
+NavigateContext performs Navigate and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-navigate
+*/
+func (protocol *PageProtocol) NavigateContext(
+	ctx context.Context,
+	params *page.NavigateParams,
+) <-chan *page.NavigateResult {
+	resultChan := make(chan *page.NavigateResult, 1)
 	command := NewCommand(protocol.Socket, "Page.navigate", params)
 	result := &page.NavigateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -398,13 +615,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-navigateToHi
 func (protocol *PageProtocol) NavigateToHistoryEntry(
 	params *page.NavigateToHistoryEntryParams,
 ) <-chan *page.NavigateToHistoryEntryResult {
-	resultChan := make(chan *page.NavigateToHistoryEntryResult)
+	return protocol.NavigateToHistoryEntryContext(context.Background(), params)
+}
+
+/*
+NavigateToHistoryEntryContext performs NavigateToHistoryEntry and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-navigateToHistoryEntry
+*/
+func (protocol *PageProtocol) NavigateToHistoryEntryContext(
+	ctx context.Context,
+	params *page.NavigateToHistoryEntryParams,
+) <-chan *page.NavigateToHistoryEntryResult {
+	resultChan := make(chan *page.NavigateToHistoryEntryResult, 1)
 	command := NewCommand(protocol.Socket, "Page.navigateToHistoryEntry", params)
 	result := &page.NavigateToHistoryEntryResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -422,13 +655,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-printToPDF
 func (protocol *PageProtocol) PrintToPDF(
 	params *page.PrintToPDFParams,
 ) <-chan *page.PrintToPDFResult {
-	resultChan := make(chan *page.PrintToPDFResult)
+	return protocol.PrintToPDFContext(context.Background(), params)
+}
+
+/*
+PrintToPDFContext performs PrintToPDF and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-printToPDF
+*/
+func (protocol *PageProtocol) PrintToPDFContext(
+	ctx context.Context,
+	params *page.PrintToPDFParams,
+) <-chan *page.PrintToPDFResult {
+	resultChan := make(chan *page.PrintToPDFResult, 1)
 	command := NewCommand(protocol.Socket, "Page.printToPDF", params)
 	result := &page.PrintToPDFResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -448,13 +696,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-reload
 func (protocol *PageProtocol) Reload(
 	params *page.ReloadParams,
 ) <-chan *page.ReloadResult {
-	resultChan := make(chan *page.ReloadResult)
+	return protocol.ReloadContext(context.Background(), params)
+}
+
+/*
+ReloadContext performs Reload and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-reload
+*/
+func (protocol *PageProtocol) ReloadContext(
+	ctx context.Context,
+	params *page.ReloadParams,
+) <-chan *page.ReloadResult {
+	resultChan := make(chan *page.ReloadResult, 1)
 	command := NewCommand(protocol.Socket, "Page.reload", params)
 	result := &page.ReloadResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -474,13 +737,30 @@ EXPERIMENTAL. DEPRECATED.
 func (protocol *PageProtocol) RemoveScriptToEvaluateOnLoad(
 	params *page.RemoveScriptToEvaluateOnLoadParams,
 ) <-chan *page.RemoveScriptToEvaluateOnLoadResult {
-	resultChan := make(chan *page.RemoveScriptToEvaluateOnLoadResult)
+	return protocol.RemoveScriptToEvaluateOnLoadContext(context.Background(), params)
+}
+
+/*
+RemoveScriptToEvaluateOnLoadContext performs RemoveScriptToEvaluateOnLoad and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-removeScriptToEvaluateOnLoad
+EXPERIMENTAL. DEPRECATED.
+*/
+func (protocol *PageProtocol) RemoveScriptToEvaluateOnLoadContext(
+	ctx context.Context,
+	params *page.RemoveScriptToEvaluateOnLoadParams,
+) <-chan *page.RemoveScriptToEvaluateOnLoadResult {
+	resultChan := make(chan *page.RemoveScriptToEvaluateOnLoadResult, 1)
 	command := NewCommand(protocol.Socket, "Page.removeScriptToEvaluateOnLoad", params)
 	result := &page.RemoveScriptToEvaluateOnLoadResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -498,13 +778,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-removeScript
 func (protocol *PageProtocol) RemoveScriptToEvaluateOnNewDocument(
 	params *page.RemoveScriptToEvaluateOnNewDocumentParams,
 ) <-chan *page.RemoveScriptToEvaluateOnNewDocumentResult {
-	resultChan := make(chan *page.RemoveScriptToEvaluateOnNewDocumentResult)
+	return protocol.RemoveScriptToEvaluateOnNewDocumentContext(context.Background(), params)
+}
+
+/*
+RemoveScriptToEvaluateOnNewDocumentContext performs
+RemoveScriptToEvaluateOnNewDocument and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-removeScriptToEvaluateOnNewDocument
+*/
+func (protocol *PageProtocol) RemoveScriptToEvaluateOnNewDocumentContext(
+	ctx context.Context,
+	params *page.RemoveScriptToEvaluateOnNewDocumentParams,
+) <-chan *page.RemoveScriptToEvaluateOnNewDocumentResult {
+	resultChan := make(chan *page.RemoveScriptToEvaluateOnNewDocumentResult, 1)
 	command := NewCommand(protocol.Socket, "Page.removeScriptToEvaluateOnNewDocument", params)
 	result := &page.RemoveScriptToEvaluateOnNewDocumentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -520,13 +816,26 @@ RequestAppBanner is experimental.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-requestAppBanner EXPERIMENTAL.
 */
 func (protocol *PageProtocol) RequestAppBanner() <-chan *page.RequestAppBannerResult {
-	resultChan := make(chan *page.RequestAppBannerResult)
+	return protocol.RequestAppBannerContext(context.Background())
+}
+
+/*
+RequestAppBannerContext performs RequestAppBanner and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-requestAppBanner EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) RequestAppBannerContext(ctx context.Context) <-chan *page.RequestAppBannerResult {
+	resultChan := make(chan *page.RequestAppBannerResult, 1)
 	command := NewCommand(protocol.Socket, "Page.requestAppBanner", nil)
 	result := &page.RequestAppBannerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -545,13 +854,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-screencastFr
 func (protocol *PageProtocol) ScreencastFrameAck(
 	params *page.ScreencastFrameAckParams,
 ) <-chan *page.ScreencastFrameAckResult {
-	resultChan := make(chan *page.ScreencastFrameAckResult)
+	return protocol.ScreencastFrameAckContext(context.Background(), params)
+}
+
+/*
+ScreencastFrameAckContext performs ScreencastFrameAck and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-screencastFrameAck EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) ScreencastFrameAckContext(
+	ctx context.Context,
+	params *page.ScreencastFrameAckParams,
+) <-chan *page.ScreencastFrameAckResult {
+	resultChan := make(chan *page.ScreencastFrameAckResult, 1)
 	command := NewCommand(protocol.Socket, "Page.screencastFrameAck", params)
 	result := &page.ScreencastFrameAckResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -570,13 +895,30 @@ EXPERIMENTAL.
 func (protocol *PageProtocol) SearchInResource(
 	params *page.SearchInResourceParams,
 ) <-chan *page.SearchInResourceResult {
-	resultChan := make(chan *page.SearchInResourceResult)
+	return protocol.SearchInResourceContext(context.Background(), params)
+}
+
+/*
+SearchInResourceContext performs SearchInResource and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-searchInResource
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) SearchInResourceContext(
+	ctx context.Context,
+	params *page.SearchInResourceParams,
+) <-chan *page.SearchInResourceResult {
+	resultChan := make(chan *page.SearchInResourceResult, 1)
 	command := NewCommand(protocol.Socket, "Page.searchInResource", params)
 	result := &page.SearchInResourceResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -597,13 +939,30 @@ EXPERIMENTAL.
 func (protocol *PageProtocol) SetAdBlockingEnabled(
 	params *page.SetAdBlockingEnabledParams,
 ) <-chan *page.SetAdBlockingEnabledResult {
-	resultChan := make(chan *page.SetAdBlockingEnabledResult)
+	return protocol.SetAdBlockingEnabledContext(context.Background(), params)
+}
+
+/*
+SetAdBlockingEnabledContext performs SetAdBlockingEnabled and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setAdBlockingEnabled
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) SetAdBlockingEnabledContext(
+	ctx context.Context,
+	params *page.SetAdBlockingEnabledParams,
+) <-chan *page.SetAdBlockingEnabledResult {
+	resultChan := make(chan *page.SetAdBlockingEnabledResult, 1)
 	command := NewCommand(protocol.Socket, "Page.setAdBlockingEnabled", params)
 	result := &page.SetAdBlockingEnabledResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -623,13 +982,30 @@ EXPERIMENTAL.
 func (protocol *PageProtocol) SetAutoAttachToCreatedPages(
 	params *page.SetAutoAttachToCreatedPagesParams,
 ) <-chan *page.SetAutoAttachToCreatedPagesResult {
-	resultChan := make(chan *page.SetAutoAttachToCreatedPagesResult)
+	return protocol.SetAutoAttachToCreatedPagesContext(context.Background(), params)
+}
+
+/*
+SetAutoAttachToCreatedPagesContext performs SetAutoAttachToCreatedPages and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setAutoAttachToCreatedPages
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) SetAutoAttachToCreatedPagesContext(
+	ctx context.Context,
+	params *page.SetAutoAttachToCreatedPagesParams,
+) <-chan *page.SetAutoAttachToCreatedPagesResult {
+	resultChan := make(chan *page.SetAutoAttachToCreatedPagesResult, 1)
 	command := NewCommand(protocol.Socket, "Page.setAutoAttachToCreatedPages", params)
 	result := &page.SetAutoAttachToCreatedPagesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -647,13 +1023,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDocumentC
 func (protocol *PageProtocol) SetDocumentContent(
 	params *page.SetDocumentContentParams,
 ) <-chan *page.SetDocumentContentResult {
-	resultChan := make(chan *page.SetDocumentContentResult)
+	return protocol.SetDocumentContentContext(context.Background(), params)
+}
+
+/*
+SetDocumentContentContext performs SetDocumentContent and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDocumentContent
+*/
+func (protocol *PageProtocol) SetDocumentContentContext(
+	ctx context.Context,
+	params *page.SetDocumentContentParams,
+) <-chan *page.SetDocumentContentResult {
+	resultChan := make(chan *page.SetDocumentContentResult, 1)
 	command := NewCommand(protocol.Socket, "Page.setDocumentContent", params)
 	result := &page.SetDocumentContentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -672,13 +1064,30 @@ EXPERIMENTAL.
 func (protocol *PageProtocol) SetDownloadBehavior(
 	params *page.SetDownloadBehaviorParams,
 ) <-chan *page.SetDownloadBehaviorResult {
-	resultChan := make(chan *page.SetDownloadBehaviorResult)
+	return protocol.SetDownloadBehaviorContext(context.Background(), params)
+}
+
+/*
+SetDownloadBehaviorContext performs SetDownloadBehavior and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDownloadBehavior
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) SetDownloadBehaviorContext(
+	ctx context.Context,
+	params *page.SetDownloadBehaviorParams,
+) <-chan *page.SetDownloadBehaviorResult {
+	resultChan := make(chan *page.SetDownloadBehaviorResult, 1)
 	command := NewCommand(protocol.Socket, "Page.setDownloadBehavior", params)
 	result := &page.SetDownloadBehaviorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -697,13 +1106,30 @@ EXPERIMENTAL.
 func (protocol *PageProtocol) SetLifecycleEventsEnabled(
 	params *page.SetLifecycleEventsEnabledParams,
 ) <-chan *page.SetLifecycleEventsEnabledResult {
-	resultChan := make(chan *page.SetLifecycleEventsEnabledResult)
+	return protocol.SetLifecycleEventsEnabledContext(context.Background(), params)
+}
+
+/*
+SetLifecycleEventsEnabledContext performs SetLifecycleEventsEnabled and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setLifecycleEventsEnabled
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) SetLifecycleEventsEnabledContext(
+	ctx context.Context,
+	params *page.SetLifecycleEventsEnabledParams,
+) <-chan *page.SetLifecycleEventsEnabledResult {
+	resultChan := make(chan *page.SetLifecycleEventsEnabledResult, 1)
 	command := NewCommand(protocol.Socket, "Page.setLifecycleEventsEnabled", params)
 	result := &page.SetLifecycleEventsEnabledResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -722,13 +1148,30 @@ EXPERIMENTAL.
 func (protocol *PageProtocol) StartScreencast(
 	params *page.StartScreencastParams,
 ) <-chan *page.StartScreencastResult {
-	resultChan := make(chan *page.StartScreencastResult)
+	return protocol.StartScreencastContext(context.Background(), params)
+}
+
+/*
+StartScreencastContext performs StartScreencast and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-startScreencast
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) StartScreencastContext(
+	ctx context.Context,
+	params *page.StartScreencastParams,
+) <-chan *page.StartScreencastResult {
+	resultChan := make(chan *page.StartScreencastResult, 1)
 	command := NewCommand(protocol.Socket, "Page.startScreencast", params)
 	result := &page.StartScreencastResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -744,13 +1187,25 @@ StopLoading force the page stop all navigations and pending resource fetches.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-stopLoading
 */
 func (protocol *PageProtocol) StopLoading() <-chan *page.StopLoadingResult {
-	resultChan := make(chan *page.StopLoadingResult)
+	return protocol.StopLoadingContext(context.Background())
+}
+
+/*
+StopLoadingContext performs StopLoading and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-stopLoading
+*/
+func (protocol *PageProtocol) StopLoadingContext(ctx context.Context) <-chan *page.StopLoadingResult {
+	resultChan := make(chan *page.StopLoadingResult, 1)
 	command := NewCommand(protocol.Socket, "Page.stopLoading", nil)
 	result := &page.StopLoadingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -767,13 +1222,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-stopScreenca
 EXPERIMENTAL.
 */
 func (protocol *PageProtocol) StopScreencast() <-chan *page.StopScreencastResult {
-	resultChan := make(chan *page.StopScreencastResult)
+	return protocol.StopScreencastContext(context.Background())
+}
+
+/*
+StopScreencastContext performs StopScreencast and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-stopScreencast
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) StopScreencastContext(ctx context.Context) <-chan *page.StopScreencastResult {
+	resultChan := make(chan *page.StopScreencastResult, 1)
 	command := NewCommand(protocol.Socket, "Page.stopScreencast", nil)
 	result := &page.StopScreencastResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -791,7 +1259,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-domContentEve
 */
 func (protocol *PageProtocol) OnDOMContentEventFired(
 	callback func(event *page.DOMContentEventFiredEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.domContentEventFired",
 		func(response *Response) {
@@ -803,7 +1271,29 @@ func (protocol *PageProtocol) OnDOMContentEventFired(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnDOMContentEventFiredChan returns a channel of Page.domContentEventFired
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-domContentEventFired
+*/
+func (protocol *PageProtocol) OnDOMContentEventFiredChan(
+	ctx context.Context,
+) <-chan *page.DOMContentEventFiredEvent {
+	eventChan := make(chan *page.DOMContentEventFiredEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnDOMContentEventFired(func(event *page.DOMContentEventFiredEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -814,7 +1304,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameAttached
 */
 func (protocol *PageProtocol) OnFrameAttached(
 	callback func(event *page.FrameAttachedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.frameAttached",
 		func(response *Response) {
@@ -826,7 +1316,29 @@ func (protocol *PageProtocol) OnFrameAttached(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnFrameAttachedChan returns a channel of Page.frameAttached events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameAttached
+*/
+func (protocol *PageProtocol) OnFrameAttachedChan(
+	ctx context.Context,
+) <-chan *page.FrameAttachedEvent {
+	eventChan := make(chan *page.FrameAttachedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnFrameAttached(func(event *page.FrameAttachedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -839,7 +1351,7 @@ EXPERIMENTAL.
 */
 func (protocol *PageProtocol) OnFrameClearedScheduledNavigation(
 	callback func(event *page.FrameClearedScheduledNavigationEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.frameClearedScheduledNavigation",
 		func(response *Response) {
@@ -851,7 +1363,31 @@ func (protocol *PageProtocol) OnFrameClearedScheduledNavigation(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnFrameClearedScheduledNavigationChan returns a channel of
+Page.frameClearedScheduledNavigation events. The event handler is removed and
+the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameClearedScheduledNavigation
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) OnFrameClearedScheduledNavigationChan(
+	ctx context.Context,
+) <-chan *page.FrameClearedScheduledNavigationEvent {
+	eventChan := make(chan *page.FrameClearedScheduledNavigationEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnFrameClearedScheduledNavigation(func(event *page.FrameClearedScheduledNavigationEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -862,7 +1398,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameDetached
 */
 func (protocol *PageProtocol) OnFrameDetached(
 	callback func(event *page.FrameDetachedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.frameDetached",
 		func(response *Response) {
@@ -874,7 +1410,29 @@ func (protocol *PageProtocol) OnFrameDetached(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnFrameDetachedChan returns a channel of Page.frameDetached events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameDetached
+*/
+func (protocol *PageProtocol) OnFrameDetachedChan(
+	ctx context.Context,
+) <-chan *page.FrameDetachedEvent {
+	eventChan := make(chan *page.FrameDetachedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnFrameDetached(func(event *page.FrameDetachedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -886,7 +1444,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameNavigate
 */
 func (protocol *PageProtocol) OnFrameNavigated(
 	callback func(event *page.FrameNavigatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.frameNavigated",
 		func(response *Response) {
@@ -898,7 +1456,29 @@ func (protocol *PageProtocol) OnFrameNavigated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnFrameNavigatedChan returns a channel of Page.frameNavigated events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameNavigated
+*/
+func (protocol *PageProtocol) OnFrameNavigatedChan(
+	ctx context.Context,
+) <-chan *page.FrameNavigatedEvent {
+	eventChan := make(chan *page.FrameNavigatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnFrameNavigated(func(event *page.FrameNavigatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -910,7 +1490,7 @@ EXPERIMENTAL.
 */
 func (protocol *PageProtocol) OnFrameResized(
 	callback func(event *page.FrameResizedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.frameResized",
 		func(response *Response) {
@@ -922,7 +1502,30 @@ func (protocol *PageProtocol) OnFrameResized(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnFrameResizedChan returns a channel of Page.frameResized events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameResized
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) OnFrameResizedChan(
+	ctx context.Context,
+) <-chan *page.FrameResizedEvent {
+	eventChan := make(chan *page.FrameResizedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnFrameResized(func(event *page.FrameResizedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -935,7 +1538,7 @@ EXPERIMENTAL.
 */
 func (protocol *PageProtocol) OnFrameScheduledNavigation(
 	callback func(event *page.FrameScheduledNavigationEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.frameScheduledNavigation",
 		func(response *Response) {
@@ -947,7 +1550,31 @@ func (protocol *PageProtocol) OnFrameScheduledNavigation(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnFrameScheduledNavigationChan returns a channel of
+Page.frameScheduledNavigation events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameScheduledNavigation
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) OnFrameScheduledNavigationChan(
+	ctx context.Context,
+) <-chan *page.FrameScheduledNavigationEvent {
+	eventChan := make(chan *page.FrameScheduledNavigationEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnFrameScheduledNavigation(func(event *page.FrameScheduledNavigationEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -959,7 +1586,7 @@ EXPERIMENTAL.
 */
 func (protocol *PageProtocol) OnFrameStartedLoading(
 	callback func(event *page.FrameStartedLoadingEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.frameStartedLoading",
 		func(response *Response) {
@@ -971,7 +1598,30 @@ func (protocol *PageProtocol) OnFrameStartedLoading(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnFrameStartedLoadingChan returns a channel of Page.frameStartedLoading events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameStartedLoading
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) OnFrameStartedLoadingChan(
+	ctx context.Context,
+) <-chan *page.FrameStartedLoadingEvent {
+	eventChan := make(chan *page.FrameStartedLoadingEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnFrameStartedLoading(func(event *page.FrameStartedLoadingEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -983,7 +1633,7 @@ EXPERIMENTAL.
 */
 func (protocol *PageProtocol) OnFrameStoppedLoading(
 	callback func(event *page.FrameStoppedLoadingEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.frameStoppedLoading",
 		func(response *Response) {
@@ -995,7 +1645,30 @@ func (protocol *PageProtocol) OnFrameStoppedLoading(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnFrameStoppedLoadingChan returns a channel of Page.frameStoppedLoading events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameStoppedLoading
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) OnFrameStoppedLoadingChan(
+	ctx context.Context,
+) <-chan *page.FrameStoppedLoadingEvent {
+	eventChan := make(chan *page.FrameStoppedLoadingEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnFrameStoppedLoading(func(event *page.FrameStoppedLoadingEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1006,7 +1679,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-interstitialH
 */
 func (protocol *PageProtocol) OnInterstitialHidden(
 	callback func(event *page.InterstitialHiddenEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.interstitialHidden",
 		func(response *Response) {
@@ -1018,7 +1691,29 @@ func (protocol *PageProtocol) OnInterstitialHidden(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnInterstitialHiddenChan returns a channel of Page.interstitialHidden events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-interstitialHidden
+*/
+func (protocol *PageProtocol) OnInterstitialHiddenChan(
+	ctx context.Context,
+) <-chan *page.InterstitialHiddenEvent {
+	eventChan := make(chan *page.InterstitialHiddenEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnInterstitialHidden(func(event *page.InterstitialHiddenEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1029,7 +1724,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-interstitialS
 */
 func (protocol *PageProtocol) OnInterstitialShown(
 	callback func(event *page.InterstitialShownEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.interstitialShown",
 		func(response *Response) {
@@ -1041,7 +1736,29 @@ func (protocol *PageProtocol) OnInterstitialShown(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnInterstitialShownChan returns a channel of Page.interstitialShown events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-interstitialShown
+*/
+func (protocol *PageProtocol) OnInterstitialShownChan(
+	ctx context.Context,
+) <-chan *page.InterstitialShownEvent {
+	eventChan := make(chan *page.InterstitialShownEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnInterstitialShown(func(event *page.InterstitialShownEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1053,7 +1770,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-javascriptDia
 */
 func (protocol *PageProtocol) OnJavascriptDialogClosed(
 	callback func(event *page.JavascriptDialogClosedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.javascriptDialogClosed",
 		func(response *Response) {
@@ -1065,7 +1782,29 @@ func (protocol *PageProtocol) OnJavascriptDialogClosed(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnJavascriptDialogClosedChan returns a channel of Page.javascriptDialogClosed
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-javascriptDialogClosed
+*/
+func (protocol *PageProtocol) OnJavascriptDialogClosedChan(
+	ctx context.Context,
+) <-chan *page.JavascriptDialogClosedEvent {
+	eventChan := make(chan *page.JavascriptDialogClosedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnJavascriptDialogClosed(func(event *page.JavascriptDialogClosedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1077,7 +1816,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-javascriptDia
 */
 func (protocol *PageProtocol) OnJavascriptDialogOpening(
 	callback func(event *page.JavascriptDialogOpeningEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.javascriptDialogOpening",
 		func(response *Response) {
@@ -1089,7 +1828,29 @@ func (protocol *PageProtocol) OnJavascriptDialogOpening(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnJavascriptDialogOpeningChan returns a channel of Page.javascriptDialogOpening
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-javascriptDialogOpening
+*/
+func (protocol *PageProtocol) OnJavascriptDialogOpeningChan(
+	ctx context.Context,
+) <-chan *page.JavascriptDialogOpeningEvent {
+	eventChan := make(chan *page.JavascriptDialogOpeningEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnJavascriptDialogOpening(func(event *page.JavascriptDialogOpeningEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1100,7 +1861,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-lifecycleEven
 */
 func (protocol *PageProtocol) OnLifecycleEvent(
 	callback func(event *page.LifecycleEventEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.lifecycleEvent",
 		func(response *Response) {
@@ -1112,7 +1873,29 @@ func (protocol *PageProtocol) OnLifecycleEvent(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnLifecycleEventChan returns a channel of Page.lifecycleEvent events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-lifecycleEvent
+*/
+func (protocol *PageProtocol) OnLifecycleEventChan(
+	ctx context.Context,
+) <-chan *page.LifecycleEventEvent {
+	eventChan := make(chan *page.LifecycleEventEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnLifecycleEvent(func(event *page.LifecycleEventEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1123,7 +1906,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-loadEventFire
 */
 func (protocol *PageProtocol) OnLoadEventFired(
 	callback func(event *page.LoadEventFiredEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.loadEventFired",
 		func(response *Response) {
@@ -1135,7 +1918,29 @@ func (protocol *PageProtocol) OnLoadEventFired(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnLoadEventFiredChan returns a channel of Page.loadEventFired events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-loadEventFired
+*/
+func (protocol *PageProtocol) OnLoadEventFiredChan(
+	ctx context.Context,
+) <-chan *page.LoadEventFiredEvent {
+	eventChan := make(chan *page.LoadEventFiredEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnLoadEventFired(func(event *page.LoadEventFiredEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1147,7 +1952,7 @@ EXPERIMENTAL.
 */
 func (protocol *PageProtocol) OnScreencastFrame(
 	callback func(event *page.ScreencastFrameEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.screencastFrame",
 		func(response *Response) {
@@ -1159,7 +1964,30 @@ func (protocol *PageProtocol) OnScreencastFrame(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnScreencastFrameChan returns a channel of Page.screencastFrame events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-screencastFrame
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) OnScreencastFrameChan(
+	ctx context.Context,
+) <-chan *page.ScreencastFrameEvent {
+	eventChan := make(chan *page.ScreencastFrameEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnScreencastFrame(func(event *page.ScreencastFrameEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1172,7 +2000,7 @@ EXPERIMENTAL.
 */
 func (protocol *PageProtocol) OnScreencastVisibilityChanged(
 	callback func(event *page.ScreencastVisibilityChangedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.screencastVisibilityChanged",
 		func(response *Response) {
@@ -1184,7 +2012,31 @@ func (protocol *PageProtocol) OnScreencastVisibilityChanged(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnScreencastVisibilityChangedChan returns a channel of
+Page.screencastVisibilityChanged events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-screencastVisibilityChanged
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) OnScreencastVisibilityChangedChan(
+	ctx context.Context,
+) <-chan *page.ScreencastVisibilityChangedEvent {
+	eventChan := make(chan *page.ScreencastVisibilityChangedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnScreencastVisibilityChanged(func(event *page.ScreencastVisibilityChangedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -1196,7 +2048,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-windowOpen
 */
 func (protocol *PageProtocol) OnWindowOpen(
 	callback func(event *page.WindowOpenEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Page.windowOpen",
 		func(response *Response) {
@@ -1208,5 +2060,27 @@ func (protocol *PageProtocol) OnWindowOpen(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWindowOpenChan returns a channel of Page.windowOpen events. The event handler
+is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-windowOpen
+*/
+func (protocol *PageProtocol) OnWindowOpenChan(
+	ctx context.Context,
+) <-chan *page.WindowOpenEvent {
+	eventChan := make(chan *page.WindowOpenEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWindowOpen(func(event *page.WindowOpenEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"testing"
@@ -180,6 +181,23 @@ func TestPageCaptureScreenshot(t *testing.T) {
 	}
 }
 
+func TestPageCaptureScreenshotContext(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageCaptureScreenshotContext")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	resultChan := mockSocket.Page().CaptureScreenshotContext(ctx, &page.CaptureScreenshotParams{
+		Format: page.Format.Jpeg,
+	})
+	cancel()
+	result := <-resultChan
+	if context.Canceled != result.Err {
+		t.Errorf("Expected context.Canceled, got '%v'", result.Err)
+	}
+}
+
 func TestPageCreateIsolatedWorld(t *testing.T) {
 	socketURL, _ := url.Parse("https://test:9222/TestPageCreateIsolatedWorld")
 	mockSocket := NewMock(socketURL)
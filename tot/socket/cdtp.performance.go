@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/performance"
@@ -22,13 +23,25 @@ Disable disables collecting and reporting metrics.
 https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-disable
 */
 func (protocol *PerformanceProtocol) Disable() <-chan *performance.DisableResult {
-	resultChan := make(chan *performance.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-disable
+*/
+func (protocol *PerformanceProtocol) DisableContext(ctx context.Context) <-chan *performance.DisableResult {
+	resultChan := make(chan *performance.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Performance.disable", nil)
 	result := &performance.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -44,13 +57,25 @@ Enable enables collecting and reporting metrics.
 https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-enable
 */
 func (protocol *PerformanceProtocol) Enable() <-chan *performance.EnableResult {
-	resultChan := make(chan *performance.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-enable
+*/
+func (protocol *PerformanceProtocol) EnableContext(ctx context.Context) <-chan *performance.EnableResult {
+	resultChan := make(chan *performance.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Performance.enable", nil)
 	result := &performance.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -66,13 +91,25 @@ GetMetrics retrieves current values of run-time metrics.
 https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-getMetrics
 */
 func (protocol *PerformanceProtocol) GetMetrics() <-chan *performance.GetMetricsResult {
-	resultChan := make(chan *performance.GetMetricsResult)
+	return protocol.GetMetricsContext(context.Background())
+}
+
+/*
+GetMetricsContext performs GetMetrics and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-getMetrics
+*/
+func (protocol *PerformanceProtocol) GetMetricsContext(ctx context.Context) <-chan *performance.GetMetricsResult {
+	resultChan := make(chan *performance.GetMetricsResult, 1)
 	command := NewCommand(protocol.Socket, "Performance.getMetrics", nil)
 	result := &performance.GetMetricsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -92,7 +129,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Performance/#event-metric
 */
 func (protocol *PerformanceProtocol) OnMetrics(
 	callback func(event *performance.MetricsEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Performance.metrics",
 		func(response *Response) {
@@ -104,5 +141,27 @@ func (protocol *PerformanceProtocol) OnMetrics(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnMetricsChan returns a channel of Performance.metrics events. The event handler
+is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Performance/#event-metrics
+*/
+func (protocol *PerformanceProtocol) OnMetricsChan(
+	ctx context.Context,
+) <-chan *performance.MetricsEvent {
+	eventChan := make(chan *performance.MetricsEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnMetrics(func(event *performance.MetricsEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
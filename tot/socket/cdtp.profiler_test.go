@@ -238,40 +238,6 @@ func TestProfilerStartPreciseCoverage(t *testing.T) {
 	}
 }
 
-func TestProfilerStartTypeProfile(t *testing.T) {
-	socketURL, _ := url.Parse("https://test:9222/TestProfilerStartTypeProfile")
-	mockSocket := NewMock(socketURL)
-	mockSocket.Listen()
-	defer mockSocket.Stop()
-
-	resultChan := mockSocket.Profiler().StartTypeProfile()
-	mockResult := &profiler.StartTypeProfileResult{}
-	mockResultBytes, _ := json.Marshal(mockResult)
-	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
-		ID:     mockSocket.CurCommandID(),
-		Error:  &Error{},
-		Result: mockResultBytes,
-	})
-	result := <-resultChan
-	if nil != result.Err {
-		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
-	}
-
-	resultChan = mockSocket.Profiler().StartTypeProfile()
-	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
-		ID: mockSocket.CurCommandID(),
-		Error: &Error{
-			Code:    1,
-			Data:    []byte(`"error data"`),
-			Message: "error message",
-		},
-	})
-	result = <-resultChan
-	if nil == result.Err {
-		t.Errorf("Expected error, got success")
-	}
-}
-
 func TestProfilerStop(t *testing.T) {
 	socketURL, _ := url.Parse("https://test:9222/TestProfilerStop")
 	mockSocket := NewMock(socketURL)
@@ -361,40 +327,6 @@ func TestProfilerStopPreciseCoverage(t *testing.T) {
 	}
 }
 
-func TestProfilerStopTypeProfile(t *testing.T) {
-	socketURL, _ := url.Parse("https://test:9222/TestProfilerStopTypeProfile")
-	mockSocket := NewMock(socketURL)
-	mockSocket.Listen()
-	defer mockSocket.Stop()
-
-	resultChan := mockSocket.Profiler().StopTypeProfile()
-	mockResult := &profiler.StopTypeProfileResult{}
-	mockResultBytes, _ := json.Marshal(mockResult)
-	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
-		ID:     mockSocket.CurCommandID(),
-		Error:  &Error{},
-		Result: mockResultBytes,
-	})
-	result := <-resultChan
-	if nil != result.Err {
-		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
-	}
-
-	resultChan = mockSocket.Profiler().StopTypeProfile()
-	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
-		ID: mockSocket.CurCommandID(),
-		Error: &Error{
-			Code:    1,
-			Data:    []byte(`"error data"`),
-			Message: "error message",
-		},
-	})
-	result = <-resultChan
-	if nil == result.Err {
-		t.Errorf("Expected error, got success")
-	}
-}
-
 func TestProfilerTakePreciseCoverage(t *testing.T) {
 	socketURL, _ := url.Parse("https://test:9222/TestProfilerTakePreciseCoverage")
 	mockSocket := NewMock(socketURL)
@@ -446,54 +378,6 @@ func TestProfilerTakePreciseCoverage(t *testing.T) {
 	}
 }
 
-func TestProfilerTakeTypeProfile(t *testing.T) {
-	socketURL, _ := url.Parse("https://test:9222/TestProfilerTakeTypeProfile")
-	mockSocket := NewMock(socketURL)
-	mockSocket.Listen()
-	defer mockSocket.Stop()
-
-	resultChan := mockSocket.Profiler().TakeTypeProfile()
-	mockResult := &profiler.TakeTypeProfileResult{
-		Result: []*profiler.ScriptTypeProfile{{
-			ScriptID: runtime.ScriptID("script-id"),
-			URL:      "http://some.url",
-			Entries: []*profiler.TypeProfileEntry{{
-				Offset: 1,
-				Types: []*profiler.TypeObject{{
-					Name: "object name",
-				}},
-			}},
-		}},
-	}
-	mockResultBytes, _ := json.Marshal(mockResult)
-	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
-		ID:     mockSocket.CurCommandID(),
-		Error:  &Error{},
-		Result: mockResultBytes,
-	})
-	result := <-resultChan
-	if nil != result.Err {
-		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
-	}
-	if mockResult.Result[0].ScriptID != result.Result[0].ScriptID {
-		t.Errorf("Expected %s, got %s", mockResult.Result[0].ScriptID, result.Result[0].ScriptID)
-	}
-
-	resultChan = mockSocket.Profiler().TakeTypeProfile()
-	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
-		ID: mockSocket.CurCommandID(),
-		Error: &Error{
-			Code:    1,
-			Data:    []byte(`"error data"`),
-			Message: "error message",
-		},
-	})
-	result = <-resultChan
-	if nil == result.Err {
-		t.Errorf("Expected error, got success")
-	}
-}
-
 func TestProfilerOnConsoleProfileFinished(t *testing.T) {
 	socketURL, _ := url.Parse("https://test:9222/TestProfilerOnConsoleProfileFinished")
 	mockSocket := NewMock(socketURL)
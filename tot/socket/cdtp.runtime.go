@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/runtime"
@@ -29,13 +30,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-awaitProm
 func (protocol *RuntimeProtocol) AwaitPromise(
 	params *runtime.AwaitPromiseParams,
 ) <-chan *runtime.AwaitPromiseResult {
-	resultChan := make(chan *runtime.AwaitPromiseResult)
+	return protocol.AwaitPromiseContext(context.Background(), params)
+}
+
+/*
+AwaitPromiseContext performs AwaitPromise and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-awaitPromise
+*/
+func (protocol *RuntimeProtocol) AwaitPromiseContext(
+	ctx context.Context,
+	params *runtime.AwaitPromiseParams,
+) <-chan *runtime.AwaitPromiseResult {
+	resultChan := make(chan *runtime.AwaitPromiseResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.awaitPromise", params)
 	result := &runtime.AwaitPromiseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -56,13 +72,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-callFunct
 func (protocol *RuntimeProtocol) CallFunctionOn(
 	params *runtime.CallFunctionOnParams,
 ) <-chan *runtime.CallFunctionOnResult {
-	resultChan := make(chan *runtime.CallFunctionOnResult)
+	return protocol.CallFunctionOnContext(context.Background(), params)
+}
+
+/*
+CallFunctionOnContext performs CallFunctionOn and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-callFunctionOn
+*/
+func (protocol *RuntimeProtocol) CallFunctionOnContext(
+	ctx context.Context,
+	params *runtime.CallFunctionOnParams,
+) <-chan *runtime.CallFunctionOnResult {
+	resultChan := make(chan *runtime.CallFunctionOnResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.callFunctionOn", params)
 	result := &runtime.CallFunctionOnResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -82,13 +113,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-compileSc
 func (protocol *RuntimeProtocol) CompileScript(
 	params *runtime.CompileScriptParams,
 ) <-chan *runtime.CompileScriptResult {
-	resultChan := make(chan *runtime.CompileScriptResult)
+	return protocol.CompileScriptContext(context.Background(), params)
+}
+
+/*
+CompileScriptContext performs CompileScript and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-compileScript
+*/
+func (protocol *RuntimeProtocol) CompileScriptContext(
+	ctx context.Context,
+	params *runtime.CompileScriptParams,
+) <-chan *runtime.CompileScriptResult {
+	resultChan := make(chan *runtime.CompileScriptResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.compileScript", params)
 	result := &runtime.CompileScriptResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -106,13 +152,25 @@ Disable disables reporting of execution contexts creation.
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-disable
 */
 func (protocol *RuntimeProtocol) Disable() <-chan *runtime.DisableResult {
-	resultChan := make(chan *runtime.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-disable
+*/
+func (protocol *RuntimeProtocol) DisableContext(ctx context.Context) <-chan *runtime.DisableResult {
+	resultChan := make(chan *runtime.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.disable", nil)
 	result := &runtime.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -128,13 +186,26 @@ DiscardConsoleEntries discards collected exceptions and console API calls.
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-discardConsoleEntries
 */
 func (protocol *RuntimeProtocol) DiscardConsoleEntries() <-chan *runtime.DiscardConsoleEntriesResult {
-	resultChan := make(chan *runtime.DiscardConsoleEntriesResult)
+	return protocol.DiscardConsoleEntriesContext(context.Background())
+}
+
+/*
+DiscardConsoleEntriesContext performs DiscardConsoleEntries and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-discardConsoleEntries
+*/
+func (protocol *RuntimeProtocol) DiscardConsoleEntriesContext(ctx context.Context) <-chan *runtime.DiscardConsoleEntriesResult {
+	resultChan := make(chan *runtime.DiscardConsoleEntriesResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.discardConsoleEntries", nil)
 	result := &runtime.DiscardConsoleEntriesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -152,13 +223,25 @@ will be sent immediately for each existing execution context.
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-enable
 */
 func (protocol *RuntimeProtocol) Enable() <-chan *runtime.EnableResult {
-	resultChan := make(chan *runtime.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-enable
+*/
+func (protocol *RuntimeProtocol) EnableContext(ctx context.Context) <-chan *runtime.EnableResult {
+	resultChan := make(chan *runtime.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.enable", nil)
 	result := &runtime.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -176,13 +259,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-evaluate
 func (protocol *RuntimeProtocol) Evaluate(
 	params *runtime.EvaluateParams,
 ) <-chan *runtime.EvaluateResult {
-	resultChan := make(chan *runtime.EvaluateResult)
+	return protocol.EvaluateContext(context.Background(), params)
+}
+
+/*
+EvaluateContext performs Evaluate and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-evaluate
+*/
+func (protocol *RuntimeProtocol) EvaluateContext(
+	ctx context.Context,
+	params *runtime.EvaluateParams,
+) <-chan *runtime.EvaluateResult {
+	resultChan := make(chan *runtime.EvaluateResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.evaluate", params)
 	result := &runtime.EvaluateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -203,13 +301,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-getProper
 func (protocol *RuntimeProtocol) GetProperties(
 	params *runtime.GetPropertiesParams,
 ) <-chan *runtime.GetPropertiesResult {
-	resultChan := make(chan *runtime.GetPropertiesResult)
+	return protocol.GetPropertiesContext(context.Background(), params)
+}
+
+/*
+GetPropertiesContext performs GetProperties and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-getProperties
+*/
+func (protocol *RuntimeProtocol) GetPropertiesContext(
+	ctx context.Context,
+	params *runtime.GetPropertiesParams,
+) <-chan *runtime.GetPropertiesResult {
+	resultChan := make(chan *runtime.GetPropertiesResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.getProperties", params)
 	result := &runtime.GetPropertiesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -230,13 +343,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-globalLex
 func (protocol *RuntimeProtocol) GlobalLexicalScopeNames(
 	params *runtime.GlobalLexicalScopeNamesParams,
 ) <-chan *runtime.GlobalLexicalScopeNamesResult {
-	resultChan := make(chan *runtime.GlobalLexicalScopeNamesResult)
+	return protocol.GlobalLexicalScopeNamesContext(context.Background(), params)
+}
+
+/*
+GlobalLexicalScopeNamesContext performs GlobalLexicalScopeNames and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-globalLexicalScopeNames
+*/
+func (protocol *RuntimeProtocol) GlobalLexicalScopeNamesContext(
+	ctx context.Context,
+	params *runtime.GlobalLexicalScopeNamesParams,
+) <-chan *runtime.GlobalLexicalScopeNamesResult {
+	resultChan := make(chan *runtime.GlobalLexicalScopeNamesResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.globalLexicalScopeNames", params)
 	result := &runtime.GlobalLexicalScopeNamesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -256,13 +385,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-queryObje
 func (protocol *RuntimeProtocol) QueryObjects(
 	params *runtime.QueryObjectsParams,
 ) <-chan *runtime.QueryObjectsResult {
-	resultChan := make(chan *runtime.QueryObjectsResult)
+	return protocol.QueryObjectsContext(context.Background(), params)
+}
+
+/*
+QueryObjectsContext performs QueryObjects and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-queryObjects
+*/
+func (protocol *RuntimeProtocol) QueryObjectsContext(
+	ctx context.Context,
+	params *runtime.QueryObjectsParams,
+) <-chan *runtime.QueryObjectsResult {
+	resultChan := make(chan *runtime.QueryObjectsResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.queryObjects", params)
 	result := &runtime.QueryObjectsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -282,13 +426,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-releaseOb
 func (protocol *RuntimeProtocol) ReleaseObject(
 	params *runtime.ReleaseObjectParams,
 ) <-chan *runtime.ReleaseObjectResult {
-	resultChan := make(chan *runtime.ReleaseObjectResult)
+	return protocol.ReleaseObjectContext(context.Background(), params)
+}
+
+/*
+ReleaseObjectContext performs ReleaseObject and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-releaseObject
+*/
+func (protocol *RuntimeProtocol) ReleaseObjectContext(
+	ctx context.Context,
+	params *runtime.ReleaseObjectParams,
+) <-chan *runtime.ReleaseObjectResult {
+	resultChan := make(chan *runtime.ReleaseObjectResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.releaseObject", params)
 	result := &runtime.ReleaseObjectResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -306,13 +465,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-releaseOb
 func (protocol *RuntimeProtocol) ReleaseObjectGroup(
 	params *runtime.ReleaseObjectGroupParams,
 ) <-chan *runtime.ReleaseObjectGroupResult {
-	resultChan := make(chan *runtime.ReleaseObjectGroupResult)
+	return protocol.ReleaseObjectGroupContext(context.Background(), params)
+}
+
+/*
+ReleaseObjectGroupContext performs ReleaseObjectGroup and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-releaseObjectGroup
+*/
+func (protocol *RuntimeProtocol) ReleaseObjectGroupContext(
+	ctx context.Context,
+	params *runtime.ReleaseObjectGroupParams,
+) <-chan *runtime.ReleaseObjectGroupResult {
+	resultChan := make(chan *runtime.ReleaseObjectGroupResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.releaseObjectGroup", params)
 	result := &runtime.ReleaseObjectGroupResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -329,13 +504,26 @@ debugger to attach.
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-runIfWaitingForDebugger
 */
 func (protocol *RuntimeProtocol) RunIfWaitingForDebugger() <-chan *runtime.RunIfWaitingForDebuggerResult {
-	resultChan := make(chan *runtime.RunIfWaitingForDebuggerResult)
+	return protocol.RunIfWaitingForDebuggerContext(context.Background())
+}
+
+/*
+RunIfWaitingForDebuggerContext performs RunIfWaitingForDebugger and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-runIfWaitingForDebugger
+*/
+func (protocol *RuntimeProtocol) RunIfWaitingForDebuggerContext(ctx context.Context) <-chan *runtime.RunIfWaitingForDebuggerResult {
+	resultChan := make(chan *runtime.RunIfWaitingForDebuggerResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.runIfWaitingForDebugger", nil)
 	result := &runtime.RunIfWaitingForDebuggerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -353,13 +541,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-runScript
 func (protocol *RuntimeProtocol) RunScript(
 	params *runtime.RunScriptParams,
 ) <-chan *runtime.RunScriptResult {
-	resultChan := make(chan *runtime.RunScriptResult)
+	return protocol.RunScriptContext(context.Background(), params)
+}
+
+/*
+RunScriptContext performs RunScript and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-runScript
+*/
+func (protocol *RuntimeProtocol) RunScriptContext(
+	ctx context.Context,
+	params *runtime.RunScriptParams,
+) <-chan *runtime.RunScriptResult {
+	resultChan := make(chan *runtime.RunScriptResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.runScript", params)
 	result := &runtime.RunScriptResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -380,13 +583,30 @@ EXPERIMENTAL.
 func (protocol *RuntimeProtocol) SetCustomObjectFormatterEnabled(
 	params *runtime.SetCustomObjectFormatterEnabledParams,
 ) <-chan *runtime.SetCustomObjectFormatterEnabledResult {
-	resultChan := make(chan *runtime.SetCustomObjectFormatterEnabledResult)
+	return protocol.SetCustomObjectFormatterEnabledContext(context.Background(), params)
+}
+
+/*
+SetCustomObjectFormatterEnabledContext performs SetCustomObjectFormatterEnabled
+and returns its result, or returns the context error if ctx is cancelled or
+times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-setCustomObjectFormatterEnabled
+EXPERIMENTAL.
+*/
+func (protocol *RuntimeProtocol) SetCustomObjectFormatterEnabledContext(
+	ctx context.Context,
+	params *runtime.SetCustomObjectFormatterEnabledParams,
+) <-chan *runtime.SetCustomObjectFormatterEnabledResult {
+	resultChan := make(chan *runtime.SetCustomObjectFormatterEnabledResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.setCustomObjectFormatterEnabled", params)
 	result := &runtime.SetCustomObjectFormatterEnabledResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -404,7 +624,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-consoleAPI
 */
 func (protocol *RuntimeProtocol) OnConsoleAPICalled(
 	callback func(event *runtime.ConsoleAPICalledEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Runtime.consoleAPICalled",
 		func(response *Response) {
@@ -416,7 +636,29 @@ func (protocol *RuntimeProtocol) OnConsoleAPICalled(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnConsoleAPICalledChan returns a channel of Runtime.consoleAPICalled events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-consoleAPICalled
+*/
+func (protocol *RuntimeProtocol) OnConsoleAPICalledChan(
+	ctx context.Context,
+) <-chan *runtime.ConsoleAPICalledEvent {
+	eventChan := make(chan *runtime.ConsoleAPICalledEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnConsoleAPICalled(func(event *runtime.ConsoleAPICalledEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -427,7 +669,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-exceptionR
 */
 func (protocol *RuntimeProtocol) OnExceptionRevoked(
 	callback func(event *runtime.ExceptionRevokedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Runtime.exceptionRevoked",
 		func(response *Response) {
@@ -439,7 +681,29 @@ func (protocol *RuntimeProtocol) OnExceptionRevoked(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnExceptionRevokedChan returns a channel of Runtime.exceptionRevoked events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-exceptionRevoked
+*/
+func (protocol *RuntimeProtocol) OnExceptionRevokedChan(
+	ctx context.Context,
+) <-chan *runtime.ExceptionRevokedEvent {
+	eventChan := make(chan *runtime.ExceptionRevokedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnExceptionRevoked(func(event *runtime.ExceptionRevokedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -450,7 +714,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-exceptionT
 */
 func (protocol *RuntimeProtocol) OnExceptionThrown(
 	callback func(event *runtime.ExceptionThrownEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Runtime.exceptionThrown",
 		func(response *Response) {
@@ -462,7 +726,29 @@ func (protocol *RuntimeProtocol) OnExceptionThrown(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnExceptionThrownChan returns a channel of Runtime.exceptionThrown events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-exceptionThrown
+*/
+func (protocol *RuntimeProtocol) OnExceptionThrownChan(
+	ctx context.Context,
+) <-chan *runtime.ExceptionThrownEvent {
+	eventChan := make(chan *runtime.ExceptionThrownEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnExceptionThrown(func(event *runtime.ExceptionThrownEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -474,7 +760,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-executionC
 */
 func (protocol *RuntimeProtocol) OnExecutionContextCreated(
 	callback func(event *runtime.ExecutionContextCreatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Runtime.executionContextCreated",
 		func(response *Response) {
@@ -486,7 +772,30 @@ func (protocol *RuntimeProtocol) OnExecutionContextCreated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnExecutionContextCreatedChan returns a channel of
+Runtime.executionContextCreated events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-executionContextCreated
+*/
+func (protocol *RuntimeProtocol) OnExecutionContextCreatedChan(
+	ctx context.Context,
+) <-chan *runtime.ExecutionContextCreatedEvent {
+	eventChan := make(chan *runtime.ExecutionContextCreatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnExecutionContextCreated(func(event *runtime.ExecutionContextCreatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -498,7 +807,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-executionC
 */
 func (protocol *RuntimeProtocol) OnExecutionContextDestroyed(
 	callback func(event *runtime.ExecutionContextDestroyedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Runtime.executionContextDestroyed",
 		func(response *Response) {
@@ -510,7 +819,30 @@ func (protocol *RuntimeProtocol) OnExecutionContextDestroyed(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnExecutionContextDestroyedChan returns a channel of
+Runtime.executionContextDestroyed events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-executionContextDestroyed
+*/
+func (protocol *RuntimeProtocol) OnExecutionContextDestroyedChan(
+	ctx context.Context,
+) <-chan *runtime.ExecutionContextDestroyedEvent {
+	eventChan := make(chan *runtime.ExecutionContextDestroyedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnExecutionContextDestroyed(func(event *runtime.ExecutionContextDestroyedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -522,7 +854,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-executionC
 */
 func (protocol *RuntimeProtocol) OnExecutionContextsCleared(
 	callback func(event *runtime.ExecutionContextsClearedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Runtime.executionContextsCleared",
 		func(response *Response) {
@@ -534,7 +866,30 @@ func (protocol *RuntimeProtocol) OnExecutionContextsCleared(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnExecutionContextsClearedChan returns a channel of
+Runtime.executionContextsCleared events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-executionContextsCleared
+*/
+func (protocol *RuntimeProtocol) OnExecutionContextsClearedChan(
+	ctx context.Context,
+) <-chan *runtime.ExecutionContextsClearedEvent {
+	eventChan := make(chan *runtime.ExecutionContextsClearedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnExecutionContextsCleared(func(event *runtime.ExecutionContextsClearedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -546,7 +901,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-inspectReq
 */
 func (protocol *RuntimeProtocol) OnInspectRequested(
 	callback func(event *runtime.InspectRequestedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Runtime.inspectRequested",
 		func(response *Response) {
@@ -558,5 +913,27 @@ func (protocol *RuntimeProtocol) OnInspectRequested(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnInspectRequestedChan returns a channel of Runtime.inspectRequested events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-inspectRequested
+*/
+func (protocol *RuntimeProtocol) OnInspectRequestedChan(
+	ctx context.Context,
+) <-chan *runtime.InspectRequestedEvent {
+	eventChan := make(chan *runtime.InspectRequestedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnInspectRequested(func(event *runtime.InspectRequestedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/security"
@@ -21,13 +22,25 @@ Disable disables tracking security state changes.
 https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-disable
 */
 func (protocol *SecurityProtocol) Disable() <-chan *security.DisableResult {
-	resultChan := make(chan *security.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-disable
+*/
+func (protocol *SecurityProtocol) DisableContext(ctx context.Context) <-chan *security.DisableResult {
+	resultChan := make(chan *security.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Security.disable", nil)
 	result := &security.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -43,13 +56,25 @@ Enable tracking security state changes.
 https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-enable
 */
 func (protocol *SecurityProtocol) Enable() <-chan *security.EnableResult {
-	resultChan := make(chan *security.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-enable
+*/
+func (protocol *SecurityProtocol) EnableContext(ctx context.Context) <-chan *security.EnableResult {
+	resultChan := make(chan *security.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Security.enable", nil)
 	result := &security.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -68,13 +93,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-handleCe
 func (protocol *SecurityProtocol) HandleCertificateError(
 	params *security.HandleCertificateErrorParams,
 ) <-chan *security.HandleCertificateErrorResult {
-	resultChan := make(chan *security.HandleCertificateErrorResult)
+	return protocol.HandleCertificateErrorContext(context.Background(), params)
+}
+
+/*
+HandleCertificateErrorContext performs HandleCertificateError and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-handleCertificateError
+*/
+func (protocol *SecurityProtocol) HandleCertificateErrorContext(
+	ctx context.Context,
+	params *security.HandleCertificateErrorParams,
+) <-chan *security.HandleCertificateErrorResult {
+	resultChan := make(chan *security.HandleCertificateErrorResult, 1)
 	command := NewCommand(protocol.Socket, "Security.handleCertificateError", params)
 	result := &security.HandleCertificateErrorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -94,13 +135,30 @@ EXPERIMENTAL.
 func (protocol *SecurityProtocol) SetIgnoreCertificateErrors(
 	params *security.SetIgnoreCertificateErrorsParams,
 ) <-chan *security.SetIgnoreCertificateErrorsResult {
-	resultChan := make(chan *security.SetIgnoreCertificateErrorsResult)
+	return protocol.SetIgnoreCertificateErrorsContext(context.Background(), params)
+}
+
+/*
+SetIgnoreCertificateErrorsContext performs SetIgnoreCertificateErrors and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-setIgnoreCertificateErrors
+EXPERIMENTAL.
+*/
+func (protocol *SecurityProtocol) SetIgnoreCertificateErrorsContext(
+	ctx context.Context,
+	params *security.SetIgnoreCertificateErrorsParams,
+) <-chan *security.SetIgnoreCertificateErrorsResult {
+	resultChan := make(chan *security.SetIgnoreCertificateErrorsResult, 1)
 	command := NewCommand(protocol.Socket, "Security.setIgnoreCertificateErrors", params)
 	result := &security.SetIgnoreCertificateErrorsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -120,13 +178,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-setOverr
 func (protocol *SecurityProtocol) SetOverrideCertificateErrors(
 	params *security.SetOverrideCertificateErrorsParams,
 ) <-chan *security.SetOverrideCertificateErrorsResult {
-	resultChan := make(chan *security.SetOverrideCertificateErrorsResult)
+	return protocol.SetOverrideCertificateErrorsContext(context.Background(), params)
+}
+
+/*
+SetOverrideCertificateErrorsContext performs SetOverrideCertificateErrors and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-setOverrideCertificateErrors
+*/
+func (protocol *SecurityProtocol) SetOverrideCertificateErrorsContext(
+	ctx context.Context,
+	params *security.SetOverrideCertificateErrorsParams,
+) <-chan *security.SetOverrideCertificateErrorsResult {
+	resultChan := make(chan *security.SetOverrideCertificateErrorsResult, 1)
 	command := NewCommand(protocol.Socket, "Security.setOverrideCertificateErrors", params)
 	result := &security.SetOverrideCertificateErrorsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -147,7 +221,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Security/#event-certifica
 */
 func (protocol *SecurityProtocol) OnCertificateError(
 	callback func(event *security.CertificateErrorEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Security.certificateError",
 		func(response *Response) {
@@ -159,7 +233,29 @@ func (protocol *SecurityProtocol) OnCertificateError(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnCertificateErrorChan returns a channel of Security.certificateError events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Security/#event-certificateError
+*/
+func (protocol *SecurityProtocol) OnCertificateErrorChan(
+	ctx context.Context,
+) <-chan *security.CertificateErrorEvent {
+	eventChan := make(chan *security.CertificateErrorEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnCertificateError(func(event *security.CertificateErrorEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -170,7 +266,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Security/#event-securityS
 */
 func (protocol *SecurityProtocol) OnSecurityStateChanged(
 	callback func(event *security.StateChangedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Security.securityStateChanged",
 		func(response *Response) {
@@ -182,5 +278,27 @@ func (protocol *SecurityProtocol) OnSecurityStateChanged(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnSecurityStateChangedChan returns a channel of Security.securityStateChanged
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Security/#event-securityStateChanged
+*/
+func (protocol *SecurityProtocol) OnSecurityStateChangedChan(
+	ctx context.Context,
+) <-chan *security.StateChangedEvent {
+	eventChan := make(chan *security.StateChangedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnSecurityStateChanged(func(event *security.StateChangedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
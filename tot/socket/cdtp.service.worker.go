@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/service/worker"
@@ -26,13 +27,30 @@ EXPERIMENTAL.
 func (protocol *ServiceWorkerProtocol) DeliverPushMessage(
 	params *worker.DeliverPushMessageParams,
 ) <-chan *worker.DeliverPushMessageResult {
-	resultChan := make(chan *worker.DeliverPushMessageResult)
+	return protocol.DeliverPushMessageContext(context.Background(), params)
+}
+
+/*
+DeliverPushMessageContext performs DeliverPushMessage and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-deliverPushMessage
+EXPERIMENTAL.
+*/
+func (protocol *ServiceWorkerProtocol) DeliverPushMessageContext(
+	ctx context.Context,
+	params *worker.DeliverPushMessageParams,
+) <-chan *worker.DeliverPushMessageResult {
+	resultChan := make(chan *worker.DeliverPushMessageResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.deliverPushMessage", params)
 	result := &worker.DeliverPushMessageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -49,13 +67,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-dis
 EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) Disable() <-chan *worker.DisableResult {
-	resultChan := make(chan *worker.DisableResult)
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-disable
+EXPERIMENTAL.
+*/
+func (protocol *ServiceWorkerProtocol) DisableContext(ctx context.Context) <-chan *worker.DisableResult {
+	resultChan := make(chan *worker.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.disable", nil)
 	result := &worker.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -74,13 +105,30 @@ EXPERIMENTAL.
 func (protocol *ServiceWorkerProtocol) DispatchSyncEvent(
 	params *worker.DispatchSyncEventParams,
 ) <-chan *worker.DispatchSyncEventResult {
-	resultChan := make(chan *worker.DispatchSyncEventResult)
+	return protocol.DispatchSyncEventContext(context.Background(), params)
+}
+
+/*
+DispatchSyncEventContext performs DispatchSyncEvent and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-dispatchSyncEvent
+EXPERIMENTAL.
+*/
+func (protocol *ServiceWorkerProtocol) DispatchSyncEventContext(
+	ctx context.Context,
+	params *worker.DispatchSyncEventParams,
+) <-chan *worker.DispatchSyncEventResult {
+	resultChan := make(chan *worker.DispatchSyncEventResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.dispatchSyncEvent", params)
 	result := &worker.DispatchSyncEventResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -97,13 +145,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-ena
 EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) Enable() <-chan *worker.EnableResult {
-	resultChan := make(chan *worker.EnableResult)
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-enable
+EXPERIMENTAL.
+*/
+func (protocol *ServiceWorkerProtocol) EnableContext(ctx context.Context) <-chan *worker.EnableResult {
+	resultChan := make(chan *worker.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.enable", nil)
 	result := &worker.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -122,13 +183,29 @@ EXPERIMENTAL.
 func (protocol *ServiceWorkerProtocol) InspectWorker(
 	params *worker.InspectWorkerParams,
 ) <-chan *worker.InspectWorkerResult {
-	resultChan := make(chan *worker.InspectWorkerResult)
+	return protocol.InspectWorkerContext(context.Background(), params)
+}
+
+/*
+InspectWorkerContext performs InspectWorker and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-inspectWorker
+EXPERIMENTAL.
+*/
+func (protocol *ServiceWorkerProtocol) InspectWorkerContext(
+	ctx context.Context,
+	params *worker.InspectWorkerParams,
+) <-chan *worker.InspectWorkerResult {
+	resultChan := make(chan *worker.InspectWorkerResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.inspectWorker", params)
 	result := &worker.InspectWorkerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -147,13 +224,30 @@ EXPERIMENTAL.
 func (protocol *ServiceWorkerProtocol) SetForceUpdateOnPageLoad(
 	params *worker.SetForceUpdateOnPageLoadParams,
 ) <-chan *worker.SetForceUpdateOnPageLoadResult {
-	resultChan := make(chan *worker.SetForceUpdateOnPageLoadResult)
+	return protocol.SetForceUpdateOnPageLoadContext(context.Background(), params)
+}
+
+/*
+SetForceUpdateOnPageLoadContext performs SetForceUpdateOnPageLoad and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-setForceUpdateOnPageLoad
+EXPERIMENTAL.
+*/
+func (protocol *ServiceWorkerProtocol) SetForceUpdateOnPageLoadContext(
+	ctx context.Context,
+	params *worker.SetForceUpdateOnPageLoadParams,
+) <-chan *worker.SetForceUpdateOnPageLoadResult {
+	resultChan := make(chan *worker.SetForceUpdateOnPageLoadResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.setForceUpdateOnPageLoad", params)
 	result := &worker.SetForceUpdateOnPageLoadResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -172,13 +266,29 @@ EXPERIMENTAL.
 func (protocol *ServiceWorkerProtocol) SkipWaiting(
 	params *worker.SkipWaitingParams,
 ) <-chan *worker.SkipWaitingResult {
-	resultChan := make(chan *worker.SkipWaitingResult)
+	return protocol.SkipWaitingContext(context.Background(), params)
+}
+
+/*
+SkipWaitingContext performs SkipWaiting and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-skipWaiting
+EXPERIMENTAL.
+*/
+func (protocol *ServiceWorkerProtocol) SkipWaitingContext(
+	ctx context.Context,
+	params *worker.SkipWaitingParams,
+) <-chan *worker.SkipWaitingResult {
+	resultChan := make(chan *worker.SkipWaitingResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.skipWaiting", params)
 	result := &worker.SkipWaitingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -197,13 +307,29 @@ EXPERIMENTAL.
 func (protocol *ServiceWorkerProtocol) StartWorker(
 	params *worker.StartWorkerParams,
 ) <-chan *worker.StartWorkerResult {
-	resultChan := make(chan *worker.StartWorkerResult)
+	return protocol.StartWorkerContext(context.Background(), params)
+}
+
+/*
+StartWorkerContext performs StartWorker and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-startWorker
+EXPERIMENTAL.
+*/
+func (protocol *ServiceWorkerProtocol) StartWorkerContext(
+	ctx context.Context,
+	params *worker.StartWorkerParams,
+) <-chan *worker.StartWorkerResult {
+	resultChan := make(chan *worker.StartWorkerResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.startWorker", params)
 	result := &worker.StartWorkerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -220,13 +346,26 @@ https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-sto
 EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) StopAllWorkers() <-chan *worker.StopAllWorkersResult {
-	resultChan := make(chan *worker.StopAllWorkersResult)
+	return protocol.StopAllWorkersContext(context.Background())
+}
+
+/*
+StopAllWorkersContext performs StopAllWorkers and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-stopAllWorkers
+EXPERIMENTAL.
+*/
+func (protocol *ServiceWorkerProtocol) StopAllWorkersContext(ctx context.Context) <-chan *worker.StopAllWorkersResult {
+	resultChan := make(chan *worker.StopAllWorkersResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.stopAllWorkers", nil)
 	result := &worker.StopAllWorkersResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -245,13 +384,29 @@ EXPERIMENTAL.
 func (protocol *ServiceWorkerProtocol) StopWorker(
 	params *worker.StopWorkerParams,
 ) <-chan *worker.StopWorkerResult {
-	resultChan := make(chan *worker.StopWorkerResult)
+	return protocol.StopWorkerContext(context.Background(), params)
+}
+
+/*
+StopWorkerContext performs StopWorker and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-stopWorker
+EXPERIMENTAL.
+*/
+func (protocol *ServiceWorkerProtocol) StopWorkerContext(
+	ctx context.Context,
+	params *worker.StopWorkerParams,
+) <-chan *worker.StopWorkerResult {
+	resultChan := make(chan *worker.StopWorkerResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.stopWorker", params)
 	result := &worker.StopWorkerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -270,13 +425,29 @@ EXPERIMENTAL.
 func (protocol *ServiceWorkerProtocol) Unregister(
 	params *worker.UnregisterParams,
 ) <-chan *worker.UnregisterResult {
-	resultChan := make(chan *worker.UnregisterResult)
+	return protocol.UnregisterContext(context.Background(), params)
+}
+
+/*
+UnregisterContext performs Unregister and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-unregister
+EXPERIMENTAL.
+*/
+func (protocol *ServiceWorkerProtocol) UnregisterContext(
+	ctx context.Context,
+	params *worker.UnregisterParams,
+) <-chan *worker.UnregisterResult {
+	resultChan := make(chan *worker.UnregisterResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.unregister", params)
 	result := &worker.UnregisterResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -295,13 +466,30 @@ EXPERIMENTAL.
 func (protocol *ServiceWorkerProtocol) UpdateRegistration(
 	params *worker.UpdateRegistrationParams,
 ) <-chan *worker.UpdateRegistrationResult {
-	resultChan := make(chan *worker.UpdateRegistrationResult)
+	return protocol.UpdateRegistrationContext(context.Background(), params)
+}
+
+/*
+UpdateRegistrationContext performs UpdateRegistration and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-updateRegistration
+EXPERIMENTAL.
+*/
+func (protocol *ServiceWorkerProtocol) UpdateRegistrationContext(
+	ctx context.Context,
+	params *worker.UpdateRegistrationParams,
+) <-chan *worker.UpdateRegistrationResult {
+	resultChan := make(chan *worker.UpdateRegistrationResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.updateRegistration", params)
 	result := &worker.UpdateRegistrationResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -319,7 +507,7 @@ EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) OnWorkerErrorReported(
 	callback func(event *worker.ErrorReportedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"ServiceWorker.workerErrorReported",
 		func(response *Response) {
@@ -331,7 +519,30 @@ func (protocol *ServiceWorkerProtocol) OnWorkerErrorReported(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWorkerErrorReportedChan returns a channel of ServiceWorker.workerErrorReported
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#event-workerErrorReported
+EXPERIMENTAL.
+*/
+func (protocol *ServiceWorkerProtocol) OnWorkerErrorReportedChan(
+	ctx context.Context,
+) <-chan *worker.ErrorReportedEvent {
+	eventChan := make(chan *worker.ErrorReportedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWorkerErrorReported(func(event *worker.ErrorReportedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -342,7 +553,7 @@ EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) OnWorkerRegistrationUpdated(
 	callback func(event *worker.RegistrationUpdatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"ServiceWorker.workerRegistrationUpdated",
 		func(response *Response) {
@@ -354,7 +565,31 @@ func (protocol *ServiceWorkerProtocol) OnWorkerRegistrationUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWorkerRegistrationUpdatedChan returns a channel of
+ServiceWorker.workerRegistrationUpdated events. The event handler is removed and
+the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#event-workerRegistrationUpdated
+EXPERIMENTAL.
+*/
+func (protocol *ServiceWorkerProtocol) OnWorkerRegistrationUpdatedChan(
+	ctx context.Context,
+) <-chan *worker.RegistrationUpdatedEvent {
+	eventChan := make(chan *worker.RegistrationUpdatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWorkerRegistrationUpdated(func(event *worker.RegistrationUpdatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -365,7 +600,7 @@ EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) OnWorkerVersionUpdated(
 	callback func(event *worker.VersionUpdatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"ServiceWorker.workerVersionUpdated",
 		func(response *Response) {
@@ -377,5 +612,29 @@ func (protocol *ServiceWorkerProtocol) OnWorkerVersionUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWorkerVersionUpdatedChan returns a channel of
+ServiceWorker.workerVersionUpdated events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#event-workerVersionUpdated
+EXPERIMENTAL.
+*/
+func (protocol *ServiceWorkerProtocol) OnWorkerVersionUpdatedChan(
+	ctx context.Context,
+) <-chan *worker.VersionUpdatedEvent {
+	eventChan := make(chan *worker.VersionUpdatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWorkerVersionUpdated(func(event *worker.VersionUpdatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
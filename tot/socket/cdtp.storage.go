@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/storage"
@@ -23,13 +24,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearData
 func (protocol *StorageProtocol) ClearDataForOrigin(
 	params *storage.ClearDataForOriginParams,
 ) <-chan *storage.ClearDataForOriginResult {
-	resultChan := make(chan *storage.ClearDataForOriginResult)
+	return protocol.ClearDataForOriginContext(context.Background(), params)
+}
+
+/*
+ClearDataForOriginContext performs ClearDataForOrigin and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearDataForOrigin
+*/
+func (protocol *StorageProtocol) ClearDataForOriginContext(
+	ctx context.Context,
+	params *storage.ClearDataForOriginParams,
+) <-chan *storage.ClearDataForOriginResult {
+	resultChan := make(chan *storage.ClearDataForOriginResult, 1)
 	command := NewCommand(protocol.Socket, "Storage.clearDataForOrigin", params)
 	result := &storage.ClearDataForOriginResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -47,13 +64,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getUsageA
 func (protocol *StorageProtocol) GetUsageAndQuota(
 	params *storage.GetUsageAndQuotaParams,
 ) <-chan *storage.GetUsageAndQuotaResult {
-	resultChan := make(chan *storage.GetUsageAndQuotaResult)
+	return protocol.GetUsageAndQuotaContext(context.Background(), params)
+}
+
+/*
+GetUsageAndQuotaContext performs GetUsageAndQuota and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getUsageAndQuota
+*/
+func (protocol *StorageProtocol) GetUsageAndQuotaContext(
+	ctx context.Context,
+	params *storage.GetUsageAndQuotaParams,
+) <-chan *storage.GetUsageAndQuotaResult {
+	resultChan := make(chan *storage.GetUsageAndQuotaResult, 1)
 	command := NewCommand(protocol.Socket, "storage.getUsageAndQuota", params)
 	result := &storage.GetUsageAndQuotaResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -74,13 +107,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackCach
 func (protocol *StorageProtocol) TrackCacheStorageForOrigin(
 	params *storage.TrackCacheStorageForOriginParams,
 ) <-chan *storage.TrackCacheStorageForOriginResult {
-	resultChan := make(chan *storage.TrackCacheStorageForOriginResult)
+	return protocol.TrackCacheStorageForOriginContext(context.Background(), params)
+}
+
+/*
+TrackCacheStorageForOriginContext performs TrackCacheStorageForOrigin and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackCacheStorageForOrigin
+*/
+func (protocol *StorageProtocol) TrackCacheStorageForOriginContext(
+	ctx context.Context,
+	params *storage.TrackCacheStorageForOriginParams,
+) <-chan *storage.TrackCacheStorageForOriginResult {
+	resultChan := make(chan *storage.TrackCacheStorageForOriginResult, 1)
 	command := NewCommand(protocol.Socket, "Storage.trackCacheStorageForOrigin", params)
 	result := &storage.TrackCacheStorageForOriginResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -99,13 +148,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackInde
 func (protocol *StorageProtocol) TrackIndexedDBForOrigin(
 	params *storage.TrackIndexedDBForOriginParams,
 ) <-chan *storage.TrackIndexedDBForOriginResult {
-	resultChan := make(chan *storage.TrackIndexedDBForOriginResult)
+	return protocol.TrackIndexedDBForOriginContext(context.Background(), params)
+}
+
+/*
+TrackIndexedDBForOriginContext performs TrackIndexedDBForOrigin and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackIndexedDBForOrigin
+*/
+func (protocol *StorageProtocol) TrackIndexedDBForOriginContext(
+	ctx context.Context,
+	params *storage.TrackIndexedDBForOriginParams,
+) <-chan *storage.TrackIndexedDBForOriginResult {
+	resultChan := make(chan *storage.TrackIndexedDBForOriginResult, 1)
 	command := NewCommand(protocol.Socket, "Storage.trackIndexedDBForOrigin", params)
 	result := &storage.TrackIndexedDBForOriginResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -124,13 +189,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackCa
 func (protocol *StorageProtocol) UntrackCacheStorageForOrigin(
 	params *storage.UntrackCacheStorageForOriginParams,
 ) <-chan *storage.UntrackCacheStorageForOriginResult {
-	resultChan := make(chan *storage.UntrackCacheStorageForOriginResult)
+	return protocol.UntrackCacheStorageForOriginContext(context.Background(), params)
+}
+
+/*
+UntrackCacheStorageForOriginContext performs UntrackCacheStorageForOrigin and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackCacheStorageForOrigin
+*/
+func (protocol *StorageProtocol) UntrackCacheStorageForOriginContext(
+	ctx context.Context,
+	params *storage.UntrackCacheStorageForOriginParams,
+) <-chan *storage.UntrackCacheStorageForOriginResult {
+	resultChan := make(chan *storage.UntrackCacheStorageForOriginResult, 1)
 	command := NewCommand(protocol.Socket, "Storage.untrackCacheStorageForOrigin", params)
 	result := &storage.UntrackCacheStorageForOriginResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -149,13 +230,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackIn
 func (protocol *StorageProtocol) UntrackIndexedDBForOrigin(
 	params *storage.UntrackIndexedDBForOriginParams,
 ) <-chan *storage.UntrackIndexedDBForOriginResult {
-	resultChan := make(chan *storage.UntrackIndexedDBForOriginResult)
+	return protocol.UntrackIndexedDBForOriginContext(context.Background(), params)
+}
+
+/*
+UntrackIndexedDBForOriginContext performs UntrackIndexedDBForOrigin and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackIndexedDBForOrigin
+*/
+func (protocol *StorageProtocol) UntrackIndexedDBForOriginContext(
+	ctx context.Context,
+	params *storage.UntrackIndexedDBForOriginParams,
+) <-chan *storage.UntrackIndexedDBForOriginResult {
+	resultChan := make(chan *storage.UntrackIndexedDBForOriginResult, 1)
 	command := NewCommand(protocol.Socket, "Storage.untrackIndexedDBForOrigin", params)
 	result := &storage.UntrackIndexedDBForOriginResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -174,7 +271,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-cacheStora
 */
 func (protocol *StorageProtocol) OnCacheStorageContentUpdated(
 	callback func(event *storage.CacheStorageContentUpdatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Storage.cacheStorageContentUpdated",
 		func(response *Response) {
@@ -186,7 +283,30 @@ func (protocol *StorageProtocol) OnCacheStorageContentUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnCacheStorageContentUpdatedChan returns a channel of
+Storage.cacheStorageContentUpdated events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-cacheStorageContentUpdated
+*/
+func (protocol *StorageProtocol) OnCacheStorageContentUpdatedChan(
+	ctx context.Context,
+) <-chan *storage.CacheStorageContentUpdatedEvent {
+	eventChan := make(chan *storage.CacheStorageContentUpdatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnCacheStorageContentUpdated(func(event *storage.CacheStorageContentUpdatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -197,7 +317,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-cacheStora
 */
 func (protocol *StorageProtocol) OnCacheStorageListUpdated(
 	callback func(event *storage.CacheStorageListUpdatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Storage.cacheStorageListUpdated",
 		func(response *Response) {
@@ -209,7 +329,30 @@ func (protocol *StorageProtocol) OnCacheStorageListUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnCacheStorageListUpdatedChan returns a channel of
+Storage.cacheStorageListUpdated events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-cacheStorageListUpdated
+*/
+func (protocol *StorageProtocol) OnCacheStorageListUpdatedChan(
+	ctx context.Context,
+) <-chan *storage.CacheStorageListUpdatedEvent {
+	eventChan := make(chan *storage.CacheStorageListUpdatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnCacheStorageListUpdated(func(event *storage.CacheStorageListUpdatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -221,7 +364,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-indexedDBC
 */
 func (protocol *StorageProtocol) OnIndexedDBContentUpdated(
 	callback func(event *storage.IndexedDBContentUpdatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Storage.indexedDBContentUpdated",
 		func(response *Response) {
@@ -233,7 +376,30 @@ func (protocol *StorageProtocol) OnIndexedDBContentUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnIndexedDBContentUpdatedChan returns a channel of
+Storage.indexedDBContentUpdated events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-indexedDBContentUpdated
+*/
+func (protocol *StorageProtocol) OnIndexedDBContentUpdatedChan(
+	ctx context.Context,
+) <-chan *storage.IndexedDBContentUpdatedEvent {
+	eventChan := make(chan *storage.IndexedDBContentUpdatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnIndexedDBContentUpdated(func(event *storage.IndexedDBContentUpdatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -245,7 +411,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-indexedDBL
 */
 func (protocol *StorageProtocol) OnIndexedDBListUpdated(
 	callback func(event *storage.IndexedDBListUpdatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Storage.indexedDBListUpdated",
 		func(response *Response) {
@@ -257,5 +423,27 @@ func (protocol *StorageProtocol) OnIndexedDBListUpdated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnIndexedDBListUpdatedChan returns a channel of Storage.indexedDBListUpdated
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-indexedDBListUpdated
+*/
+func (protocol *StorageProtocol) OnIndexedDBListUpdatedChan(
+	ctx context.Context,
+) <-chan *storage.IndexedDBListUpdatedEvent {
+	eventChan := make(chan *storage.IndexedDBListUpdatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnIndexedDBListUpdated(func(event *storage.IndexedDBListUpdatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/system/info"
@@ -23,13 +24,25 @@ GetInfo returns information about the system.
 https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#method-getInfo
 */
 func (protocol *SystemInfoProtocol) GetInfo() <-chan *info.GetInfoResult {
-	resultChan := make(chan *info.GetInfoResult)
+	return protocol.GetInfoContext(context.Background())
+}
+
+/*
+GetInfoContext performs GetInfo and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#method-getInfo
+*/
+func (protocol *SystemInfoProtocol) GetInfoContext(ctx context.Context) <-chan *info.GetInfoResult {
+	resultChan := make(chan *info.GetInfoResult, 1)
 	command := NewCommand(protocol.Socket, "SystemInfo.getInfo", nil)
 	result := &info.GetInfoResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
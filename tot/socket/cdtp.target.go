@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/target"
@@ -24,13 +25,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-activateTa
 func (protocol *TargetProtocol) ActivateTarget(
 	params *target.ActivateTargetParams,
 ) <-chan *target.ActivateTargetResult {
-	resultChan := make(chan *target.ActivateTargetResult)
+	return protocol.ActivateTargetContext(context.Background(), params)
+}
+
+/*
+ActivateTargetContext performs ActivateTarget and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-activateTarget
+*/
+func (protocol *TargetProtocol) ActivateTargetContext(
+	ctx context.Context,
+	params *target.ActivateTargetParams,
+) <-chan *target.ActivateTargetResult {
+	resultChan := make(chan *target.ActivateTargetResult, 1)
 	command := NewCommand(protocol.Socket, "Target.activateTarget", params)
 	result := &target.ActivateTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -48,13 +64,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-attachToTa
 func (protocol *TargetProtocol) AttachToTarget(
 	params *target.AttachToTargetParams,
 ) <-chan *target.AttachToTargetResult {
-	resultChan := make(chan *target.AttachToTargetResult)
+	return protocol.AttachToTargetContext(context.Background(), params)
+}
+
+/*
+AttachToTargetContext performs AttachToTarget and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-attachToTarget
+*/
+func (protocol *TargetProtocol) AttachToTargetContext(
+	ctx context.Context,
+	params *target.AttachToTargetParams,
+) <-chan *target.AttachToTargetResult {
+	resultChan := make(chan *target.AttachToTargetResult, 1)
 	command := NewCommand(protocol.Socket, "Target.attachToTarget", params)
 	result := &target.AttachToTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -74,13 +105,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-closeTarge
 func (protocol *TargetProtocol) CloseTarget(
 	params *target.CloseTargetParams,
 ) <-chan *target.CloseTargetResult {
-	resultChan := make(chan *target.CloseTargetResult)
+	return protocol.CloseTargetContext(context.Background(), params)
+}
+
+/*
+CloseTargetContext performs CloseTarget and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-closeTarget
+*/
+func (protocol *TargetProtocol) CloseTargetContext(
+	ctx context.Context,
+	params *target.CloseTargetParams,
+) <-chan *target.CloseTargetResult {
+	resultChan := make(chan *target.CloseTargetResult, 1)
 	command := NewCommand(protocol.Socket, "Target.closeTarget", params)
 	result := &target.CloseTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -100,13 +146,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-createBrow
 EXPERIMENTAL.
 */
 func (protocol *TargetProtocol) CreateBrowserContext() <-chan *target.CreateBrowserContextResult {
-	resultChan := make(chan *target.CreateBrowserContextResult)
+	return protocol.CreateBrowserContextContext(context.Background())
+}
+
+/*
+CreateBrowserContextContext performs CreateBrowserContext and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-createBrowserContext
+EXPERIMENTAL.
+*/
+func (protocol *TargetProtocol) CreateBrowserContextContext(ctx context.Context) <-chan *target.CreateBrowserContextResult {
+	resultChan := make(chan *target.CreateBrowserContextResult, 1)
 	command := NewCommand(protocol.Socket, "Target.createBrowserContext", nil)
 	result := &target.CreateBrowserContextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -126,13 +186,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-createTarg
 func (protocol *TargetProtocol) CreateTarget(
 	params *target.CreateTargetParams,
 ) <-chan *target.CreateTargetResult {
-	resultChan := make(chan *target.CreateTargetResult)
+	return protocol.CreateTargetContext(context.Background(), params)
+}
+
+/*
+CreateTargetContext performs CreateTarget and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-createTarget
+*/
+func (protocol *TargetProtocol) CreateTargetContext(
+	ctx context.Context,
+	params *target.CreateTargetParams,
+) <-chan *target.CreateTargetResult {
+	resultChan := make(chan *target.CreateTargetResult, 1)
 	command := NewCommand(protocol.Socket, "Target.createTarget", params)
 	result := &target.CreateTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -152,13 +227,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-detachFrom
 func (protocol *TargetProtocol) DetachFromTarget(
 	params *target.DetachFromTargetParams,
 ) <-chan *target.DetachFromTargetResult {
-	resultChan := make(chan *target.DetachFromTargetResult)
+	return protocol.DetachFromTargetContext(context.Background(), params)
+}
+
+/*
+DetachFromTargetContext performs DetachFromTarget and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-detachFromTarget
+*/
+func (protocol *TargetProtocol) DetachFromTargetContext(
+	ctx context.Context,
+	params *target.DetachFromTargetParams,
+) <-chan *target.DetachFromTargetResult {
+	resultChan := make(chan *target.DetachFromTargetResult, 1)
 	command := NewCommand(protocol.Socket, "Target.detachFromTarget", params)
 	result := &target.DetachFromTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -178,13 +269,30 @@ EXPERIMENTAL.
 func (protocol *TargetProtocol) DisposeBrowserContext(
 	params *target.DisposeBrowserContextParams,
 ) <-chan *target.DisposeBrowserContextResult {
-	resultChan := make(chan *target.DisposeBrowserContextResult)
+	return protocol.DisposeBrowserContextContext(context.Background(), params)
+}
+
+/*
+DisposeBrowserContextContext performs DisposeBrowserContext and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-disposeBrowserContext
+EXPERIMENTAL.
+*/
+func (protocol *TargetProtocol) DisposeBrowserContextContext(
+	ctx context.Context,
+	params *target.DisposeBrowserContextParams,
+) <-chan *target.DisposeBrowserContextResult {
+	resultChan := make(chan *target.DisposeBrowserContextResult, 1)
 	command := NewCommand(protocol.Socket, "Target.disposeBrowserContext", params)
 	result := &target.DisposeBrowserContextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -205,13 +313,29 @@ EXPERIMENTAL.
 func (protocol *TargetProtocol) GetTargetInfo(
 	params *target.GetTargetInfoParams,
 ) <-chan *target.GetTargetInfoResult {
-	resultChan := make(chan *target.GetTargetInfoResult)
+	return protocol.GetTargetInfoContext(context.Background(), params)
+}
+
+/*
+GetTargetInfoContext performs GetTargetInfo and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-getTargetInfo
+EXPERIMENTAL.
+*/
+func (protocol *TargetProtocol) GetTargetInfoContext(
+	ctx context.Context,
+	params *target.GetTargetInfoParams,
+) <-chan *target.GetTargetInfoResult {
+	resultChan := make(chan *target.GetTargetInfoResult, 1)
 	command := NewCommand(protocol.Socket, "Target.getTargetInfo", params)
 	result := &target.GetTargetInfoResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -231,14 +355,31 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-getTargets
 func (protocol *TargetProtocol) GetTargets(
 	params *target.GetTargetsParams,
 ) <-chan *target.GetTargetsResult {
-	resultChan := make(chan *target.GetTargetsResult)
+	return protocol.GetTargetsContext(context.Background(), params)
+}
+
+/*
+GetTargetsContext performs GetTargets and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-getTargets
+*/
+func (protocol *TargetProtocol) GetTargetsContext(
+	ctx context.Context,
+	params *target.GetTargetsParams,
+) <-chan *target.GetTargetsResult {
+	resultChan := make(chan *target.GetTargetsResult, 1)
 	command := NewCommand(protocol.Socket, "Target.getTargets", params)
 	result := &target.GetTargetsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -255,13 +396,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-sendMessag
 func (protocol *TargetProtocol) SendMessageToTarget(
 	params *target.SendMessageToTargetParams,
 ) <-chan *target.SendMessageToTargetResult {
-	resultChan := make(chan *target.SendMessageToTargetResult)
+	return protocol.SendMessageToTargetContext(context.Background(), params)
+}
+
+/*
+SendMessageToTargetContext performs SendMessageToTarget and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-sendMessageToTarget
+*/
+func (protocol *TargetProtocol) SendMessageToTargetContext(
+	ctx context.Context,
+	params *target.SendMessageToTargetParams,
+) <-chan *target.SendMessageToTargetResult {
+	resultChan := make(chan *target.SendMessageToTargetResult, 1)
 	command := NewCommand(protocol.Socket, "Target.sendMessageToTarget", params)
 	result := &target.SendMessageToTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -280,13 +437,30 @@ EXPERIMENTAL.
 func (protocol *TargetProtocol) SetAttachToFrames(
 	params *target.SetAttachToFramesParams,
 ) <-chan *target.SetAttachToFramesResult {
-	resultChan := make(chan *target.SetAttachToFramesResult)
+	return protocol.SetAttachToFramesContext(context.Background(), params)
+}
+
+/*
+SetAttachToFramesContext performs SetAttachToFrames and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setAttachToFrames
+EXPERIMENTAL.
+*/
+func (protocol *TargetProtocol) SetAttachToFramesContext(
+	ctx context.Context,
+	params *target.SetAttachToFramesParams,
+) <-chan *target.SetAttachToFramesResult {
+	resultChan := make(chan *target.SetAttachToFramesResult, 1)
 	command := NewCommand(protocol.Socket, "Target.setAttachToFrames", params)
 	result := &target.SetAttachToFramesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -307,13 +481,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setAutoAtt
 func (protocol *TargetProtocol) SetAutoAttach(
 	params *target.SetAutoAttachParams,
 ) <-chan *target.SetAutoAttachResult {
-	resultChan := make(chan *target.SetAutoAttachResult)
+	return protocol.SetAutoAttachContext(context.Background(), params)
+}
+
+/*
+SetAutoAttachContext performs SetAutoAttach and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setAutoAttach EXPERIMENTAL.
+*/
+func (protocol *TargetProtocol) SetAutoAttachContext(
+	ctx context.Context,
+	params *target.SetAutoAttachParams,
+) <-chan *target.SetAutoAttachResult {
+	resultChan := make(chan *target.SetAutoAttachResult, 1)
 	command := NewCommand(protocol.Socket, "Target.setAutoAttach", params)
 	result := &target.SetAutoAttachResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -332,13 +521,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setDiscove
 func (protocol *TargetProtocol) SetDiscoverTargets(
 	params *target.SetDiscoverTargetsParams,
 ) <-chan *target.SetDiscoverTargetsResult {
-	resultChan := make(chan *target.SetDiscoverTargetsResult)
+	return protocol.SetDiscoverTargetsContext(context.Background(), params)
+}
+
+/*
+SetDiscoverTargetsContext performs SetDiscoverTargets and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setDiscoverTargets
+*/
+func (protocol *TargetProtocol) SetDiscoverTargetsContext(
+	ctx context.Context,
+	params *target.SetDiscoverTargetsParams,
+) <-chan *target.SetDiscoverTargetsResult {
+	resultChan := make(chan *target.SetDiscoverTargetsResult, 1)
 	command := NewCommand(protocol.Socket, "Target.setDiscoverTargets", params)
 	result := &target.SetDiscoverTargetsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -358,13 +563,30 @@ EXPERIMENTAL.
 func (protocol *TargetProtocol) SetRemoteLocations(
 	params *target.SetRemoteLocationsParams,
 ) <-chan *target.SetRemoteLocationsResult {
-	resultChan := make(chan *target.SetRemoteLocationsResult)
+	return protocol.SetRemoteLocationsContext(context.Background(), params)
+}
+
+/*
+SetRemoteLocationsContext performs SetRemoteLocations and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setRemoteLocations
+EXPERIMENTAL.
+*/
+func (protocol *TargetProtocol) SetRemoteLocationsContext(
+	ctx context.Context,
+	params *target.SetRemoteLocationsParams,
+) <-chan *target.SetRemoteLocationsResult {
+	resultChan := make(chan *target.SetRemoteLocationsResult, 1)
 	command := NewCommand(protocol.Socket, "Target.setRemoteLocations", params)
 	result := &target.SetRemoteLocationsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -383,7 +605,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-attachedToT
 */
 func (protocol *TargetProtocol) OnAttachedToTarget(
 	callback func(event *target.AttachedToTargetEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Target.attachedToTarget",
 		func(response *Response) {
@@ -395,7 +617,29 @@ func (protocol *TargetProtocol) OnAttachedToTarget(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnAttachedToTargetChan returns a channel of Target.attachedToTarget events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-attachedToTarget EXPERIMENTAL.
+*/
+func (protocol *TargetProtocol) OnAttachedToTargetChan(
+	ctx context.Context,
+) <-chan *target.AttachedToTargetEvent {
+	eventChan := make(chan *target.AttachedToTargetEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnAttachedToTarget(func(event *target.AttachedToTargetEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -409,7 +653,7 @@ EXPERIMENTAL.
 */
 func (protocol *TargetProtocol) OnDetachedFromTarget(
 	callback func(event *target.DetachedFromTargetEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Target.detachedFromTarget",
 		func(response *Response) {
@@ -421,7 +665,30 @@ func (protocol *TargetProtocol) OnDetachedFromTarget(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnDetachedFromTargetChan returns a channel of Target.detachedFromTarget events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-detachedFromTarget
+EXPERIMENTAL.
+*/
+func (protocol *TargetProtocol) OnDetachedFromTargetChan(
+	ctx context.Context,
+) <-chan *target.DetachedFromTargetEvent {
+	eventChan := make(chan *target.DetachedFromTargetEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnDetachedFromTarget(func(event *target.DetachedFromTargetEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -433,7 +700,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-receivedMes
 */
 func (protocol *TargetProtocol) OnReceivedMessageFromTarget(
 	callback func(event *target.ReceivedMessageFromTargetEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Target.receivedMessageFromTarget",
 		func(response *Response) {
@@ -445,7 +712,30 @@ func (protocol *TargetProtocol) OnReceivedMessageFromTarget(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnReceivedMessageFromTargetChan returns a channel of
+Target.receivedMessageFromTarget events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-receivedMessageFromTarget
+*/
+func (protocol *TargetProtocol) OnReceivedMessageFromTargetChan(
+	ctx context.Context,
+) <-chan *target.ReceivedMessageFromTargetEvent {
+	eventChan := make(chan *target.ReceivedMessageFromTargetEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnReceivedMessageFromTarget(func(event *target.ReceivedMessageFromTargetEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -456,7 +746,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetCreat
 */
 func (protocol *TargetProtocol) OnTargetCreated(
 	callback func(event *target.CreatedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Target.targetCreated",
 		func(response *Response) {
@@ -468,7 +758,29 @@ func (protocol *TargetProtocol) OnTargetCreated(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnTargetCreatedChan returns a channel of Target.targetCreated events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetCreated
+*/
+func (protocol *TargetProtocol) OnTargetCreatedChan(
+	ctx context.Context,
+) <-chan *target.CreatedEvent {
+	eventChan := make(chan *target.CreatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnTargetCreated(func(event *target.CreatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -479,7 +791,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetDestr
 */
 func (protocol *TargetProtocol) OnTargetDestroyed(
 	callback func(event *target.DestroyedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Target.targetDestroyed",
 		func(response *Response) {
@@ -491,7 +803,29 @@ func (protocol *TargetProtocol) OnTargetDestroyed(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnTargetDestroyedChan returns a channel of Target.targetDestroyed events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetDestroyed
+*/
+func (protocol *TargetProtocol) OnTargetDestroyedChan(
+	ctx context.Context,
+) <-chan *target.DestroyedEvent {
+	eventChan := make(chan *target.DestroyedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnTargetDestroyed(func(event *target.DestroyedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -503,7 +837,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetInfoC
 */
 func (protocol *TargetProtocol) OnTargetInfoChanged(
 	callback func(event *target.InfoChangedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Target.targetInfoChanged",
 		func(response *Response) {
@@ -515,5 +849,27 @@ func (protocol *TargetProtocol) OnTargetInfoChanged(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnTargetInfoChangedChan returns a channel of Target.targetInfoChanged events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetInfoChanged
+*/
+func (protocol *TargetProtocol) OnTargetInfoChangedChan(
+	ctx context.Context,
+) <-chan *target.InfoChangedEvent {
+	eventChan := make(chan *target.InfoChangedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnTargetInfoChanged(func(event *target.InfoChangedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/tethering"
@@ -8,30 +11,47 @@ import (
 
 /*
 TetheringProtocol provides a namespace for the Chrome Tethering protocol
-methods. The Tethering protocol defines methods and events for browser port
-binding.
+methods.
+
+The Tethering domain defines methods and events for browser port binding.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tethering/
+EXPERIMENTAL.
 */
 type TetheringProtocol struct {
 	Socket Socketer
 }
 
 /*
-Bind requests browser port binding.
+Bind request browser port binding.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tethering/#method-bind
 */
 func (protocol *TetheringProtocol) Bind(
 	params *tethering.BindParams,
 ) <-chan *tethering.BindResult {
-	resultChan := make(chan *tethering.BindResult)
+	return protocol.BindContext(context.Background(), params)
+}
+
+/*
+BindContext performs Bind and returns its result, or returns the context error
+if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Tethering/#method-bind
+*/
+func (protocol *TetheringProtocol) BindContext(
+	ctx context.Context,
+	params *tethering.BindParams,
+) <-chan *tethering.BindResult {
+	resultChan := make(chan *tethering.BindResult, 1)
 	command := NewCommand(protocol.Socket, "Tethering.bind", params)
 	result := &tethering.BindResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -42,20 +62,35 @@ func (protocol *TetheringProtocol) Bind(
 }
 
 /*
-Unbind requests browser port unbinding.
+Unbind request browser port unbinding.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tethering/#method-unbind
 */
 func (protocol *TetheringProtocol) Unbind(
 	params *tethering.UnbindParams,
 ) <-chan *tethering.UnbindResult {
-	resultChan := make(chan *tethering.UnbindResult)
+	return protocol.UnbindContext(context.Background(), params)
+}
+
+/*
+UnbindContext performs Unbind and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Tethering/#method-unbind
+*/
+func (protocol *TetheringProtocol) UnbindContext(
+	ctx context.Context,
+	params *tethering.UnbindParams,
+) <-chan *tethering.UnbindResult {
+	resultChan := make(chan *tethering.UnbindResult, 1)
 	command := NewCommand(protocol.Socket, "Tethering.unbind", params)
 	result := &tethering.UnbindResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -66,14 +101,14 @@ func (protocol *TetheringProtocol) Unbind(
 }
 
 /*
-OnAccepted adds a handler to the Tethering.accepted event. Tethering.accepted
-fires when a port was successfully bound and got a specified connection id.
+OnAccepted adds a handler to the Tethering.accepted event. Informs that port was
+successfully bound and got a specified connection id.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tethering/#event-accepted
 */
 func (protocol *TetheringProtocol) OnAccepted(
 	callback func(event *tethering.AcceptedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Tethering.accepted",
 		func(response *Response) {
@@ -85,5 +120,27 @@ func (protocol *TetheringProtocol) OnAccepted(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnAcceptedChan returns a channel of Tethering.accepted events. The event handler
+is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Tethering/#event-accepted
+*/
+func (protocol *TetheringProtocol) OnAcceptedChan(
+	ctx context.Context,
+) <-chan *tethering.AcceptedEvent {
+	eventChan := make(chan *tethering.AcceptedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnAccepted(func(event *tethering.AcceptedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
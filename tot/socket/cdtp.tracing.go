@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/tracing"
@@ -21,13 +22,25 @@ End stops trace events collection.
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-end
 */
 func (protocol *TracingProtocol) End() <-chan *tracing.EndResult {
-	resultChan := make(chan *tracing.EndResult)
+	return protocol.EndContext(context.Background())
+}
+
+/*
+EndContext performs End and returns its result, or returns the context error if
+ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-end
+*/
+func (protocol *TracingProtocol) EndContext(ctx context.Context) <-chan *tracing.EndResult {
+	resultChan := make(chan *tracing.EndResult, 1)
 	command := NewCommand(protocol.Socket, "Tracing.end", nil)
 	result := &tracing.EndResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -43,13 +56,25 @@ GetCategories gets supported tracing categories.
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-getCategories
 */
 func (protocol *TracingProtocol) GetCategories() <-chan *tracing.GetCategoriesResult {
-	resultChan := make(chan *tracing.GetCategoriesResult)
+	return protocol.GetCategoriesContext(context.Background())
+}
+
+/*
+GetCategoriesContext performs GetCategories and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-getCategories
+*/
+func (protocol *TracingProtocol) GetCategoriesContext(ctx context.Context) <-chan *tracing.GetCategoriesResult {
+	resultChan := make(chan *tracing.GetCategoriesResult, 1)
 	command := NewCommand(protocol.Socket, "Tracing.getCategories", nil)
 	result := &tracing.GetCategoriesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -68,13 +93,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-recordClo
 func (protocol *TracingProtocol) RecordClockSyncMarker(
 	params *tracing.RecordClockSyncMarkerParams,
 ) <-chan *tracing.RecordClockSyncMarkerResult {
-	resultChan := make(chan *tracing.RecordClockSyncMarkerResult)
+	return protocol.RecordClockSyncMarkerContext(context.Background(), params)
+}
+
+/*
+RecordClockSyncMarkerContext performs RecordClockSyncMarker and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-recordClockSyncMarker
+*/
+func (protocol *TracingProtocol) RecordClockSyncMarkerContext(
+	ctx context.Context,
+	params *tracing.RecordClockSyncMarkerParams,
+) <-chan *tracing.RecordClockSyncMarkerResult {
+	resultChan := make(chan *tracing.RecordClockSyncMarkerResult, 1)
 	command := NewCommand(protocol.Socket, "Tracing.recordClockSyncMarker", params)
 	result := &tracing.RecordClockSyncMarkerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -90,13 +131,26 @@ RequestMemoryDump requests a global memory dump.
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-requestMemoryDump
 */
 func (protocol *TracingProtocol) RequestMemoryDump() <-chan *tracing.RequestMemoryDumpResult {
-	resultChan := make(chan *tracing.RequestMemoryDumpResult)
+	return protocol.RequestMemoryDumpContext(context.Background())
+}
+
+/*
+RequestMemoryDumpContext performs RequestMemoryDump and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-requestMemoryDump
+*/
+func (protocol *TracingProtocol) RequestMemoryDumpContext(ctx context.Context) <-chan *tracing.RequestMemoryDumpResult {
+	resultChan := make(chan *tracing.RequestMemoryDumpResult, 1)
 	command := NewCommand(protocol.Socket, "Tracing.requestMemoryDump", nil)
 	result := &tracing.RequestMemoryDumpResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -115,13 +169,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-start
 func (protocol *TracingProtocol) Start(
 	params *tracing.StartParams,
 ) <-chan *tracing.StartResult {
-	resultChan := make(chan *tracing.StartResult)
+	return protocol.StartContext(context.Background(), params)
+}
+
+/*
+StartContext performs Start and returns its result, or returns the context error
+if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-start
+*/
+func (protocol *TracingProtocol) StartContext(
+	ctx context.Context,
+	params *tracing.StartParams,
+) <-chan *tracing.StartResult {
+	resultChan := make(chan *tracing.StartResult, 1)
 	command := NewCommand(protocol.Socket, "Tracing.start", params)
 	result := &tracing.StartResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -139,7 +208,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#event-bufferUsag
 */
 func (protocol *TracingProtocol) OnBufferUsage(
 	callback func(event *tracing.BufferUsageEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Tracing.bufferUsage",
 		func(response *Response) {
@@ -151,7 +220,29 @@ func (protocol *TracingProtocol) OnBufferUsage(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnBufferUsageChan returns a channel of Tracing.bufferUsage events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#event-bufferUsage
+*/
+func (protocol *TracingProtocol) OnBufferUsageChan(
+	ctx context.Context,
+) <-chan *tracing.BufferUsageEvent {
+	eventChan := make(chan *tracing.BufferUsageEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnBufferUsage(func(event *tracing.BufferUsageEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -164,7 +255,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#event-dataCollec
 */
 func (protocol *TracingProtocol) OnDataCollected(
 	callback func(event *tracing.DataCollectedEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Tracing.dataCollected",
 		func(response *Response) {
@@ -176,7 +267,29 @@ func (protocol *TracingProtocol) OnDataCollected(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnDataCollectedChan returns a channel of Tracing.dataCollected events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#event-dataCollected
+*/
+func (protocol *TracingProtocol) OnDataCollectedChan(
+	ctx context.Context,
+) <-chan *tracing.DataCollectedEvent {
+	eventChan := make(chan *tracing.DataCollectedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnDataCollected(func(event *tracing.DataCollectedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
 
 /*
@@ -188,7 +301,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#event-tracingCom
 */
 func (protocol *TracingProtocol) OnTracingComplete(
 	callback func(event *tracing.CompleteEvent),
-) {
+) *Subscription {
 	handler := NewEventHandler(
 		"Tracing.tracingComplete",
 		func(response *Response) {
@@ -200,5 +313,27 @@ func (protocol *TracingProtocol) OnTracingComplete(
 			callback(event)
 		},
 	)
-	protocol.Socket.AddEventHandler(handler)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnTracingCompleteChan returns a channel of Tracing.tracingComplete events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#event-tracingComplete
+*/
+func (protocol *TracingProtocol) OnTracingCompleteChan(
+	ctx context.Context,
+) <-chan *tracing.CompleteEvent {
+	eventChan := make(chan *tracing.CompleteEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnTracingComplete(func(event *tracing.CompleteEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
 }
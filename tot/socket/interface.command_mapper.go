@@ -4,6 +4,9 @@ package socket
 CommandMapper defines a management interface for the stack of pending commands.
 */
 type CommandMapper interface {
+	// All returns the commands in the stack, ordered by ID.
+	All() []Commander
+
 	// Delete removes a command from the stack.
 	Delete(commandID int)
 
@@ -0,0 +1,18 @@
+package socket
+
+/*
+EventDispatcher defines the interface for managing how events are delivered to
+event handlers.
+*/
+type EventDispatcher interface {
+	// DispatchMetrics returns the current event queue metrics.
+	DispatchMetrics() DispatchMetrics
+
+	// DispatchPolicy returns the event dispatch policy of the socket, nil if
+	// every handler runs in its own goroutine.
+	DispatchPolicy() *DispatchPolicy
+
+	// SetDispatchPolicy sets the event dispatch policy. A nil policy runs
+	// every handler in its own goroutine.
+	SetDispatchPolicy(policy *DispatchPolicy)
+}
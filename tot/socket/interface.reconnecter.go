@@ -0,0 +1,27 @@
+package socket
+
+import (
+	"context"
+)
+
+/*
+Reconnecter defines the interface for managing automatic reconnects of a
+socket connection.
+*/
+type Reconnecter interface {
+	// OnConnectionStateChanged adds a handler for connection state changes.
+	OnConnectionStateChanged(callback func(event *ConnectionStateEvent)) *Subscription
+
+	// OnConnectionStateChangedChan returns a channel of connection state
+	// changes. The handler is removed and the channel closed when ctx is
+	// done.
+	OnConnectionStateChangedChan(ctx context.Context) <-chan *ConnectionStateEvent
+
+	// ReconnectPolicy returns the reconnect policy of the socket, nil if the
+	// socket does not reconnect.
+	ReconnectPolicy() *ReconnectPolicy
+
+	// SetReconnectPolicy enables automatic reconnects using policy. A nil
+	// policy disables reconnects.
+	SetReconnectPolicy(policy *ReconnectPolicy)
+}
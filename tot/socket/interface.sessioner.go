@@ -0,0 +1,33 @@
+package socket
+
+import (
+	"context"
+
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+Sessioner defines the interface for multiplexing target sessions over a single
+browser-level websocket connection.
+
+See https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-attachToTarget
+*/
+type Sessioner interface {
+	// AttachToTarget attaches to a target in flattened session mode and
+	// returns a socket for the new session.
+	AttachToTarget(ctx context.Context, targetID target.ID) (*Socket, error)
+
+	// NewSession returns a socket that sends commands to and receives
+	// messages from an existing target session.
+	NewSession(sessionID string) *Socket
+
+	// Session returns the socket for an attached target session.
+	Session(sessionID string) (*Socket, error)
+
+	// SessionID returns the ID of the target session, or an empty string for
+	// a browser-level or page connection.
+	SessionID() string
+
+	// Sessions returns the sockets for all attached target sessions.
+	Sessions() []*Socket
+}
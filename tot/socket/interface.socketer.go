@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"net/url"
 )
 
@@ -32,6 +33,11 @@ type Socketer interface {
 	// SendCommand delivers a command payload to the websocket connection.
 	SendCommand(command Commander) chan *Response
 
+	// SendCommandContext delivers a command payload to the websocket
+	// connection and waits for the response or for the context to be done,
+	// whichever happens first.
+	SendCommandContext(ctx context.Context, command Commander) (*Response, error)
+
 	// Stop signals the socket read loop to stop listening for data and close
 	// the websocket connection.
 	Stop()
@@ -18,9 +18,13 @@ func NewMock(socketURL *url.URL) *Socket {
 	socket := &Socket{
 		commandIDMux: &sync.Mutex{},
 		commands:     NewCommandMap(),
+		enabledMux:   &sync.Mutex{},
 		handlers:     NewEventHandlerMap(),
+		listeningMux: &sync.Mutex{},
 		mux:          &sync.Mutex{},
 		newSocket:    NewMockWebsocket,
+		sessionMux:   &sync.Mutex{},
+		sessions:     make(map[string]*sessionConn),
 		socketID:     NextSocketID(),
 		url:          socketURL,
 	}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"sync"
 	"time"
 
 	errs "github.com/bdlm/errors"
@@ -24,10 +25,13 @@ func NewMockWebsocket(socketURL *url.URL) (WebSocketer, error) {
 
 type MockChromeWebSocket struct {
 	mockResponses []*Response
+	mux           sync.Mutex
 	sleep         time.Duration
 }
 
 func (socket *MockChromeWebSocket) Close() error {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
 	socket.mockResponses = []*Response{{}, {}, {}, {}, {}}
 	return nil
 }
@@ -37,6 +41,8 @@ This method populates a queue of mock data that will be delivered to the
 websocket API for testing.
 */
 func (socket *MockChromeWebSocket) AddMockData(response *Response) {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
 	socket.mockResponses = append(socket.mockResponses, response)
 }
 
@@ -52,15 +58,21 @@ func (socket *MockChromeWebSocket) ReadJSON(v interface{}) error {
 	var data interface{}
 	time.Sleep(time.Millisecond * 10)
 
-	if socket.sleep > 0 {
-		time.Sleep(socket.sleep)
-		socket.sleep = 0
+	socket.mux.Lock()
+	sleep := socket.sleep
+	socket.sleep = 0
+	socket.mux.Unlock()
+	if sleep > 0 {
+		time.Sleep(sleep)
 	}
 
+	socket.mux.Lock()
 	if len(socket.mockResponses) > 0 {
 		data = socket.mockResponses[0]
 		socket.mockResponses = socket.mockResponses[1:]
-	} else {
+	}
+	socket.mux.Unlock()
+	if nil == data {
 		data = &Response{
 			Error:  &Error{},
 			ID:     0,
@@ -82,6 +94,8 @@ Sleep sets the sleep duration for the next ReadJSON call to replicate
 timeouts and delays.
 */
 func (socket *MockChromeWebSocket) Sleep(duration time.Duration) {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
 	socket.sleep = duration
 }
 
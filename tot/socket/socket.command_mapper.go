@@ -2,6 +2,7 @@ package socket
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	errs "github.com/bdlm/errors"
@@ -27,6 +28,24 @@ type CommandMap struct {
 	stack map[int]Commander
 }
 
+/*
+All returns the commands in the stack, ordered by ID.
+
+All is a CommandMapper implementation.
+*/
+func (stack *CommandMap) All() []Commander {
+	stack.mux.Lock()
+	commands := make([]Commander, 0, len(stack.stack))
+	for _, command := range stack.stack {
+		commands = append(commands, command)
+	}
+	stack.mux.Unlock()
+	sort.Slice(commands, func(i, j int) bool {
+		return commands[i].ID() < commands[j].ID()
+	})
+	return commands
+}
+
 /*
 Delete removes a command from the stack.
 
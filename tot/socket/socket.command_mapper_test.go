@@ -11,3 +11,20 @@ func TestSocketCommandMapperError(t *testing.T) {
 		t.Errorf("Expected nil, got error: '%s'", err.Error())
 	}
 }
+
+func TestSocketCommandMapperAll(t *testing.T) {
+	commandMap := NewCommandMap()
+	for _, id := range []int{3, 1, 2} {
+		command := &Command{id: id}
+		commandMap.Set(command)
+	}
+	commands := commandMap.All()
+	if 3 != len(commands) {
+		t.Fatalf("Expected 3 commands, got %d", len(commands))
+	}
+	for k, command := range commands {
+		if k+1 != command.ID() {
+			t.Errorf("Expected command #%d, got #%d", k+1, command.ID())
+		}
+	}
+}
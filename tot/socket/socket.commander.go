@@ -1,5 +1,9 @@
 package socket
 
+import (
+	"github.com/bdlm/log"
+)
+
 /*
 NewCommand creates and returns a pointer to a struct that implements the
 Commander interface.
@@ -9,7 +13,7 @@ func NewCommand(socket Socketer, method string, params interface{}) *Command {
 		id:       socket.NextCommandID(),
 		method:   method,
 		params:   params,
-		response: make(chan *Response),
+		response: make(chan *Response, 1),
 		socket:   socket,
 	}
 }
@@ -76,12 +80,19 @@ func (cmd *Command) Params() interface{} {
 }
 
 /*
-Respond sends a response to the command response channel.
+Respond sends a response to the command response channel. The channel is
+buffered so a command that is no longer being waited on, for example because
+its context was cancelled, does not block the socket read loop.
 
 Respond is a Commander implementation.
 */
 func (cmd *Command) Respond(response *Response) {
-	cmd.response <- response
+	select {
+	case cmd.response <- response:
+	default:
+		log.WithFields(log.Fields{"commandID": cmd.id, "method": cmd.method}).
+			Warn("command response already delivered, discarding")
+	}
 }
 
 /*
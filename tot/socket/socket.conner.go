@@ -77,18 +77,34 @@ func (socket *Socket) Disconnect() error {
 	return err
 }
 
+/*
+connection connects if necessary and returns the websocket connection, which
+is replaced when the socket reconnects.
+*/
+func (socket *Socket) connection() (WebSocketer, error) {
+	if err := socket.Connect(); nil != err {
+		return nil, err
+	}
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	if nil == socket.conn {
+		return nil, errs.New(codes.SocketNotConnected, "connection closed")
+	}
+	return socket.conn, nil
+}
+
 /*
 ReadJSON reads data from a websocket connection.
 
 ReadJSON is a Conner implementation.
 */
 func (socket *Socket) ReadJSON(v interface{}) error {
-	err := socket.Connect()
+	conn, err := socket.connection()
 	if nil != err {
 		return errs.Wrap(err, codes.SocketNotConnected, "not connected")
 	}
 
-	err = socket.conn.ReadJSON(&v)
+	err = conn.ReadJSON(&v)
 	if nil != err {
 		return errs.Wrap(err, codes.SocketReadFailed, "socket read failed")
 	}
@@ -102,12 +118,12 @@ WriteJSON writes data to a websocket connection.
 WriteJSON is a Conner implementation.
 */
 func (socket *Socket) WriteJSON(v interface{}) error {
-	err := socket.Connect()
+	conn, err := socket.connection()
 	if nil != err {
 		return errs.Wrap(err, codes.SocketNotConnected, "not connected")
 	}
 
-	err = socket.conn.WriteJSON(v)
+	err = conn.WriteJSON(v)
 	if nil != err {
 		return errs.Wrap(err, codes.SocketWriteFailed, "socket write failed")
 	}
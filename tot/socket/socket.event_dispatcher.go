@@ -0,0 +1,433 @@
+package socket
+
+import (
+	"fmt"
+	"sync"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+DispatchMode defines how events are ordered when they are delivered.
+*/
+type DispatchMode int
+
+const (
+	// DispatchOrdered - Events are delivered one at a time in the order they
+	// were received by the socket.
+	DispatchOrdered DispatchMode = iota + 1
+	// DispatchOrderedPerEvent - Events with the same name are delivered one
+	// at a time in the order they were received, different events are
+	// delivered concurrently.
+	DispatchOrderedPerEvent
+)
+
+/*
+OverflowPolicy defines what happens when an event arrives and its queue is full.
+*/
+type OverflowPolicy int
+
+const (
+	// OverflowBlock - The socket read loop waits until there is room in the
+	// queue.
+	OverflowBlock OverflowPolicy = iota + 1
+	// OverflowDropOldest - The oldest queued event is discarded.
+	OverflowDropOldest
+	// OverflowError - The new event is discarded and a SocketEventQueueFull
+	// error is sent to the Errors() channel if it has room.
+	OverflowError
+)
+
+/*
+DispatchPolicy defines ordered event delivery through bounded queues.
+
+Handlers for an event are called in the order they were added, and the next
+event is not delivered until they have returned. A handler that waits for a
+command response can therefore block the queue, and with OverflowBlock the
+socket read loop as well.
+*/
+type DispatchPolicy struct {
+	// Optional. Mode defines how events are ordered. Defaults to
+	// DispatchOrdered.
+	Mode DispatchMode
+
+	// Optional. QueueSize is the maximum number of events waiting in a queue.
+	// Defaults to 1000.
+	QueueSize int
+
+	// Optional. Overflow defines what happens when an event arrives and its
+	// queue is full. Defaults to OverflowBlock.
+	Overflow OverflowPolicy
+}
+
+/*
+DispatchMetrics contains event queue metrics.
+*/
+type DispatchMetrics struct {
+	// Depth is the number of events currently queued.
+	Depth int
+
+	// MaxDepth is the largest number of events that have been queued at once.
+	MaxDepth int
+
+	// Dispatched is the number of events delivered to handlers.
+	Dispatched uint64
+
+	// Dropped is the number of queued events discarded by
+	// OverflowDropOldest.
+	Dropped uint64
+
+	// Rejected is the number of events discarded by OverflowError.
+	Rejected uint64
+
+	// Queues holds the current depth of each event queue by event name when
+	// the mode is DispatchOrderedPerEvent.
+	Queues map[string]int
+}
+
+/*
+DispatchMetrics returns the current event queue metrics.
+
+DispatchMetrics is an EventDispatcher implementation.
+*/
+func (socket *Socket) DispatchMetrics() DispatchMetrics {
+	socket.mux.Lock()
+	dispatcher := socket.dispatcher
+	socket.mux.Unlock()
+	if nil == dispatcher {
+		return DispatchMetrics{}
+	}
+	return dispatcher.metrics()
+}
+
+/*
+DispatchPolicy returns the event dispatch policy of the socket, nil if every
+handler runs in its own goroutine.
+
+DispatchPolicy is an EventDispatcher implementation.
+*/
+func (socket *Socket) DispatchPolicy() *DispatchPolicy {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	if nil == socket.dispatcher {
+		return nil
+	}
+	return socket.dispatcher.policy
+}
+
+/*
+SetDispatchPolicy sets the event dispatch policy. A nil policy runs every
+handler in its own goroutine. Events already queued under a previous policy are
+still delivered.
+
+SetDispatchPolicy is an EventDispatcher implementation.
+*/
+func (socket *Socket) SetDispatchPolicy(policy *DispatchPolicy) {
+	socket.mux.Lock()
+	previous := socket.dispatcher
+	socket.dispatcher = nil
+	if nil != policy {
+		socket.dispatcher = newEventDispatcher(socket, policy)
+	}
+	socket.mux.Unlock()
+	if nil != previous {
+		previous.close()
+	}
+}
+
+/*
+resetDispatcher stops the event queue workers once the queued events have been
+delivered. The dispatch policy is kept for the next time the socket listens.
+*/
+func (socket *Socket) resetDispatcher() {
+	socket.mux.Lock()
+	previous := socket.dispatcher
+	if nil != previous {
+		socket.dispatcher = newEventDispatcher(socket, previous.policy)
+	}
+	socket.mux.Unlock()
+	if nil != previous {
+		previous.close()
+	}
+}
+
+/*
+eventDispatcher delivers events through bounded queues according to a
+DispatchPolicy.
+*/
+type eventDispatcher struct {
+	closed     bool
+	dispatched uint64
+	dropped    uint64
+	maxDepth   int
+	mux        *sync.Mutex
+	policy     *DispatchPolicy
+	queues     map[string]*eventQueue
+	rejected   uint64
+	socket     *Socket
+}
+
+/*
+newEventDispatcher returns an eventDispatcher for policy.
+*/
+func newEventDispatcher(socket *Socket, policy *DispatchPolicy) *eventDispatcher {
+	return &eventDispatcher{
+		mux:    &sync.Mutex{},
+		policy: policy,
+		queues: make(map[string]*eventQueue),
+		socket: socket,
+	}
+}
+
+/*
+close stops accepting events. Queued events are still delivered.
+*/
+func (dispatcher *eventDispatcher) close() {
+	dispatcher.mux.Lock()
+	dispatcher.closed = true
+	queues := dispatcher.queues
+	dispatcher.queues = make(map[string]*eventQueue)
+	dispatcher.mux.Unlock()
+	for _, queue := range queues {
+		queue.close()
+	}
+}
+
+/*
+dispatch queues an event for delivery to handlers.
+*/
+func (dispatcher *eventDispatcher) dispatch(response *Response, handlers []EventHandler) {
+	name := ""
+	if DispatchOrderedPerEvent == dispatcher.policy.Mode {
+		name = response.Method
+	}
+
+	dispatcher.mux.Lock()
+	if dispatcher.closed {
+		dispatcher.mux.Unlock()
+		return
+	}
+	queue, ok := dispatcher.queues[name]
+	if !ok {
+		size := dispatcher.policy.QueueSize
+		if size <= 0 {
+			size = 1000
+		}
+		queue = newEventQueue(dispatcher, size)
+		dispatcher.queues[name] = queue
+	}
+	dispatcher.mux.Unlock()
+
+	queue.push(&queuedEvent{handlers: handlers, response: response})
+}
+
+/*
+metrics returns the current event queue metrics.
+
+The dispatcher lock is never held while a queue lock is taken, queues update
+the dispatcher counters while holding their own lock. The queues are copied
+and their depth read after the dispatcher lock is released.
+*/
+func (dispatcher *eventDispatcher) metrics() DispatchMetrics {
+	dispatcher.mux.Lock()
+	metrics := DispatchMetrics{
+		Dispatched: dispatcher.dispatched,
+		Dropped:    dispatcher.dropped,
+		MaxDepth:   dispatcher.maxDepth,
+		Rejected:   dispatcher.rejected,
+	}
+	if DispatchOrderedPerEvent == dispatcher.policy.Mode {
+		metrics.Queues = make(map[string]int, len(dispatcher.queues))
+	}
+	queues := dispatcher.snapshot()
+	dispatcher.mux.Unlock()
+
+	for name, queue := range queues {
+		depth := queue.depth()
+		metrics.Depth += depth
+		if nil != metrics.Queues {
+			metrics.Queues[name] = depth
+		}
+	}
+	return metrics
+}
+
+/*
+count updates the dispatcher counters.
+*/
+func (dispatcher *eventDispatcher) count(dispatched, dropped, rejected uint64) {
+	dispatcher.mux.Lock()
+	dispatcher.dispatched += dispatched
+	dispatcher.dropped += dropped
+	dispatcher.rejected += rejected
+	dispatcher.mux.Unlock()
+}
+
+/*
+depthChanged records the high-water mark of the total queue depth.
+*/
+func (dispatcher *eventDispatcher) depthChanged() {
+	dispatcher.mux.Lock()
+	queues := dispatcher.snapshot()
+	dispatcher.mux.Unlock()
+
+	depth := 0
+	for _, queue := range queues {
+		depth += queue.depth()
+	}
+	dispatcher.mux.Lock()
+	if depth > dispatcher.maxDepth {
+		dispatcher.maxDepth = depth
+	}
+	dispatcher.mux.Unlock()
+}
+
+/*
+snapshot returns a copy of the event queues. The caller must hold the
+dispatcher lock.
+*/
+func (dispatcher *eventDispatcher) snapshot() map[string]*eventQueue {
+	queues := make(map[string]*eventQueue, len(dispatcher.queues))
+	for name, queue := range dispatcher.queues {
+		queues[name] = queue
+	}
+	return queues
+}
+
+/*
+queuedEvent is an event waiting to be delivered and the handlers that were
+registered when it arrived.
+*/
+type queuedEvent struct {
+	handlers []EventHandler
+	response *Response
+}
+
+/*
+eventQueue is a bounded FIFO queue of events delivered by a single worker
+goroutine.
+*/
+type eventQueue struct {
+	closed     bool
+	dispatcher *eventDispatcher
+	events     []*queuedEvent
+	mux        *sync.Mutex
+	notEmpty   *sync.Cond
+	notFull    *sync.Cond
+	size       int
+}
+
+/*
+newEventQueue returns a running eventQueue that holds at most size events.
+*/
+func newEventQueue(dispatcher *eventDispatcher, size int) *eventQueue {
+	mux := &sync.Mutex{}
+	queue := &eventQueue{
+		dispatcher: dispatcher,
+		events:     make([]*queuedEvent, 0, size),
+		mux:        mux,
+		notEmpty:   sync.NewCond(mux),
+		notFull:    sync.NewCond(mux),
+		size:       size,
+	}
+	go queue.run()
+	return queue
+}
+
+/*
+close stops the worker once the queued events have been delivered.
+*/
+func (queue *eventQueue) close() {
+	queue.mux.Lock()
+	queue.closed = true
+	queue.notEmpty.Broadcast()
+	queue.notFull.Broadcast()
+	queue.mux.Unlock()
+}
+
+/*
+depth returns the number of queued events.
+*/
+func (queue *eventQueue) depth() int {
+	queue.mux.Lock()
+	defer queue.mux.Unlock()
+	return len(queue.events)
+}
+
+/*
+push adds an event to the queue, applying the overflow policy if it is full.
+*/
+func (queue *eventQueue) push(event *queuedEvent) {
+	socket := queue.dispatcher.socket
+	dropped := []*queuedEvent{}
+	// Dropped events are counted once the queue lock is released, see
+	// eventDispatcher.metrics.
+	defer func() {
+		for _, event := range dropped {
+			queue.dispatcher.count(0, 1, 0)
+			log.WithFields(log.Fields{"event": event.response.Method, "socketID": socket.socketID}).
+				Warn("event queue full, dropped oldest event")
+		}
+	}()
+
+	queue.mux.Lock()
+	for len(queue.events) >= queue.size && !queue.closed {
+		switch queue.dispatcher.policy.Overflow {
+		case OverflowDropOldest:
+			dropped = append(dropped, queue.events[0])
+			queue.events = queue.events[1:]
+
+		case OverflowError:
+			queue.mux.Unlock()
+			queue.dispatcher.count(0, 0, 1)
+			err := errs.New(codes.SocketEventQueueFull, fmt.Sprintf("event queue full, rejected '%s' event", event.response.Method))
+			log.WithFields(log.Fields{"error": err, "socketID": socket.socketID}).
+				Warn(err)
+			select {
+			case socket.errCh <- err:
+			default:
+			}
+			return
+
+		default:
+			queue.notFull.Wait()
+		}
+	}
+	if queue.closed {
+		queue.mux.Unlock()
+		return
+	}
+	queue.events = append(queue.events, event)
+	queue.notEmpty.Signal()
+	queue.mux.Unlock()
+	queue.dispatcher.depthChanged()
+}
+
+/*
+run delivers queued events to their handlers one at a time.
+*/
+func (queue *eventQueue) run() {
+	for {
+		queue.mux.Lock()
+		for 0 == len(queue.events) && !queue.closed {
+			queue.notEmpty.Wait()
+		}
+		if 0 == len(queue.events) {
+			queue.mux.Unlock()
+			return
+		}
+		event := queue.events[0]
+		queue.events = queue.events[1:]
+		queue.notFull.Signal()
+		queue.mux.Unlock()
+
+		for a, handler := range event.handlers {
+			log.WithFields(log.Fields{"event": event.response.Method, "handler#": a, "socketID": queue.dispatcher.socket.socketID}).
+				Info("Executing handler")
+			handler.Handle(event.response)
+		}
+		queue.dispatcher.count(1, 0, 0)
+	}
+}
@@ -0,0 +1,274 @@
+package socket
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+blockingHandler records the events it receives. The first event blocks until
+release is closed.
+*/
+type blockingHandler struct {
+	events  []string
+	mux     sync.Mutex
+	release chan bool
+	started chan bool
+}
+
+func newBlockingHandler() *blockingHandler {
+	return &blockingHandler{
+		release: make(chan bool),
+		started: make(chan bool, 1),
+	}
+}
+
+func (handler *blockingHandler) handle(response *Response) {
+	handler.mux.Lock()
+	handler.events = append(handler.events, string(response.Params))
+	first := 1 == len(handler.events)
+	handler.mux.Unlock()
+	if first {
+		handler.started <- true
+		<-handler.release
+	}
+}
+
+func (handler *blockingHandler) received(count int) []string {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		handler.mux.Lock()
+		events := append([]string{}, handler.events...)
+		handler.mux.Unlock()
+		if len(events) >= count {
+			return events
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	handler.mux.Lock()
+	defer handler.mux.Unlock()
+	return append([]string{}, handler.events...)
+}
+
+func sendEvents(socket *Socket, method string, from, to int) {
+	for a := from; a <= to; a++ {
+		socket.handleEvent(&Response{Method: method, Params: []byte(fmt.Sprintf("%d", a))})
+	}
+}
+
+func expectEvents(t *testing.T, expected, events []string) {
+	if fmt.Sprintf("%v", expected) != fmt.Sprintf("%v", events) {
+		t.Errorf("Expected events %v, got %v", expected, events)
+	}
+}
+
+func TestDispatchOrdered(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDispatchOrdered")
+	mockSocket := NewMock(socketURL)
+	mockSocket.SetDispatchPolicy(&DispatchPolicy{})
+	defer mockSocket.SetDispatchPolicy(nil)
+
+	events := []string{}
+	mux := sync.Mutex{}
+	for _, method := range []string{"Network.requestWillBeSent", "Network.responseReceived"} {
+		method := method
+		mockSocket.AddEventHandler(NewEventHandler(method, func(response *Response) {
+			mux.Lock()
+			events = append(events, fmt.Sprintf("%s:%s", method, response.Params))
+			mux.Unlock()
+		}))
+	}
+
+	expected := []string{}
+	for a := 0; a < 50; a++ {
+		for _, method := range []string{"Network.requestWillBeSent", "Network.responseReceived"} {
+			mockSocket.handleEvent(&Response{Method: method, Params: []byte(fmt.Sprintf("%d", a))})
+			expected = append(expected, fmt.Sprintf("%s:%d", method, a))
+		}
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) && uint64(len(expected)) != mockSocket.DispatchMetrics().Dispatched {
+		time.Sleep(5 * time.Millisecond)
+	}
+	mux.Lock()
+	expectEvents(t, expected, events)
+	mux.Unlock()
+
+	metrics := mockSocket.DispatchMetrics()
+	if 0 != metrics.Depth {
+		t.Errorf("Expected an empty queue, got depth %d", metrics.Depth)
+	}
+	if metrics.MaxDepth < 1 {
+		t.Errorf("Expected a max depth of at least 1, got %d", metrics.MaxDepth)
+	}
+}
+
+func TestDispatchOrderedPerEvent(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDispatchOrderedPerEvent")
+	mockSocket := NewMock(socketURL)
+	mockSocket.SetDispatchPolicy(&DispatchPolicy{Mode: DispatchOrderedPerEvent})
+	defer mockSocket.SetDispatchPolicy(nil)
+
+	blocked := newBlockingHandler()
+	mockSocket.AddEventHandler(NewEventHandler("DOM.childNodeInserted", blocked.handle))
+	other := newBlockingHandler()
+	close(other.release)
+	mockSocket.AddEventHandler(NewEventHandler("DOM.childNodeRemoved", other.handle))
+
+	sendEvents(mockSocket, "DOM.childNodeInserted", 1, 1)
+	<-blocked.started
+	sendEvents(mockSocket, "DOM.childNodeInserted", 2, 3)
+	sendEvents(mockSocket, "DOM.childNodeRemoved", 1, 3)
+
+	// A blocked queue does not hold up other events.
+	expectEvents(t, []string{"1", "2", "3"}, other.received(3))
+	metrics := mockSocket.DispatchMetrics()
+	if 2 != metrics.Queues["DOM.childNodeInserted"] {
+		t.Errorf("Expected a queue depth of 2, got %d", metrics.Queues["DOM.childNodeInserted"])
+	}
+	if 2 != metrics.Depth {
+		t.Errorf("Expected a total depth of 2, got %d", metrics.Depth)
+	}
+
+	close(blocked.release)
+	expectEvents(t, []string{"1", "2", "3"}, blocked.received(3))
+}
+
+func TestDispatchOverflowBlock(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDispatchOverflowBlock")
+	mockSocket := NewMock(socketURL)
+	mockSocket.SetDispatchPolicy(&DispatchPolicy{QueueSize: 2, Overflow: OverflowBlock})
+	defer mockSocket.SetDispatchPolicy(nil)
+
+	handler := newBlockingHandler()
+	mockSocket.AddEventHandler(NewEventHandler("Page.frameNavigated", handler.handle))
+
+	sendEvents(mockSocket, "Page.frameNavigated", 1, 1)
+	<-handler.started
+	sendEvents(mockSocket, "Page.frameNavigated", 2, 3)
+
+	done := make(chan bool)
+	go func() {
+		sendEvents(mockSocket, "Page.frameNavigated", 4, 4)
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Errorf("Expected the dispatch to block while the queue is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(handler.release)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Errorf("Expected the dispatch to resume when the queue drained")
+	}
+	expectEvents(t, []string{"1", "2", "3", "4"}, handler.received(4))
+	if 2 != mockSocket.DispatchMetrics().MaxDepth {
+		t.Errorf("Expected a max depth of 2, got %d", mockSocket.DispatchMetrics().MaxDepth)
+	}
+}
+
+func TestDispatchOverflowDropOldest(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDispatchOverflowDropOldest")
+	mockSocket := NewMock(socketURL)
+	mockSocket.SetDispatchPolicy(&DispatchPolicy{QueueSize: 2, Overflow: OverflowDropOldest})
+	defer mockSocket.SetDispatchPolicy(nil)
+
+	handler := newBlockingHandler()
+	mockSocket.AddEventHandler(NewEventHandler("Page.frameNavigated", handler.handle))
+
+	sendEvents(mockSocket, "Page.frameNavigated", 1, 1)
+	<-handler.started
+	sendEvents(mockSocket, "Page.frameNavigated", 2, 5)
+	close(handler.release)
+
+	expectEvents(t, []string{"1", "4", "5"}, handler.received(3))
+	if 2 != mockSocket.DispatchMetrics().Dropped {
+		t.Errorf("Expected 2 dropped events, got %d", mockSocket.DispatchMetrics().Dropped)
+	}
+}
+
+func TestDispatchOverflowDropOldestMetrics(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDispatchOverflowDropOldestMetrics")
+	mockSocket := NewMock(socketURL)
+	mockSocket.SetDispatchPolicy(&DispatchPolicy{QueueSize: 1, Overflow: OverflowDropOldest})
+	defer mockSocket.SetDispatchPolicy(nil)
+
+	handler := newBlockingHandler()
+	mockSocket.AddEventHandler(NewEventHandler("Page.frameNavigated", handler.handle))
+	sendEvents(mockSocket, "Page.frameNavigated", 1, 1)
+	<-handler.started
+
+	// Reading the metrics while events overflow the queue must not deadlock.
+	stop := make(chan bool)
+	readers := sync.WaitGroup{}
+	for a := 0; a < 4; a++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					mockSocket.DispatchMetrics()
+				}
+			}
+		}()
+	}
+	sent := make(chan bool)
+	go func() {
+		sendEvents(mockSocket, "Page.frameNavigated", 2, 501)
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(10 * time.Second):
+		t.Fatalf("Timed out dispatching events")
+	}
+	close(stop)
+	readers.Wait()
+	close(handler.release)
+
+	if 499 != mockSocket.DispatchMetrics().Dropped {
+		t.Errorf("Expected 499 dropped events, got %d", mockSocket.DispatchMetrics().Dropped)
+	}
+}
+
+func TestDispatchOverflowError(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDispatchOverflowError")
+	mockSocket := NewMock(socketURL)
+	mockSocket.errCh = make(chan error, 3)
+	mockSocket.SetDispatchPolicy(&DispatchPolicy{QueueSize: 2, Overflow: OverflowError})
+	defer mockSocket.SetDispatchPolicy(nil)
+
+	handler := newBlockingHandler()
+	mockSocket.AddEventHandler(NewEventHandler("Page.frameNavigated", handler.handle))
+
+	sendEvents(mockSocket, "Page.frameNavigated", 1, 1)
+	<-handler.started
+	sendEvents(mockSocket, "Page.frameNavigated", 2, 4)
+	close(handler.release)
+
+	expectEvents(t, []string{"1", "2", "3"}, handler.received(3))
+	if 1 != mockSocket.DispatchMetrics().Rejected {
+		t.Errorf("Expected 1 rejected event, got %d", mockSocket.DispatchMetrics().Rejected)
+	}
+	select {
+	case err := <-mockSocket.Errors():
+		if codes.SocketEventQueueFull != err.(errs.Err).Code() {
+			t.Errorf("Expected code %d, got %d", codes.SocketEventQueueFull, err.(errs.Err).Code())
+		}
+	default:
+		t.Errorf("Expected a queue full error")
+	}
+}
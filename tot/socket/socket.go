@@ -32,19 +32,22 @@ func (err Error) Error() string {
 Response represents a socket message.
 */
 type Response struct {
-	Error  *Error          `json:"error"`
-	ID     int             `json:"id"`
-	Method string          `json:"method"`
-	Params json.RawMessage `json:"params"`
-	Result json.RawMessage `json:"result"`
+	Error     *Error          `json:"error"`
+	ID        int             `json:"id"`
+	Method    string          `json:"method"`
+	Params    json.RawMessage `json:"params"`
+	Result    json.RawMessage `json:"result"`
+	SessionID string          `json:"sessionId,omitempty"`
 }
 
 /*
 Payload represents a WebSocket JSON payload for a sending a command to the
-websocket.
+websocket. SessionID is set when the command is sent to a target session over
+a browser-level connection.
 */
 type Payload struct {
-	ID     int         `json:"id"`
-	Method string      `json:"method"`
-	Params interface{} `json:"params"`
+	ID        int         `json:"id"`
+	Method    string      `json:"method"`
+	Params    interface{} `json:"params"`
+	SessionID string      `json:"sessionId,omitempty"`
 }
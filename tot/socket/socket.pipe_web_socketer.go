@@ -0,0 +1,136 @@
+package socket
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/url"
+	"sync"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+PipeURL is the URL reported by sockets connected over a debugging pipe.
+*/
+var PipeURL = &url.URL{Scheme: "pipe", Host: "browser"}
+
+/*
+NewPipe returns a WebSocketer that exchanges NUL-delimited JSON messages with
+Chromium's --remote-debugging-pipe interface. Chromium reads commands from file
+descriptor 3 and writes responses and events to file descriptor 4, reader
+should be connected to the latter and writer to the former.
+*/
+func NewPipe(reader io.ReadCloser, writer io.WriteCloser) *PipeWebSocket {
+	return &PipeWebSocket{
+		reader: bufio.NewReader(reader),
+		rc:     reader,
+		writer: writer,
+	}
+}
+
+/*
+NewPipeSocket returns a pointer to a Socket listening to a debugging pipe. A
+pipe cannot be reopened, reconnects fail once the pipe has been closed.
+*/
+func NewPipeSocket(reader io.ReadCloser, writer io.WriteCloser) *Socket {
+	pipe := NewPipe(reader, writer)
+	socket := NewWithWebsocket(PipeURL, func(socketURL *url.URL) (WebSocketer, error) {
+		if pipe.isClosed() {
+			return nil, errs.New(codes.WebsocketConnectFailed, "the debugging pipe has been closed")
+		}
+		return pipe, nil
+	})
+	socket.Listen()
+
+	log.WithFields(log.Fields{"socketID": socket.socketID, "url": socket.url.String()}).
+		Info("New pipe connection listening")
+
+	return socket
+}
+
+/*
+PipeWebSocket provides a WebSocketer interface for a Chromium debugging pipe.
+
+PipeWebSocket represents a WebSocketer interface
+*/
+type PipeWebSocket struct {
+	closed    bool
+	closedMux sync.Mutex
+	reader    *bufio.Reader
+	rc        io.ReadCloser
+	writer    io.WriteCloser
+	// writeMux serializes writes, messages must not interleave.
+	writeMux sync.Mutex
+}
+
+/*
+Close closes both ends of the pipe.
+
+Close is a WebSocketer implementation.
+*/
+func (pipe *PipeWebSocket) Close() error {
+	pipe.closedMux.Lock()
+	defer pipe.closedMux.Unlock()
+	if pipe.closed {
+		return nil
+	}
+	pipe.closed = true
+	werr := pipe.writer.Close()
+	rerr := pipe.rc.Close()
+	if nil != werr {
+		return errs.Wrap(werr, codes.SocketCloseFailed, "could not close the debugging pipe")
+	}
+	if nil != rerr {
+		return errs.Wrap(rerr, codes.SocketCloseFailed, "could not close the debugging pipe")
+	}
+	return nil
+}
+
+/*
+ReadJSON reads the next NUL-delimited message from the pipe and unmarshalls it
+into the provided variable.
+
+ReadJSON is a WebSocketer implementation.
+*/
+func (pipe *PipeWebSocket) ReadJSON(v interface{}) error {
+	if pipe.isClosed() {
+		return errs.New(codes.WebsocketNotConnected, "not connected")
+	}
+	message, err := pipe.reader.ReadBytes(0)
+	if nil != err {
+		return errs.Wrap(err, codes.SocketReadFailed, "could not read from the debugging pipe")
+	}
+	return json.Unmarshal(message[:len(message)-1], v)
+}
+
+/*
+WriteJSON marshalls the provided data as JSON and writes it to the pipe followed
+by a NUL byte.
+
+WriteJSON is a WebSocketer implementation.
+*/
+func (pipe *PipeWebSocket) WriteJSON(v interface{}) error {
+	if pipe.isClosed() {
+		return errs.New(codes.WebsocketNotConnected, "not connected")
+	}
+	message, err := json.Marshal(v)
+	if nil != err {
+		return err
+	}
+	pipe.writeMux.Lock()
+	defer pipe.writeMux.Unlock()
+	_, err = pipe.writer.Write(append(message, 0))
+	return err
+}
+
+/*
+isClosed returns whether the pipe has been closed.
+*/
+func (pipe *PipeWebSocket) isClosed() bool {
+	pipe.closedMux.Lock()
+	defer pipe.closedMux.Unlock()
+	return pipe.closed
+}
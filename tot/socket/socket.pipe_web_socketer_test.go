@@ -0,0 +1,89 @@
+package socket
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestPipeReadWrite(t *testing.T) {
+	cmdReader, cmdWriter := io.Pipe()
+	respReader, respWriter := io.Pipe()
+	pipe := NewPipe(respReader, cmdWriter)
+
+	go func() {
+		respWriter.Write([]byte(`{"id":1,"result":{}}` + "\x00" + `{"method":"Page.loadEventFired","params":{"timestamp":1}}` + "\x00"))
+	}()
+	for _, expected := range []string{"", "Page.loadEventFired"} {
+		response := &Response{}
+		if err := pipe.ReadJSON(response); nil != err {
+			t.Fatalf("Expected nil, got error: '%s'", err.Error())
+		}
+		if expected != response.Method {
+			t.Errorf("Expected method '%s', got '%s'", expected, response.Method)
+		}
+	}
+
+	go pipe.WriteJSON(&Payload{ID: 1, Method: "Page.enable"})
+	message, err := bufio.NewReader(cmdReader).ReadBytes(0)
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if !bytes.HasSuffix(message, []byte("}\x00")) {
+		t.Errorf("Expected a NUL-delimited message, got '%s'", message)
+	}
+	payload := &Payload{}
+	if err := json.Unmarshal(message[:len(message)-1], payload); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if "Page.enable" != payload.Method {
+		t.Errorf("Expected method 'Page.enable', got '%s'", payload.Method)
+	}
+
+	if err := pipe.Close(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+	if err := pipe.WriteJSON(&Payload{ID: 2}); nil == err {
+		t.Errorf("Expected an error writing to a closed pipe")
+	}
+}
+
+func TestPipeSocket(t *testing.T) {
+	cmdReader, cmdWriter := io.Pipe()
+	respReader, respWriter := io.Pipe()
+
+	// Echo an empty result for each command.
+	go func() {
+		reader := bufio.NewReader(cmdReader)
+		for {
+			message, err := reader.ReadBytes(0)
+			if nil != err {
+				return
+			}
+			payload := &Payload{}
+			json.Unmarshal(message[:len(message)-1], payload)
+			response, _ := json.Marshal(&Response{ID: payload.ID, Result: []byte(`{"product":"HeadlessChrome/70.0"}`)})
+			respWriter.Write(append(response, 0))
+		}
+	}()
+
+	pipeSocket := NewPipeSocket(respReader, cmdWriter)
+	defer pipeSocket.Stop()
+	if PipeURL != pipeSocket.URL() {
+		t.Errorf("Expected URL '%s', got '%s'", PipeURL, pipeSocket.URL())
+	}
+	select {
+	case result := <-pipeSocket.Browser().GetVersion():
+		if nil != result.Err {
+			t.Fatalf("Expected nil, got error: '%s'", result.Err.Error())
+		}
+		if "HeadlessChrome/70.0" != result.Product {
+			t.Errorf("Expected 'HeadlessChrome/70.0', got '%s'", result.Product)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Timed out waiting for the command response")
+	}
+}
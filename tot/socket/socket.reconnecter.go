@@ -0,0 +1,359 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+ConnectionStateChangedEvent is the name of the event emitted by a socket when
+its connection state changes.
+*/
+const ConnectionStateChangedEvent = "Socket.connectionStateChanged"
+
+/*
+ConnectionState represents the state of a socket connection.
+*/
+type ConnectionState int
+
+const (
+	// StateConnected - The connection has been (re-)established.
+	StateConnected ConnectionState = iota + 1
+	// StateDisconnected - The connection dropped.
+	StateDisconnected
+	// StateReconnecting - A reconnect attempt is being made.
+	StateReconnecting
+	// StateFailed - All reconnect attempts failed, the socket has stopped.
+	StateFailed
+)
+
+var _connectionStates = map[ConnectionState]string{
+	StateConnected:    "connected",
+	StateDisconnected: "disconnected",
+	StateReconnecting: "reconnecting",
+	StateFailed:       "failed",
+}
+
+/*
+String implements Stringer.
+*/
+func (state ConnectionState) String() string {
+	if name, ok := _connectionStates[state]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+/*
+ConnectionStateEvent represents a change of the connection state of a socket.
+*/
+type ConnectionStateEvent struct {
+	// The new connection state.
+	State ConnectionState `json:"state"`
+
+	// The reconnect attempt, 0 when the connection drops.
+	Attempt int `json:"attempt"`
+
+	// Optional. The error that caused the state change.
+	Error string `json:"error,omitempty"`
+}
+
+/*
+ReconnectPolicy defines how a socket reconnects when its connection drops.
+
+While reconnecting, commands that are waiting for a response are either failed
+with a SocketConnectionLost error or, if RetryCommands is set, sent again once
+the connection is re-established. Successful *.enable commands are always
+re-issued after a reconnect so event subscriptions keep working. They are sent
+one at a time in the order they were enabled, and the commands to retry are
+sent once all of them have been answered. Target sessions do not survive a
+reconnect and are closed.
+*/
+type ReconnectPolicy struct {
+	// Optional. MaxAttempts is the number of reconnect attempts before the
+	// socket gives up. Defaults to 0, no limit.
+	MaxAttempts int
+
+	// Optional. Backoff is the delay before the first reconnect attempt, it
+	// doubles after each failed attempt. Defaults to 100ms.
+	Backoff time.Duration
+
+	// Optional. MaxBackoff is the maximum delay between reconnect attempts.
+	// Defaults to 10s.
+	MaxBackoff time.Duration
+
+	// Optional. RetryCommands sends the commands that were waiting for a
+	// response when the connection dropped again after reconnecting instead
+	// of failing them.
+	RetryCommands bool
+}
+
+/*
+backoff returns the delay before a reconnect attempt.
+*/
+func (policy *ReconnectPolicy) backoff(attempt int) time.Duration {
+	backoff := policy.Backoff
+	if backoff <= 0 {
+		backoff = 100 * time.Millisecond
+	}
+	maxBackoff := policy.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = 10 * time.Second
+	}
+	for a := 1; a < attempt && backoff < maxBackoff; a++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return backoff
+}
+
+/*
+OnConnectionStateChanged adds a handler for connection state changes.
+
+OnConnectionStateChanged is a Reconnecter implementation.
+*/
+func (socket *Socket) OnConnectionStateChanged(
+	callback func(event *ConnectionStateEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		ConnectionStateChangedEvent,
+		func(response *Response) {
+			event := &ConnectionStateEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			callback(event)
+		},
+	)
+	return NewSubscription(socket, handler)
+}
+
+/*
+OnConnectionStateChangedChan returns a channel of connection state changes. The
+event handler is removed and the channel closed when ctx is done.
+
+OnConnectionStateChangedChan is a Reconnecter implementation.
+*/
+func (socket *Socket) OnConnectionStateChangedChan(
+	ctx context.Context,
+) <-chan *ConnectionStateEvent {
+	eventChan := make(chan *ConnectionStateEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(socket.OnConnectionStateChanged(func(event *ConnectionStateEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+ReconnectPolicy returns the reconnect policy of the socket, nil if the socket
+does not reconnect.
+
+ReconnectPolicy is a Reconnecter implementation.
+*/
+func (socket *Socket) ReconnectPolicy() *ReconnectPolicy {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	return socket.reconnectPolicy
+}
+
+/*
+SetReconnectPolicy enables automatic reconnects using policy. A nil policy
+disables reconnects.
+
+SetReconnectPolicy is a Reconnecter implementation.
+*/
+func (socket *Socket) SetReconnectPolicy(policy *ReconnectPolicy) {
+	socket.mux.Lock()
+	socket.reconnectPolicy = policy
+	socket.mux.Unlock()
+}
+
+/*
+emitState delivers a connection state change to the event handlers.
+*/
+func (socket *Socket) emitState(state ConnectionState, attempt int, err error) {
+	event := &ConnectionStateEvent{
+		State:   state,
+		Attempt: attempt,
+	}
+	if nil != err {
+		event.Error = err.Error()
+	}
+	params, _ := json.Marshal(event)
+	log.WithFields(log.Fields{"attempt": attempt, "error": err, "socketID": socket.socketID, "state": state.String()}).
+		Info("Connection state changed")
+	socket.handleEvent(&Response{
+		Method: ConnectionStateChangedEvent,
+		Params: params,
+	})
+}
+
+/*
+failCommands removes commands from the stack and sends them an error response.
+*/
+func (socket *Socket) failCommands(commands []Commander, err error) {
+	for _, command := range commands {
+		socket.commands.Delete(command.ID())
+		command.Respond(&Response{
+			ID: command.ID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(fmt.Sprintf("%q", err.Error())),
+				Message: "Connection to the socket was lost",
+			},
+		})
+		log.WithFields(log.Fields{"commandID": command.ID(), "method": command.Method(), "socketID": socket.socketID}).
+			Debug("command failed, connection lost")
+	}
+}
+
+/*
+reconnect re-establishes a dropped connection according to the reconnect
+policy. It returns an error if all attempts failed or the socket was stopped.
+*/
+func (socket *Socket) reconnect(policy *ReconnectPolicy, cause error) error {
+	socket.mux.Lock()
+	if nil != socket.conn {
+		socket.conn.Close()
+	}
+	socket.conn = nil
+	socket.connected = false
+	socket.reconnects++
+	reconnects := socket.reconnects
+	socket.mux.Unlock()
+
+	socket.closeSessions()
+	socket.emitState(StateDisconnected, 0, cause)
+
+	lost := errs.Wrap(cause, codes.SocketConnectionLost, "connection lost")
+	var pending []Commander
+	if policy.RetryCommands {
+		pending = socket.commands.All()
+	} else {
+		socket.failCommands(socket.commands.All(), lost)
+	}
+
+	attempt := 0
+	for 0 == policy.MaxAttempts || attempt < policy.MaxAttempts {
+		attempt++
+		select {
+		case <-time.After(policy.backoff(attempt)):
+		case <-socket.stopCh:
+			socket.failCommands(socket.commands.All(), lost)
+			return errs.New(codes.SocketReconnectFailed, "socket stopped while reconnecting")
+		}
+
+		socket.emitState(StateReconnecting, attempt, nil)
+		if err := socket.Connect(); nil != err {
+			log.WithFields(log.Fields{"attempt": attempt, "error": err, "socketID": socket.socketID}).
+				Warn("reconnect failed")
+			continue
+		}
+
+		socket.emitState(StateConnected, attempt, nil)
+		// The responses to the replayed commands are delivered by the read
+		// loop, which must not wait for them.
+		go socket.replay(reconnects, pending)
+		return nil
+	}
+
+	err := errs.Wrap(cause, codes.SocketReconnectFailed, fmt.Sprintf("reconnect failed after %d attempts", attempt))
+	socket.failCommands(socket.commands.All(), err)
+	socket.emitState(StateFailed, attempt, err)
+	return err
+}
+
+/*
+replay re-issues the enabled domains in the order they were enabled, waiting
+for each response, and then re-sends the pending commands so they reach the
+browser after the domains they depend on. reconnects identifies the
+connection, the replay is abandoned if the connection drops again and the next
+replay sends the commands that are still pending.
+*/
+func (socket *Socket) replay(reconnects int, pending []Commander) {
+	current := func() bool {
+		socket.mux.Lock()
+		defer socket.mux.Unlock()
+		return reconnects == socket.reconnects
+	}
+
+	socket.enabledMux.Lock()
+	enabled := append([]*Payload{}, socket.enabled...)
+	socket.enabledMux.Unlock()
+
+	for _, payload := range enabled {
+		command := NewCommand(socket, payload.Method, payload.Params)
+		response := <-socket.SendCommand(command)
+		if nil != response.Error && 0 != response.Error.Code {
+			log.WithFields(log.Fields{"error": response.Error, "method": command.Method(), "socketID": socket.socketID}).
+				Warn("could not re-enable domain after reconnect")
+		}
+		if !current() {
+			return
+		}
+	}
+
+	for _, command := range pending {
+		if !current() {
+			return
+		}
+		if _, err := socket.commands.Get(command.ID()); nil != err {
+			// Already answered or abandoned.
+			continue
+		}
+		err := socket.WriteJSON(&Payload{
+			ID:     command.ID(),
+			Method: command.Method(),
+			Params: command.Params(),
+		})
+		if nil != err {
+			socket.failCommands([]Commander{command}, err)
+		}
+	}
+}
+
+/*
+trackEnable records successful *.enable commands so they can be re-issued after
+a reconnect, a successful *.disable command removes the domain again.
+*/
+func (socket *Socket) trackEnable(command Commander, response *Response) {
+	if nil != response.Error && 0 != response.Error.Code {
+		return
+	}
+	method := command.Method()
+	var domain string
+	switch {
+	case strings.HasSuffix(method, ".enable"):
+		domain = strings.TrimSuffix(method, ".enable")
+	case strings.HasSuffix(method, ".disable"):
+		domain = strings.TrimSuffix(method, ".disable")
+	default:
+		return
+	}
+
+	socket.enabledMux.Lock()
+	defer socket.enabledMux.Unlock()
+	enabled := make([]*Payload, 0, len(socket.enabled)+1)
+	for _, payload := range socket.enabled {
+		if payload.Method != domain+".enable" {
+			enabled = append(enabled, payload)
+		}
+	}
+	if strings.HasSuffix(method, ".enable") {
+		enabled = append(enabled, &Payload{Method: method, Params: command.Params()})
+	}
+	socket.enabled = enabled
+}
@@ -0,0 +1,267 @@
+package socket
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/network"
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+/*
+flakyDialer opens recordingWebSockets whose reads fail once they are dropped.
+Dials fail while refuse is set.
+*/
+type flakyDialer struct {
+	conns  []*flakyWebSocket
+	mux    sync.Mutex
+	refuse bool
+}
+
+type flakyWebSocket struct {
+	*recordingWebSocket
+	dropped bool
+	mux     sync.Mutex
+}
+
+func (socket *flakyWebSocket) drop() {
+	socket.mux.Lock()
+	socket.dropped = true
+	socket.mux.Unlock()
+}
+
+func (socket *flakyWebSocket) ReadJSON(v interface{}) error {
+	socket.mux.Lock()
+	dropped := socket.dropped
+	socket.mux.Unlock()
+	if dropped {
+		time.Sleep(10 * time.Millisecond)
+		return fmt.Errorf("connection reset by peer")
+	}
+	return socket.recordingWebSocket.ReadJSON(v)
+}
+
+func (dialer *flakyDialer) dial(socketURL *url.URL) (WebSocketer, error) {
+	dialer.mux.Lock()
+	defer dialer.mux.Unlock()
+	if dialer.refuse {
+		return nil, fmt.Errorf("connection refused")
+	}
+	conn := &flakyWebSocket{recordingWebSocket: &recordingWebSocket{MockChromeWebSocket: &MockChromeWebSocket{}}}
+	dialer.conns = append(dialer.conns, conn)
+	return conn, nil
+}
+
+func (dialer *flakyDialer) conn(k int) *flakyWebSocket {
+	for a := 0; a < 100; a++ {
+		dialer.mux.Lock()
+		if len(dialer.conns) > k {
+			conn := dialer.conns[k]
+			dialer.mux.Unlock()
+			return conn
+		}
+		dialer.mux.Unlock()
+		time.Sleep(10 * time.Millisecond)
+	}
+	return nil
+}
+
+/*
+expectStates waits for the expected connection state changes. Events are
+dispatched concurrently so the order is not checked.
+*/
+func expectStates(t *testing.T, states <-chan *ConnectionStateEvent, expected ...ConnectionStateEvent) {
+	found := map[ConnectionStateEvent]int{}
+	for range expected {
+		select {
+		case event := <-states:
+			found[ConnectionStateEvent{State: event.State, Attempt: event.Attempt}]++
+		case <-time.After(2 * time.Second):
+		}
+	}
+	for _, event := range expected {
+		if found[event] > 0 {
+			found[event]--
+			continue
+		}
+		t.Errorf("Expected state '%s' attempt %d", event.State, event.Attempt)
+	}
+}
+
+func TestReconnectPolicyBackoff(t *testing.T) {
+	policy := &ReconnectPolicy{}
+	for attempt, expected := range map[int]time.Duration{
+		1:   100 * time.Millisecond,
+		2:   200 * time.Millisecond,
+		4:   800 * time.Millisecond,
+		100: 10 * time.Second,
+	} {
+		if backoff := policy.backoff(attempt); expected != backoff {
+			t.Errorf("Expected %s for attempt %d, got %s", expected, attempt, backoff)
+		}
+	}
+	policy = &ReconnectPolicy{Backoff: time.Second, MaxBackoff: 3 * time.Second}
+	if backoff := policy.backoff(3); 3*time.Second != backoff {
+		t.Errorf("Expected 3s, got %s", backoff)
+	}
+}
+
+func TestReconnect(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestReconnect")
+	dialer := &flakyDialer{}
+	socket := NewWithWebsocket(socketURL, dialer.dial)
+	socket.SetReconnectPolicy(&ReconnectPolicy{Backoff: 10 * time.Millisecond})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	states := socket.OnConnectionStateChangedChan(ctx)
+	socket.Listen()
+	defer socket.Stop()
+	conn := dialer.conn(0)
+
+	// Enable a domain so it is re-issued after reconnecting.
+	enableChan := socket.Page().Enable()
+	time.Sleep(50 * time.Millisecond)
+	conn.AddMockData(&Response{ID: conn.lastPayload().ID, Error: &Error{}})
+	if result := <-enableChan; nil != result.Err {
+		t.Fatalf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	navigateChan := socket.Page().Navigate(&page.NavigateParams{URL: "https://www.example.com/"})
+	time.Sleep(50 * time.Millisecond)
+	conn.drop()
+
+	result := <-navigateChan
+	if nil == result.Err {
+		t.Errorf("Expected the pending command to fail")
+	}
+	expectStates(t, states,
+		ConnectionStateEvent{State: StateDisconnected},
+		ConnectionStateEvent{State: StateReconnecting, Attempt: 1},
+		ConnectionStateEvent{State: StateConnected, Attempt: 1},
+	)
+
+	conn = dialer.conn(1)
+	time.Sleep(50 * time.Millisecond)
+	if payload := conn.lastPayload(); nil == payload || "Page.enable" != payload.Method {
+		t.Errorf("Expected Page.enable to be re-issued, got %#v", payload)
+	}
+}
+
+func TestReconnectRetryCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestReconnectRetryCommands")
+	dialer := &flakyDialer{}
+	socket := NewWithWebsocket(socketURL, dialer.dial)
+	socket.SetReconnectPolicy(&ReconnectPolicy{Backoff: 10 * time.Millisecond, RetryCommands: true})
+	socket.Listen()
+	defer socket.Stop()
+	conn := dialer.conn(0)
+
+	navigateChan := socket.Page().Navigate(&page.NavigateParams{URL: "https://www.example.com/"})
+	time.Sleep(50 * time.Millisecond)
+	id := conn.lastPayload().ID
+	conn.drop()
+
+	conn = dialer.conn(1)
+	time.Sleep(50 * time.Millisecond)
+	if payload := conn.lastPayload(); nil == payload || id != payload.ID || "Page.navigate" != payload.Method {
+		t.Fatalf("Expected command #%d to be re-sent, got %#v", id, payload)
+	}
+	conn.AddMockData(&Response{ID: id, Error: &Error{}, Result: []byte(`{"frameId":"frame-1"}`)})
+	if result := <-navigateChan; nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+}
+
+func TestReconnectFailed(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestReconnectFailed")
+	dialer := &flakyDialer{}
+	socket := NewWithWebsocket(socketURL, dialer.dial)
+	socket.SetReconnectPolicy(&ReconnectPolicy{
+		Backoff:       10 * time.Millisecond,
+		MaxAttempts:   2,
+		RetryCommands: true,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	states := socket.OnConnectionStateChangedChan(ctx)
+	socket.Listen()
+	conn := dialer.conn(0)
+
+	navigateChan := socket.Page().Navigate(&page.NavigateParams{URL: "https://www.example.com/"})
+	time.Sleep(50 * time.Millisecond)
+	dialer.mux.Lock()
+	dialer.refuse = true
+	dialer.mux.Unlock()
+	conn.drop()
+
+	expectStates(t, states,
+		ConnectionStateEvent{State: StateDisconnected},
+		ConnectionStateEvent{State: StateReconnecting, Attempt: 1},
+		ConnectionStateEvent{State: StateReconnecting, Attempt: 2},
+		ConnectionStateEvent{State: StateFailed, Attempt: 2},
+	)
+	if result := <-navigateChan; nil == result.Err {
+		t.Errorf("Expected the pending command to fail")
+	}
+	select {
+	case err := <-socket.Errors():
+		if nil == err {
+			t.Errorf("Expected error, got nil")
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Expected the socket to stop")
+	}
+}
+
+func TestReconnectReplayOrder(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestReconnectReplayOrder")
+	dialer := &flakyDialer{}
+	socket := NewWithWebsocket(socketURL, dialer.dial)
+	socket.SetReconnectPolicy(&ReconnectPolicy{Backoff: 10 * time.Millisecond, RetryCommands: true})
+	socket.Listen()
+	defer socket.Stop()
+	conn := dialer.conn(0)
+
+	answer := func() {
+		time.Sleep(50 * time.Millisecond)
+		conn.AddMockData(&Response{ID: conn.lastPayload().ID, Error: &Error{}})
+	}
+	pageChan := socket.Page().Enable()
+	answer()
+	<-pageChan
+	networkChan := socket.Network().Enable(&network.EnableParams{})
+	answer()
+	<-networkChan
+	runtimeChan := socket.Runtime().Enable()
+	answer()
+	<-runtimeChan
+
+	navigateChan := socket.Page().Navigate(&page.NavigateParams{URL: "https://www.example.com/"})
+	time.Sleep(50 * time.Millisecond)
+	id := conn.lastPayload().ID
+	conn.drop()
+
+	// Each domain is re-enabled once the previous one has been, the pending
+	// command is sent last.
+	conn = dialer.conn(1)
+	for _, method := range []string{"Page.enable", "Network.enable", "Runtime.enable"} {
+		time.Sleep(50 * time.Millisecond)
+		payload := conn.lastPayload()
+		if nil == payload || method != payload.Method {
+			t.Fatalf("Expected %s, got %#v", method, payload)
+		}
+		conn.AddMockData(&Response{ID: payload.ID, Error: &Error{}})
+	}
+	time.Sleep(50 * time.Millisecond)
+	if payload := conn.lastPayload(); nil == payload || id != payload.ID {
+		t.Fatalf("Expected command #%d to be re-sent last, got %#v", id, payload)
+	}
+	conn.AddMockData(&Response{ID: id, Error: &Error{}, Result: []byte(`{"frameId":"frame-1"}`)})
+	if result := <-navigateChan; nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+}
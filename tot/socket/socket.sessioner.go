@@ -0,0 +1,331 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+sessionBufferSize is the number of messages that can be queued for a session
+before messages for the session are discarded, see sessionConn.deliver.
+*/
+const sessionBufferSize = 100
+
+/*
+AttachToTarget attaches to a target in flattened session mode and returns a
+socket for the new session. The session shares the browser-level connection,
+messages are routed using their session ID.
+
+AttachToTarget is a Sessioner implementation.
+*/
+func (socket *Socket) AttachToTarget(
+	ctx context.Context,
+	targetID target.ID,
+) (*Socket, error) {
+	browser := socket.browserSocket()
+	result := <-browser.Target().AttachToTargetContext(ctx, &target.AttachToTargetParams{
+		ID:      targetID,
+		Flatten: true,
+	})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.SocketSessionAttachFailed, fmt.Sprintf("could not attach to target '%s'", targetID))
+	}
+	return browser.NewSession(string(result.SessionID)), nil
+}
+
+/*
+NewSession returns a socket that sends commands to and receives messages from
+an existing target session, for example one reported by a
+Target.attachedToTarget event. The session shares the browser-level
+connection and is listening when it is returned.
+
+NewSession is a Sessioner implementation.
+*/
+func (socket *Socket) NewSession(sessionID string) *Socket {
+	browser := socket.browserSocket()
+	conn := &sessionConn{
+		browser:   browser,
+		closed:    make(chan bool),
+		messages:  make(chan *Response, sessionBufferSize),
+		sessionID: sessionID,
+	}
+
+	session := NewWithWebsocket(browser.url, func(socketURL *url.URL) (WebSocketer, error) {
+		return conn, nil
+	})
+	session.parent = browser
+	session.sessionID = sessionID
+	if policy := browser.DispatchPolicy(); nil != policy {
+		session.SetDispatchPolicy(policy)
+	}
+	conn.session = session
+
+	// A session attached again replaces the previous one, which is closed
+	// after the lock is released, see sessionConn.Close.
+	browser.sessionMux.Lock()
+	existing := browser.sessions[sessionID]
+	browser.sessions[sessionID] = conn
+	browser.sessionMux.Unlock()
+	if nil != existing {
+		existing.Close()
+	}
+
+	session.Listen()
+	log.WithFields(log.Fields{"sessionID": sessionID, "socketID": session.socketID, "url": session.url.String()}).
+		Info("New session listening")
+
+	return session
+}
+
+/*
+Session returns the socket for an attached target session.
+
+Session is a Sessioner implementation.
+*/
+func (socket *Socket) Session(sessionID string) (*Socket, error) {
+	browser := socket.browserSocket()
+	browser.sessionMux.Lock()
+	defer browser.sessionMux.Unlock()
+	if conn, ok := browser.sessions[sessionID]; ok {
+		return conn.session, nil
+	}
+	return nil, errs.New(codes.SocketSessionNotFound, fmt.Sprintf("session '%s' not found", sessionID))
+}
+
+/*
+SessionID returns the ID of the target session, or an empty string for a
+browser-level or page connection.
+
+SessionID is a Sessioner implementation.
+*/
+func (socket *Socket) SessionID() string {
+	return socket.sessionID
+}
+
+/*
+Sessions returns the sockets for all attached target sessions.
+
+Sessions is a Sessioner implementation.
+*/
+func (socket *Socket) Sessions() []*Socket {
+	browser := socket.browserSocket()
+	browser.sessionMux.Lock()
+	defer browser.sessionMux.Unlock()
+	sessions := make([]*Socket, 0, len(browser.sessions))
+	for _, conn := range browser.sessions {
+		sessions = append(sessions, conn.session)
+	}
+	return sessions
+}
+
+/*
+browserSocket returns the socket that owns the connection, sessions are always
+attached to the browser-level socket.
+*/
+func (socket *Socket) browserSocket() *Socket {
+	if nil != socket.parent {
+		return socket.parent
+	}
+	return socket
+}
+
+/*
+closeSession removes a session from the browser socket and stops its read
+loop.
+*/
+func (socket *Socket) closeSession(sessionID string) {
+	browser := socket.browserSocket()
+	browser.sessionMux.Lock()
+	conn, ok := browser.sessions[sessionID]
+	browser.sessionMux.Unlock()
+	if ok {
+		conn.Close()
+		log.WithFields(log.Fields{"sessionID": sessionID, "socketID": socket.socketID}).
+			Info("Session closed")
+	}
+}
+
+/*
+closeSessions closes all sessions attached to the socket.
+*/
+func (socket *Socket) closeSessions() {
+	socket.sessionMux.Lock()
+	conns := make([]*sessionConn, 0, len(socket.sessions))
+	for _, conn := range socket.sessions {
+		conns = append(conns, conn)
+	}
+	socket.sessionMux.Unlock()
+	for _, conn := range conns {
+		conn.Close()
+	}
+}
+
+/*
+handleSession delivers a message to the session it belongs to. It returns false
+if the session is unknown so the message can be handled by the socket itself.
+*/
+func (socket *Socket) handleSession(response *Response) bool {
+	socket.sessionMux.Lock()
+	conn, ok := socket.sessions[response.SessionID]
+	socket.sessionMux.Unlock()
+	if !ok {
+		return false
+	}
+	conn.deliver(response)
+	return true
+}
+
+/*
+sessionClosed returns whether the socket is a session that has been closed.
+Nothing more is delivered to a closed session and its read loop exits.
+*/
+func (socket *Socket) sessionClosed() bool {
+	socket.mux.Lock()
+	conn, ok := socket.conn.(*sessionConn)
+	socket.mux.Unlock()
+	if !ok {
+		return false
+	}
+	select {
+	case <-conn.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+/*
+handleDetached closes the session reported by a Target.detachedFromTarget
+event.
+*/
+func (socket *Socket) handleDetached(response *Response) {
+	event := &target.DetachedFromTargetEvent{}
+	if err := json.Unmarshal(response.Params, event); nil != err {
+		log.WithFields(log.Fields{"error": err, "socketID": socket.socketID}).
+			Warn("could not decode Target.detachedFromTarget event")
+		return
+	}
+	socket.closeSession(string(event.SessionID))
+}
+
+/*
+sessionConn is a WebSocketer implementation that multiplexes a target session
+over the connection of a browser-level socket. Payloads written to it are
+tagged with the session ID and written to the browser connection, messages
+for the session are delivered to it by the browser socket read loop.
+*/
+type sessionConn struct {
+	browser   *Socket
+	closed    chan bool
+	closeOnce sync.Once
+	messages  chan *Response
+	session   *Socket
+	sessionID string
+}
+
+/*
+Close detaches the session from the browser socket and stops its read loop.
+
+Close is a WebSocketer implementation.
+*/
+func (conn *sessionConn) Close() error {
+	conn.closeOnce.Do(func() {
+		conn.browser.sessionMux.Lock()
+		if conn.browser.sessions[conn.sessionID] == conn {
+			delete(conn.browser.sessions, conn.sessionID)
+		}
+		conn.browser.sessionMux.Unlock()
+		// The session read loop exits once ReadJSON reports the closed
+		// session, see Socket.sessionClosed.
+		close(conn.closed)
+	})
+	return nil
+}
+
+/*
+deliver queues a message for the session read loop. It is called from the
+browser socket read loop, which is shared by all sessions, and never waits for
+a session to catch up. Messages for a closed session are discarded. If the
+buffer of the session is full the message is discarded, the command it answers
+fails and a SocketSessionBufferFull error is sent to the Errors() channel of
+the session if it has room.
+*/
+func (conn *sessionConn) deliver(response *Response) {
+	select {
+	case <-conn.closed:
+		log.WithFields(log.Fields{"method": response.Method, "responseID": response.ID, "sessionID": conn.sessionID}).
+			Debug("session closed, discarding message")
+		return
+	case conn.messages <- response:
+		return
+	default:
+	}
+
+	err := errs.New(codes.SocketSessionBufferFull, fmt.Sprintf("session '%s' is not keeping up, discarded message", conn.sessionID))
+	log.WithFields(log.Fields{"error": err, "method": response.Method, "responseID": response.ID, "sessionID": conn.sessionID}).
+		Warn(err)
+	if response.ID > 0 {
+		if command, cmdErr := conn.session.commands.Get(response.ID); nil == cmdErr {
+			conn.session.commands.Delete(command.ID())
+			command.Respond(&Response{
+				ID: command.ID(),
+				Error: &Error{
+					Code:    1,
+					Data:    []byte(fmt.Sprintf("%q", err.Error())),
+					Message: "The session message buffer is full",
+				},
+			})
+		}
+	}
+	select {
+	case conn.session.errCh <- err:
+	default:
+	}
+}
+
+/*
+ReadJSON waits for the next message for the session and unmarshalls it into the
+provided variable.
+
+ReadJSON is a WebSocketer implementation.
+*/
+func (conn *sessionConn) ReadJSON(v interface{}) error {
+	select {
+	case response := <-conn.messages:
+		data, err := json.Marshal(response)
+		if nil != err {
+			return errs.Wrap(err, codes.SocketReadFailed, "could not encode session message")
+		}
+		return json.Unmarshal(data, v)
+	case <-conn.closed:
+		return errs.New(codes.SocketSessionClosed, fmt.Sprintf("session '%s' closed", conn.sessionID))
+	}
+}
+
+/*
+WriteJSON tags command payloads with the session ID and writes them to the
+browser connection.
+
+WriteJSON is a WebSocketer implementation.
+*/
+func (conn *sessionConn) WriteJSON(v interface{}) error {
+	select {
+	case <-conn.closed:
+		return errs.New(codes.SocketSessionClosed, fmt.Sprintf("session '%s' closed", conn.sessionID))
+	default:
+	}
+	if payload, ok := v.(*Payload); ok {
+		tagged := *payload
+		tagged.SessionID = conn.sessionID
+		v = &tagged
+	}
+	return conn.browser.WriteJSON(v)
+}
@@ -0,0 +1,284 @@
+package socket
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/page"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+recordingWebSocket is a MockChromeWebSocket that records the payloads written
+to it.
+*/
+type recordingWebSocket struct {
+	*MockChromeWebSocket
+	mux      sync.Mutex
+	payloads []*Payload
+}
+
+func (socket *recordingWebSocket) WriteJSON(v interface{}) error {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	if payload, ok := v.(*Payload); ok {
+		socket.payloads = append(socket.payloads, payload)
+	}
+	return nil
+}
+
+func (socket *recordingWebSocket) lastPayload() *Payload {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	if 0 == len(socket.payloads) {
+		return nil
+	}
+	return socket.payloads[len(socket.payloads)-1]
+}
+
+func newRecordingMock(socketURL *url.URL) (*Socket, *recordingWebSocket) {
+	conn := &recordingWebSocket{MockChromeWebSocket: &MockChromeWebSocket{}}
+	socket := NewWithWebsocket(socketURL, func(*url.URL) (WebSocketer, error) {
+		return conn, nil
+	})
+	return socket, conn
+}
+
+func TestSessionCommand(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSessionCommand")
+	browser, conn := newRecordingMock(socketURL)
+	browser.Listen()
+	defer browser.Stop()
+
+	session := browser.NewSession("session-1")
+	if "session-1" != session.SessionID() {
+		t.Errorf("Expected session ID 'session-1', got '%s'", session.SessionID())
+	}
+	if "" != browser.SessionID() {
+		t.Errorf("Expected empty session ID, got '%s'", browser.SessionID())
+	}
+	if found, err := browser.Session("session-1"); nil != err || found != session {
+		t.Errorf("Expected to find the session, got error: '%v'", err)
+	}
+	if found, err := session.Session("session-1"); nil != err || found != session {
+		t.Errorf("Expected sessions to be shared with the browser socket, got error: '%v'", err)
+	}
+	if 1 != len(browser.Sessions()) {
+		t.Errorf("Expected 1 session, got %d", len(browser.Sessions()))
+	}
+
+	resultChan := session.Page().Enable()
+	time.Sleep(50 * time.Millisecond)
+	payload := conn.lastPayload()
+	if nil == payload || "session-1" != payload.SessionID || "Page.enable" != payload.Method {
+		t.Fatalf("Expected a Page.enable payload for session 'session-1', got %#v", payload)
+	}
+	conn.AddMockData(&Response{
+		ID:        payload.ID,
+		Error:     &Error{},
+		SessionID: "session-1",
+	})
+	select {
+	case result := <-resultChan:
+		if nil != result.Err {
+			t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Expected the session to receive the response")
+	}
+}
+
+func TestSessionEvent(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSessionEvent")
+	browser, conn := newRecordingMock(socketURL)
+	browser.Listen()
+	defer browser.Stop()
+	session := browser.NewSession("session-1")
+
+	browserEvents := make(chan *page.LoadEventFiredEvent, 1)
+	browser.Page().OnLoadEventFired(func(event *page.LoadEventFiredEvent) {
+		browserEvents <- event
+	})
+	sessionEvents := make(chan *page.LoadEventFiredEvent, 1)
+	session.Page().OnLoadEventFired(func(event *page.LoadEventFiredEvent) {
+		sessionEvents <- event
+	})
+
+	conn.AddMockData(&Response{
+		Method:    "Page.loadEventFired",
+		Params:    []byte(`{"timestamp":1}`),
+		SessionID: "session-1",
+	})
+	select {
+	case event := <-sessionEvents:
+		if nil != event.Err {
+			t.Errorf("Expected nil, got error: '%s'", event.Err.Error())
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Expected the session to receive the event")
+	}
+	select {
+	case <-browserEvents:
+		t.Errorf("Expected the browser socket not to receive session events")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestSessionDetached(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSessionDetached")
+	browser, conn := newRecordingMock(socketURL)
+	browser.Listen()
+	defer browser.Stop()
+	session := browser.NewSession("session-1")
+
+	conn.AddMockData(&Response{
+		Method: "Target.detachedFromTarget",
+		Params: []byte(`{"sessionId":"session-1"}`),
+	})
+	time.Sleep(100 * time.Millisecond)
+	if _, err := browser.Session("session-1"); nil == err {
+		t.Errorf("Expected the detached session to be removed")
+	}
+	if err := session.WriteJSON(&Payload{ID: 1, Method: "Page.enable"}); nil == err {
+		t.Errorf("Expected an error writing to a detached session")
+	}
+}
+
+func TestSessionStop(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSessionStop")
+	browser, _ := newRecordingMock(socketURL)
+	browser.Listen()
+	defer browser.Stop()
+	session := browser.NewSession("session-1")
+	time.Sleep(50 * time.Millisecond)
+
+	start := time.Now()
+	session.Stop()
+	if elapsed := time.Since(start); elapsed >= 1*time.Second {
+		t.Errorf("Expected the session to stop immediately, %s elapsed", elapsed)
+	}
+	if 0 != len(browser.Sessions()) {
+		t.Errorf("Expected 0 sessions, got %d", len(browser.Sessions()))
+	}
+}
+
+func TestSessionReattach(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSessionReattach")
+	browser, _ := newRecordingMock(socketURL)
+	browser.Listen()
+	defer browser.Stop()
+
+	first := browser.NewSession("session-1")
+	attached := make(chan *Socket)
+	go func() {
+		attached <- browser.NewSession("session-1")
+	}()
+	var second *Socket
+	select {
+	case second = <-attached:
+	case <-time.After(time.Second):
+		t.Fatalf("Timed out attaching the session again")
+	}
+	if found, err := browser.Session("session-1"); nil != err || found != second {
+		t.Errorf("Expected the new session to replace the previous one, got error: '%v'", err)
+	}
+	if 1 != len(browser.Sessions()) {
+		t.Errorf("Expected 1 session, got %d", len(browser.Sessions()))
+	}
+	if first == second {
+		t.Errorf("Expected a new session")
+	}
+}
+
+func TestSessionBufferFull(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSessionBufferFull")
+	browser, _ := newRecordingMock(socketURL)
+	session := NewWithWebsocket(socketURL, nil)
+	conn := &sessionConn{
+		browser:   browser,
+		closed:    make(chan bool),
+		messages:  make(chan *Response, 1),
+		session:   session,
+		sessionID: "session-1",
+	}
+	command := NewCommand(session, "Page.enable", nil)
+	session.commands.Set(command)
+
+	// A session that is not keeping up must not block the browser socket read
+	// loop.
+	delivered := make(chan bool)
+	go func() {
+		conn.deliver(&Response{Method: "Page.loadEventFired", SessionID: "session-1"})
+		conn.deliver(&Response{ID: command.ID(), SessionID: "session-1"})
+		close(delivered)
+	}()
+	select {
+	case <-delivered:
+	case <-time.After(time.Second):
+		t.Fatalf("Expected deliver not to block on a full buffer")
+	}
+
+	select {
+	case response := <-command.Response():
+		if nil == response.Error || 0 == response.Error.Code {
+			t.Errorf("Expected the command to fail, got %#v", response)
+		}
+	default:
+		t.Errorf("Expected the command to fail")
+	}
+	select {
+	case err := <-session.Errors():
+		if codes.SocketSessionBufferFull != err.(errs.Err).Code() {
+			t.Errorf("Expected SocketSessionBufferFull, got '%s'", err.Error())
+		}
+	default:
+		t.Errorf("Expected a SocketSessionBufferFull error")
+	}
+}
+
+func TestAttachToTarget(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestAttachToTarget")
+	browser, conn := newRecordingMock(socketURL)
+	browser.Listen()
+	defer browser.Stop()
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		conn.AddMockData(&Response{
+			ID:     conn.lastPayload().ID,
+			Error:  &Error{},
+			Result: []byte(`{"sessionId":"session-1"}`),
+		})
+	}()
+	session, err := browser.AttachToTarget(context.Background(), "target-1")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	if "session-1" != session.SessionID() {
+		t.Errorf("Expected session ID 'session-1', got '%s'", session.SessionID())
+	}
+	params, ok := conn.lastPayload().Params.(*target.AttachToTargetParams)
+	if !ok || "target-1" != params.ID || !params.Flatten {
+		t.Errorf("Expected flattened attach params, got %#v", conn.lastPayload().Params)
+	}
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		conn.AddMockData(&Response{
+			ID: conn.lastPayload().ID,
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+	}()
+	if _, err = browser.AttachToTarget(context.Background(), "target-2"); nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+}
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -17,13 +18,45 @@ New returns a pointer to a websocket struct that implements Socketer interface
 listening to the specified URL.
 */
 func New(url *url.URL) *Socket {
+	return NewWithOptions(url, nil)
+}
+
+/*
+NewWithOptions returns a pointer to a websocket struct that implements
+Socketer interface listening to the specified URL. The websocket connection is
+opened with the options.
+*/
+func NewWithOptions(url *url.URL, options *WebsocketOptions) *Socket {
+	socket := NewWithWebsocket(url, options.NewWebsocket)
+	socket.Listen()
+
+	log.WithFields(log.Fields{"socketID": socket.socketID, "url": socket.url.String()}).
+		Info("New socket connection listening")
+
+	return socket
+}
+
+/*
+NewWithWebsocket returns a pointer to a Socket that uses newSocket to open the
+connection to the specified URL, which allows transports other than the default
+websocket to be used. Unlike New, the socket does not connect until Listen() is
+called.
+*/
+func NewWithWebsocket(
+	url *url.URL,
+	newSocket func(socketURL *url.URL) (WebSocketer, error),
+) *Socket {
 	socket := &Socket{
 		commandIDMux: &sync.Mutex{},
 		commands:     NewCommandMap(),
+		enabledMux:   &sync.Mutex{},
 		errCh:        make(chan error, 3),
 		handlers:     NewEventHandlerMap(),
+		listeningMux: &sync.Mutex{},
 		mux:          &sync.Mutex{},
-		newSocket:    NewWebsocket,
+		newSocket:    newSocket,
+		sessionMux:   &sync.Mutex{},
+		sessions:     make(map[string]*sessionConn),
 		socketID:     NextSocketID(),
 		url:          url,
 	}
@@ -68,11 +101,6 @@ func New(url *url.URL) *Socket {
 	socket.tethering = &TetheringProtocol{Socket: socket}
 	socket.tracing = &TracingProtocol{Socket: socket}
 
-	socket.Listen()
-
-	log.WithFields(log.Fields{"socketID": socket.socketID, "url": socket.url.String()}).
-		Info("New socket connection listening")
-
 	return socket
 }
 
@@ -104,11 +132,33 @@ type Socket struct {
 	handlers     EventHandlerMapper
 	listenCh     chan bool
 	listening    bool
+	listeningMux *sync.Mutex
 	mux          *sync.Mutex
 	newSocket    func(socketURL *url.URL) (WebSocketer, error)
 	socketID     int
 	url          *url.URL
 
+	// Target sessions. parent is the browser-level socket a session is
+	// multiplexed over, sessions are the sessions attached to a browser-level
+	// socket.
+	parent     *Socket
+	sessionID  string
+	sessionMux *sync.Mutex
+	sessions   map[string]*sessionConn
+
+	// Reconnects. enabled holds the *.enable commands to re-issue after a
+	// reconnect, reconnects counts the dropped connections, stopCh is closed
+	// when the socket is stopped.
+	enabled         []*Payload
+	enabledMux      *sync.Mutex
+	reconnectPolicy *ReconnectPolicy
+	reconnects      int
+	stopCh          chan bool
+
+	// Event dispatch. dispatcher delivers events through ordered queues, nil
+	// runs every handler in its own goroutine.
+	dispatcher *eventDispatcher
+
 	// Protocol interfaces for the API.
 	accessibility        *AccessibilityProtocol
 	animation            *AnimationProtocol
@@ -189,6 +239,7 @@ func (socket *Socket) handleResponse(response *Response) {
 			Debug("executing handler")
 		command.Respond(response)
 		socket.commands.Delete(command.ID())
+		socket.trackEnable(command, response)
 		log.WithFields(log.Fields{"commandID": command.ID(), "method": command.Method(), "socketID": socket.socketID, "url": socket.url.String()}).
 			Debug("Command complete")
 	}
@@ -205,13 +256,33 @@ func (socket *Socket) handleEvent(
 		Debug("handling event")
 
 	if response.Method == "Inspector.targetCrashed" {
-		log.WithFields(log.Fields{"socketID": socket.socketID}).
+		err := errs.New(codes.SocketTargetCrashed, "the target has crashed")
+		log.WithFields(log.Fields{"error": err, "socketID": socket.socketID}).
 			Error("Chrome has crashed!")
+		select {
+		case socket.errCh <- err:
+		default:
+		}
 	}
 
-	if handlers, err := socket.handlers.Get(response.Method); nil != err {
+	if response.Method == "Target.detachedFromTarget" {
+		socket.handleDetached(response)
+	}
+
+	socket.handlers.Lock()
+	handlers, err := socket.handlers.Get(response.Method)
+	socket.handlers.Unlock()
+	if nil != err {
 		log.WithFields(log.Fields{"error": err, "socketID": socket.socketID}).
 			Debug(err)
+		return
+	}
+
+	socket.mux.Lock()
+	dispatcher := socket.dispatcher
+	socket.mux.Unlock()
+	if nil != dispatcher {
+		dispatcher.dispatch(response, handlers)
 	} else {
 		for a, event := range handlers {
 			log.WithFields(log.Fields{"event": response.Method, "handler#": a, "socketID": socket.socketID}).
@@ -263,7 +334,8 @@ Listen is a Socketer implementation.
 */
 func (socket *Socket) Listen() {
 	socket.listenCh = make(chan bool)
-	socket.listening = true
+	socket.stopCh = make(chan bool)
+	socket.setListening(true)
 	go socket.listen(socket.errCh)
 }
 
@@ -281,6 +353,8 @@ func (socket *Socket) listen(errCh chan error) {
 			log.WithFields(log.Fields{"error": err}).
 				Error(err)
 		}
+		// Nothing will answer the commands that are still waiting.
+		socket.failCommands(socket.commands.All(), errs.New(codes.SocketConnectionLost, "socket closed"))
 		errCh <- err
 	}()
 
@@ -299,6 +373,20 @@ func (socket *Socket) listen(errCh chan error) {
 			log.WithFields(log.Fields{
 				"socketID": socket.socketID,
 			}).Error(err)
+
+			if socket.sessionClosed() {
+				socket.stopped()
+				break
+			}
+			if policy := socket.ReconnectPolicy(); nil != policy && socket.isListening() {
+				if err = socket.reconnect(policy, err); nil != err {
+					if !socket.isListening() {
+						socket.stopped()
+					}
+					break
+				}
+				continue
+			}
 		}
 		if 0 == response.ID &&
 			"" == response.Method &&
@@ -308,7 +396,11 @@ func (socket *Socket) listen(errCh chan error) {
 				Error("nil response from socket")
 		}
 
-		if response.ID > 0 {
+		if "" != response.SessionID && socket.handleSession(response) {
+			log.WithFields(log.Fields{"responseID": response.ID, "sessionID": response.SessionID, "socketID": socket.socketID}).
+				Debug("sent to session")
+
+		} else if response.ID > 0 {
 			log.WithFields(log.Fields{"responseID": response.ID, "socketID": socket.socketID}).
 				Debug("sending to command handler")
 			socket.handleResponse(response)
@@ -331,20 +423,14 @@ func (socket *Socket) listen(errCh chan error) {
 			socket.handleUnknown(response)
 		}
 
-		if !socket.listening {
-			log.WithFields(log.Fields{"socketID": socket.socketID, "url": socket.url.String()}).
-				Info("Socket shutting down")
-			go func() {
-				select {
-				case socket.listenCh <- true:
-				case <-time.After(10 * time.Second):
-				}
-			}()
+		if !socket.isListening() {
+			socket.stopped()
 			break
 		}
 	}
 
-	socket.listening = false
+	socket.setListening(false)
+	socket.closeSessions()
 	if nil != err {
 		errCh <- errs.Wrap(err, 0, "socket closed")
 		return
@@ -352,6 +438,42 @@ func (socket *Socket) listen(errCh chan error) {
 	errCh <- nil
 }
 
+/*
+isListening returns whether the read loop is running and has not been asked to
+stop.
+*/
+func (socket *Socket) isListening() bool {
+	socket.listeningMux.Lock()
+	defer socket.listeningMux.Unlock()
+	return socket.listening
+}
+
+/*
+setListening sets the listening state and returns the previous state. Stop and
+the read loop run in different goroutines.
+*/
+func (socket *Socket) setListening(listening bool) bool {
+	socket.listeningMux.Lock()
+	defer socket.listeningMux.Unlock()
+	previous := socket.listening
+	socket.listening = listening
+	return previous
+}
+
+/*
+stopped signals Stop() that the read loop has exited.
+*/
+func (socket *Socket) stopped() {
+	log.WithFields(log.Fields{"socketID": socket.socketID, "url": socket.url.String()}).
+		Info("Socket shutting down")
+	go func() {
+		select {
+		case socket.listenCh <- true:
+		case <-time.After(10 * time.Second):
+		}
+	}()
+}
+
 /*
 NextCommandID generates and returns the next command ID.
 
@@ -385,8 +507,12 @@ func (socket *Socket) RemoveEventHandler(
 
 	for i, hndlr := range handlers {
 		if hndlr == handler {
-			handlers = append(handlers[:i], handlers[i+1:]...)
-			socket.handlers.Set(handler.Name(), handlers)
+			// Copy the stack, handleEvent may be iterating over the current
+			// one.
+			remaining := make([]EventHandler, 0, len(handlers)-1)
+			remaining = append(remaining, handlers[:i]...)
+			remaining = append(remaining, handlers[i+1:]...)
+			socket.handlers.Set(handler.Name(), remaining)
 			log.WithFields(log.Fields{"handler": handler.Name(), "handlerID": i, "socketID": socket.socketID}).
 				Info("Removed event handler")
 			return nil
@@ -404,17 +530,19 @@ SendCommand delivers a command payload to the websocket connection.
 SendCommand is a Socketer implementation.
 
 Workflow:
-	1. The socket's command mutex is locked.
-	2. The command counter is incremented.
-	3. The payload is sent to the socket connection and the mutex is unlocked.
-	4. The command is stored using the generated ID.
-	5. When the command has been executed and the socket responds,
-	socket.HandleCmd() is triggered from the command instance to generate the
-	response and the command unlocks itself.
+	1. The command is stored using its ID so the response can be routed back
+	to it.
+	2. The payload is sent to the socket connection. If the write fails the
+	command is removed from the stack and an error response is sent to the
+	command.
+	3. When the command has been executed and the socket responds,
+	socket.handleResponse() is triggered to deliver the response to the
+	command and remove it from the stack.
 */
 func (socket *Socket) SendCommand(command Commander) chan *Response {
 	log.WithFields(log.Fields{"commandID": command.ID(), "method": command.Method(), "socketID": socket.socketID}).
 		Debug("sending command payload to socket")
+	socket.commands.Set(command)
 	go func() {
 		payload := &Payload{
 			ID:     command.ID(),
@@ -424,20 +552,41 @@ func (socket *Socket) SendCommand(command Commander) chan *Response {
 
 		if err := socket.WriteJSON(payload); err != nil {
 			err = errs.Wrap(err, 0, "write failed: could not write data to websocket")
+			socket.commands.Delete(command.ID())
 			command.Respond(&Response{Error: &Error{
 				Code:    1,
 				Data:    []byte(fmt.Sprintf(`"%#v"`, err)),
 				Message: "Failed to send command payload to socket connection",
 			}})
-			return
 		}
-
-		socket.commands.Set(command)
 	}()
 
 	return command.Response()
 }
 
+/*
+SendCommandContext delivers a command payload to the websocket connection and
+waits for the response. If the context is cancelled or its deadline is exceeded
+before a response arrives the command is removed from the stack and the context
+error is returned.
+
+SendCommandContext is a Socketer implementation.
+*/
+func (socket *Socket) SendCommandContext(
+	ctx context.Context,
+	command Commander,
+) (*Response, error) {
+	select {
+	case response := <-socket.SendCommand(command):
+		return response, nil
+	case <-ctx.Done():
+		socket.commands.Delete(command.ID())
+		log.WithFields(log.Fields{"commandID": command.ID(), "error": ctx.Err(), "method": command.Method(), "socketID": socket.socketID}).
+			Debug("command abandoned")
+		return nil, ctx.Err()
+	}
+}
+
 /*
 Stop signals the socket read loop to stop listening for data and close the
 websocket connection.
@@ -445,13 +594,23 @@ websocket connection.
 Stop is a Socketer implementation.
 */
 func (socket *Socket) Stop() {
-	if socket.listening {
-		socket.listening = false
+	if socket.setListening(false) {
+		close(socket.stopCh)
+		if "" != socket.sessionID {
+			// Nothing more is delivered to a stopped session, unblock its
+			// read loop rather than waiting for the next message.
+			socket.closeSession(socket.sessionID)
+		}
 		select {
 		case <-socket.listenCh:
 		case <-time.After(1 * time.Second):
-			socket.conn.Close()
+			socket.mux.Lock()
+			if nil != socket.conn {
+				socket.conn.Close()
+			}
+			socket.mux.Unlock()
 		}
+		socket.resetDispatcher()
 		log.WithFields(log.Fields{"socketID": socket.socketID}).
 			Debug("socket stopped")
 	}
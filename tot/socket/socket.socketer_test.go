@@ -1,10 +1,14 @@
 package socket
 
 import (
+	"context"
 	"net/url"
 	"reflect"
 	"testing"
 	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
 )
 
 func TestNewSocket(t *testing.T) {
@@ -15,6 +19,25 @@ func TestNewSocket(t *testing.T) {
 	}
 }
 
+func TestNewWithWebsocket(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestNewWithWebsocket")
+	socket := NewWithWebsocket(socketURL, NewMockWebsocket)
+	if socket.Connected() {
+		t.Errorf("Expected socket not to connect before Listen()")
+	}
+
+	socket.Listen()
+	defer socket.Stop()
+	resultChan := socket.Page().Enable()
+	socket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:    socket.CurCommandID(),
+		Error: &Error{},
+	})
+	if result := <-resultChan; nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+}
+
 func TestCommandNotFound(t *testing.T) {
 	socketURL, _ := url.Parse("https://test:9222/TestCommandNotFound")
 	mockSocket := NewMock(socketURL)
@@ -118,6 +141,69 @@ func TestListenCommandUnknown(t *testing.T) {
 	}
 }
 
+func TestSendCommandContext(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSendCommandContext")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	command := NewCommand(mockSocket, "Some.method", nil)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     command.ID(),
+		Error:  &Error{},
+		Method: "Some.method",
+		Result: []byte(`"Mock Command Result"`),
+	})
+	result, err := mockSocket.SendCommandContext(context.Background(), command)
+	if nil != err {
+		t.Errorf("Expected nil, received error: %s", err.Error())
+	}
+	if `"Mock Command Result"` != string(result.Result) {
+		t.Errorf("Invalid result: expected 'Mock Command Result', received '%s'", result.Result)
+	}
+}
+
+func TestSendCommandContextTimeout(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSendCommandContextTimeout")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	command := NewCommand(mockSocket, "Some.method", nil)
+	result, err := mockSocket.SendCommandContext(ctx, command)
+	if context.DeadlineExceeded != err {
+		t.Errorf("Expected context.DeadlineExceeded, received '%v'", err)
+	}
+	if nil != result {
+		t.Errorf("Expected nil result, received '%v'", result)
+	}
+	if _, err := mockSocket.commands.Get(command.ID()); nil == err {
+		t.Errorf("Expected the abandoned command to be removed from the command map")
+	}
+
+	// A late response to the abandoned command must not block the socket.
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     command.ID(),
+		Error:  &Error{},
+		Method: "Some.method",
+		Result: []byte(`"Mock Command Result"`),
+	})
+	command = NewCommand(mockSocket, "Some.method", nil)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     command.ID(),
+		Error:  &Error{},
+		Method: "Some.method",
+		Result: []byte(`"Mock Command Result"`),
+	})
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err = mockSocket.SendCommandContext(ctx, command); nil != err {
+		t.Errorf("Expected nil, received error: %s", err.Error())
+	}
+}
+
 func TestRemoveEventHandler(t *testing.T) {
 	var err error
 	socketURL, _ := url.Parse("https://test:9222/TestRemoveEventHandler")
@@ -250,3 +336,19 @@ func TestResponseType(t *testing.T) {
 		t.Errorf("Response.Result should have a default value of nil, %v found", v.Result)
 	}
 }
+
+func TestSocketTargetCrashed(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketTargetCrashed")
+	mockSocket := NewMock(socketURL)
+	mockSocket.errCh = make(chan error, 3)
+
+	mockSocket.handleEvent(&Response{Method: "Inspector.targetCrashed"})
+	select {
+	case err := <-mockSocket.Errors():
+		if codes.SocketTargetCrashed != err.(errs.Err).Code() {
+			t.Errorf("Expected code %d, got %d", codes.SocketTargetCrashed, err.(errs.Err).Code())
+		}
+	default:
+		t.Errorf("Expected a target crashed error")
+	}
+}
@@ -0,0 +1,101 @@
+package socket
+
+import (
+	"context"
+	"sync"
+)
+
+/*
+NewSubscription adds an event handler to a socket and returns a Subscription
+that can be used to remove it again.
+*/
+func NewSubscription(socket Socketer, handler EventHandler) *Subscription {
+	socket.AddEventHandler(handler)
+	return &Subscription{
+		handler: handler,
+		mux:     &sync.Mutex{},
+		socket:  socket,
+	}
+}
+
+/*
+Subscription is a handle to an event handler added by one of the protocol On*
+methods.
+*/
+type Subscription struct {
+	handler      EventHandler
+	mux          *sync.Mutex
+	socket       Socketer
+	unsubscribed bool
+}
+
+/*
+Handler returns the event handler of the subscription.
+*/
+func (sub *Subscription) Handler() EventHandler {
+	return sub.handler
+}
+
+/*
+Unsubscribe removes the event handler from the socket. Events that are already
+being delivered when Unsubscribe is called may still reach the callback.
+Calling Unsubscribe more than once has no effect.
+*/
+func (sub *Subscription) Unsubscribe() error {
+	sub.mux.Lock()
+	defer sub.mux.Unlock()
+	if sub.unsubscribed {
+		return nil
+	}
+	sub.unsubscribed = true
+	return sub.socket.RemoveEventHandler(sub.handler)
+}
+
+/*
+NewChanSubscription returns a ChanSubscription for ctx.
+*/
+func NewChanSubscription(ctx context.Context) *ChanSubscription {
+	return &ChanSubscription{
+		ctx: ctx,
+		mux: &sync.RWMutex{},
+	}
+}
+
+/*
+ChanSubscription ties the channel returned by a protocol On*Chan method to a
+context. The handler is removed and the channel closed when the context is
+done.
+*/
+type ChanSubscription struct {
+	closed bool
+	ctx    context.Context
+	mux    *sync.RWMutex
+}
+
+/*
+Send calls deliver unless the channel has been closed. deliver must return once
+the context is done.
+*/
+func (sub *ChanSubscription) Send(deliver func()) {
+	sub.mux.RLock()
+	defer sub.mux.RUnlock()
+	if !sub.closed {
+		deliver()
+	}
+}
+
+/*
+CloseWhenDone removes the event handler and calls closeChan when the context is
+done. closeChan is not called while an event is being delivered, so sends never
+race with closing the channel.
+*/
+func (sub *ChanSubscription) CloseWhenDone(subscription *Subscription, closeChan func()) {
+	go func() {
+		<-sub.ctx.Done()
+		subscription.Unsubscribe()
+		sub.mux.Lock()
+		sub.closed = true
+		closeChan()
+		sub.mux.Unlock()
+	}()
+}
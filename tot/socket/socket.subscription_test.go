@@ -0,0 +1,87 @@
+package socket
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+func TestSubscriptionUnsubscribe(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSubscriptionUnsubscribe")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *page.LoadEventFiredEvent, 10)
+	sub := mockSocket.Page().OnLoadEventFired(func(event *page.LoadEventFiredEvent) {
+		resultChan <- event
+	})
+	if "Page.loadEventFired" != sub.Handler().Name() {
+		t.Errorf("Expected handler for 'Page.loadEventFired', got '%s'", sub.Handler().Name())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected repeated Unsubscribe() to return nil, got error: '%s'", err.Error())
+	}
+
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Error:  &Error{},
+		Method: "Page.loadEventFired",
+		Params: []byte(`{"timestamp":1}`),
+	})
+	select {
+	case <-resultChan:
+		t.Errorf("Expected no events after Unsubscribe()")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestSubscriptionChan(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSubscriptionChan")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.Page().OnLoadEventFiredChan(ctx)
+	for a := 1; a <= 3; a++ {
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			Error:  &Error{},
+			Method: "Page.loadEventFired",
+			Params: []byte(`{"timestamp":1}`),
+		})
+	}
+	for a := 1; a <= 3; a++ {
+		select {
+		case event := <-eventChan:
+			if nil != event.Err {
+				t.Errorf("Expected nil, got error: '%s'", event.Err.Error())
+			}
+			if 1 != event.Timestamp {
+				t.Errorf("Expected timestamp 1, got %v", event.Timestamp)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Expected event #%d", a)
+		}
+	}
+
+	// Events that are not received before the context is done are dropped
+	// and the channel is closed.
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Error:  &Error{},
+		Method: "Page.loadEventFired",
+		Params: []byte(`{"timestamp":2}`),
+	})
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	for range eventChan {
+	}
+	if handlers, _ := mockSocket.handlers.Get("Page.loadEventFired"); 0 != len(handlers) {
+		t.Errorf("Expected the event handler to be removed, %d found", len(handlers))
+	}
+}
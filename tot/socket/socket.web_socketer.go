@@ -1,10 +1,12 @@
 package socket
 
 import (
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"sync"
 
 	errs "github.com/bdlm/errors"
 	"github.com/bdlm/log"
@@ -12,19 +14,60 @@ import (
 	"github.com/mkenney/go-chrome/codes"
 )
 
+/*
+MaxMessageSize is the default maximum size of an outbound message in bytes. It
+matches the receive buffer size of the Chrome DevTools server, see
+https://chromium.googlesource.com/chromium/src/+/master/content/browser/devtools/devtools_http_handler.cc
+
+Larger messages are rejected before they are sent. Messages larger than the
+write buffer of the connection are sent in multiple frames.
+*/
+var MaxMessageSize = 100 * 1024 * 1024
+
 /*
 NewWebsocket returns a connected socket connection that implements the
 WebSocketer interface.
 */
 func NewWebsocket(socketURL *url.URL) (WebSocketer, error) {
+	return (&WebsocketOptions{}).NewWebsocket(socketURL)
+}
+
+/*
+WebsocketOptions configures the handshake of websocket connections, e.g. to
+reach a remote browser through a TLS proxy.
+*/
+type WebsocketOptions struct {
+	// Optional. Header is added to the handshake request, e.g. the
+	// authorization required by a proxy.
+	Header http.Header
+
+	// Optional. Proxy returns the proxy to connect through, see
+	// http.Transport.Proxy. Connections are direct by default.
+	Proxy func(*http.Request) (*url.URL, error)
+
+	// Optional. TLSClientConfig configures wss:// connections.
+	TLSClientConfig *tls.Config
+}
+
+/*
+NewWebsocket returns a connected socket connection that implements the
+WebSocketer interface. A nil options value uses the defaults.
+*/
+func (options *WebsocketOptions) NewWebsocket(socketURL *url.URL) (WebSocketer, error) {
+	if nil == options {
+		options = &WebsocketOptions{}
+	}
+	// The write buffer is allocated up front for each connection, so the
+	// default size is kept.
 	dialer := &websocket.Dialer{
 		EnableCompression: true,
-		// See: https://github.com/gorilla/websocket/issues/245
-		// Chrome does not support socket fragmentation: https://chromium.googlesource.com/chromium/src/+/master/net/server/web_socket_encoder.cc#85
-		// Chrome does not support payloads larger than 1MB: https://chromium.googlesource.com/chromium/src/+/master/net/server/http_connection.h#33
-		WriteBufferSize: 1 * 1024 * 1024,
+		Proxy:             options.Proxy,
+		TLSClientConfig:   options.TLSClientConfig,
 	}
 	header := http.Header{"Origin": []string{}}
+	for name, values := range options.Header {
+		header[http.CanonicalHeaderKey(name)] = append([]string{}, values...)
+	}
 
 	websocket, response, err := dialer.Dial(socketURL.String(), header)
 	if err != nil {
@@ -36,7 +79,10 @@ func NewWebsocket(socketURL *url.URL) (WebSocketer, error) {
 	log.WithFields(log.Fields{"status": response.Status, "url": socketURL.String()}).
 		Info("Websocket connection established")
 
-	return &ChromeWebSocket{conn: websocket}, nil
+	return &ChromeWebSocket{
+		conn:           websocket,
+		maxMessageSize: MaxMessageSize,
+	}, nil
 }
 
 /*
@@ -46,8 +92,13 @@ connection.
 ChromeWebSocket represents a WebSocketer interface
 */
 type ChromeWebSocket struct {
-	conn          *websocket.Conn
-	mockResponses []*Response
+	conn *websocket.Conn
+	// maxMessageSize is the maximum size of an outbound message in bytes.
+	maxMessageSize int
+	mockResponses  []*Response
+	// writeMux serializes writes, the connection supports only one
+	// concurrent writer.
+	writeMux sync.Mutex
 }
 
 /*
@@ -89,9 +140,33 @@ func (socket *ChromeWebSocket) WriteJSON(v interface{}) error {
 	if nil == socket.conn {
 		return errs.New(codes.WebsocketNotConnected, "not connected")
 	}
-	tmp, _ := json.Marshal(v)
-	if len(tmp) > 1*1024*1024 {
-		return fmt.Errorf("payload too large. chrome supports a maximum payload size of 1MB. See https://github.com/gorilla/websocket/issues/245")
+	data, err := json.Marshal(v)
+	if nil != err {
+		return err
 	}
-	return socket.conn.WriteJSON(v)
+	if socket.maxMessageSize > 0 && len(data) > socket.maxMessageSize {
+		return errs.New(codes.WebsocketMessageTooLarge, fmt.Sprintf(
+			"message size %d exceeds the maximum message size of %d bytes",
+			len(data),
+			socket.maxMessageSize,
+		))
+	}
+	socket.writeMux.Lock()
+	defer socket.writeMux.Unlock()
+	return socket.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+/*
+MaxMessageSize returns the maximum size of an outbound message in bytes.
+*/
+func (socket *ChromeWebSocket) MaxMessageSize() int {
+	return socket.maxMessageSize
+}
+
+/*
+SetMaxMessageSize sets the maximum size of an outbound message in bytes. A value
+of 0 removes the limit.
+*/
+func (socket *ChromeWebSocket) SetMaxMessageSize(size int) {
+	socket.maxMessageSize = size
 }
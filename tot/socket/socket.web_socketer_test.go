@@ -0,0 +1,118 @@
+package socket
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/gorilla/websocket"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+func TestWebsocketLargeMessage(t *testing.T) {
+	received := make(chan []byte, 2)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if nil != err {
+			return
+		}
+		defer conn.Close()
+		for {
+			_, message, err := conn.ReadMessage()
+			if nil != err {
+				return
+			}
+			received <- message
+		}
+	}))
+	defer server.Close()
+
+	socketURL, _ := url.Parse(strings.Replace(server.URL, "http", "ws", 1))
+	conn, err := NewWebsocket(socketURL)
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	defer conn.Close()
+
+	// Messages larger than the write buffer are sent in multiple frames.
+	for _, size := range []int{100, 3 * 1024 * 1024} {
+		params := strings.Repeat("x", size)
+		if err := conn.WriteJSON(&Payload{ID: 1, Method: "Page.setDocumentContent", Params: params}); nil != err {
+			t.Fatalf("Expected nil, got error: '%s'", err.Error())
+		}
+		select {
+		case message := <-received:
+			if !strings.Contains(string(message), params) {
+				t.Errorf("Expected a %d byte payload, got %d bytes", size, len(message))
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for a %d byte payload", size)
+		}
+	}
+
+	conn.(*ChromeWebSocket).SetMaxMessageSize(1024)
+	err = conn.WriteJSON(&Payload{ID: 2, Params: strings.Repeat("x", 2048)})
+	if nil == err {
+		t.Fatalf("Expected an error, got nil")
+	}
+	if codes.WebsocketMessageTooLarge != err.(errs.Err).Code() {
+		t.Errorf("Expected code %d, got %d", codes.WebsocketMessageTooLarge, err.(errs.Err).Code())
+	}
+}
+
+func TestWebsocketMaxMessageSize(t *testing.T) {
+	received := make(chan []byte, 1)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if nil != err {
+			return
+		}
+		defer conn.Close()
+		for {
+			_, message, err := conn.ReadMessage()
+			if nil != err {
+				return
+			}
+			received <- message
+		}
+	}))
+	defer server.Close()
+
+	maxMessageSize := MaxMessageSize
+	MaxMessageSize = 1024
+	defer func() { MaxMessageSize = maxMessageSize }()
+
+	socketURL, _ := url.Parse(strings.Replace(server.URL, "http", "ws", 1))
+	conn, err := NewWebsocket(socketURL)
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	defer conn.Close()
+
+	if 1024 != conn.(*ChromeWebSocket).MaxMessageSize() {
+		t.Errorf("Expected a limit of 1024 bytes, got %d", conn.(*ChromeWebSocket).MaxMessageSize())
+	}
+	err = conn.WriteJSON(&Payload{ID: 1, Params: strings.Repeat("x", 2048)})
+	if nil == err || codes.WebsocketMessageTooLarge != err.(errs.Err).Code() {
+		t.Fatalf("Expected a WebsocketMessageTooLarge error, got %v", err)
+	}
+
+	conn.(*ChromeWebSocket).SetMaxMessageSize(0)
+	if err := conn.WriteJSON(&Payload{ID: 2, Params: strings.Repeat("x", 2048)}); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	select {
+	case message := <-received:
+		if len(message) < 2048 {
+			t.Errorf("Expected the whole message, got %d bytes", len(message))
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for the message")
+	}
+}
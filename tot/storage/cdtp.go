@@ -17,3 +17,23 @@ type UsageForType struct {
 	// Storage usage (bytes).
 	Usage int `json:"usage"`
 }
+
+/*
+SerializedStorageKey is the serialized storage key of an origin.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-SerializedStorageKey
+*/
+type SerializedStorageKey string
+
+/*
+StorageBucket identifies a storage bucket.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-StorageBucket
+*/
+type StorageBucket struct {
+	// Storage key of the bucket.
+	StorageKey SerializedStorageKey `json:"storageKey"`
+
+	// Optional. If not specified, it is the default bucket of the storageKey.
+	Name string `json:"name,omitempty"`
+}
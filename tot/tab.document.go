@@ -0,0 +1,130 @@
+package chrome
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/runtime"
+)
+
+/*
+DefaultDocumentChunkSize is the default number of document bytes WriteDocument
+sends in each message.
+*/
+const DefaultDocumentChunkSize = 512 * 1024
+
+/*
+WriteDocument replaces the document of the tab with the HTML read from html. The
+document is sent in pieces of at most chunkSize bytes, so documents too large
+for a single message, or not yet fully available, can be written. A chunkSize
+of 0 uses DefaultDocumentChunkSize.
+
+The document is opened with document.open(), each piece is appended with
+document.write() and the document is closed once html is exhausted. The HTML
+parser handles markup split across pieces, multi-byte characters are never
+split.
+*/
+func (tab *Tab) WriteDocument(ctx context.Context, html io.Reader, chunkSize int) error {
+	return WriteDocument(ctx, tab.evaluate, html, chunkSize)
+}
+
+/*
+WriteDocument writes the HTML read from html to a document using evaluate to
+run the document.open(), document.write() and document.close() expressions, see
+Tab.WriteDocument. It does not depend on the protocol version so other versions
+of the Tab can share it.
+*/
+func WriteDocument(
+	ctx context.Context,
+	evaluate func(ctx context.Context, expression string) error,
+	html io.Reader,
+	chunkSize int,
+) error {
+	if chunkSize <= 0 {
+		chunkSize = DefaultDocumentChunkSize
+	}
+	if chunkSize < utf8.UTFMax {
+		chunkSize = utf8.UTFMax
+	}
+
+	if err := evaluate(ctx, "document.open()"); nil != err {
+		return err
+	}
+
+	chunk := make([]byte, chunkSize)
+	carry := 0
+	for {
+		n, err := io.ReadFull(html, chunk[carry:])
+		n += carry
+		done := io.EOF == err || io.ErrUnexpectedEOF == err
+		if nil != err && !done {
+			return errs.Wrap(err, codes.TabDocumentWriteFailed, "could not read the document")
+		}
+
+		// Hold back an incomplete trailing character for the next piece.
+		end := n
+		if !done {
+			end = completeRunes(chunk[:n])
+		}
+		if end > 0 {
+			if err := evaluate(ctx, fmt.Sprintf("document.write(%s)", quoteJS(chunk[:end]))); nil != err {
+				return err
+			}
+		}
+		carry = copy(chunk, chunk[end:n])
+		if done {
+			break
+		}
+	}
+
+	return evaluate(ctx, "document.close()")
+}
+
+/*
+completeRunes returns the length of the longest prefix of data that does not
+end with an incomplete UTF-8 sequence.
+*/
+func completeRunes(data []byte) int {
+	for a := len(data) - 1; a >= 0 && a >= len(data)-utf8.UTFMax; a-- {
+		if utf8.RuneStart(data[a]) {
+			if !utf8.FullRune(data[a:]) {
+				return a
+			}
+			break
+		}
+	}
+	return len(data)
+}
+
+/*
+quoteJS returns data as a JavaScript string literal.
+*/
+func quoteJS(data []byte) string {
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(string(data))
+	return string(bytes.TrimRight(buf.Bytes(), "\n"))
+}
+
+/*
+evaluate evaluates a document expression in the tab.
+*/
+func (tab *Tab) evaluate(ctx context.Context, expression string) error {
+	result := <-tab.Protocol().Runtime().EvaluateContext(ctx, &runtime.EvaluateParams{
+		Expression: expression,
+	})
+	if nil != result.Err {
+		return errs.Wrap(result.Err, codes.TabDocumentWriteFailed, "document write failed")
+	}
+	if nil != result.ExceptionDetails {
+		return errs.New(codes.TabDocumentWriteFailed, fmt.Sprintf("document write failed: %s", result.ExceptionDetails.Text))
+	}
+	return nil
+}
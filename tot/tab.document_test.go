@@ -0,0 +1,54 @@
+package chrome
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTabWriteDocument(t *testing.T) {
+	browser, payloads := newPipeBrowser(map[string]string{
+		"Runtime.evaluate": `{"result":{"type":"undefined"}}`,
+	})
+	defer browser.Stop()
+	tab := &Tab{data: &TabData{}, protocol: browser, socket: browser}
+
+	// 'é' is two bytes and straddles the first chunk boundary.
+	html := "<p>abcdefé</p>"
+	if err := tab.WriteDocument(context.Background(), strings.NewReader(html), 5); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	expected := []string{
+		`document.open()`,
+		`document.write("<p>ab")`,
+		`document.write("cdef")`,
+		`document.write("é</p")`,
+		`document.write(">")`,
+		`document.close()`,
+	}
+	for _, expression := range expected {
+		select {
+		case payload := <-payloads:
+			params, _ := payload.Params.(map[string]interface{})
+			if expression != params["expression"] {
+				t.Errorf("Expected '%s', got '%v'", expression, params["expression"])
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Timed out waiting for '%s'", expression)
+		}
+	}
+}
+
+func TestTabWriteDocumentException(t *testing.T) {
+	browser, _ := newPipeBrowser(map[string]string{
+		"Runtime.evaluate": `{"result":{"type":"object"},"exceptionDetails":{"text":"Uncaught"}}`,
+	})
+	defer browser.Stop()
+	tab := &Tab{data: &TabData{}, protocol: browser, socket: browser}
+
+	if err := tab.WriteDocument(context.Background(), strings.NewReader("<p></p>"), 0); nil == err {
+		t.Errorf("Expected an error, got nil")
+	}
+}
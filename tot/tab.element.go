@@ -0,0 +1,506 @@
+package chrome
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/dom"
+	"github.com/mkenney/go-chrome/tot/input"
+	"github.com/mkenney/go-chrome/tot/page"
+	"github.com/mkenney/go-chrome/tot/runtime"
+)
+
+/*
+ElementObjectGroup is the object group of the remote objects of elements, they
+can be released together with ReleaseElements.
+*/
+const ElementObjectGroup = "go-chrome-elements"
+
+/*
+BoundingBox is the border box of an element in CSS pixels, relative to the
+viewport.
+*/
+type BoundingBox struct {
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+}
+
+/*
+Element is a handle to a DOM element of a tab. It holds the ID of the DOM node
+and the ID of the JavaScript object of the element. The remote object is kept
+alive by the browser until the element is released or the document is
+unloaded.
+*/
+type Element struct {
+	nodeID   dom.NodeID
+	objectID runtime.RemoteObjectID
+	tab      *Tab
+}
+
+/*
+Query returns the first element of the document that matches the CSS
+selector, or nil if no element matches.
+*/
+func (tab *Tab) Query(ctx context.Context, selector string) (*Element, error) {
+	root, err := tab.documentNode(ctx)
+	if nil != err {
+		return nil, err
+	}
+	return tab.querySelector(ctx, root, selector)
+}
+
+/*
+QueryAll returns the elements of the document that match the CSS selector.
+*/
+func (tab *Tab) QueryAll(ctx context.Context, selector string) ([]*Element, error) {
+	root, err := tab.documentNode(ctx)
+	if nil != err {
+		return nil, err
+	}
+	return tab.querySelectorAll(ctx, root, selector)
+}
+
+/*
+ReleaseElements releases the remote objects of all elements of the tab.
+*/
+func (tab *Tab) ReleaseElements(ctx context.Context) error {
+	result := <-tab.Protocol().Runtime().ReleaseObjectGroupContext(ctx, &runtime.ReleaseObjectGroupParams{
+		ObjectGroup: ElementObjectGroup,
+	})
+	if nil != result.Err {
+		return errs.Wrap(result.Err, codes.TabElementFailed, "could not release the elements")
+	}
+	return nil
+}
+
+/*
+documentNode returns the node ID of the document. Requesting the document
+invalidates the node IDs of previously returned elements, their object IDs
+remain valid.
+*/
+func (tab *Tab) documentNode(ctx context.Context) (dom.NodeID, error) {
+	result := <-tab.Protocol().DOM().GetDocumentContext(ctx, &dom.GetDocumentParams{})
+	if nil != result.Err {
+		return 0, errs.Wrap(result.Err, codes.TabElementFailed, "could not get the document")
+	}
+	if nil == result.Root {
+		return 0, errs.New(codes.TabElementFailed, "the tab has no document")
+	}
+	return result.Root.NodeID, nil
+}
+
+func (tab *Tab) querySelector(ctx context.Context, nodeID dom.NodeID, selector string) (*Element, error) {
+	result := <-tab.Protocol().DOM().QuerySelectorContext(ctx, &dom.QuerySelectorParams{
+		NodeID:   nodeID,
+		Selector: selector,
+	})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.TabElementFailed, fmt.Sprintf("could not query '%s'", selector))
+	}
+	if 0 == result.NodeID {
+		return nil, nil
+	}
+	return tab.element(ctx, result.NodeID)
+}
+
+func (tab *Tab) querySelectorAll(ctx context.Context, nodeID dom.NodeID, selector string) ([]*Element, error) {
+	result := <-tab.Protocol().DOM().QuerySelectorAllContext(ctx, &dom.QuerySelectorAllParams{
+		NodeID:   nodeID,
+		Selector: selector,
+	})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.TabElementFailed, fmt.Sprintf("could not query '%s'", selector))
+	}
+	elements := make([]*Element, 0, len(result.NodeIDs))
+	for _, nodeID := range result.NodeIDs {
+		element, err := tab.element(ctx, nodeID)
+		if nil != err {
+			return nil, err
+		}
+		elements = append(elements, element)
+	}
+	return elements, nil
+}
+
+/*
+element resolves the JavaScript object of a node.
+*/
+func (tab *Tab) element(ctx context.Context, nodeID dom.NodeID) (*Element, error) {
+	result := <-tab.Protocol().DOM().ResolveNodeContext(ctx, &dom.ResolveNodeParams{
+		NodeID:      nodeID,
+		ObjectGroup: ElementObjectGroup,
+	})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.TabElementFailed, fmt.Sprintf("could not resolve node %d", nodeID))
+	}
+	if nil == result.Object || "" == result.Object.ObjectID {
+		return nil, errs.New(codes.TabElementFailed, fmt.Sprintf("node %d has no object", nodeID))
+	}
+	return &Element{
+		nodeID:   nodeID,
+		objectID: result.Object.ObjectID,
+		tab:      tab,
+	}, nil
+}
+
+/*
+elementFromObject returns the element of a JavaScript object.
+*/
+func (tab *Tab) elementFromObject(ctx context.Context, objectID runtime.RemoteObjectID) (*Element, error) {
+	element := &Element{
+		objectID: objectID,
+		tab:      tab,
+	}
+	nodeID, err := element.node(ctx)
+	if nil != err {
+		return nil, err
+	}
+	element.nodeID = nodeID
+	return element, nil
+}
+
+/*
+NodeID returns the ID of the DOM node of the element, as of when the element
+was found. Node IDs are invalidated when the document is requested again, e.g.
+by Tab.Query.
+*/
+func (element *Element) NodeID() dom.NodeID {
+	return element.nodeID
+}
+
+/*
+ObjectID returns the ID of the JavaScript object of the element.
+*/
+func (element *Element) ObjectID() runtime.RemoteObjectID {
+	return element.objectID
+}
+
+/*
+Tab returns the tab of the element.
+*/
+func (element *Element) Tab() *Tab {
+	return element.tab
+}
+
+/*
+Query returns the first descendant of the element that matches the CSS
+selector, or nil if no element matches.
+*/
+func (element *Element) Query(ctx context.Context, selector string) (*Element, error) {
+	nodeID, err := element.node(ctx)
+	if nil != err {
+		return nil, err
+	}
+	return element.tab.querySelector(ctx, nodeID, selector)
+}
+
+/*
+QueryAll returns the descendants of the element that match the CSS selector.
+*/
+func (element *Element) QueryAll(ctx context.Context, selector string) ([]*Element, error) {
+	nodeID, err := element.node(ctx)
+	if nil != err {
+		return nil, err
+	}
+	return element.tab.querySelectorAll(ctx, nodeID, selector)
+}
+
+/*
+Children returns the child elements of the element.
+*/
+func (element *Element) Children(ctx context.Context) ([]*Element, error) {
+	return element.QueryAll(ctx, ":scope > *")
+}
+
+/*
+Text returns the text content of the element.
+*/
+func (element *Element) Text(ctx context.Context) (string, error) {
+	value, err := element.call(ctx, "function() { return this.textContent; }")
+	if nil != err {
+		return "", err
+	}
+	text, _ := value.(string)
+	return text, nil
+}
+
+/*
+Attribute returns the value of an attribute of the element, and whether the
+element has the attribute.
+*/
+func (element *Element) Attribute(ctx context.Context, name string) (string, bool, error) {
+	value, err := element.call(ctx, "function(name) { return this.getAttribute(name); }", name)
+	if nil != err {
+		return "", false, err
+	}
+	text, ok := value.(string)
+	return text, ok, nil
+}
+
+/*
+BoundingBox returns the border box of the element. Transformed elements return
+the box enclosing the transformed border.
+*/
+func (element *Element) BoundingBox(ctx context.Context) (*BoundingBox, error) {
+	value, err := element.call(ctx, `function() {
+		const rect = this.getBoundingClientRect();
+		return {x: rect.left, y: rect.top, width: rect.width, height: rect.height};
+	}`)
+	if nil != err {
+		return nil, err
+	}
+	box, ok := boundingBox(value)
+	if !ok {
+		return nil, errs.New(codes.TabElementFailed, "could not get the bounding box")
+	}
+	return box, nil
+}
+
+/*
+boundingBox decodes a bounding box returned by value.
+*/
+func boundingBox(value interface{}) (*BoundingBox, bool) {
+	rect, _ := value.(map[string]interface{})
+	x, okX := rect["x"].(float64)
+	y, okY := rect["y"].(float64)
+	width, okWidth := rect["width"].(float64)
+	height, okHeight := rect["height"].(float64)
+	if !okX || !okY || !okWidth || !okHeight {
+		return nil, false
+	}
+	return &BoundingBox{
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+	}, true
+}
+
+/*
+ScrollIntoView scrolls the element to the center of the viewport.
+*/
+func (element *Element) ScrollIntoView(ctx context.Context) error {
+	_, err := element.call(ctx, "function() { this.scrollIntoView({block: 'center', inline: 'center'}); }")
+	return err
+}
+
+/*
+Focus focuses the element.
+*/
+func (element *Element) Focus(ctx context.Context) error {
+	result := <-element.tab.Protocol().DOM().FocusContext(ctx, &dom.FocusParams{
+		ObjectID: element.objectID,
+	})
+	if nil != result.Err {
+		return errs.Wrap(result.Err, codes.TabElementFailed, "could not focus the element")
+	}
+	return nil
+}
+
+/*
+Click scrolls the element into view and clicks the center of its bounding box
+with the left mouse button of the tab.
+*/
+func (element *Element) Click(ctx context.Context) error {
+	x, y, err := element.center(ctx)
+	if nil != err {
+		return err
+	}
+	return element.tab.Mouse().Click(ctx, x, y, input.ButtonEvent.Left, 1)
+}
+
+/*
+DoubleClick scrolls the element into view and double clicks the center of its
+bounding box.
+*/
+func (element *Element) DoubleClick(ctx context.Context) error {
+	x, y, err := element.center(ctx)
+	if nil != err {
+		return err
+	}
+	return element.tab.Mouse().DoubleClick(ctx, x, y)
+}
+
+/*
+Hover scrolls the element into view and moves the mouse to the center of its
+bounding box.
+*/
+func (element *Element) Hover(ctx context.Context) error {
+	x, y, err := element.center(ctx)
+	if nil != err {
+		return err
+	}
+	return element.tab.Mouse().Move(ctx, x, y, 1)
+}
+
+/*
+DragTo drags the element with the mouse to the center of the target element,
+moving in steps. Draggable elements, e.g. elements with the draggable
+attribute, links and images, are dragged with native HTML5 drag and drop, see
+Mouse.DragAndDrop, other elements with mouse events, see Mouse.Drag.
+*/
+func (element *Element) DragTo(ctx context.Context, target *Element, steps int) error {
+	draggable, err := element.call(ctx, `function() {
+		return this.draggable || null !== this.closest('[draggable="true"]');
+	}`)
+	if nil != err {
+		return err
+	}
+	fromX, fromY, err := element.center(ctx)
+	if nil != err {
+		return err
+	}
+	box, err := target.BoundingBox(ctx)
+	if nil != err {
+		return err
+	}
+	toX, toY := box.X+box.Width/2, box.Y+box.Height/2
+	if dragging, _ := draggable.(bool); dragging {
+		return element.tab.Mouse().DragAndDrop(ctx, fromX, fromY, toX, toY, steps)
+	}
+	return element.tab.Mouse().Drag(ctx, fromX, fromY, toX, toY, steps)
+}
+
+/*
+Tap scrolls the element into view and taps the center of its bounding box on
+the touchscreen of the tab.
+*/
+func (element *Element) Tap(ctx context.Context) error {
+	x, y, err := element.center(ctx)
+	if nil != err {
+		return err
+	}
+	return element.tab.Touchscreen().Tap(ctx, x, y)
+}
+
+/*
+center scrolls the element into view and returns the center of its bounding
+box.
+*/
+func (element *Element) center(ctx context.Context) (float64, float64, error) {
+	if err := element.ScrollIntoView(ctx); nil != err {
+		return 0, 0, err
+	}
+	box, err := element.BoundingBox(ctx)
+	if nil != err {
+		return 0, 0, err
+	}
+	return box.X + box.Width/2, box.Y + box.Height/2, nil
+}
+
+/*
+Type focuses the element and types the text with the keyboard of the tab.
+*/
+func (element *Element) Type(ctx context.Context, text string) error {
+	if err := element.Focus(ctx); nil != err {
+		return err
+	}
+	return element.tab.Keyboard().Type(ctx, text, 0)
+}
+
+/*
+Screenshot scrolls the element into view and returns a PNG image of its
+bounding box.
+*/
+func (element *Element) Screenshot(ctx context.Context) ([]byte, error) {
+	if err := element.ScrollIntoView(ctx); nil != err {
+		return nil, err
+	}
+	box, err := element.BoundingBox(ctx)
+	if nil != err {
+		return nil, err
+	}
+	if box.Width <= 0 || box.Height <= 0 {
+		return nil, errs.New(codes.TabElementFailed, "the element has no area")
+	}
+
+	// The clip is relative to the document, the box to the viewport.
+	metrics := <-element.tab.Protocol().Page().GetLayoutMetricsContext(ctx)
+	if nil != metrics.Err {
+		return nil, errs.Wrap(metrics.Err, codes.TabElementFailed, "could not get the layout metrics")
+	}
+	var pageX, pageY int
+	if nil != metrics.LayoutViewport {
+		pageX = metrics.LayoutViewport.PageX
+		pageY = metrics.LayoutViewport.PageY
+	}
+	x := math.Floor(box.X)
+	y := math.Floor(box.Y)
+	result := <-element.tab.Protocol().Page().CaptureScreenshotContext(ctx, &page.CaptureScreenshotParams{
+		Format: page.Format.Png,
+		Clip: &page.Viewport{
+			X:      int(x) + pageX,
+			Y:      int(y) + pageY,
+			Width:  int(math.Ceil(box.X + box.Width - x)),
+			Height: int(math.Ceil(box.Y + box.Height - y)),
+			Scale:  1,
+		},
+	})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.TabElementFailed, "could not capture the screenshot")
+	}
+	data, err := base64.StdEncoding.DecodeString(result.Data)
+	if nil != err {
+		return nil, errs.Wrap(err, codes.TabElementFailed, "could not decode the screenshot")
+	}
+	return data, nil
+}
+
+/*
+Release releases the remote object of the element. The element can not be used
+after it is released.
+*/
+func (element *Element) Release(ctx context.Context) error {
+	result := <-element.tab.Protocol().Runtime().ReleaseObjectContext(ctx, &runtime.ReleaseObjectParams{
+		ObjectID: element.objectID,
+	})
+	if nil != result.Err {
+		return errs.Wrap(result.Err, codes.TabElementFailed, "could not release the element")
+	}
+	return nil
+}
+
+/*
+node returns the current node ID of the element.
+*/
+func (element *Element) node(ctx context.Context) (dom.NodeID, error) {
+	result := <-element.tab.Protocol().DOM().RequestNodeContext(ctx, &dom.RequestNodeParams{
+		ObjectID: element.objectID,
+	})
+	if nil != result.Err {
+		return 0, errs.Wrap(result.Err, codes.TabElementFailed, "could not get the element node")
+	}
+	return result.NodeID, nil
+}
+
+/*
+call calls a function with the element as this and returns the result value.
+*/
+func (element *Element) call(ctx context.Context, declaration string, args ...interface{}) (interface{}, error) {
+	arguments := make([]*runtime.CallArgument, len(args))
+	for k, arg := range args {
+		arguments[k] = &runtime.CallArgument{Value: arg}
+	}
+	result := <-element.tab.Protocol().Runtime().CallFunctionOnContext(ctx, &runtime.CallFunctionOnParams{
+		FunctionDeclaration: declaration,
+		ObjectID:            element.objectID,
+		Arguments:           arguments,
+		ReturnByValue:       true,
+	})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.TabElementFailed, "could not call a function on the element")
+	}
+	if nil != result.ExceptionDetails {
+		return nil, errs.New(codes.TabElementFailed, fmt.Sprintf("function call failed: %s", result.ExceptionDetails.Text))
+	}
+	if nil == result.Result {
+		return nil, nil
+	}
+	return result.Result.Value, nil
+}
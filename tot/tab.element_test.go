@@ -0,0 +1,181 @@
+package chrome
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+func TestTabElement(t *testing.T) {
+	browser, payloads := newPipeBrowser(map[string]string{
+		"DOM.getDocument":        `{"root":{"nodeId":1}}`,
+		"DOM.querySelector":      `{"nodeId":5}`,
+		"DOM.resolveNode":        `{"object":{"type":"object","objectId":"object-5"}}`,
+		"Runtime.callFunctionOn": `{"result":{"type":"object","value":{"x":10,"y":20,"width":100,"height":50}}}`,
+	})
+	defer browser.Stop()
+	tab := &Tab{data: &TabData{}, protocol: browser, socket: browser}
+	ctx := context.Background()
+
+	element, err := tab.Query(ctx, "button")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if 5 != element.NodeID() || "object-5" != element.ObjectID() {
+		t.Errorf("Unexpected element %d '%s'", element.NodeID(), element.ObjectID())
+	}
+
+	box, err := element.BoundingBox(ctx)
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if 10 != box.X || 20 != box.Y || 100 != box.Width || 50 != box.Height {
+		t.Errorf("Unexpected bounding box %+v", box)
+	}
+
+	drain(payloads)
+	if err := element.Click(ctx); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	var clicks int
+	for _, payload := range drain(payloads) {
+		if "Input.dispatchMouseEvent" != payload.Method {
+			continue
+		}
+		params, _ := payload.Params.(map[string]interface{})
+		if 60.0 != params["x"] || 45.0 != params["y"] {
+			t.Errorf("Expected a click at 60,45, got %v,%v", params["x"], params["y"])
+		}
+		clicks++
+	}
+	if 3 != clicks {
+		t.Errorf("Expected 3 mouse events, got %d", clicks)
+	}
+}
+
+func TestTabElementText(t *testing.T) {
+	browser, _ := newPipeBrowser(map[string]string{
+		"Runtime.callFunctionOn": `{"result":{"type":"string","value":"Submit"}}`,
+	})
+	defer browser.Stop()
+	tab := &Tab{data: &TabData{}, protocol: browser, socket: browser}
+	element := &Element{nodeID: 5, objectID: "object-5", tab: tab}
+
+	text, err := element.Text(context.Background())
+	if nil != err || "Submit" != text {
+		t.Errorf("Expected 'Submit', got '%s' (%v)", text, err)
+	}
+	value, ok, err := element.Attribute(context.Background(), "value")
+	if nil != err || !ok || "Submit" != value {
+		t.Errorf("Expected 'Submit', got '%s' %t (%v)", value, ok, err)
+	}
+}
+
+func TestTabElementDragTo(t *testing.T) {
+	browser, payloads := newPipeBrowserEvents(func(payload *socket.Payload) string {
+		if "Runtime.callFunctionOn" != payload.Method {
+			return `{}`
+		}
+		params, _ := payload.Params.(map[string]interface{})
+		declaration, _ := params["functionDeclaration"].(string)
+		switch {
+		case strings.Contains(declaration, "draggable"):
+			return fmt.Sprintf(`{"result":{"type":"boolean","value":%t}}`, "object-draggable" == params["objectId"])
+		case strings.Contains(declaration, "getBoundingClientRect"):
+			return `{"result":{"type":"object","value":{"x":10,"y":20,"width":100,"height":50}}}`
+		}
+		return `{"result":{"type":"undefined"}}`
+	}, func(payload *socket.Payload) []*socket.Response {
+		// The page starts a native drag when the pressed cursor moves.
+		params, _ := payload.Params.(map[string]interface{})
+		if "Input.dispatchMouseEvent" != payload.Method || "mouseMoved" != params["type"] || 1.0 != params["buttons"] {
+			return nil
+		}
+		return []*socket.Response{{
+			Method: "Input.dragIntercepted",
+			Params: []byte(`{"data":{"items":[{"mimeType":"text/plain","data":"dragged"}],"dragOperationsMask":1}}`),
+		}}
+	})
+	defer browser.Stop()
+	tab := &Tab{data: &TabData{}, protocol: browser, socket: browser}
+	target := &Element{nodeID: 7, objectID: "object-target", tab: tab}
+	ctx := context.Background()
+
+	// Draggable elements are dragged with native drag and drop.
+	draggable := &Element{nodeID: 5, objectID: "object-draggable", tab: tab}
+	if err := draggable.DragTo(ctx, target, 2); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	events := []string{}
+	for _, payload := range drain(payloads) {
+		params, _ := payload.Params.(map[string]interface{})
+		switch payload.Method {
+		case "Input.setInterceptDrags":
+			events = append(events, fmt.Sprintf("intercept=%v", params["enabled"]))
+		case "Input.dispatchMouseEvent":
+			events = append(events, fmt.Sprintf("%v", params["type"]))
+		case "Input.dispatchDragEvent":
+			data, _ := json.Marshal(params["data"])
+			if !strings.Contains(string(data), `"data":"dragged"`) {
+				t.Errorf("Expected the intercepted data, got %s", data)
+			}
+			events = append(events, fmt.Sprintf("%v:%v,%v", params["type"], params["x"], params["y"]))
+		}
+	}
+	expected := "intercept=true,mouseMoved,mousePressed,mouseMoved,mouseMoved,dragEnter:60,45,dragOver:60,45,drop:60,45,mouseReleased,intercept=false"
+	if actual := strings.Join(events, ","); expected != actual {
+		t.Errorf("Expected '%s', got '%s'", expected, actual)
+	}
+
+	element := &Element{nodeID: 6, objectID: "object-6", tab: tab}
+	if err := element.DragTo(ctx, target, 2); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	events = []string{}
+	for _, payload := range drain(payloads) {
+		if "Input.dispatchMouseEvent" == payload.Method {
+			params, _ := payload.Params.(map[string]interface{})
+			events = append(events, fmt.Sprintf("%v", params["type"]))
+		}
+	}
+	if "mouseMoved,mousePressed,mouseMoved,mouseMoved,mouseReleased" != strings.Join(events, ",") {
+		t.Errorf("Unexpected mouse events %v", events)
+	}
+}
+
+func TestTabElementNotFound(t *testing.T) {
+	browser, _ := newPipeBrowser(map[string]string{
+		"DOM.getDocument":   `{"root":{"nodeId":1}}`,
+		"DOM.querySelector": `{"nodeId":0}`,
+	})
+	defer browser.Stop()
+	tab := &Tab{data: &TabData{}, protocol: browser, socket: browser}
+
+	element, err := tab.Query(context.Background(), "#missing")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if nil != element {
+		t.Errorf("Expected no element, got %v", element)
+	}
+}
+
+/*
+drain returns the payloads received so far.
+*/
+func drain(payloads chan *socket.Payload) []*socket.Payload {
+	var received []*socket.Payload
+	for {
+		select {
+		case payload := <-payloads:
+			received = append(received, payload)
+		case <-time.After(50 * time.Millisecond):
+			return received
+		}
+	}
+}
@@ -0,0 +1,442 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/input"
+)
+
+/*
+Modifier key bits of input events.
+*/
+const (
+	ModifierAlt     = 1
+	ModifierControl = 2
+	ModifierMeta    = 4
+	ModifierShift   = 8
+)
+
+/*
+Key locations of input events.
+*/
+const (
+	KeyLocationStandard = 0
+	KeyLocationLeft     = 1
+	KeyLocationRight    = 2
+	KeyLocationNumpad   = 3
+)
+
+/*
+KeyDefinition describes a key of the US keyboard layout.
+*/
+type KeyDefinition struct {
+	// Key is the key value, e.g. 'a' or 'Enter', ShiftKey the key value while
+	// Shift is pressed, e.g. 'A'.
+	Key      string
+	ShiftKey string
+
+	// Code is the physical key, e.g. 'KeyA'.
+	Code string
+
+	// KeyCode is the Windows virtual key code.
+	KeyCode int
+
+	// Text and ShiftText are the text the key generates, empty for keys that
+	// do not generate text.
+	Text      string
+	ShiftText string
+
+	// Location is the location of the key on the keyboard.
+	Location int
+}
+
+/*
+usKeyboardLayout holds the keys that are not letters or digits.
+*/
+var usKeyboardLayout = []*KeyDefinition{
+	{Code: "Backspace", Key: "Backspace", KeyCode: 8},
+	{Code: "Tab", Key: "Tab", KeyCode: 9},
+	{Code: "Enter", Key: "Enter", KeyCode: 13, Text: "\r"},
+	{Code: "ShiftLeft", Key: "Shift", KeyCode: 16, Location: KeyLocationLeft},
+	{Code: "ShiftRight", Key: "Shift", KeyCode: 16, Location: KeyLocationRight},
+	{Code: "ControlLeft", Key: "Control", KeyCode: 17, Location: KeyLocationLeft},
+	{Code: "ControlRight", Key: "Control", KeyCode: 17, Location: KeyLocationRight},
+	{Code: "AltLeft", Key: "Alt", KeyCode: 18, Location: KeyLocationLeft},
+	{Code: "AltRight", Key: "Alt", KeyCode: 18, Location: KeyLocationRight},
+	{Code: "Pause", Key: "Pause", KeyCode: 19},
+	{Code: "CapsLock", Key: "CapsLock", KeyCode: 20},
+	{Code: "Escape", Key: "Escape", KeyCode: 27},
+	{Code: "Space", Key: " ", KeyCode: 32},
+	{Code: "PageUp", Key: "PageUp", KeyCode: 33},
+	{Code: "PageDown", Key: "PageDown", KeyCode: 34},
+	{Code: "End", Key: "End", KeyCode: 35},
+	{Code: "Home", Key: "Home", KeyCode: 36},
+	{Code: "ArrowLeft", Key: "ArrowLeft", KeyCode: 37},
+	{Code: "ArrowUp", Key: "ArrowUp", KeyCode: 38},
+	{Code: "ArrowRight", Key: "ArrowRight", KeyCode: 39},
+	{Code: "ArrowDown", Key: "ArrowDown", KeyCode: 40},
+	{Code: "Insert", Key: "Insert", KeyCode: 45},
+	{Code: "Delete", Key: "Delete", KeyCode: 46},
+	{Code: "MetaLeft", Key: "Meta", KeyCode: 91, Location: KeyLocationLeft},
+	{Code: "MetaRight", Key: "Meta", KeyCode: 92, Location: KeyLocationRight},
+	{Code: "ContextMenu", Key: "ContextMenu", KeyCode: 93},
+	{Code: "NumpadMultiply", Key: "*", KeyCode: 106, Location: KeyLocationNumpad},
+	{Code: "NumpadAdd", Key: "+", KeyCode: 107, Location: KeyLocationNumpad},
+	{Code: "NumpadSubtract", Key: "-", KeyCode: 109, Location: KeyLocationNumpad},
+	{Code: "NumpadDecimal", Key: ".", KeyCode: 110, Location: KeyLocationNumpad},
+	{Code: "NumpadDivide", Key: "/", KeyCode: 111, Location: KeyLocationNumpad},
+	{Code: "Semicolon", Key: ";", ShiftKey: ":", KeyCode: 186},
+	{Code: "Equal", Key: "=", ShiftKey: "+", KeyCode: 187},
+	{Code: "Comma", Key: ",", ShiftKey: "<", KeyCode: 188},
+	{Code: "Minus", Key: "-", ShiftKey: "_", KeyCode: 189},
+	{Code: "Period", Key: ".", ShiftKey: ">", KeyCode: 190},
+	{Code: "Slash", Key: "/", ShiftKey: "?", KeyCode: 191},
+	{Code: "Backquote", Key: "`", ShiftKey: "~", KeyCode: 192},
+	{Code: "BracketLeft", Key: "[", ShiftKey: "{", KeyCode: 219},
+	{Code: "Backslash", Key: "\\", ShiftKey: "|", KeyCode: 220},
+	{Code: "BracketRight", Key: "]", ShiftKey: "}", KeyCode: 221},
+	{Code: "Quote", Key: "'", ShiftKey: "\"", KeyCode: 222},
+}
+
+/*
+keyDefinitions maps key values and codes to key definitions. Shifted key
+values, e.g. 'A', map to definitions that generate the shifted key without
+pressing Shift.
+*/
+var keyDefinitions = map[string]*KeyDefinition{}
+
+func init() {
+	definitions := []*KeyDefinition{}
+	digitShiftKeys := ")!@#$%^&*("
+	for a := 0; a < 10; a++ {
+		digit := fmt.Sprintf("%d", a)
+		definitions = append(definitions, &KeyDefinition{
+			Code:     "Digit" + digit,
+			Key:      digit,
+			ShiftKey: digitShiftKeys[a : a+1],
+			KeyCode:  48 + a,
+		})
+	}
+	for a := 'a'; a <= 'z'; a++ {
+		definitions = append(definitions, &KeyDefinition{
+			Code:     "Key" + strings.ToUpper(string(a)),
+			Key:      string(a),
+			ShiftKey: strings.ToUpper(string(a)),
+			KeyCode:  int(a - 'a' + 'A'),
+		})
+	}
+	for a := 1; a <= 12; a++ {
+		definitions = append(definitions, &KeyDefinition{
+			Code:    fmt.Sprintf("F%d", a),
+			Key:     fmt.Sprintf("F%d", a),
+			KeyCode: 111 + a,
+		})
+	}
+	for a := 0; a < 10; a++ {
+		digit := fmt.Sprintf("%d", a)
+		definitions = append(definitions, &KeyDefinition{
+			Code:     "Numpad" + digit,
+			Key:      digit,
+			KeyCode:  96 + a,
+			Location: KeyLocationNumpad,
+		})
+	}
+	definitions = append(definitions, usKeyboardLayout...)
+
+	// Codes take precedence over key values and shifted key values, the
+	// first definition of a key value wins and numpad keys come last.
+	for _, definition := range definitions {
+		if 1 == len([]rune(definition.Key)) && "" == definition.Text {
+			definition.Text = definition.Key
+		}
+		if 1 == len([]rune(definition.ShiftKey)) && "" == definition.ShiftText {
+			definition.ShiftText = definition.ShiftKey
+		}
+		keyDefinitions[definition.Code] = definition
+	}
+	for _, definition := range definitions {
+		if _, ok := keyDefinitions[definition.Key]; !ok && KeyLocationNumpad != definition.Location {
+			keyDefinitions[definition.Key] = definition
+		}
+	}
+	for _, definition := range definitions {
+		if _, ok := keyDefinitions[definition.ShiftKey]; !ok && "" != definition.ShiftKey {
+			keyDefinitions[definition.ShiftKey] = &KeyDefinition{
+				Code:     definition.Code,
+				Key:      definition.ShiftKey,
+				KeyCode:  definition.KeyCode,
+				Text:     definition.ShiftText,
+				Location: definition.Location,
+			}
+		}
+	}
+	for _, definition := range definitions {
+		if _, ok := keyDefinitions[definition.Key]; !ok {
+			keyDefinitions[definition.Key] = definition
+		}
+	}
+	keyDefinitions["\r"] = keyDefinitions["Enter"]
+	keyDefinitions["\n"] = keyDefinitions["Enter"]
+}
+
+/*
+LookupKey returns the definition of a key value, e.g. 'a', 'A' or 'Enter', or
+a key code, e.g. 'KeyA' or 'ShiftRight', of the US keyboard layout.
+*/
+func LookupKey(key string) (*KeyDefinition, bool) {
+	definition, ok := keyDefinitions[key]
+	return definition, ok
+}
+
+/*
+Keyboard dispatches key events to a tab and tracks the pressed keys and
+modifiers.
+*/
+type Keyboard struct {
+	modifiers int
+	mux       sync.Mutex
+	pressed   map[string]bool
+	tab       *Tab
+}
+
+/*
+Keyboard returns the keyboard of the tab.
+*/
+func (tab *Tab) Keyboard() *Keyboard {
+	tab.mux.Lock()
+	defer tab.mux.Unlock()
+	if nil == tab.keyboard {
+		tab.keyboard = &Keyboard{
+			pressed: map[string]bool{},
+			tab:     tab,
+		}
+	}
+	return tab.keyboard
+}
+
+/*
+Modifiers returns the bits of the pressed modifier keys.
+*/
+func (keyboard *Keyboard) Modifiers() int {
+	keyboard.mux.Lock()
+	defer keyboard.mux.Unlock()
+	return keyboard.modifiers
+}
+
+/*
+Down presses a key, see LookupKey. Pressing a modifier key adds it to the
+modifiers of the following events, a key pressed again is an auto-repeat.
+*/
+func (keyboard *Keyboard) Down(ctx context.Context, key string) error {
+	definition, ok := LookupKey(key)
+	if !ok {
+		return errs.New(codes.TabKeyUnknown, fmt.Sprintf("unknown key '%s'", key))
+	}
+	return keyboard.down(ctx, definition)
+}
+
+/*
+Up releases a key, see LookupKey.
+*/
+func (keyboard *Keyboard) Up(ctx context.Context, key string) error {
+	definition, ok := LookupKey(key)
+	if !ok {
+		return errs.New(codes.TabKeyUnknown, fmt.Sprintf("unknown key '%s'", key))
+	}
+	return keyboard.up(ctx, definition)
+}
+
+/*
+Press presses and releases a key or a combination of keys joined with '+', e.g.
+'Enter', 'Shift+ArrowLeft' or 'Control+A'. The keys are pressed in order and
+released in reverse order.
+*/
+func (keyboard *Keyboard) Press(ctx context.Context, keys string) error {
+	definitions := []*KeyDefinition{}
+	for _, key := range splitKeys(keys) {
+		definition, ok := LookupKey(key)
+		if !ok {
+			return errs.New(codes.TabKeyUnknown, fmt.Sprintf("unknown key '%s' in '%s'", key, keys))
+		}
+		definitions = append(definitions, definition)
+	}
+
+	var err error
+	pressed := 0
+	for _, definition := range definitions {
+		if err = keyboard.down(ctx, definition); nil != err {
+			break
+		}
+		pressed++
+	}
+	for a := pressed - 1; a >= 0; a-- {
+		if upErr := keyboard.up(ctx, definitions[a]); nil == err {
+			err = upErr
+		}
+	}
+	return err
+}
+
+/*
+Type types the text into the focused element, waiting delay between the
+characters. Characters of the keyboard layout are typed with key events, other
+characters, e.g. 'é', are inserted with Input.insertText.
+*/
+func (keyboard *Keyboard) Type(ctx context.Context, text string, delay time.Duration) error {
+	for k, char := range []rune(text) {
+		if k > 0 && delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return errs.Wrap(ctx.Err(), codes.TabInputFailed, "typing was interrupted")
+			}
+		}
+		definition, ok := LookupKey(string(char))
+		if !ok {
+			if err := keyboard.InsertText(ctx, string(char)); nil != err {
+				return err
+			}
+			continue
+		}
+		if err := keyboard.down(ctx, definition); nil != err {
+			return err
+		}
+		if err := keyboard.up(ctx, definition); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+InsertText inserts the text into the focused element without key events, like
+an IME or an emoji picker.
+*/
+func (keyboard *Keyboard) InsertText(ctx context.Context, text string) error {
+	result := <-keyboard.tab.Protocol().Input().InsertTextContext(ctx, &input.InsertTextParams{Text: text})
+	if nil != result.Err {
+		return errs.Wrap(result.Err, codes.TabInputFailed, "could not insert the text")
+	}
+	return nil
+}
+
+/*
+down dispatches a rawKeyDown event, and a char event for keys that generate
+text. Keys do not generate text while modifiers other than Shift are pressed.
+*/
+func (keyboard *Keyboard) down(ctx context.Context, definition *KeyDefinition) error {
+	keyboard.mux.Lock()
+	defer keyboard.mux.Unlock()
+
+	autoRepeat := keyboard.pressed[definition.Code]
+	keyboard.pressed[definition.Code] = true
+	keyboard.modifiers |= modifierBit(definition.Key)
+
+	key, text := definition.Key, definition.Text
+	if 0 != keyboard.modifiers&ModifierShift && "" != definition.ShiftKey {
+		key, text = definition.ShiftKey, definition.ShiftText
+	}
+	if 0 != keyboard.modifiers&^ModifierShift {
+		text = ""
+	}
+
+	events := []*input.DispatchKeyEventParams{{
+		Type:                  input.KeyEvent.RawKeyDown,
+		Modifiers:             keyboard.modifiers,
+		Key:                   key,
+		Code:                  definition.Code,
+		WindowsVirtualKeyCode: definition.KeyCode,
+		NativeVirtualKeyCode:  definition.KeyCode,
+		AutoRepeat:            autoRepeat,
+		IsKeypad:              KeyLocationNumpad == definition.Location,
+		Location:              definition.Location,
+	}}
+	if "" != text {
+		events = append(events, &input.DispatchKeyEventParams{
+			Type:                  input.KeyEvent.Char,
+			Modifiers:             keyboard.modifiers,
+			Text:                  text,
+			UnmodifiedText:        text,
+			Key:                   key,
+			Code:                  definition.Code,
+			WindowsVirtualKeyCode: definition.KeyCode,
+			NativeVirtualKeyCode:  definition.KeyCode,
+			AutoRepeat:            autoRepeat,
+			IsKeypad:              KeyLocationNumpad == definition.Location,
+			Location:              definition.Location,
+		})
+	}
+	return keyboard.dispatch(ctx, events)
+}
+
+/*
+up dispatches a keyUp event.
+*/
+func (keyboard *Keyboard) up(ctx context.Context, definition *KeyDefinition) error {
+	keyboard.mux.Lock()
+	defer keyboard.mux.Unlock()
+
+	delete(keyboard.pressed, definition.Code)
+	keyboard.modifiers &^= modifierBit(definition.Key)
+
+	key := definition.Key
+	if 0 != keyboard.modifiers&ModifierShift && "" != definition.ShiftKey {
+		key = definition.ShiftKey
+	}
+	return keyboard.dispatch(ctx, []*input.DispatchKeyEventParams{{
+		Type:                  input.KeyEvent.KeyUp,
+		Modifiers:             keyboard.modifiers,
+		Key:                   key,
+		Code:                  definition.Code,
+		WindowsVirtualKeyCode: definition.KeyCode,
+		NativeVirtualKeyCode:  definition.KeyCode,
+		IsKeypad:              KeyLocationNumpad == definition.Location,
+		Location:              definition.Location,
+	}})
+}
+
+func (keyboard *Keyboard) dispatch(ctx context.Context, events []*input.DispatchKeyEventParams) error {
+	for _, event := range events {
+		result := <-keyboard.tab.Protocol().Input().DispatchKeyEventContext(ctx, event)
+		if nil != result.Err {
+			return errs.Wrap(result.Err, codes.TabInputFailed, fmt.Sprintf("could not dispatch the %s event of '%s'", event.Type, event.Key))
+		}
+	}
+	return nil
+}
+
+/*
+modifierBit returns the modifier bit of a modifier key value.
+*/
+func modifierBit(key string) int {
+	switch key {
+	case "Alt":
+		return ModifierAlt
+	case "Control":
+		return ModifierControl
+	case "Meta":
+		return ModifierMeta
+	case "Shift":
+		return ModifierShift
+	}
+	return 0
+}
+
+/*
+splitKeys splits a key combination, '+' itself is a key, e.g. 'Shift++'.
+*/
+func splitKeys(keys string) []string {
+	if "+" == keys {
+		return []string{"+"}
+	}
+	if strings.HasSuffix(keys, "++") {
+		return append(splitKeys(strings.TrimSuffix(keys, "++")), "+")
+	}
+	return strings.Split(keys, "+")
+}
@@ -0,0 +1,93 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestLookupKey(t *testing.T) {
+	tests := map[string]string{
+		"a":       "KeyA a a",
+		"A":       "KeyA A A",
+		"KeyA":    "KeyA a a",
+		"+":       "Equal + +",
+		"0":       "Digit0 0 0",
+		"Numpad0": "Numpad0 0 0",
+		"\n":      "Enter Enter \r",
+		"Shift":   "ShiftLeft Shift ",
+	}
+	for key, expected := range tests {
+		definition, ok := LookupKey(key)
+		if !ok {
+			t.Errorf("Expected a definition of '%s'", key)
+			continue
+		}
+		if actual := fmt.Sprintf("%s %s %s", definition.Code, definition.Key, definition.Text); expected != actual {
+			t.Errorf("Expected '%s' for '%s', got '%s'", expected, key, actual)
+		}
+	}
+	if _, ok := LookupKey("é"); ok {
+		t.Errorf("Expected no definition of 'é'")
+	}
+}
+
+func TestKeyboard(t *testing.T) {
+	browser, payloads := newPipeBrowser(map[string]string{})
+	defer browser.Stop()
+	tab := &Tab{data: &TabData{}, protocol: browser, socket: browser}
+	keyboard := tab.Keyboard()
+	ctx := context.Background()
+
+	events := func() string {
+		received := []string{}
+		for _, payload := range drain(payloads) {
+			params, _ := payload.Params.(map[string]interface{})
+			if "Input.insertText" == payload.Method {
+				received = append(received, fmt.Sprintf("insertText:%v", params["text"]))
+				continue
+			}
+			event := fmt.Sprintf("%v:%v", params["type"], params["key"])
+			if modifiers, ok := params["modifiers"]; ok {
+				event += fmt.Sprintf(":%v", modifiers)
+			}
+			received = append(received, event)
+		}
+		return strings.Join(received, " ")
+	}
+
+	if err := keyboard.Press(ctx, "Control+a"); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if expected, actual := "rawKeyDown:Control:2 rawKeyDown:a:2 keyUp:a:2 keyUp:Control", events(); expected != actual {
+		t.Errorf("Expected '%s', got '%s'", expected, actual)
+	}
+
+	if err := keyboard.Down(ctx, "Shift"); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if err := keyboard.Press(ctx, "a"); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if err := keyboard.Up(ctx, "Shift"); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if expected, actual := "rawKeyDown:Shift:8 rawKeyDown:A:8 char:A:8 keyUp:A:8 keyUp:Shift", events(); expected != actual {
+		t.Errorf("Expected '%s', got '%s'", expected, actual)
+	}
+
+	if err := keyboard.Type(ctx, "é\n", 0); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if expected, actual := "insertText:é rawKeyDown:Enter char:Enter keyUp:Enter", events(); expected != actual {
+		t.Errorf("Expected '%s', got '%s'", expected, actual)
+	}
+
+	if err := keyboard.Press(ctx, "Control+Unknown"); nil == err {
+		t.Errorf("Expected an error for an unknown key")
+	}
+	if 0 != keyboard.Modifiers() {
+		t.Errorf("Expected no modifiers, got %d", keyboard.Modifiers())
+	}
+}
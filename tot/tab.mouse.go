@@ -0,0 +1,301 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/input"
+)
+
+/*
+DragStartTimeout is how long DragAndDrop waits for the page to start a native
+drag after the cursor has moved to the target.
+*/
+var DragStartTimeout = time.Second
+
+/*
+Mouse dispatches mouse events to a tab and tracks the cursor position and the
+pressed button. Events carry the modifiers pressed on the keyboard of the tab.
+*/
+type Mouse struct {
+	button input.ButtonEventEnum
+	mux    sync.Mutex
+	tab    *Tab
+	x      float64
+	y      float64
+}
+
+/*
+Mouse returns the mouse of the tab.
+*/
+func (tab *Tab) Mouse() *Mouse {
+	tab.mux.Lock()
+	defer tab.mux.Unlock()
+	if nil == tab.mouse {
+		tab.mouse = &Mouse{
+			button: input.ButtonEvent.None,
+			tab:    tab,
+		}
+	}
+	return tab.mouse
+}
+
+/*
+Position returns the position of the cursor in CSS pixels, relative to the
+viewport.
+*/
+func (mouse *Mouse) Position() (float64, float64) {
+	mouse.mux.Lock()
+	defer mouse.mux.Unlock()
+	return mouse.x, mouse.y
+}
+
+/*
+Move moves the cursor to x, y in steps mouseMoved events along a straight
+line. Pages see a drag if a button is pressed.
+*/
+func (mouse *Mouse) Move(ctx context.Context, x, y float64, steps int) error {
+	mouse.mux.Lock()
+	defer mouse.mux.Unlock()
+	if steps < 1 {
+		steps = 1
+	}
+	fromX, fromY := mouse.x, mouse.y
+	for step := 1; step <= steps; step++ {
+		mouse.x = fromX + (x-fromX)*float64(step)/float64(steps)
+		mouse.y = fromY + (y-fromY)*float64(step)/float64(steps)
+		if err := mouse.dispatch(ctx, &input.DispatchMouseEventParams{
+			Type:   input.MouseEvent.MouseMoved,
+			Button: mouse.button,
+		}); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+Down presses a button at the cursor position. clickCount is the number of the
+press in a series of clicks, e.g. 2 for the second press of a double click.
+*/
+func (mouse *Mouse) Down(ctx context.Context, button input.ButtonEventEnum, clickCount int) error {
+	mouse.mux.Lock()
+	defer mouse.mux.Unlock()
+	mouse.button = button
+	return mouse.dispatch(ctx, &input.DispatchMouseEventParams{
+		Type:       input.MouseEvent.MousePressed,
+		Button:     button,
+		ClickCount: clickCount,
+	})
+}
+
+/*
+Up releases a button at the cursor position.
+*/
+func (mouse *Mouse) Up(ctx context.Context, button input.ButtonEventEnum, clickCount int) error {
+	mouse.mux.Lock()
+	defer mouse.mux.Unlock()
+	mouse.button = input.ButtonEvent.None
+	return mouse.dispatch(ctx, &input.DispatchMouseEventParams{
+		Type:       input.MouseEvent.MouseReleased,
+		Button:     button,
+		ClickCount: clickCount,
+	})
+}
+
+/*
+Click moves the cursor to x, y and clicks the button clickCount times, e.g.
+twice for a double click. Each press and release carries its number in the
+series, so pages receive click and dblclick events.
+*/
+func (mouse *Mouse) Click(ctx context.Context, x, y float64, button input.ButtonEventEnum, clickCount int) error {
+	if err := mouse.Move(ctx, x, y, 1); nil != err {
+		return err
+	}
+	if clickCount < 1 {
+		clickCount = 1
+	}
+	for count := 1; count <= clickCount; count++ {
+		if err := mouse.Down(ctx, button, count); nil != err {
+			return err
+		}
+		if err := mouse.Up(ctx, button, count); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+DoubleClick double clicks the left button at x, y.
+*/
+func (mouse *Mouse) DoubleClick(ctx context.Context, x, y float64) error {
+	return mouse.Click(ctx, x, y, input.ButtonEvent.Left, 2)
+}
+
+/*
+Drag presses the left button at fromX, fromY, moves the cursor to toX, toY in
+steps and releases the button.
+
+Pages see the mousedown, mousemove and mouseup events of the drag, which is
+enough for drag handling implemented with mouse events. Use DragAndDrop for
+native HTML5 drag and drop.
+*/
+func (mouse *Mouse) Drag(ctx context.Context, fromX, fromY, toX, toY float64, steps int) error {
+	if err := mouse.Move(ctx, fromX, fromY, 1); nil != err {
+		return err
+	}
+	if err := mouse.Down(ctx, input.ButtonEvent.Left, 1); nil != err {
+		return err
+	}
+	if err := mouse.Move(ctx, toX, toY, steps); nil != err {
+		mouse.Up(ctx, input.ButtonEvent.Left, 1)
+		return err
+	}
+	return mouse.Up(ctx, input.ButtonEvent.Left, 1)
+}
+
+/*
+DragAndDrop drags with the left button from fromX, fromY to toX, toY in steps
+and drops with native HTML5 drag and drop.
+
+The drag is intercepted with Input.setInterceptDrags while the cursor moves,
+then the dragged data is dispatched at toX, toY with the dragenter, dragover
+and drop events. DragAndDrop returns a TabDragFailed error if the page does not
+start a drag within DragStartTimeout, e.g. if nothing draggable is at fromX,
+fromY.
+*/
+func (mouse *Mouse) DragAndDrop(ctx context.Context, fromX, fromY, toX, toY float64, steps int) error {
+	intercepted := make(chan *input.DragInterceptedEvent, 1)
+	sub := mouse.tab.Protocol().Input().OnDragIntercepted(func(event *input.DragInterceptedEvent) {
+		select {
+		case intercepted <- event:
+		default:
+		}
+	})
+	defer sub.Unsubscribe()
+
+	if err := mouse.interceptDrags(ctx, true); nil != err {
+		return err
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		mouse.interceptDrags(ctx, false)
+	}()
+
+	if err := mouse.Move(ctx, fromX, fromY, 1); nil != err {
+		return err
+	}
+	if err := mouse.Down(ctx, input.ButtonEvent.Left, 1); nil != err {
+		return err
+	}
+	if err := mouse.Move(ctx, toX, toY, steps); nil != err {
+		mouse.Up(ctx, input.ButtonEvent.Left, 1)
+		return err
+	}
+
+	var data *input.DragData
+	select {
+	case event := <-intercepted:
+		if nil != event.Err {
+			mouse.Up(ctx, input.ButtonEvent.Left, 1)
+			return errs.Wrap(event.Err, codes.TabDragFailed, "could not intercept the drag")
+		}
+		data = event.Data
+	case <-time.After(DragStartTimeout):
+		mouse.Up(ctx, input.ButtonEvent.Left, 1)
+		return errs.New(codes.TabDragFailed, fmt.Sprintf("the page did not start a drag at %v,%v", fromX, fromY))
+	case <-ctx.Done():
+		mouse.Up(context.Background(), input.ButtonEvent.Left, 1)
+		return errs.Wrap(ctx.Err(), codes.TabDragFailed, "the drag was interrupted")
+	}
+
+	for _, event := range []input.DragEventEnum{
+		input.DragEvent.DragEnter,
+		input.DragEvent.DragOver,
+		input.DragEvent.Drop,
+	} {
+		if err := mouse.dispatchDrag(ctx, event, data); nil != err {
+			mouse.Up(ctx, input.ButtonEvent.Left, 1)
+			return err
+		}
+	}
+	return mouse.Up(ctx, input.ButtonEvent.Left, 1)
+}
+
+/*
+Wheel scrolls by deltaX, deltaY CSS pixels with the mouse wheel at the cursor
+position.
+*/
+func (mouse *Mouse) Wheel(ctx context.Context, deltaX, deltaY float64) error {
+	mouse.mux.Lock()
+	defer mouse.mux.Unlock()
+	return mouse.dispatch(ctx, &input.DispatchMouseEventParams{
+		Type:   input.MouseEvent.MouseWheel,
+		Button: mouse.button,
+		DeltaX: deltaX,
+		DeltaY: deltaY,
+	})
+}
+
+/*
+dispatch dispatches a mouse event at the cursor position with the pressed
+buttons.
+*/
+func (mouse *Mouse) dispatch(ctx context.Context, event *input.DispatchMouseEventParams) error {
+	event.X = mouse.x
+	event.Y = mouse.y
+	event.Buttons = buttons[mouse.button]
+	event.Modifiers = mouse.tab.Keyboard().Modifiers()
+	result := <-mouse.tab.Protocol().Input().DispatchMouseEventContext(ctx, event)
+	if nil != result.Err {
+		return errs.Wrap(result.Err, codes.TabInputFailed, fmt.Sprintf("could not dispatch the %s event", event.Type))
+	}
+	return nil
+}
+
+/*
+dispatchDrag dispatches a drag event with the dragged data at the cursor
+position.
+*/
+func (mouse *Mouse) dispatchDrag(ctx context.Context, event input.DragEventEnum, data *input.DragData) error {
+	x, y := mouse.Position()
+	result := <-mouse.tab.Protocol().Input().DispatchDragEventContext(ctx, &input.DispatchDragEventParams{
+		Type:      event,
+		X:         x,
+		Y:         y,
+		Data:      data,
+		Modifiers: mouse.tab.Keyboard().Modifiers(),
+	})
+	if nil != result.Err {
+		return errs.Wrap(result.Err, codes.TabInputFailed, fmt.Sprintf("could not dispatch the %s event", event))
+	}
+	return nil
+}
+
+/*
+interceptDrags enables or disables the interception of native drags.
+*/
+func (mouse *Mouse) interceptDrags(ctx context.Context, enabled bool) error {
+	result := <-mouse.tab.Protocol().Input().SetInterceptDragsContext(ctx, &input.SetInterceptDragsParams{
+		Enabled: enabled,
+	})
+	if nil != result.Err {
+		return errs.Wrap(result.Err, codes.TabInputFailed, "could not intercept drags")
+	}
+	return nil
+}
+
+/*
+buttons maps the pressed button to the buttons bit field of mouse events.
+*/
+var buttons = map[input.ButtonEventEnum]int{
+	input.ButtonEvent.Left:   1,
+	input.ButtonEvent.Right:  2,
+	input.ButtonEvent.Middle: 4,
+}
@@ -0,0 +1,134 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/input"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+mouseEvents returns the received mouse events as
+'type:x,y:button:clickCount:buttons=buttons'.
+*/
+func mouseEvents(payloads chan *socket.Payload) string {
+	received := []string{}
+	for _, payload := range drain(payloads) {
+		if "Input.dispatchMouseEvent" != payload.Method {
+			continue
+		}
+		params, _ := payload.Params.(map[string]interface{})
+		event := fmt.Sprintf("%v:%v,%v", params["type"], params["x"], params["y"])
+		if button, ok := params["button"]; ok {
+			event += fmt.Sprintf(":%v", button)
+		}
+		if count, ok := params["clickCount"]; ok {
+			event += fmt.Sprintf(":%v", count)
+		}
+		if buttons, ok := params["buttons"]; ok {
+			event += fmt.Sprintf(":buttons=%v", buttons)
+		}
+		if modifiers, ok := params["modifiers"]; ok {
+			event += fmt.Sprintf(":modifiers=%v", modifiers)
+		}
+		received = append(received, event)
+	}
+	return strings.Join(received, " ")
+}
+
+func TestMouse(t *testing.T) {
+	browser, payloads := newPipeBrowser(map[string]string{})
+	defer browser.Stop()
+	tab := &Tab{data: &TabData{}, protocol: browser, socket: browser}
+	mouse := tab.Mouse()
+	ctx := context.Background()
+
+	if err := mouse.Move(ctx, 30, 15, 3); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if expected, actual := "mouseMoved:10,5:none mouseMoved:20,10:none mouseMoved:30,15:none", mouseEvents(payloads); expected != actual {
+		t.Errorf("Expected '%s', got '%s'", expected, actual)
+	}
+	if x, y := mouse.Position(); 30 != x || 15 != y {
+		t.Errorf("Expected position 30,15, got %v,%v", x, y)
+	}
+
+	if err := mouse.DoubleClick(ctx, 40, 20); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	expected := "mouseMoved:40,20:none mousePressed:40,20:left:1:buttons=1 mouseReleased:40,20:left:1 mousePressed:40,20:left:2:buttons=1 mouseReleased:40,20:left:2"
+	if actual := mouseEvents(payloads); expected != actual {
+		t.Errorf("Expected '%s', got '%s'", expected, actual)
+	}
+
+	if err := mouse.Drag(ctx, 0, 0, 20, 0, 2); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	expected = "mouseMoved:0,0:none mousePressed:0,0:left:1:buttons=1 mouseMoved:10,0:left:buttons=1 mouseMoved:20,0:left:buttons=1 mouseReleased:20,0:left:1"
+	if actual := mouseEvents(payloads); expected != actual {
+		t.Errorf("Expected '%s', got '%s'", expected, actual)
+	}
+
+	// Events carry the modifiers of the keyboard.
+	if err := tab.Keyboard().Down(ctx, "Shift"); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if err := mouse.Click(ctx, 20, 0, input.ButtonEvent.Right, 1); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	expected = "mouseMoved:20,0:none:modifiers=8 mousePressed:20,0:right:1:buttons=2:modifiers=8 mouseReleased:20,0:right:1:modifiers=8"
+	if actual := mouseEvents(payloads); expected != actual {
+		t.Errorf("Expected '%s', got '%s'", expected, actual)
+	}
+}
+
+func TestMouseWheel(t *testing.T) {
+	browser, payloads := newPipeBrowser(map[string]string{})
+	defer browser.Stop()
+	tab := &Tab{data: &TabData{}, protocol: browser, socket: browser}
+
+	if err := tab.Mouse().Wheel(context.Background(), 0, 120); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	for _, payload := range drain(payloads) {
+		params, _ := payload.Params.(map[string]interface{})
+		if "mouseWheel" != params["type"] || 120.0 != params["deltaY"] {
+			t.Errorf("Unexpected wheel event %v", params)
+		}
+	}
+}
+
+func TestMouseDragAndDropNotStarted(t *testing.T) {
+	browser, payloads := newPipeBrowser(map[string]string{})
+	defer browser.Stop()
+	tab := &Tab{data: &TabData{}, protocol: browser, socket: browser}
+	timeout := DragStartTimeout
+	DragStartTimeout = 50 * time.Millisecond
+	defer func() { DragStartTimeout = timeout }()
+
+	err := tab.Mouse().DragAndDrop(context.Background(), 0, 0, 20, 0, 1)
+	if nil == err || codes.TabDragFailed != err.(errs.Err).Code() {
+		t.Errorf("Expected a TabDragFailed error, got %v", err)
+	}
+	released, disabled := false, false
+	for _, payload := range drain(payloads) {
+		params, _ := payload.Params.(map[string]interface{})
+		switch payload.Method {
+		case "Input.dispatchMouseEvent":
+			released = "mouseReleased" == params["type"]
+		case "Input.dispatchDragEvent":
+			t.Errorf("Expected no drag events, got %v", params)
+		case "Input.setInterceptDrags":
+			disabled = false == params["enabled"]
+		}
+	}
+	if !released || !disabled {
+		t.Errorf("Expected the button released and interception disabled, got %t %t", released, disabled)
+	}
+}
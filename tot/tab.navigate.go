@@ -0,0 +1,214 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/network"
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+/*
+WaitUntil is the page lifecycle event Navigate waits for.
+*/
+type WaitUntil string
+
+const (
+	// WaitNone returns once the navigation is committed.
+	WaitNone WaitUntil = ""
+	// WaitDOMContentLoaded waits for the DOMContentLoaded event.
+	WaitDOMContentLoaded WaitUntil = "DOMContentLoaded"
+	// WaitLoad waits for the load event.
+	WaitLoad WaitUntil = "load"
+	// WaitNetworkAlmostIdle waits until there are no more than 2 network
+	// connections for at least 500ms.
+	WaitNetworkAlmostIdle WaitUntil = "networkAlmostIdle"
+	// WaitNetworkIdle waits until there are no network connections for at
+	// least 500ms.
+	WaitNetworkIdle WaitUntil = "networkIdle"
+	// WaitFirstMeaningfulPaint waits for the first meaningful paint.
+	WaitFirstMeaningfulPaint WaitUntil = "firstMeaningfulPaint"
+)
+
+/*
+Navigation describes a completed navigation.
+*/
+type Navigation struct {
+	// FrameID and LoaderID identify the navigation.
+	FrameID  page.FrameID
+	LoaderID page.LoaderID
+
+	// URL is the final URL of the document, after redirects.
+	URL string
+
+	// Status and StatusText are the HTTP status of the document response,
+	// 0 and empty for documents not loaded over HTTP and same-document
+	// navigations.
+	Status     int
+	StatusText string
+}
+
+/*
+Navigate navigates the tab to uri and waits for the waitUntil lifecycle event
+of the new document. A navigation to a fragment of the current document does
+not load a document and returns once it is committed.
+
+A navigation that fails, e.g. because the host cannot be resolved, returns an
+error with the reported error text. HTTP error statuses are not errors, they
+are returned in the Navigation.
+
+Navigate enables the Page and Network domains and the Page lifecycle events, and
+leaves them enabled when it returns: Chromium does not report whether they were
+enabled before, and disabling them would stop the events of handlers added by
+the caller. Disable them once the tab no longer navigates to stop the events,
+e.g. Network().Disable() and Page().SetLifecycleEventsEnabled() with Enabled
+false.
+*/
+func (tab *Tab) Navigate(ctx context.Context, uri string, waitUntil WaitUntil) (*Navigation, error) {
+	protocol := tab.Protocol()
+	if result := <-protocol.Page().EnableContext(ctx); nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.TabNavigateFailed, "could not enable page events")
+	}
+	if result := <-protocol.Network().EnableContext(ctx, &network.EnableParams{}); nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.TabNavigateFailed, "could not enable network events")
+	}
+	if result := <-protocol.Page().SetLifecycleEventsEnabledContext(ctx, &page.SetLifecycleEventsEnabledParams{Enabled: true}); nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.TabNavigateFailed, "could not enable lifecycle events")
+	}
+
+	// Events may arrive before Page.navigate returns the loader ID.
+	tracker := &navigationTracker{
+		changed:   make(chan bool, 1),
+		lifecycle: map[page.LoaderID]map[string]bool{},
+		navigated: map[page.LoaderID]*page.Frame{},
+		responses: map[page.LoaderID]*network.Response{},
+	}
+	lifecycle := protocol.Page().OnLifecycleEvent(tracker.lifecycleEvent)
+	defer lifecycle.Unsubscribe()
+	navigated := protocol.Page().OnFrameNavigated(tracker.frameNavigated)
+	defer navigated.Unsubscribe()
+	received := protocol.Network().OnResponseReceived(tracker.responseReceived)
+	defer received.Unsubscribe()
+
+	result := <-protocol.Page().NavigateContext(ctx, &page.NavigateParams{URL: uri})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.TabNavigateFailed, fmt.Sprintf("could not navigate to '%s'", uri))
+	}
+	if "" != result.ErrorText {
+		return nil, errs.New(codes.TabNavigateFailed, fmt.Sprintf("navigation to '%s' failed: %s", uri, result.ErrorText))
+	}
+
+	navigation := &Navigation{
+		FrameID:  result.FrameID,
+		LoaderID: result.LoaderID,
+		URL:      uri,
+	}
+	if "" == result.LoaderID {
+		// Same-document navigation.
+		return navigation, nil
+	}
+
+	for {
+		done := tracker.done(result.LoaderID, waitUntil)
+		if done {
+			break
+		}
+		select {
+		case <-tracker.changed:
+		case <-ctx.Done():
+			return nil, errs.Wrap(ctx.Err(), codes.TabNavigateFailed, fmt.Sprintf("timed out waiting for '%s' on '%s'", waitUntil, uri))
+		}
+	}
+
+	tracker.mux.Lock()
+	defer tracker.mux.Unlock()
+	if frame, ok := tracker.navigated[result.LoaderID]; ok {
+		navigation.URL = frame.URL
+		if "" != frame.UnreachableURL {
+			return nil, errs.New(codes.TabNavigateFailed, fmt.Sprintf("navigation to '%s' failed, '%s' is unreachable", uri, frame.UnreachableURL))
+		}
+	}
+	if response, ok := tracker.responses[result.LoaderID]; ok {
+		navigation.Status = response.Status
+		navigation.StatusText = response.StatusText
+		if "" == navigation.URL {
+			navigation.URL = response.URL
+		}
+	}
+	return navigation, nil
+}
+
+/*
+navigationTracker collects the events of the main frame navigations.
+*/
+type navigationTracker struct {
+	changed   chan bool
+	lifecycle map[page.LoaderID]map[string]bool
+	mux       sync.Mutex
+	navigated map[page.LoaderID]*page.Frame
+	responses map[page.LoaderID]*network.Response
+}
+
+/*
+done returns whether the navigation is committed and waitUntil has fired.
+*/
+func (tracker *navigationTracker) done(loaderID page.LoaderID, waitUntil WaitUntil) bool {
+	tracker.mux.Lock()
+	defer tracker.mux.Unlock()
+	if _, ok := tracker.navigated[loaderID]; !ok {
+		return false
+	}
+	return WaitNone == waitUntil || tracker.lifecycle[loaderID][string(waitUntil)]
+}
+
+/*
+notify wakes up Navigate.
+*/
+func (tracker *navigationTracker) notify() {
+	select {
+	case tracker.changed <- true:
+	default:
+	}
+}
+
+/*
+frameNavigated records the main frame of a navigation, which is committed.
+*/
+func (tracker *navigationTracker) frameNavigated(event *page.FrameNavigatedEvent) {
+	if nil == event.Frame || "" != event.Frame.ParentID {
+		return
+	}
+	tracker.mux.Lock()
+	tracker.navigated[event.Frame.LoaderID] = event.Frame
+	tracker.mux.Unlock()
+	tracker.notify()
+}
+
+/*
+lifecycleEvent records the lifecycle events fired for a loader.
+*/
+func (tracker *navigationTracker) lifecycleEvent(event *page.LifecycleEventEvent) {
+	tracker.mux.Lock()
+	if nil == tracker.lifecycle[event.LoaderID] {
+		tracker.lifecycle[event.LoaderID] = map[string]bool{}
+	}
+	tracker.lifecycle[event.LoaderID][event.Name] = true
+	tracker.mux.Unlock()
+	tracker.notify()
+}
+
+/*
+responseReceived records the response of a loader's document, which has the
+HTTP status of the navigation.
+*/
+func (tracker *navigationTracker) responseReceived(event *network.ResponseReceivedEvent) {
+	if page.ResourceType.Document != event.Type || nil == event.Response {
+		return
+	}
+	tracker.mux.Lock()
+	tracker.responses[page.LoaderID(event.LoaderID)] = event.Response
+	tracker.mux.Unlock()
+}
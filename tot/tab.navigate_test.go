@@ -0,0 +1,117 @@
+package chrome
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+newNavigateTab returns a tab connected to an emulated browser, which responds
+to Page.navigate with result and sends the events, one per line, around the
+response.
+*/
+func newNavigateTab(result string, before, after string) (*Tab, *socket.Socket) {
+	cmdReader, cmdWriter := io.Pipe()
+	respReader, respWriter := io.Pipe()
+	send := func(events string) {
+		for _, event := range strings.Split(strings.TrimSpace(events), "\n") {
+			if event = strings.TrimSpace(event); "" != event {
+				respWriter.Write(append([]byte(event), 0))
+			}
+		}
+	}
+	go func() {
+		reader := bufio.NewReader(cmdReader)
+		for {
+			message, err := reader.ReadBytes(0)
+			if nil != err {
+				return
+			}
+			payload := &socket.Payload{}
+			json.Unmarshal(message[:len(message)-1], payload)
+			response := &socket.Response{ID: payload.ID, Result: []byte(`{}`)}
+			if "Page.navigate" == payload.Method {
+				send(before)
+				response.Result = []byte(result)
+			}
+			message, _ = json.Marshal(response)
+			respWriter.Write(append(message, 0))
+			if "Page.navigate" == payload.Method {
+				send(after)
+			}
+		}
+	}()
+	browser := socket.NewPipeSocket(respReader, cmdWriter)
+	return &Tab{data: &TabData{}, protocol: browser, socket: browser}, browser
+}
+
+func TestTabNavigate(t *testing.T) {
+	tab, browser := newNavigateTab(`{"frameId":"frame-1","loaderId":"loader-2"}`, `
+		{"method":"Page.lifecycleEvent","params":{"frameId":"frame-1","loaderId":"loader-1","name":"load"}}
+		{"method":"Network.responseReceived","params":{"loaderId":"loader-2","type":"Document","response":{"url":"http://example.com/redirected","status":404,"statusText":"Not Found"}}}
+	`, `
+		{"method":"Page.frameNavigated","params":{"frame":{"id":"frame-1","loaderId":"loader-2","url":"http://example.com/redirected"}}}
+		{"method":"Page.lifecycleEvent","params":{"frameId":"frame-1","loaderId":"loader-2","name":"DOMContentLoaded"}}
+		{"method":"Page.lifecycleEvent","params":{"frameId":"frame-1","loaderId":"loader-2","name":"load"}}
+	`)
+	defer browser.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	navigation, err := tab.Navigate(ctx, "http://example.com/", WaitLoad)
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if "loader-2" != navigation.LoaderID || "http://example.com/redirected" != navigation.URL {
+		t.Errorf("Unexpected navigation %+v", navigation)
+	}
+	if 404 != navigation.Status || "Not Found" != navigation.StatusText {
+		t.Errorf("Expected status 404, got %d '%s'", navigation.Status, navigation.StatusText)
+	}
+}
+
+func TestTabNavigateTimeout(t *testing.T) {
+	// The load event of the previous document does not end the wait.
+	tab, browser := newNavigateTab(`{"frameId":"frame-1","loaderId":"loader-2"}`, "", `
+		{"method":"Page.frameNavigated","params":{"frame":{"id":"frame-1","loaderId":"loader-2","url":"http://example.com/"}}}
+		{"method":"Page.lifecycleEvent","params":{"frameId":"frame-1","loaderId":"loader-1","name":"networkIdle"}}
+	`)
+	defer browser.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	_, err := tab.Navigate(ctx, "http://example.com/", WaitNetworkIdle)
+	if nil == err || codes.TabNavigateFailed != err.(errs.Err).Code() {
+		t.Errorf("Expected a TabNavigateFailed error, got %v", err)
+	}
+}
+
+func TestTabNavigateFailed(t *testing.T) {
+	tab, browser := newNavigateTab(`{"frameId":"frame-1","loaderId":"loader-2","errorText":"net::ERR_NAME_NOT_RESOLVED"}`, "", "")
+	defer browser.Stop()
+
+	_, err := tab.Navigate(context.Background(), "http://invalid.invalid/", WaitLoad)
+	if nil == err || !strings.Contains(err.Error(), "net::ERR_NAME_NOT_RESOLVED") {
+		t.Errorf("Expected the error text, got %v", err)
+	}
+
+	// Same-document navigations have no loader.
+	tab, browser = newNavigateTab(`{"frameId":"frame-1"}`, "", "")
+	defer browser.Stop()
+	navigation, err := tab.Navigate(context.Background(), "http://example.com/#anchor", WaitLoad)
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if "http://example.com/#anchor" != navigation.URL {
+		t.Errorf("Unexpected navigation %+v", navigation)
+	}
+}
@@ -1,6 +1,8 @@
 package chrome
 
 import (
+	"context"
+
 	"github.com/mkenney/go-chrome/tot/socket"
 )
 
@@ -24,3 +26,10 @@ SendCommand implements Socketer
 func (tab *Tab) SendCommand(command socket.Commander) chan *socket.Response {
 	return tab.Socket().SendCommand(command)
 }
+
+/*
+SendCommandContext implements Socketer
+*/
+func (tab *Tab) SendCommandContext(ctx context.Context, command socket.Commander) (*socket.Response, error) {
+	return tab.Socket().SendCommandContext(ctx, command)
+}
@@ -1,21 +1,29 @@
 package chrome
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"sync"
 
 	errs "github.com/bdlm/errors"
 	"github.com/bdlm/log"
 	"github.com/mkenney/go-chrome/codes"
 	"github.com/mkenney/go-chrome/tot/socket"
+	"github.com/mkenney/go-chrome/tot/target"
 )
 
 /*
-NewTab spawns a new Tab and returns a reference to it
+NewTab spawns a new Tab and returns a reference to it. In pipe mode the tab is
+a session tab, see NewSessionTab.
 */
 func (chrome *Chrome) NewTab(uri string) (*Tab, error) {
 	var err error
 
+	if chrome.Pipe() {
+		return chrome.NewSessionTab(context.Background(), uri)
+	}
+
 	if "" == uri {
 		uri = "about:blank"
 	}
@@ -24,30 +32,90 @@ func (chrome *Chrome) NewTab(uri string) (*Tab, error) {
 		return nil, errs.Wrap(err, codes.TabURLInvalid, "invalid URL")
 	}
 
+	endpoint := chrome.Endpoint()
+	data, err := endpoint.New(context.Background(), uri)
+	if nil != err {
+		return nil, errs.Wrap(err, codes.TabQueryFailed, fmt.Sprintf("/new?%s query failed", url.QueryEscape(uri)))
+	}
 	tab := &Tab{
 		chrome: chrome,
-		data:   &TabData{},
+		data:   data,
 		url:    targetURL,
 	}
 
-	_, err = tab.Chromium().Query(
-		fmt.Sprintf("/json/new?%s", url.QueryEscape(uri)),
-		url.Values{},
-		tab.data,
-	)
+	websocketURL, err := endpoint.WebsocketURL(tab.Data().WebSocketDebuggerURL)
 	if nil != err {
-		return nil, errs.Wrap(err, codes.TabQueryFailed, fmt.Sprintf("/new?%s query failed", url.QueryEscape(uri)))
+		return nil, err
 	}
 
-	websocketURL, err := url.Parse(tab.Data().WebSocketDebuggerURL)
+	socket := socket.NewWithOptions(websocketURL, endpoint.WebsocketOptions())
+	tab.socket = socket
+	tab.protocol = socket
+	tab.watch()
+	chrome.addTab(tab)
+
+	return tab, nil
+}
+
+/*
+NewSessionTab creates a new target and returns a Tab attached to it over the
+browser-level socket connection. Unlike tabs created by NewTab, session tabs do
+not open a websocket connection of their own.
+*/
+func (chrome *Chrome) NewSessionTab(ctx context.Context, uri string) (*Tab, error) {
+	if "" == uri {
+		uri = "about:blank"
+	}
+	targetURL, err := url.Parse(uri)
 	if nil != err {
-		return nil, errs.Wrap(err, codes.TabWebsocketURLInvalid, fmt.Sprintf("invalid websocket URL '%s'", tab.Data().WebSocketDebuggerURL))
+		return nil, errs.Wrap(err, codes.TabURLInvalid, "invalid URL")
 	}
 
-	socket := socket.New(websocketURL)
-	tab.socket = socket
-	tab.protocol = socket
-	chrome.tabs = append(chrome.tabs, tab)
+	browser, err := chrome.BrowserSocket()
+	if nil != err {
+		return nil, err
+	}
+	result := <-browser.Target().CreateTargetContext(ctx, &target.CreateTargetParams{URL: uri})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.TabQueryFailed, fmt.Sprintf("could not create target for '%s'", uri))
+	}
+
+	tab, err := chrome.AttachTab(ctx, string(result.ID))
+	if nil != err {
+		return nil, err
+	}
+	tab.mux.Lock()
+	updated := *tab.data
+	updated.URL = uri
+	tab.data = &updated
+	tab.url = targetURL
+	tab.mux.Unlock()
+	return tab, nil
+}
+
+/*
+AttachTab attaches to an existing target over the browser-level socket
+connection and returns a Tab backed by the target session.
+*/
+func (chrome *Chrome) AttachTab(ctx context.Context, targetID string) (*Tab, error) {
+	browser, err := chrome.BrowserSocket()
+	if nil != err {
+		return nil, err
+	}
+	session, err := browser.AttachToTarget(ctx, target.ID(targetID))
+	if nil != err {
+		return nil, err
+	}
+
+	tab := &Tab{
+		browser:  browser,
+		chrome:   chrome,
+		data:     &TabData{ID: targetID},
+		protocol: session,
+		socket:   session,
+	}
+	tab.watch()
+	chrome.addTab(tab)
 
 	return tab, nil
 }
@@ -56,11 +124,23 @@ func (chrome *Chrome) NewTab(uri string) (*Tab, error) {
 Tab is a struct representing an individual Chrome tab
 */
 type Tab struct {
+	// browser is the browser-level socket a session tab is attached through,
+	// nil for tabs with their own connection.
+	browser  *socket.Socket
 	chrome   Chromium
 	data     *TabData
 	protocol socket.Protocoller
 	socket   socket.Socketer
 	url      *url.URL
+
+	// mux guards the connection fields, which are replaced when a tab is
+	// reopened after a relaunch. navigated tracks the URL of the tab.
+	mux       sync.Mutex
+	navigated *socket.Subscription
+
+	// keyboard and mouse track the pressed keys and buttons of the tab.
+	keyboard *Keyboard
+	mouse    *Mouse
 }
 
 /*
@@ -76,8 +156,15 @@ Close implements Tabber.
 func (tab *Tab) Close() (interface{}, error) {
 	var err error
 	var result interface{}
-	tab.Socket().Stop()
-	_, err = tab.Chromium().Query(fmt.Sprintf("/json/close/%s", tab.Data().ID), url.Values{}, &result)
+	tab.disconnect()
+	if nil != tab.browser {
+		return tab.closeTarget()
+	}
+	if chrome, ok := tab.Chromium().(*Chrome); ok {
+		err = chrome.Endpoint().Close(context.Background(), tab.Data().ID)
+	} else {
+		_, err = tab.Chromium().Query(fmt.Sprintf("/json/close/%s", tab.Data().ID), url.Values{}, &result)
+	}
 	if nil != err {
 		log.WithFields(log.Fields{
 			"result": result,
@@ -89,10 +176,30 @@ func (tab *Tab) Close() (interface{}, error) {
 	return result, nil
 }
 
+/*
+closeTarget closes a session tab over the browser-level socket.
+*/
+func (tab *Tab) closeTarget() (interface{}, error) {
+	result := <-tab.browser.Target().CloseTarget(&target.CloseTargetParams{
+		ID: target.ID(tab.Data().ID),
+	})
+	if nil != result.Err {
+		log.WithFields(log.Fields{
+			"error":    result.Err,
+			"targetID": tab.Data().ID,
+		}).Warn(result.Err)
+		return nil, errs.Wrap(result.Err, 0, fmt.Sprintf("could not close target '%s'", tab.Data().ID))
+	}
+	tab.Chromium().RemoveTab(tab)
+	return result, nil
+}
+
 /*
 Data implements Tabber.
 */
 func (tab *Tab) Data() *TabData {
+	tab.mux.Lock()
+	defer tab.mux.Unlock()
 	return tab.data
 }
 
@@ -100,6 +207,9 @@ func (tab *Tab) Data() *TabData {
 Protocol implements Tabber.
 */
 func (tab *Tab) Protocol() socket.Protocoller {
+	tab.connect()
+	tab.mux.Lock()
+	defer tab.mux.Unlock()
 	return tab.protocol
 }
 
@@ -107,6 +217,9 @@ func (tab *Tab) Protocol() socket.Protocoller {
 Socket implements Tabber.
 */
 func (tab *Tab) Socket() socket.Socketer {
+	tab.connect()
+	tab.mux.Lock()
+	defer tab.mux.Unlock()
 	return tab.socket
 }
 
@@ -114,5 +227,7 @@ func (tab *Tab) Socket() socket.Socketer {
 URL implements Tabber.
 */
 func (tab *Tab) URL() *url.URL {
+	tab.mux.Lock()
+	defer tab.mux.Unlock()
 	return tab.url
 }
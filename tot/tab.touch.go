@@ -0,0 +1,111 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/input"
+)
+
+/*
+Touchscreen dispatches touch events and gestures to a tab. Pages receive touch
+events if touch is emulated or the browser has a touchscreen.
+*/
+type Touchscreen struct {
+	tab *Tab
+}
+
+/*
+Touchscreen returns the touchscreen of the tab.
+*/
+func (tab *Tab) Touchscreen() *Touchscreen {
+	return &Touchscreen{tab: tab}
+}
+
+/*
+Tap touches and releases x, y with one finger.
+*/
+func (touch *Touchscreen) Tap(ctx context.Context, x, y float64) error {
+	if err := touch.dispatch(ctx, input.TouchEvent.TouchStart, touchPoint(1, x, y)); nil != err {
+		return err
+	}
+	return touch.dispatch(ctx, input.TouchEvent.TouchEnd)
+}
+
+/*
+Pinch touches two fingers distance CSS pixels apart, centered on x, y, and
+moves them apart or together in steps until they are scaleFactor times as far
+apart, e.g. 2 to zoom in or 0.5 to zoom out.
+*/
+func (touch *Touchscreen) Pinch(ctx context.Context, x, y, distance, scaleFactor float64, steps int) error {
+	if steps < 1 {
+		steps = 1
+	}
+	points := func(distance float64) []*input.TouchPoint {
+		return []*input.TouchPoint{
+			touchPoint(1, x-distance/2, y),
+			touchPoint(2, x+distance/2, y),
+		}
+	}
+	if err := touch.dispatch(ctx, input.TouchEvent.TouchStart, points(distance)...); nil != err {
+		return err
+	}
+	for step := 1; step <= steps; step++ {
+		scale := 1 + (scaleFactor-1)*float64(step)/float64(steps)
+		if err := touch.dispatch(ctx, input.TouchEvent.TouchMove, points(distance*scale)...); nil != err {
+			touch.dispatch(ctx, input.TouchEvent.TouchCancel)
+			return err
+		}
+	}
+	return touch.dispatch(ctx, input.TouchEvent.TouchEnd)
+}
+
+/*
+Scroll scrolls by xDistance, yDistance CSS pixels with a touch swipe starting
+at x, y. Positive distances scroll the content to the right and down, i.e. the
+finger moves left and up. It returns once the gesture has completed.
+*/
+func (touch *Touchscreen) Scroll(ctx context.Context, x, y, xDistance, yDistance float64) error {
+	result := <-touch.tab.Protocol().Input().SynthesizeScrollGestureContext(ctx, &input.SynthesizeScrollGestureParams{
+		X:                 x,
+		Y:                 y,
+		XDistance:         -xDistance,
+		YDistance:         -yDistance,
+		GestureSourceType: "touch",
+	})
+	if nil != result.Err {
+		return errs.Wrap(result.Err, codes.TabInputFailed, "could not scroll")
+	}
+	return nil
+}
+
+/*
+dispatch dispatches a touch event with the touch points that are touching.
+*/
+func (touch *Touchscreen) dispatch(ctx context.Context, event input.TouchEventEnum, points ...*input.TouchPoint) error {
+	if nil == points {
+		points = []*input.TouchPoint{}
+	}
+	result := <-touch.tab.Protocol().Input().DispatchTouchEventContext(ctx, &input.DispatchTouchEventParams{
+		Type:        event,
+		TouchPoints: points,
+		Modifiers:   touch.tab.Keyboard().Modifiers(),
+	})
+	if nil != result.Err {
+		return errs.Wrap(result.Err, codes.TabInputFailed, fmt.Sprintf("could not dispatch the %s event", event))
+	}
+	return nil
+}
+
+/*
+touchPoint returns a touch point at x, y.
+*/
+func touchPoint(id int, x, y float64) *input.TouchPoint {
+	return &input.TouchPoint{
+		ID: id,
+		X:  x,
+		Y:  y,
+	}
+}
@@ -0,0 +1,45 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestTouchscreen(t *testing.T) {
+	browser, payloads := newPipeBrowser(map[string]string{})
+	defer browser.Stop()
+	tab := &Tab{data: &TabData{}, protocol: browser, socket: browser}
+	touch := tab.Touchscreen()
+	ctx := context.Background()
+
+	events := func() string {
+		received := []string{}
+		for _, payload := range drain(payloads) {
+			params, _ := payload.Params.(map[string]interface{})
+			event := fmt.Sprintf("%v", params["type"])
+			points, _ := params["touchPoints"].([]interface{})
+			for _, point := range points {
+				point, _ := point.(map[string]interface{})
+				event += fmt.Sprintf(":%v,%v", point["x"], point["y"])
+			}
+			received = append(received, event)
+		}
+		return strings.Join(received, " ")
+	}
+
+	if err := touch.Tap(ctx, 10, 20); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if expected, actual := "touchStart:10,20 touchEnd", events(); expected != actual {
+		t.Errorf("Expected '%s', got '%s'", expected, actual)
+	}
+
+	if err := touch.Pinch(ctx, 100, 50, 20, 2, 2); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if expected, actual := "touchStart:90,50:110,50 touchMove:85,50:115,50 touchMove:80,50:120,50 touchEnd", events(); expected != actual {
+		t.Errorf("Expected '%s', got '%s'", expected, actual)
+	}
+}
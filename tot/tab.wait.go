@@ -0,0 +1,283 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/dom"
+	"github.com/mkenney/go-chrome/tot/runtime"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+ElementState is the state of an element WaitFor waits for.
+*/
+type ElementState string
+
+const (
+	// StateAttached waits for an element to match the selector.
+	StateAttached ElementState = "attached"
+	// StateDetached waits for no element to match the selector.
+	StateDetached ElementState = "detached"
+	// StateVisible waits for an element with a non-empty bounding box and
+	// without 'visibility: hidden' to match the selector.
+	StateVisible ElementState = "visible"
+	// StateHidden waits for no element, or a hidden element, to match the
+	// selector.
+	StateHidden ElementState = "hidden"
+	// StateStable waits for a visible element with the same bounding box in
+	// two consecutive checks, e.g. once an animation has finished.
+	StateStable ElementState = "stable"
+)
+
+/*
+WaitPollInterval is the interval at which WaitFor checks the state of the
+element in addition to the checks on DOM mutations. Visibility and position
+changes do not cause DOM mutation events.
+*/
+var WaitPollInterval = 100 * time.Millisecond
+
+/*
+WaitFor waits until an element matching the selector is in the state, or ctx
+is done, and returns the element. StateDetached, and StateHidden if no element
+matches, return a nil element. Selectors are:
+
+  - 'xpath=//button' or '//button', an XPath expression searched with
+    DOM.performSearch in the documents of all frames.
+  - 'text=Sign in', the innermost element whose visible text contains
+    'Sign in', ignoring differences in whitespace.
+  - 'css=button.primary' or 'button.primary', a CSS selector.
+
+CSS and text selectors pierce open shadow roots and the documents of
+same-origin iframes. An invalid selector returns a TabSelectorInvalid error
+without waiting.
+*/
+func (tab *Tab) WaitFor(ctx context.Context, selector string, state ElementState) (*Element, error) {
+	switch state {
+	case StateAttached, StateDetached, StateVisible, StateHidden, StateStable:
+	default:
+		return nil, errs.New(codes.TabSelectorInvalid, fmt.Sprintf("unknown element state '%s'", state))
+	}
+
+	changed := make(chan bool, 1)
+	updated := make(chan bool, 1)
+	updated <- true
+	notify := func(channel chan bool) {
+		select {
+		case channel <- true:
+		default:
+		}
+	}
+	protocol := tab.Protocol().DOM()
+	subscriptions := []*socket.Subscription{
+		protocol.OnAttributeModified(func(*dom.AttributeModifiedEvent) { notify(changed) }),
+		protocol.OnAttributeRemoved(func(*dom.AttributeRemovedEvent) { notify(changed) }),
+		protocol.OnCharacterDataModified(func(*dom.CharacterDataModifiedEvent) { notify(changed) }),
+		protocol.OnChildNodeInserted(func(*dom.ChildNodeInsertedEvent) { notify(changed) }),
+		protocol.OnChildNodeRemoved(func(*dom.ChildNodeRemovedEvent) { notify(changed) }),
+		protocol.OnShadowRootPushed(func(*dom.ShadowRootPushedEvent) { notify(changed) }),
+		protocol.OnDocumentUpdated(func(*dom.DocumentUpdatedEvent) {
+			notify(updated)
+			notify(changed)
+		}),
+	}
+	defer func() {
+		for _, subscription := range subscriptions {
+			subscription.Unsubscribe()
+		}
+	}()
+
+	ticker := time.NewTicker(WaitPollInterval)
+	defer ticker.Stop()
+	var previous *BoundingBox
+	var lastErr error
+	for {
+		select {
+		case <-updated:
+			// Mutation events are only sent for the nodes of a requested
+			// document.
+			result := <-protocol.GetDocumentContext(ctx, &dom.GetDocumentParams{Depth: -1, Pierce: true})
+			lastErr = result.Err
+		default:
+		}
+
+		element, err := tab.find(ctx, selector)
+		if nil != err && codes.TabSelectorInvalid == err.(errs.Err).Code() {
+			return nil, err
+		}
+		if nil == err {
+			var done bool
+			done, previous, err = element.inState(ctx, state, previous)
+			if nil == err && done {
+				return element, nil
+			}
+			if nil != element {
+				element.Release(ctx)
+			}
+		}
+		if nil != err {
+			lastErr = err
+		}
+
+		select {
+		case <-changed:
+		case <-ticker.C:
+		case <-ctx.Done():
+			if nil == lastErr {
+				lastErr = ctx.Err()
+			}
+			return nil, errs.Wrap(lastErr, codes.TabWaitTimeout, fmt.Sprintf("timed out waiting for '%s' to be %s", selector, state))
+		}
+	}
+}
+
+/*
+find returns the first element matching the selector, or nil.
+*/
+func (tab *Tab) find(ctx context.Context, selector string) (*Element, error) {
+	kind, query := "css", selector
+	switch {
+	case strings.HasPrefix(selector, "css="):
+		query = strings.TrimPrefix(selector, "css=")
+	case strings.HasPrefix(selector, "text="):
+		kind, query = "text", strings.TrimPrefix(selector, "text=")
+	case strings.HasPrefix(selector, "xpath="):
+		kind, query = "xpath", strings.TrimPrefix(selector, "xpath=")
+	case strings.HasPrefix(selector, "//") || strings.HasPrefix(selector, "(//"):
+		kind = "xpath"
+	}
+	if "" == strings.TrimSpace(query) {
+		return nil, errs.New(codes.TabSelectorInvalid, fmt.Sprintf("empty selector '%s'", selector))
+	}
+	if "xpath" == kind {
+		return tab.search(ctx, query)
+	}
+
+	result := <-tab.Protocol().Runtime().EvaluateContext(ctx, &runtime.EvaluateParams{
+		Expression:  fmt.Sprintf("(%s)(%s, %s)", findElementScript, quoteJS([]byte(kind)), quoteJS([]byte(query))),
+		ObjectGroup: ElementObjectGroup,
+	})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.TabElementFailed, fmt.Sprintf("could not find '%s'", selector))
+	}
+	if nil != result.ExceptionDetails {
+		return nil, errs.New(codes.TabSelectorInvalid, fmt.Sprintf("invalid selector '%s': %s", selector, result.ExceptionDetails.Text))
+	}
+	if nil == result.Result || "" == result.Result.ObjectID {
+		return nil, nil
+	}
+	return tab.elementFromObject(ctx, result.Result.ObjectID)
+}
+
+/*
+search returns the first node found by DOM.performSearch, or nil.
+*/
+func (tab *Tab) search(ctx context.Context, query string) (*Element, error) {
+	protocol := tab.Protocol().DOM()
+	result := <-protocol.PerformSearchContext(ctx, &dom.PerformSearchParams{Query: query})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.TabElementFailed, fmt.Sprintf("could not search '%s'", query))
+	}
+	defer protocol.DiscardSearchResultsContext(ctx, &dom.DiscardSearchResultsParams{SearchID: result.SearchID})
+	if 0 == result.ResultCount {
+		return nil, nil
+	}
+	nodes := <-protocol.GetSearchResultsContext(ctx, &dom.GetSearchResultsParams{
+		SearchID:  result.SearchID,
+		FromIndex: 0,
+		ToIndex:   1,
+	})
+	if nil != nodes.Err {
+		return nil, errs.Wrap(nodes.Err, codes.TabElementFailed, fmt.Sprintf("could not get the results of '%s'", query))
+	}
+	if 0 == len(nodes.NodeIDs) {
+		return nil, nil
+	}
+	return tab.element(ctx, nodes.NodeIDs[0])
+}
+
+/*
+inState returns whether the element, which is nil if no element matches, is in
+the state. previous is the bounding box of the last check, the bounding box of
+this check is returned.
+*/
+func (element *Element) inState(ctx context.Context, state ElementState, previous *BoundingBox) (bool, *BoundingBox, error) {
+	switch state {
+	case StateAttached:
+		return nil != element, nil, nil
+	case StateDetached:
+		return nil == element, nil, nil
+	}
+	if nil == element {
+		return StateHidden == state, nil, nil
+	}
+
+	value, err := element.call(ctx, `function() {
+		const rect = this.getBoundingClientRect();
+		const style = this.ownerDocument.defaultView.getComputedStyle(this);
+		return {
+			visible: 'hidden' !== style.visibility && rect.width > 0 && rect.height > 0,
+			x: rect.left, y: rect.top, width: rect.width, height: rect.height
+		};
+	}`)
+	if nil != err {
+		return false, nil, err
+	}
+	box, ok := boundingBox(value)
+	if !ok {
+		return false, nil, errs.New(codes.TabElementFailed, "could not get the bounding box")
+	}
+	visible, _ := value.(map[string]interface{})["visible"].(bool)
+	switch state {
+	case StateVisible:
+		return visible, box, nil
+	case StateHidden:
+		return !visible, box, nil
+	}
+	return visible && nil != previous && *previous == *box, box, nil
+}
+
+/*
+findElementScript returns the first element matching a CSS selector or
+containing a text, searching open shadow roots and same-origin iframes.
+*/
+const findElementScript = `function(kind, query) {
+	const skip = {HEAD: true, NOSCRIPT: true, SCRIPT: true, STYLE: true, TEMPLATE: true, TITLE: true};
+	const normalize = text => (text || '').replace(/\s+/g, ' ').trim();
+	const text = element => normalize(undefined === element.innerText ? element.textContent : element.innerText);
+	const contains = element => !skip[element.tagName] && text(element).includes(query);
+	const matches = element => contains(element) && !Array.prototype.some.call(element.children, contains);
+	if ('text' === kind) {
+		query = normalize(query);
+	}
+	const search = root => {
+		if ('css' === kind) {
+			const found = root.querySelector(query);
+			if (found) {
+				return found;
+			}
+		}
+		const walker = (root.ownerDocument || root).createTreeWalker(root, NodeFilter.SHOW_ELEMENT);
+		for (let node = walker.nextNode(); node; node = walker.nextNode()) {
+			if ('text' === kind && matches(node)) {
+				return node;
+			}
+			const roots = [node.shadowRoot];
+			try {
+				roots.push(node.contentDocument);
+			} catch (e) {}
+			for (const child of roots) {
+				const found = child && search(child);
+				if (found) {
+					return found;
+				}
+			}
+		}
+		return null;
+	};
+	return search(document);
+}`
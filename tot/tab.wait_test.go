@@ -0,0 +1,123 @@
+package chrome
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+func TestTabWaitFor(t *testing.T) {
+	WaitPollInterval = 10 * time.Millisecond
+	defer func() { WaitPollInterval = 100 * time.Millisecond }()
+
+	// The element is inserted on the third check and moves once.
+	var mux sync.Mutex
+	var evaluated, called int
+	browser, _ := newPipeBrowserFunc(func(payload *socket.Payload) string {
+		mux.Lock()
+		defer mux.Unlock()
+		switch payload.Method {
+		case "Runtime.evaluate":
+			evaluated++
+			if evaluated < 3 {
+				return `{"result":{"type":"object","subtype":"null"}}`
+			}
+			return `{"result":{"type":"object","objectId":"object-1"}}`
+		case "DOM.requestNode":
+			return `{"nodeId":7}`
+		case "Runtime.callFunctionOn":
+			called++
+			if called < 2 {
+				return `{"result":{"type":"object","value":{"visible":true,"x":0,"y":0,"width":10,"height":10}}}`
+			}
+			return `{"result":{"type":"object","value":{"visible":true,"x":5,"y":0,"width":10,"height":10}}}`
+		}
+		return `{}`
+	})
+	defer browser.Stop()
+	tab := &Tab{data: &TabData{}, protocol: browser, socket: browser}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	element, err := tab.WaitFor(ctx, "text=Sign in", StateStable)
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if 7 != element.NodeID() || "object-1" != element.ObjectID() {
+		t.Errorf("Unexpected element %d '%s'", element.NodeID(), element.ObjectID())
+	}
+	mux.Lock()
+	defer mux.Unlock()
+	if 3 != called {
+		t.Errorf("Expected 3 checks of the bounding box, got %d", called)
+	}
+}
+
+func TestTabWaitForXPath(t *testing.T) {
+	browser, payloads := newPipeBrowser(map[string]string{
+		"DOM.performSearch":    `{"searchId":"search-1","resultCount":2}`,
+		"DOM.getSearchResults": `{"nodeIds":[9,10]}`,
+		"DOM.resolveNode":      `{"object":{"type":"object","objectId":"object-9"}}`,
+	})
+	defer browser.Stop()
+	tab := &Tab{data: &TabData{}, protocol: browser, socket: browser}
+
+	element, err := tab.WaitFor(context.Background(), "//button[@type='submit']", StateAttached)
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if 9 != element.NodeID() {
+		t.Errorf("Expected node 9, got %d", element.NodeID())
+	}
+
+	var discarded bool
+	for _, payload := range drain(payloads) {
+		params, _ := payload.Params.(map[string]interface{})
+		switch payload.Method {
+		case "DOM.performSearch":
+			if "//button[@type='submit']" != params["query"] {
+				t.Errorf("Unexpected query '%v'", params["query"])
+			}
+		case "DOM.discardSearchResults":
+			discarded = "search-1" == params["searchId"]
+		}
+	}
+	if !discarded {
+		t.Errorf("Expected the search results to be discarded")
+	}
+}
+
+func TestTabWaitForErrors(t *testing.T) {
+	WaitPollInterval = 10 * time.Millisecond
+	defer func() { WaitPollInterval = 100 * time.Millisecond }()
+
+	browser, _ := newPipeBrowser(map[string]string{
+		"Runtime.evaluate": `{"result":{"type":"object","objectId":"object-1"}}`,
+		"DOM.requestNode":  `{"nodeId":7}`,
+	})
+	defer browser.Stop()
+	tab := &Tab{data: &TabData{}, protocol: browser, socket: browser}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	_, err := tab.WaitFor(ctx, "#dialog", StateDetached)
+	if nil == err || codes.TabWaitTimeout != err.(errs.Err).Code() {
+		t.Errorf("Expected a TabWaitTimeout error, got %v", err)
+	}
+
+	browser, _ = newPipeBrowser(map[string]string{
+		"Runtime.evaluate": `{"result":{"type":"object"},"exceptionDetails":{"text":"SyntaxError"}}`,
+	})
+	defer browser.Stop()
+	tab = &Tab{data: &TabData{}, protocol: browser, socket: browser}
+	_, err = tab.WaitFor(context.Background(), "#[", StateAttached)
+	if nil == err || codes.TabSelectorInvalid != err.(errs.Err).Code() || !strings.Contains(err.Error(), "SyntaxError") {
+		t.Errorf("Expected a TabSelectorInvalid error, got %v", err)
+	}
+}
@@ -49,6 +49,9 @@ type Info struct {
 
 	// Optional. Opener target Id.
 	OpenerID ID `json:"openerId,omitempty"`
+
+	// Optional. The browser context the target belongs to.
+	BrowserContextID BrowserContextID `json:"browserContextId,omitempty"`
 }
 
 /*
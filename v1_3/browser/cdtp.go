@@ -0,0 +1,19 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package browser provides type definitions for use with the Chrome Browser
+protocol.
+
+The Browser domain defines methods and events for browser managing.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/
+*/
+package browser
+
+/*
+BrowserContextID represents the Browser.BrowserContextID type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#type-BrowserContextID
+EXPERIMENTAL.
+*/
+type BrowserContextID string
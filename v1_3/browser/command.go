@@ -0,0 +1,108 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package browser
+
+/*
+AddPrivacySandboxCoordinatorKeyConfigParams represents Browser.addPrivacySandboxCoordinatorKeyConfig parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#method-addPrivacySandboxCoordinatorKeyConfig
+*/
+type AddPrivacySandboxCoordinatorKeyConfigParams struct {
+	// Allowed values:
+	// 	- PrivacySandboxAPI.BiddingAndAuctionServices
+	// 	- PrivacySandboxAPI.TrustedKeyValue
+	API PrivacySandboxAPIEnum `json:"api"`
+
+	CoordinatorOrigin string `json:"coordinatorOrigin"`
+
+	KeyConfig string `json:"keyConfig"`
+
+	// Optional. BrowserContext to perform the action in. When omitted, default
+	// browser context is used.
+	BrowserContextID BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+/*
+AddPrivacySandboxCoordinatorKeyConfigResult represents the result of calls to Browser.addPrivacySandboxCoordinatorKeyConfig.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#method-addPrivacySandboxCoordinatorKeyConfig
+*/
+type AddPrivacySandboxCoordinatorKeyConfigResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+AddPrivacySandboxEnrollmentOverrideParams represents Browser.addPrivacySandboxEnrollmentOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#method-addPrivacySandboxEnrollmentOverride
+*/
+type AddPrivacySandboxEnrollmentOverrideParams struct {
+	URL string `json:"url"`
+}
+
+/*
+AddPrivacySandboxEnrollmentOverrideResult represents the result of calls to Browser.addPrivacySandboxEnrollmentOverride.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#method-addPrivacySandboxEnrollmentOverride
+*/
+type AddPrivacySandboxEnrollmentOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+CloseResult represents the result of calls to Browser.close.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#method-close
+*/
+type CloseResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetVersionResult represents the result of calls to Browser.getVersion.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#method-getVersion
+*/
+type GetVersionResult struct {
+	// Protocol version.
+	ProtocolVersion string `json:"protocolVersion"`
+
+	// Product name.
+	Product string `json:"product"`
+
+	// Product revision.
+	Revision string `json:"revision"`
+
+	// User-Agent.
+	UserAgent string `json:"userAgent"`
+
+	// V8 version.
+	JSVersion string `json:"jsVersion"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ResetPermissionsParams represents Browser.resetPermissions parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#method-resetPermissions
+*/
+type ResetPermissionsParams struct {
+	// Optional. BrowserContext to reset permissions. When omitted, default
+	// browser context is used.
+	BrowserContextID BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+/*
+ResetPermissionsResult represents the result of calls to Browser.resetPermissions.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#method-resetPermissions
+*/
+type ResetPermissionsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
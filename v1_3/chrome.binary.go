@@ -0,0 +1,26 @@
+package chrome
+
+import (
+	tot "github.com/mkenney/go-chrome/tot"
+)
+
+/*
+ChromiumBinary describes a Chromium executable.
+*/
+type ChromiumBinary = tot.ChromiumBinary
+
+/*
+FindBinary searches for a Chromium executable that reports a major version of
+at least minVersion, see the Tip-of-Tree implementation for the search order.
+*/
+func FindBinary(minVersion int) (*ChromiumBinary, error) {
+	return tot.FindBinary(minVersion)
+}
+
+/*
+ProbeBinary runs a Chromium executable with the --version flag and returns its
+version information.
+*/
+func ProbeBinary(path string) (*ChromiumBinary, error) {
+	return tot.ProbeBinary(path)
+}
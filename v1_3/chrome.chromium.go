@@ -0,0 +1,144 @@
+package chrome
+
+import (
+	"fmt"
+	"sync"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	tot "github.com/mkenney/go-chrome/tot"
+	"github.com/mkenney/go-chrome/v1_3/socket"
+)
+
+/*
+New returns a pointer to a Chromium instance.
+*/
+func New(
+	flags ChromiumFlags,
+	binary string,
+	workdir string,
+	stdout string,
+	stderr string,
+) *Chrome {
+	chrome := &Chrome{
+		Chrome: tot.New(flags, binary, workdir, stdout, stderr),
+	}
+	chrome.Chrome.Watch(&supervisor{chrome: chrome})
+	return chrome
+}
+
+/*
+Chrome implements Chromium.
+
+The browser process is managed by the embedded Tip-of-Tree implementation, tabs
+use the v1.3 protocol.
+*/
+type Chrome struct {
+	*tot.Chrome
+
+	// browser is the browser-level socket connection, opened on first use.
+	browser *socket.Socket
+
+	// tabs is a list of the currently open tabs.
+	tabs []*Tab
+
+	// connected is set by Connect, the targets of a connected instance are
+	// left open by Close.
+	connected bool
+
+	// mux guards the tab list, which the supervisor updates after a relaunch.
+	mux sync.Mutex
+}
+
+/*
+Close implements Chromium.
+*/
+func (chrome *Chrome) Close() error {
+	chrome.mux.Lock()
+	connected := chrome.connected
+	chrome.connected = false
+	chrome.mux.Unlock()
+
+	// Closing a tab removes it from the list.
+	tabs := append([]*Tab{}, chrome.Tabs()...)
+	for _, tab := range tabs {
+		if connected {
+			tab.disconnect()
+			chrome.RemoveTab(tab)
+		} else {
+			tab.Close()
+		}
+	}
+	if nil != chrome.browser {
+		chrome.browser.Stop()
+		chrome.browser = nil
+	}
+	return chrome.Chrome.Close()
+}
+
+/*
+BrowserSocket returns the browser-level socket connection, connecting to the
+Version.WebSocketDebuggerURL endpoint on first use. Target sessions attached
+through the browser socket share its connection.
+*/
+func (chrome *Chrome) BrowserSocket() (*socket.Socket, error) {
+	if nil == chrome.browser && chrome.Pipe() {
+		// The pipe connection is opened by Launch.
+		conn, err := chrome.Chrome.BrowserSocket()
+		if nil != err {
+			return nil, err
+		}
+		chrome.browser = socket.NewWithTransport(conn)
+	}
+	if nil == chrome.browser {
+		version, err := chrome.Version()
+		if nil != err {
+			return nil, err
+		}
+		endpoint := chrome.Endpoint()
+		websocketURL, err := endpoint.WebsocketURL(version.WebSocketDebuggerURL)
+		if nil != err {
+			return nil, err
+		}
+		chrome.browser = socket.NewWithOptions(websocketURL, endpoint.WebsocketOptions())
+	}
+	return chrome.browser, nil
+}
+
+/*
+GetTab implements Chromium.
+*/
+func (chrome *Chrome) GetTab(tabID string) (Tabber, error) {
+	for _, tab := range chrome.Tabs() {
+		if tab.Data().ID == tabID {
+			return tab, nil
+		}
+	}
+	return nil, errs.New(codes.ChromeTabNotFound, fmt.Sprintf("tab '%s' not found", tabID))
+}
+
+/*
+RemoveTab implements Chromium.
+*/
+func (chrome *Chrome) RemoveTab(tab *Tab) {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	for k, t := range chrome.tabs {
+		if t == tab {
+			chrome.tabs = append(chrome.tabs[:k], chrome.tabs[k+1:]...)
+			break
+		}
+	}
+}
+
+/*
+Tabs implements Chromium.
+*/
+func (chrome *Chrome) Tabs() []*Tab {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	if nil == chrome.tabs {
+		return nil
+	}
+	return append([]*Tab{}, chrome.tabs...)
+}
@@ -0,0 +1,165 @@
+package chrome
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/mkenney/go-chrome/v1_3/socket"
+)
+
+func newTestChrome(t *testing.T) (*Chrome, *httptest.Server) {
+	var host string
+	var count int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/new", func(w http.ResponseWriter, r *http.Request) {
+		count++
+		id := "tab-" + strconv.Itoa(count)
+		json.NewEncoder(w).Encode(&TabData{
+			ID:                   id,
+			Type:                 "page",
+			URL:                  r.URL.RawQuery,
+			WebSocketDebuggerURL: "ws://" + host + "/devtools/page/" + id,
+		})
+	})
+	mux.HandleFunc("/json/version", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&Version{
+			Browser:              "HeadlessChrome/70.0.3538.77",
+			WebSocketDebuggerURL: "ws://" + host + "/devtools/browser/test",
+		})
+	})
+	mux.HandleFunc("/devtools/browser/", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if nil != err {
+			t.Errorf("Expected nil, got error: '%v'", err)
+			return
+		}
+		defer conn.Close()
+		for {
+			payload := &socket.Payload{}
+			if err := conn.ReadJSON(payload); nil != err {
+				return
+			}
+			result := `{}`
+			switch payload.Method {
+			case "Target.createTarget":
+				result = `{"targetId":"target-1"}`
+			case "Target.attachToTarget":
+				result = `{"sessionId":"session-1"}`
+			}
+			conn.WriteJSON(&socket.Response{
+				ID:        payload.ID,
+				Result:    []byte(result),
+				SessionID: payload.SessionID,
+			})
+		}
+	})
+	mux.HandleFunc("/devtools/page/", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if nil != err {
+			t.Errorf("Expected nil, got error: '%v'", err)
+			return
+		}
+		defer conn.Close()
+		for {
+			if _, _, err := conn.ReadMessage(); nil != err {
+				return
+			}
+		}
+	})
+	mux.HandleFunc("/json/close/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("Target is closing"))
+	})
+	server := httptest.NewServer(mux)
+
+	host = strings.TrimPrefix(server.URL, "http://")
+	addr, port, _ := net.SplitHostPort(host)
+	portNum, _ := strconv.Atoi(port)
+	flags := &Flags{}
+	flags.Set("addr", addr)
+	flags.Set("port", portNum)
+
+	return New(flags, "", "", "", ""), server
+}
+
+func TestChromiumTabs(t *testing.T) {
+	chrome, server := newTestChrome(t)
+	defer server.Close()
+
+	tab1, err := chrome.NewTab("https://www.example.com/1")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	tab2, err := chrome.NewTab("https://www.example.com/2")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	if 2 != len(chrome.Tabs()) {
+		t.Errorf("Expected 2 tabs, got %d", len(chrome.Tabs()))
+	}
+	if nil == tab1.Page() || nil == tab1.Protocol().Runtime() {
+		t.Errorf("Expected v1.3 protocol accessors")
+	}
+
+	tab, err := chrome.GetTab(tab2.Data().ID)
+	if nil != err || tab != Tabber(tab2) {
+		t.Errorf("Expected to find tab '%s', got error: '%v'", tab2.Data().ID, err)
+	}
+	if _, err = chrome.GetTab("no-such-tab"); nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+
+	if _, err = tab1.Close(); nil != err {
+		t.Errorf("Expected nil, got error: '%v'", err)
+	}
+	if 1 != len(chrome.Tabs()) || tab2 != chrome.Tabs()[0] {
+		t.Errorf("Expected only the second tab to remain open")
+	}
+
+	if err = chrome.Close(); nil != err {
+		t.Errorf("Expected nil, got error: '%v'", err)
+	}
+	if 0 != len(chrome.Tabs()) {
+		t.Errorf("Expected all tabs to be closed, %d open", len(chrome.Tabs()))
+	}
+}
+
+func TestChromiumSessionTabs(t *testing.T) {
+	chrome, server := newTestChrome(t)
+	defer server.Close()
+
+	tab, err := chrome.NewSessionTab(context.Background(), "https://www.example.com/")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	if "target-1" != tab.Data().ID {
+		t.Errorf("Expected target ID 'target-1', got '%s'", tab.Data().ID)
+	}
+	if session, ok := tab.Socket().(*socket.Socket); !ok || "session-1" != session.SessionID() {
+		t.Errorf("Expected the tab to use session 'session-1'")
+	}
+	if result := <-tab.Page().Enable(); nil != result.Err {
+		t.Errorf("Expected nil, got error: '%v'", result.Err)
+	}
+
+	browser, err := chrome.BrowserSocket()
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	if 1 != len(browser.Sessions()) {
+		t.Errorf("Expected 1 session, got %d", len(browser.Sessions()))
+	}
+
+	if err = chrome.Close(); nil != err {
+		t.Errorf("Expected nil, got error: '%v'", err)
+	}
+	if 0 != len(browser.Sessions()) {
+		t.Errorf("Expected all sessions to be closed, %d open", len(browser.Sessions()))
+	}
+}
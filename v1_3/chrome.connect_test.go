@@ -0,0 +1,101 @@
+package chrome
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/mkenney/go-chrome/v1_3/socket"
+)
+
+func TestChromiumConnect(t *testing.T) {
+	var host string
+	activated := make(chan string, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/list", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]*TabData{
+			{ID: "page-1", Type: "page", URL: "about:blank", WebSocketDebuggerURL: "ws://" + host + "/devtools/page/page-1"},
+			{ID: "browser-1", Type: "browser"},
+		})
+	})
+	mux.HandleFunc("/json/version", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&Version{WebSocketDebuggerURL: "ws://" + host + "/devtools/browser/browser-1"})
+	})
+	mux.HandleFunc("/json/activate/", func(w http.ResponseWriter, r *http.Request) {
+		activated <- strings.TrimPrefix(r.URL.Path, "/json/activate/")
+		w.Write([]byte("Target activated"))
+	})
+	mux.HandleFunc("/devtools/browser/", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if nil != err {
+			return
+		}
+		defer conn.Close()
+		for {
+			payload := &socket.Payload{}
+			if err := conn.ReadJSON(payload); nil != err {
+				return
+			}
+			conn.WriteJSON(&socket.Response{ID: payload.ID, Result: []byte(`{}`)})
+			if "Target.setDiscoverTargets" == payload.Method {
+				conn.WriteJSON(&socket.Response{
+					Method: "Target.targetCreated",
+					Params: []byte(`{"targetInfo":{"targetId":"page-2","type":"page","title":"","url":"about:blank"}}`),
+				})
+				conn.WriteJSON(&socket.Response{
+					Method: "Target.targetDestroyed",
+					Params: []byte(`{"targetId":"page-1"}`),
+				})
+			}
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	host = strings.TrimPrefix(server.URL, "http://")
+	addr, port, _ := net.SplitHostPort(host)
+	portNum, _ := strconv.Atoi(port)
+	flags := &Flags{}
+	flags.Set("addr", addr)
+	flags.Set("port", portNum)
+	chrome := New(flags, "", "", "", "")
+
+	if err := chrome.Connect(context.Background()); nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	timeout := time.After(5 * time.Second)
+	for 1 != len(chrome.Tabs()) || "page-2" != chrome.Tabs()[0].Data().ID {
+		select {
+		case <-timeout:
+			t.Fatalf("Expected only tab 'page-2', got %d tabs", len(chrome.Tabs()))
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	if err := chrome.Tabs()[0].Activate(); nil != err {
+		t.Errorf("Expected nil, got error: '%v'", err)
+	}
+	select {
+	case id := <-activated:
+		if "page-2" != id {
+			t.Errorf("Expected 'page-2' to be activated, got '%s'", id)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Expected the tab to be activated")
+	}
+
+	// The targets of a connected instance are left open.
+	if err := chrome.Close(); nil != err {
+		t.Errorf("Expected nil, got error: '%v'", err)
+	}
+	if 0 != len(chrome.Tabs()) {
+		t.Errorf("Expected no tabs, got %d", len(chrome.Tabs()))
+	}
+}
@@ -0,0 +1,34 @@
+package chrome
+
+import (
+	tot "github.com/mkenney/go-chrome/tot"
+)
+
+/*
+Endpoint is a client for the developer tools HTTP endpoints.
+*/
+type Endpoint = tot.Endpoint
+
+/*
+ProtocolDescriptor is the protocol description returned by the /json/protocol
+endpoint.
+*/
+type ProtocolDescriptor = tot.ProtocolDescriptor
+
+/*
+ProtocolDomain describes a protocol domain.
+*/
+type ProtocolDomain = tot.ProtocolDomain
+
+/*
+ProtocolMember describes a protocol command or event.
+*/
+type ProtocolMember = tot.ProtocolMember
+
+/*
+NewEndpoint returns a client for the endpoints at baseURL, e.g.
+'http://localhost:9222'.
+*/
+func NewEndpoint(baseURL string) (*Endpoint, error) {
+	return tot.NewEndpoint(baseURL)
+}
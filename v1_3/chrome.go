@@ -0,0 +1,26 @@
+/*
+Package chrome aims to be a complete Chrome DevTools Protocol Viewer
+implementation.
+
+This version implements the stable v1.3 API. See
+https://chromedevtools.github.io/devtools-protocol/1-3/ for details.
+
+The browser process management and the websocket transport are shared with the
+Tip-of-Tree implementation in github.com/mkenney/go-chrome/tot; the domain
+packages, the socket protocol API and the Tab type are specific to this
+version. The domain packages are generated from the protocol definitions in
+/protocol/v1_3, which are the stable subset of the Tip-of-Tree definitions
+until the published 1-3 definitions are checked in.
+*/
+package chrome
+
+//go:generate go run ../cmd/cdtpgen -protocol ../protocol/v1_3 -out . -import github.com/mkenney/go-chrome/v1_3 -version 1-3
+
+import (
+	tot "github.com/mkenney/go-chrome/tot"
+)
+
+/*
+Version is a struct representing the Chromium version information.
+*/
+type Version = tot.Version
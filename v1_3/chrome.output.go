@@ -0,0 +1,17 @@
+package chrome
+
+import (
+	tot "github.com/mkenney/go-chrome/tot"
+)
+
+/*
+OutputLine is a line written to STDOUT or STDERR by the Chromium process.
+*/
+type OutputLine = tot.OutputLine
+
+/*
+ParseOutputLine decodes the log message prefix of a line written by Chromium.
+*/
+func ParseOutputLine(stream, text string) *OutputLine {
+	return tot.ParseOutputLine(stream, text)
+}
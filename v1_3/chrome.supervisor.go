@@ -0,0 +1,170 @@
+package chrome
+
+import (
+	"net/url"
+
+	"github.com/bdlm/log"
+	tot "github.com/mkenney/go-chrome/tot"
+	"github.com/mkenney/go-chrome/v1_3/page"
+	"github.com/mkenney/go-chrome/v1_3/socket"
+)
+
+type (
+	// ProcessWatcher defines the interface for receiving Chromium process
+	// lifecycle notifications from the supervisor.
+	ProcessWatcher = tot.ProcessWatcher
+
+	// RestartPolicy defines how the supervisor relaunches a Chromium process
+	// that exits unexpectedly.
+	RestartPolicy = tot.RestartPolicy
+)
+
+/*
+supervisor applies the process supervisor of the embedded Tip-of-Tree
+implementation to the v1.3 tabs.
+
+supervisor is a ProcessWatcher implementation.
+*/
+type supervisor struct {
+	chrome *Chrome
+}
+
+/*
+ProcessExited reports err on the Errors() channel of each connected tab.
+
+ProcessExited is a ProcessWatcher implementation.
+*/
+func (supervisor *supervisor) ProcessExited(err error) {
+	tot.ReportExit(supervisedTabs(supervisor.chrome, supervisor.chrome.Tabs()), err)
+}
+
+/*
+ProcessRestarted reopens the tabs if the restart policy requires it.
+
+ProcessRestarted is a ProcessWatcher implementation.
+*/
+func (supervisor *supervisor) ProcessRestarted() {
+	chrome := supervisor.chrome
+	chrome.mux.Lock()
+	tabs := chrome.tabs
+	chrome.tabs = nil
+	browser := chrome.browser
+	chrome.browser = nil
+	chrome.mux.Unlock()
+
+	if nil != browser {
+		browser.Stop()
+	}
+	policy := chrome.RestartPolicy()
+	tot.ReopenTabs(supervisedTabs(chrome, tabs), nil != policy && policy.ReopenTabs)
+}
+
+/*
+supervisedTab is a tot.SupervisedTab implementation.
+*/
+type supervisedTab struct {
+	chrome *Chrome
+	tab    *Tab
+}
+
+/*
+supervisedTabs adapts tabs to the process supervisor.
+*/
+func supervisedTabs(chrome *Chrome, tabs []*Tab) []tot.SupervisedTab {
+	supervised := make([]tot.SupervisedTab, 0, len(tabs))
+	for _, tab := range tabs {
+		supervised = append(supervised, &supervisedTab{chrome: chrome, tab: tab})
+	}
+	return supervised
+}
+
+/*
+Disconnect is a tot.SupervisedTab implementation.
+*/
+func (supervised *supervisedTab) Disconnect() {
+	supervised.tab.disconnect()
+}
+
+/*
+OpenSocket is a tot.SupervisedTab implementation.
+*/
+func (supervised *supervisedTab) OpenSocket() socket.Socketer {
+	return supervised.tab.openSocket()
+}
+
+/*
+Reopen opens a new tab at uri and moves its connection to the tab, which takes
+its place in the tab list.
+
+Reopen is a tot.SupervisedTab implementation.
+*/
+func (supervised *supervisedTab) Reopen(uri string) error {
+	chrome, tab := supervised.chrome, supervised.tab
+	reopened, err := chrome.NewTab(uri)
+	if nil != err {
+		return err
+	}
+	reopened.navigated.Unsubscribe()
+	chrome.mux.Lock()
+	tab.mux.Lock()
+	tab.browser = reopened.browser
+	tab.data = reopened.data
+	tab.protocol = reopened.protocol
+	tab.socket = reopened.socket
+	tab.mux.Unlock()
+	for k, t := range chrome.tabs {
+		if t == reopened {
+			chrome.tabs[k] = tab
+		}
+	}
+	chrome.mux.Unlock()
+	tab.watch()
+	return nil
+}
+
+/*
+URL is a tot.SupervisedTab implementation.
+*/
+func (supervised *supervisedTab) URL() *url.URL {
+	return supervised.tab.URL()
+}
+
+/*
+watch enables the Page domain to track the last URL of a tab and, when the
+process is supervised, enables the Inspector domain so renderer crashes are
+reported on the Errors() channel.
+*/
+func (tab *Tab) watch() {
+	tab.navigated = tab.Protocol().Page().OnFrameNavigated(func(event *page.FrameNavigatedEvent) {
+		tab.frameNavigated(event.Frame)
+	})
+	go func(enabled <-chan *page.EnableResult) {
+		if result := <-enabled; nil != result.Err {
+			log.WithFields(log.Fields{"error": result.Err}).
+				Warn("Cannot enable the Page domain, the tab URL is not tracked")
+		}
+	}(tab.Protocol().Page().Enable())
+
+	if chrome, ok := tab.chrome.(*Chrome); ok && chrome.Supervised() {
+		tot.WatchCrashes(tab.Socket())
+	}
+}
+
+/*
+frameNavigated records the URL of the main frame.
+*/
+func (tab *Tab) frameNavigated(frame *page.Frame) {
+	if nil == frame {
+		return
+	}
+	uri := tot.MainFrameURL(string(frame.ParentID), frame.URL)
+	if nil == uri {
+		return
+	}
+	tab.mux.Lock()
+	tab.url = uri
+	updated := *tab.data
+	updated.URL = frame.URL
+	tab.data = &updated
+	tab.mux.Unlock()
+}
@@ -0,0 +1,54 @@
+package chrome
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/v1_3/page"
+)
+
+func TestSupervisor(t *testing.T) {
+	chrome, server := newTestChrome(t)
+	defer server.Close()
+	chrome.SetRestartPolicy(&RestartPolicy{ReopenTabs: true})
+
+	tab, err := chrome.NewTab("https://www.example.com/")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%v'", err)
+	}
+	tab.frameNavigated(&page.Frame{ParentID: "parent", URL: "https://www.example.com/frame"})
+	tab.frameNavigated(&page.Frame{URL: "https://www.example.com/next"})
+	if "https://www.example.com/next" != tab.URL().String() {
+		t.Errorf("Expected the main frame URL, got '%s'", tab.URL().String())
+	}
+
+	supervisor := &supervisor{chrome: chrome}
+	oldSocket := tab.Socket()
+	supervisor.ProcessExited(errs.New(codes.ChromeProcessExited, "chromium process exited unexpectedly"))
+	select {
+	case err := <-oldSocket.Errors():
+		if codes.ChromeProcessExited != err.(errs.Err).Code() {
+			t.Errorf("Expected code %d, got %d", codes.ChromeProcessExited, err.(errs.Err).Code())
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Expected a process exit error on the tab Errors() channel")
+	}
+
+	supervisor.ProcessRestarted()
+	if "tab-2" != tab.Data().ID {
+		t.Errorf("Expected the tab to be reopened as 'tab-2', got '%s'", tab.Data().ID)
+	}
+	if oldSocket == tab.Socket() {
+		t.Errorf("Expected a new tab socket")
+	}
+	// The test server echoes the escaped query.
+	if url.QueryEscape("https://www.example.com/next") != tab.Data().URL {
+		t.Errorf("Expected the tab to be reopened at its last URL, got '%s'", tab.Data().URL)
+	}
+	if 1 != len(chrome.Tabs()) || tab != chrome.Tabs()[0] {
+		t.Errorf("Expected the reopened tab in the tab list")
+	}
+}
@@ -0,0 +1,55 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package console provides type definitions for use with the Chrome Console
+protocol.
+
+This domain is deprecated - use Runtime or Log instead.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Console/
+DEPRECATED.
+*/
+package console
+
+/*
+ConsoleMessage represents the Console.ConsoleMessage type. Console message.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Console/#type-ConsoleMessage
+*/
+type ConsoleMessage struct {
+	// Message source. Allowed values:
+	// 	- Source.XML
+	// 	- Source.Javascript
+	// 	- Source.Network
+	// 	- Source.ConsoleAPI
+	// 	- Source.Storage
+	// 	- Source.Appcache
+	// 	- Source.Rendering
+	// 	- Source.Security
+	// 	- Source.Other
+	// 	- Source.Deprecation
+	// 	- Source.Worker
+	Source SourceEnum `json:"source"`
+
+	// Message severity. Allowed values:
+	// 	- Level.Log
+	// 	- Level.Warning
+	// 	- Level.Error
+	// 	- Level.Debug
+	// 	- Level.Info
+	Level LevelEnum `json:"level"`
+
+	// Message text.
+	Text string `json:"text"`
+
+	// Optional. URL of the message origin.
+	URL string `json:"url,omitempty"`
+
+	// Optional. Line number in the resource that generated this message
+	// (1-based).
+	Line int `json:"line,omitempty"`
+
+	// Optional. Column number in the resource that generated this message
+	// (1-based).
+	Column int `json:"column,omitempty"`
+}
@@ -0,0 +1,181 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package debugger provides type definitions for use with the Chrome Debugger
+protocol.
+
+Debugger domain exposes JavaScript debugging capabilities. It allows setting and
+removing breakpoints, stepping through execution, exploring stack traces, etc.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/
+*/
+package debugger
+
+import (
+	"github.com/mkenney/go-chrome/v1_3/runtime"
+)
+
+/*
+BreakLocation represents the Debugger.BreakLocation type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-BreakLocation
+*/
+type BreakLocation struct {
+	// Script identifier as reported in the `Debugger.scriptParsed`.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+
+	// Line number in the script (0-based).
+	LineNumber int `json:"lineNumber"`
+
+	// Optional. Column number in the script (0-based).
+	ColumnNumber int `json:"columnNumber,omitempty"`
+
+	// Optional. Allowed values:
+	// 	- BreakLocationType.DebuggerStatement
+	// 	- BreakLocationType.Call
+	// 	- BreakLocationType.Return
+	Type BreakLocationTypeEnum `json:"type,omitempty"`
+}
+
+/*
+BreakpointID represents the Debugger.BreakpointId type. Breakpoint identifier.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-BreakpointId
+*/
+type BreakpointID string
+
+/*
+CallFrame represents the Debugger.CallFrame type. JavaScript call frame. Array
+of call frames form the call stack.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-CallFrame
+*/
+type CallFrame struct {
+	// Call frame identifier. This identifier is only valid while the virtual
+	// machine is paused.
+	CallFrameID CallFrameID `json:"callFrameId"`
+
+	// Name of the JavaScript function called on this call frame.
+	FunctionName string `json:"functionName"`
+
+	// Optional. Location in the source code.
+	FunctionLocation *Location `json:"functionLocation,omitempty"`
+
+	// Location in the source code.
+	Location *Location `json:"location"`
+
+	// JavaScript script name or url. Deprecated in favor of using the
+	// `location.scriptId` to resolve the URL via a previously sent
+	// `Debugger.scriptParsed` event. DEPRECATED.
+	URL string `json:"url"`
+
+	// Scope chain for this call frame.
+	ScopeChain []*Scope `json:"scopeChain"`
+
+	// `this` object for this call frame.
+	This *runtime.RemoteObject `json:"this"`
+
+	// Optional. The value being returned, if the function is at return point.
+	ReturnValue *runtime.RemoteObject `json:"returnValue,omitempty"`
+}
+
+/*
+CallFrameID represents the Debugger.CallFrameId type. Call frame identifier.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-CallFrameId
+*/
+type CallFrameID string
+
+/*
+DebugSymbols represents the Debugger.DebugSymbols type. Debug symbols available
+for a wasm script.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-DebugSymbols
+*/
+type DebugSymbols struct {
+	// Type of the debug symbols. Allowed values:
+	// 	- DebugSymbolsType.SourceMap
+	// 	- DebugSymbolsType.EmbeddedDWARF
+	// 	- DebugSymbolsType.ExternalDWARF
+	Type DebugSymbolsTypeEnum `json:"type"`
+
+	// Optional. URL of the external symbol source.
+	ExternalURL string `json:"externalURL,omitempty"`
+}
+
+/*
+Location represents the Debugger.Location type. Location in the source code.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-Location
+*/
+type Location struct {
+	// Script identifier as reported in the `Debugger.scriptParsed`.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+
+	// Line number in the script (0-based).
+	LineNumber int `json:"lineNumber"`
+
+	// Optional. Column number in the script (0-based).
+	ColumnNumber int `json:"columnNumber,omitempty"`
+}
+
+/*
+ResolvedBreakpoint represents the Debugger.ResolvedBreakpoint type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-ResolvedBreakpoint
+*/
+type ResolvedBreakpoint struct {
+	// Breakpoint unique identifier.
+	BreakpointID BreakpointID `json:"breakpointId"`
+
+	// Actual breakpoint location.
+	Location *Location `json:"location"`
+}
+
+/*
+Scope represents the Debugger.Scope type. Scope description.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-Scope
+*/
+type Scope struct {
+	// Scope type. Allowed values:
+	// 	- Type.Global
+	// 	- Type.Local
+	// 	- Type.With
+	// 	- Type.Closure
+	// 	- Type.Catch
+	// 	- Type.Block
+	// 	- Type.Script
+	// 	- Type.Eval
+	// 	- Type.Module
+	// 	- Type.WasmExpressionStack
+	Type TypeEnum `json:"type"`
+
+	// Object representing the scope. For `global` and `with` scopes it
+	// represents the actual object; for the rest of the scopes, it is
+	// artificial transient object enumerating scope variables as its
+	// properties.
+	Object *runtime.RemoteObject `json:"object"`
+
+	// Optional.
+	Name string `json:"name,omitempty"`
+
+	// Optional. Location in the source code where scope starts.
+	StartLocation *Location `json:"startLocation,omitempty"`
+
+	// Optional. Location in the source code where scope ends.
+	EndLocation *Location `json:"endLocation,omitempty"`
+}
+
+/*
+SearchMatch represents the Debugger.SearchMatch type. Search match for resource.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-SearchMatch
+*/
+type SearchMatch struct {
+	// Line number in resource content.
+	LineNumber float64 `json:"lineNumber"`
+
+	// Line with match content.
+	LineContent string `json:"lineContent"`
+}
@@ -0,0 +1,182 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"github.com/mkenney/go-chrome/v1_3/runtime"
+)
+
+/*
+BreakpointResolvedEvent represents Debugger.breakpointResolved event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-breakpointResolved
+DEPRECATED.
+*/
+type BreakpointResolvedEvent struct {
+	// Breakpoint unique identifier.
+	BreakpointID BreakpointID `json:"breakpointId"`
+
+	// Actual breakpoint location.
+	Location *Location `json:"location"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+PausedEvent represents Debugger.paused event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-paused
+*/
+type PausedEvent struct {
+	// Call stack the virtual machine stopped on.
+	CallFrames []*CallFrame `json:"callFrames"`
+
+	// Pause reason. Allowed values:
+	// 	- Reason.Ambiguous
+	// 	- Reason.Assert
+	// 	- Reason.CSPViolation
+	// 	- Reason.DebugCommand
+	// 	- Reason.DOM
+	// 	- Reason.EventListener
+	// 	- Reason.Exception
+	// 	- Reason.Instrumentation
+	// 	- Reason.OOM
+	// 	- Reason.Other
+	// 	- Reason.PromiseRejection
+	// 	- Reason.XHR
+	// 	- Reason.Step
+	Reason ReasonEnum `json:"reason"`
+
+	// Optional. Object containing break-specific auxiliary properties.
+	Data map[string]interface{} `json:"data,omitempty"`
+
+	// Optional. Hit breakpoints IDs.
+	HitBreakpoints []string `json:"hitBreakpoints,omitempty"`
+
+	// Optional. Async stack trace, if any.
+	AsyncStackTrace *runtime.StackTrace `json:"asyncStackTrace,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ResumedEvent represents Debugger.resumed event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-resumed
+*/
+type ResumedEvent struct {
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ScriptFailedToParseEvent represents Debugger.scriptFailedToParse event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-scriptFailedToParse
+*/
+type ScriptFailedToParseEvent struct {
+	// Identifier of the script parsed.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+
+	// URL or name of the script parsed (if any).
+	URL string `json:"url"`
+
+	// Line offset of the script within the resource with given URL (for script
+	// tags).
+	StartLine int `json:"startLine"`
+
+	// Column offset of the script within the resource with given URL.
+	StartColumn int `json:"startColumn"`
+
+	// Last line of the script.
+	EndLine int `json:"endLine"`
+
+	// Length of the last line of the script.
+	EndColumn int `json:"endColumn"`
+
+	// Specifies script creation context.
+	ExecutionContextID runtime.ExecutionContextID `json:"executionContextId"`
+
+	// Content hash of the script, SHA-256.
+	Hash string `json:"hash"`
+
+	// For Wasm modules, the content of the `build_id` custom section. For
+	// JavaScript the `debugId` magic comment.
+	BuildID string `json:"buildId"`
+
+	// Optional. Embedder-specific auxiliary data likely matching {isDefault:
+	// boolean, type: 'default'|'isolated'|'worker', frameId: string}.
+	ExecutionContextAuxData map[string]interface{} `json:"executionContextAuxData,omitempty"`
+
+	// Optional. URL of source map associated with script (if any).
+	SourceMapURL string `json:"sourceMapURL,omitempty"`
+
+	// Optional. True, if this script has sourceURL.
+	HasSourceURL bool `json:"hasSourceURL,omitempty"`
+
+	// Optional. True, if this script is ES6 module.
+	IsModule bool `json:"isModule,omitempty"`
+
+	// Optional. This script length.
+	Length int `json:"length,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ScriptParsedEvent represents Debugger.scriptParsed event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-scriptParsed
+*/
+type ScriptParsedEvent struct {
+	// Identifier of the script parsed.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+
+	// URL or name of the script parsed (if any).
+	URL string `json:"url"`
+
+	// Line offset of the script within the resource with given URL (for script
+	// tags).
+	StartLine int `json:"startLine"`
+
+	// Column offset of the script within the resource with given URL.
+	StartColumn int `json:"startColumn"`
+
+	// Last line of the script.
+	EndLine int `json:"endLine"`
+
+	// Length of the last line of the script.
+	EndColumn int `json:"endColumn"`
+
+	// Specifies script creation context.
+	ExecutionContextID runtime.ExecutionContextID `json:"executionContextId"`
+
+	// Content hash of the script, SHA-256.
+	Hash string `json:"hash"`
+
+	// For Wasm modules, the content of the `build_id` custom section. For
+	// JavaScript the `debugId` magic comment.
+	BuildID string `json:"buildId"`
+
+	// Optional. Embedder-specific auxiliary data likely matching {isDefault:
+	// boolean, type: 'default'|'isolated'|'worker', frameId: string}.
+	ExecutionContextAuxData map[string]interface{} `json:"executionContextAuxData,omitempty"`
+
+	// Optional. URL of source map associated with script (if any).
+	SourceMapURL string `json:"sourceMapURL,omitempty"`
+
+	// Optional. True, if this script has sourceURL.
+	HasSourceURL bool `json:"hasSourceURL,omitempty"`
+
+	// Optional. True, if this script is ES6 module.
+	IsModule bool `json:"isModule,omitempty"`
+
+	// Optional. This script length.
+	Length int `json:"length,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -0,0 +1,174 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"github.com/mkenney/go-chrome/v1_3/dom"
+	"github.com/mkenney/go-chrome/v1_3/runtime"
+)
+
+/*
+GetEventListenersParams represents DOMDebugger.getEventListeners parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-getEventListeners
+*/
+type GetEventListenersParams struct {
+	// Identifier of the object to return listeners for.
+	ObjectID runtime.RemoteObjectID `json:"objectId"`
+
+	// Optional. The maximum depth at which Node children should be retrieved,
+	// defaults to 1. Use -1 for the entire subtree or provide an integer
+	// larger than 0.
+	Depth int `json:"depth,omitempty"`
+
+	// Optional. Whether or not iframes and shadow roots should be traversed
+	// when returning the subtree (default is false). Reports listeners for all
+	// contexts if pierce is enabled.
+	Pierce bool `json:"pierce,omitempty"`
+}
+
+/*
+GetEventListenersResult represents the result of calls to DOMDebugger.getEventListeners.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-getEventListeners
+*/
+type GetEventListenersResult struct {
+	// Array of relevant listeners.
+	Listeners []*EventListener `json:"listeners"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RemoveDOMBreakpointParams represents DOMDebugger.removeDOMBreakpoint parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-removeDOMBreakpoint
+*/
+type RemoveDOMBreakpointParams struct {
+	// Identifier of the node to remove breakpoint from.
+	NodeID dom.NodeID `json:"nodeId"`
+
+	// Type of the breakpoint to remove. Allowed values:
+	// 	- DOMBreakpointType.SubtreeModified
+	// 	- DOMBreakpointType.AttributeModified
+	// 	- DOMBreakpointType.NodeRemoved
+	Type DOMBreakpointTypeEnum `json:"type"`
+}
+
+/*
+RemoveDOMBreakpointResult represents the result of calls to DOMDebugger.removeDOMBreakpoint.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-removeDOMBreakpoint
+*/
+type RemoveDOMBreakpointResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RemoveEventListenerBreakpointParams represents DOMDebugger.removeEventListenerBreakpoint parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-removeEventListenerBreakpoint
+*/
+type RemoveEventListenerBreakpointParams struct {
+	// Event name.
+	EventName string `json:"eventName"`
+}
+
+/*
+RemoveEventListenerBreakpointResult represents the result of calls to DOMDebugger.removeEventListenerBreakpoint.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-removeEventListenerBreakpoint
+*/
+type RemoveEventListenerBreakpointResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RemoveXHRBreakpointParams represents DOMDebugger.removeXHRBreakpoint parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-removeXHRBreakpoint
+*/
+type RemoveXHRBreakpointParams struct {
+	// Resource URL substring.
+	URL string `json:"url"`
+}
+
+/*
+RemoveXHRBreakpointResult represents the result of calls to DOMDebugger.removeXHRBreakpoint.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-removeXHRBreakpoint
+*/
+type RemoveXHRBreakpointResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetDOMBreakpointParams represents DOMDebugger.setDOMBreakpoint parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-setDOMBreakpoint
+*/
+type SetDOMBreakpointParams struct {
+	// Identifier of the node to set breakpoint on.
+	NodeID dom.NodeID `json:"nodeId"`
+
+	// Type of the operation to stop upon. Allowed values:
+	// 	- DOMBreakpointType.SubtreeModified
+	// 	- DOMBreakpointType.AttributeModified
+	// 	- DOMBreakpointType.NodeRemoved
+	Type DOMBreakpointTypeEnum `json:"type"`
+}
+
+/*
+SetDOMBreakpointResult represents the result of calls to DOMDebugger.setDOMBreakpoint.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-setDOMBreakpoint
+*/
+type SetDOMBreakpointResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetEventListenerBreakpointParams represents DOMDebugger.setEventListenerBreakpoint parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-setEventListenerBreakpoint
+*/
+type SetEventListenerBreakpointParams struct {
+	// DOM Event name to stop on (any DOM event will do).
+	EventName string `json:"eventName"`
+}
+
+/*
+SetEventListenerBreakpointResult represents the result of calls to DOMDebugger.setEventListenerBreakpoint.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-setEventListenerBreakpoint
+*/
+type SetEventListenerBreakpointResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetXHRBreakpointParams represents DOMDebugger.setXHRBreakpoint parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-setXHRBreakpoint
+*/
+type SetXHRBreakpointParams struct {
+	// Resource URL substring. All XHRs having this substring in the URL will
+	// get stopped upon.
+	URL string `json:"url"`
+}
+
+/*
+SetXHRBreakpointResult represents the result of calls to DOMDebugger.setXHRBreakpoint.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-setXHRBreakpoint
+*/
+type SetXHRBreakpointResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
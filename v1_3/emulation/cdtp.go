@@ -0,0 +1,73 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package emulation provides type definitions for use with the Chrome Emulation
+protocol.
+
+This domain emulates different environments for the page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/
+*/
+package emulation
+
+/*
+DevicePosture represents the Emulation.DevicePosture type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#type-DevicePosture
+*/
+type DevicePosture struct {
+	// Current posture of the device. Allowed values:
+	// 	- DevicePostureType.Continuous
+	// 	- DevicePostureType.Folded
+	Type DevicePostureTypeEnum `json:"type"`
+}
+
+/*
+DisplayFeature represents the Emulation.DisplayFeature type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#type-DisplayFeature
+*/
+type DisplayFeature struct {
+	// Orientation of a display feature in relation to screen. Allowed values:
+	// 	- Orientation.Vertical
+	// 	- Orientation.Horizontal
+	Orientation OrientationEnum `json:"orientation"`
+
+	// The offset from the screen origin in either the x (for vertical
+	// orientation) or y (for horizontal orientation) direction.
+	Offset int `json:"offset"`
+
+	// A display feature may mask content such that it is not physically
+	// displayed - this length along with the offset describes this area. A
+	// display feature that only splits content will have a 0 mask_length.
+	MaskLength int `json:"maskLength"`
+}
+
+/*
+MediaFeature represents the Emulation.MediaFeature type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#type-MediaFeature
+*/
+type MediaFeature struct {
+	Name string `json:"name"`
+
+	Value string `json:"value"`
+}
+
+/*
+ScreenOrientation represents the Emulation.ScreenOrientation type. Screen
+orientation.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#type-ScreenOrientation
+*/
+type ScreenOrientation struct {
+	// Orientation type. Allowed values:
+	// 	- Type.PortraitPrimary
+	// 	- Type.PortraitSecondary
+	// 	- Type.LandscapePrimary
+	// 	- Type.LandscapeSecondary
+	Type TypeEnum `json:"type"`
+
+	// Orientation angle.
+	Angle int `json:"angle"`
+}
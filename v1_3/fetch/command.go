@@ -0,0 +1,231 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package fetch
+
+import (
+	"github.com/mkenney/go-chrome/v1_3/io"
+	"github.com/mkenney/go-chrome/v1_3/network"
+)
+
+/*
+ContinueRequestParams represents Fetch.continueRequest parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#method-continueRequest
+*/
+type ContinueRequestParams struct {
+	// An id the client received in requestPaused event.
+	RequestID RequestID `json:"requestId"`
+
+	// Optional. If set, the request url will be modified in a way that's not
+	// observable by page.
+	URL string `json:"url,omitempty"`
+
+	// Optional. If set, the request method is overridden.
+	Method string `json:"method,omitempty"`
+
+	// Optional. If set, overrides the post data in the request. (Encoded as a
+	// base64 string when passed over JSON).
+	PostData string `json:"postData,omitempty"`
+
+	// Optional. If set, overrides the request headers. Note that the overrides
+	// do not extend to subsequent redirect hops, if a redirect happens.
+	// Another override may be applied to a different request produced by a
+	// redirect.
+	Headers []*HeaderEntry `json:"headers,omitempty"`
+}
+
+/*
+ContinueRequestResult represents the result of calls to Fetch.continueRequest.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#method-continueRequest
+*/
+type ContinueRequestResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ContinueWithAuthParams represents Fetch.continueWithAuth parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#method-continueWithAuth
+*/
+type ContinueWithAuthParams struct {
+	// An id the client received in authRequired event.
+	RequestID RequestID `json:"requestId"`
+
+	// Response to with an authChallenge.
+	AuthChallengeResponse *AuthChallengeResponse `json:"authChallengeResponse"`
+}
+
+/*
+ContinueWithAuthResult represents the result of calls to Fetch.continueWithAuth.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#method-continueWithAuth
+*/
+type ContinueWithAuthResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DisableResult represents the result of calls to Fetch.disable.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+EnableParams represents Fetch.enable parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#method-enable
+*/
+type EnableParams struct {
+	// Optional. If specified, only requests matching any of these patterns
+	// will produce fetchRequested event and will be paused until clients
+	// response. If not set, all requests will be affected.
+	Patterns []*RequestPattern `json:"patterns,omitempty"`
+
+	// Optional. If true, authRequired events will be issued and requests will
+	// be paused expecting a call to continueWithAuth.
+	HandleAuthRequests bool `json:"handleAuthRequests,omitempty"`
+}
+
+/*
+EnableResult represents the result of calls to Fetch.enable.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+FailRequestParams represents Fetch.failRequest parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#method-failRequest
+*/
+type FailRequestParams struct {
+	// An id the client received in requestPaused event.
+	RequestID RequestID `json:"requestId"`
+
+	// Causes the request to fail with the given reason. Allowed values:
+	// 	- ErrorReason.Failed
+	// 	- ErrorReason.Aborted
+	// 	- ErrorReason.TimedOut
+	// 	- ErrorReason.AccessDenied
+	// 	- ErrorReason.ConnectionClosed
+	// 	- ErrorReason.ConnectionReset
+	// 	- ErrorReason.ConnectionRefused
+	// 	- ErrorReason.ConnectionAborted
+	// 	- ErrorReason.ConnectionFailed
+	// 	- ErrorReason.NameNotResolved
+	// 	- ErrorReason.InternetDisconnected
+	// 	- ErrorReason.AddressUnreachable
+	// 	- ErrorReason.BlockedByClient
+	// 	- ErrorReason.BlockedByResponse
+	ErrorReason network.ErrorReasonEnum `json:"errorReason"`
+}
+
+/*
+FailRequestResult represents the result of calls to Fetch.failRequest.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#method-failRequest
+*/
+type FailRequestResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+FulfillRequestParams represents Fetch.fulfillRequest parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#method-fulfillRequest
+*/
+type FulfillRequestParams struct {
+	// An id the client received in requestPaused event.
+	RequestID RequestID `json:"requestId"`
+
+	// An HTTP response code.
+	ResponseCode int `json:"responseCode"`
+
+	// Optional. Response headers.
+	ResponseHeaders []*HeaderEntry `json:"responseHeaders,omitempty"`
+
+	// Optional. Alternative way of specifying response headers as a
+	// \0-separated series of name: value pairs. Prefer the above method unless
+	// you need to represent some non-UTF8 values that can't be transmitted
+	// over the protocol as text. (Encoded as a base64 string when passed over
+	// JSON).
+	BinaryResponseHeaders string `json:"binaryResponseHeaders,omitempty"`
+
+	// Optional. A response body. If absent, original response body will be
+	// used if the request is intercepted at the response stage and empty body
+	// will be used if the request is intercepted at the request stage.
+	// (Encoded as a base64 string when passed over JSON).
+	Body string `json:"body,omitempty"`
+
+	// Optional. A textual representation of responseCode. If absent, a
+	// standard phrase matching responseCode is used.
+	ResponsePhrase string `json:"responsePhrase,omitempty"`
+}
+
+/*
+FulfillRequestResult represents the result of calls to Fetch.fulfillRequest.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#method-fulfillRequest
+*/
+type FulfillRequestResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetResponseBodyParams represents Fetch.getResponseBody parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#method-getResponseBody
+*/
+type GetResponseBodyParams struct {
+	// Identifier for the intercepted request to get body for.
+	RequestID RequestID `json:"requestId"`
+}
+
+/*
+GetResponseBodyResult represents the result of calls to Fetch.getResponseBody.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#method-getResponseBody
+*/
+type GetResponseBodyResult struct {
+	// Response body.
+	Body string `json:"body"`
+
+	// True, if content was sent as base64.
+	Base64Encoded bool `json:"base64Encoded"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+TakeResponseBodyAsStreamParams represents Fetch.takeResponseBodyAsStream parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#method-takeResponseBodyAsStream
+*/
+type TakeResponseBodyAsStreamParams struct {
+	RequestID RequestID `json:"requestId"`
+}
+
+/*
+TakeResponseBodyAsStreamResult represents the result of calls to Fetch.takeResponseBodyAsStream.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#method-takeResponseBodyAsStream
+*/
+type TakeResponseBodyAsStreamResult struct {
+	Stream io.StreamHandle `json:"stream"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,127 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package fetch
+
+import (
+	"github.com/mkenney/go-chrome/v1_3/network"
+	"github.com/mkenney/go-chrome/v1_3/page"
+)
+
+/*
+AuthRequiredEvent represents Fetch.authRequired event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#event-authRequired
+*/
+type AuthRequiredEvent struct {
+	// Each request the page makes will have a unique id.
+	RequestID RequestID `json:"requestId"`
+
+	// The details of the request.
+	Request *network.Request `json:"request"`
+
+	// The id of the frame that initiated the request.
+	FrameID page.FrameID `json:"frameId"`
+
+	// How the requested resource will be used. Allowed values:
+	// 	- ResourceType.Document
+	// 	- ResourceType.Stylesheet
+	// 	- ResourceType.Image
+	// 	- ResourceType.Media
+	// 	- ResourceType.Font
+	// 	- ResourceType.Script
+	// 	- ResourceType.TextTrack
+	// 	- ResourceType.XHR
+	// 	- ResourceType.Fetch
+	// 	- ResourceType.Prefetch
+	// 	- ResourceType.EventSource
+	// 	- ResourceType.WebSocket
+	// 	- ResourceType.Manifest
+	// 	- ResourceType.SignedExchange
+	// 	- ResourceType.Ping
+	// 	- ResourceType.CSPViolationReport
+	// 	- ResourceType.Preflight
+	// 	- ResourceType.FedCM
+	// 	- ResourceType.Other
+	ResourceType network.ResourceTypeEnum `json:"resourceType"`
+
+	// Details of the Authorization Challenge encountered. If this is set,
+	// client should respond with continueRequest that contains
+	// AuthChallengeResponse.
+	AuthChallenge *AuthChallenge `json:"authChallenge"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+RequestPausedEvent represents Fetch.requestPaused event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#event-requestPaused
+*/
+type RequestPausedEvent struct {
+	// Each request the page makes will have a unique id.
+	RequestID RequestID `json:"requestId"`
+
+	// The details of the request.
+	Request *network.Request `json:"request"`
+
+	// The id of the frame that initiated the request.
+	FrameID page.FrameID `json:"frameId"`
+
+	// How the requested resource will be used. Allowed values:
+	// 	- ResourceType.Document
+	// 	- ResourceType.Stylesheet
+	// 	- ResourceType.Image
+	// 	- ResourceType.Media
+	// 	- ResourceType.Font
+	// 	- ResourceType.Script
+	// 	- ResourceType.TextTrack
+	// 	- ResourceType.XHR
+	// 	- ResourceType.Fetch
+	// 	- ResourceType.Prefetch
+	// 	- ResourceType.EventSource
+	// 	- ResourceType.WebSocket
+	// 	- ResourceType.Manifest
+	// 	- ResourceType.SignedExchange
+	// 	- ResourceType.Ping
+	// 	- ResourceType.CSPViolationReport
+	// 	- ResourceType.Preflight
+	// 	- ResourceType.FedCM
+	// 	- ResourceType.Other
+	ResourceType network.ResourceTypeEnum `json:"resourceType"`
+
+	// Optional. Response error if intercepted at response stage. Allowed
+	// values:
+	// 	- ErrorReason.Failed
+	// 	- ErrorReason.Aborted
+	// 	- ErrorReason.TimedOut
+	// 	- ErrorReason.AccessDenied
+	// 	- ErrorReason.ConnectionClosed
+	// 	- ErrorReason.ConnectionReset
+	// 	- ErrorReason.ConnectionRefused
+	// 	- ErrorReason.ConnectionAborted
+	// 	- ErrorReason.ConnectionFailed
+	// 	- ErrorReason.NameNotResolved
+	// 	- ErrorReason.InternetDisconnected
+	// 	- ErrorReason.AddressUnreachable
+	// 	- ErrorReason.BlockedByClient
+	// 	- ErrorReason.BlockedByResponse
+	ResponseErrorReason network.ErrorReasonEnum `json:"responseErrorReason,omitempty"`
+
+	// Optional. Response code if intercepted at response stage.
+	ResponseStatusCode int `json:"responseStatusCode,omitempty"`
+
+	// Optional. Response status text if intercepted at response stage.
+	ResponseStatusText string `json:"responseStatusText,omitempty"`
+
+	// Optional. Response headers if intercepted at the response stage.
+	ResponseHeaders []*HeaderEntry `json:"responseHeaders,omitempty"`
+
+	// Optional. If the intercepted request had a corresponding
+	// Network.requestWillBeSent event fired for it, then this networkId will
+	// be the same as the requestId present in the requestWillBeSent event.
+	NetworkID network.RequestID `json:"networkId,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -0,0 +1,47 @@
+package chrome
+
+import (
+	tot "github.com/mkenney/go-chrome/tot"
+)
+
+/*
+ChromiumFlags provides an interface for managing CLI arguments to the Chromium
+binary.
+*/
+type ChromiumFlags = tot.ChromiumFlags
+
+/*
+Flags is a ChromiumFlags implementation.
+*/
+type Flags = tot.Flags
+
+/*
+LaunchOptions is a ChromiumFlags implementation with typed setters.
+*/
+type LaunchOptions = tot.LaunchOptions
+
+/*
+LaunchPreset configures a set of launch options for a common use case.
+*/
+type LaunchPreset = tot.LaunchPreset
+
+var (
+	// KnownFlags lists the flag names accepted by LaunchOptions.Validate.
+	KnownFlags = tot.KnownFlags
+
+	// PresetContainer allows Chromium to run as root in a container.
+	PresetContainer LaunchPreset = tot.PresetContainer
+
+	// PresetDeterministic makes rendering reproducible across machines.
+	PresetDeterministic LaunchPreset = tot.PresetDeterministic
+
+	// PresetHeadless runs Chromium without a user interface.
+	PresetHeadless LaunchPreset = tot.PresetHeadless
+)
+
+/*
+NewLaunchOptions returns launch options configured by the presets.
+*/
+func NewLaunchOptions(presets ...LaunchPreset) *LaunchOptions {
+	return tot.NewLaunchOptions(presets...)
+}
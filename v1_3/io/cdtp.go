@@ -0,0 +1,19 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package io provides type definitions for use with the Chrome IO protocol.
+
+Input/Output operations for streams produced by DevTools.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/IO/
+*/
+package io
+
+/*
+StreamHandle represents the IO.StreamHandle type. This is either obtained from
+another method or specified as `blob:<uuid>` where `<uuid>` is an UUID of a
+Blob.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/IO/#type-StreamHandle
+*/
+type StreamHandle string
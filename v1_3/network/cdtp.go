@@ -0,0 +1,629 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package network provides type definitions for use with the Chrome Network
+protocol.
+
+Network domain allows tracking network activities of the page. It exposes
+information about http, file, data and other requests and responses, their
+headers, bodies, timing, etc.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/
+*/
+package network
+
+import (
+	"github.com/mkenney/go-chrome/v1_3/runtime"
+	"github.com/mkenney/go-chrome/v1_3/security"
+)
+
+/*
+CachedResource represents the Network.CachedResource type. Information about the
+cached resource.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-CachedResource
+*/
+type CachedResource struct {
+	// Resource URL. This is the url of the original network request.
+	URL string `json:"url"`
+
+	// Type of this resource. Allowed values:
+	// 	- ResourceType.Document
+	// 	- ResourceType.Stylesheet
+	// 	- ResourceType.Image
+	// 	- ResourceType.Media
+	// 	- ResourceType.Font
+	// 	- ResourceType.Script
+	// 	- ResourceType.TextTrack
+	// 	- ResourceType.XHR
+	// 	- ResourceType.Fetch
+	// 	- ResourceType.Prefetch
+	// 	- ResourceType.EventSource
+	// 	- ResourceType.WebSocket
+	// 	- ResourceType.Manifest
+	// 	- ResourceType.SignedExchange
+	// 	- ResourceType.Ping
+	// 	- ResourceType.CSPViolationReport
+	// 	- ResourceType.Preflight
+	// 	- ResourceType.FedCM
+	// 	- ResourceType.Other
+	Type ResourceTypeEnum `json:"type"`
+
+	// Optional. Cached response data.
+	Response *Response `json:"response,omitempty"`
+
+	// Cached response body size.
+	BodySize float64 `json:"bodySize"`
+}
+
+/*
+Cookie represents the Network.Cookie type. Cookie object.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-Cookie
+*/
+type Cookie struct {
+	// Cookie name.
+	Name string `json:"name"`
+
+	// Cookie value.
+	Value string `json:"value"`
+
+	// Cookie domain.
+	Domain string `json:"domain"`
+
+	// Cookie path.
+	Path string `json:"path"`
+
+	// Cookie expiration date as the number of seconds since the UNIX epoch.
+	Expires float64 `json:"expires"`
+
+	// Cookie size.
+	Size int `json:"size"`
+
+	// True if cookie is http-only.
+	HTTPOnly bool `json:"httpOnly"`
+
+	// True if cookie is secure.
+	Secure bool `json:"secure"`
+
+	// True in case of session cookie.
+	Session bool `json:"session"`
+
+	// Optional. Cookie SameSite type. Allowed values:
+	// 	- CookieSameSite.Strict
+	// 	- CookieSameSite.Lax
+	// 	- CookieSameSite.None
+	SameSite CookieSameSiteEnum `json:"sameSite,omitempty"`
+}
+
+/*
+CookieParam represents the Network.CookieParam type. Cookie parameter object.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-CookieParam
+*/
+type CookieParam struct {
+	// Cookie name.
+	Name string `json:"name"`
+
+	// Cookie value.
+	Value string `json:"value"`
+
+	// Optional. The request-URI to associate with the setting of the cookie.
+	// This value can affect the default domain, path, source port, and source
+	// scheme values of the created cookie.
+	URL string `json:"url,omitempty"`
+
+	// Optional. Cookie domain.
+	Domain string `json:"domain,omitempty"`
+
+	// Optional. Cookie path.
+	Path string `json:"path,omitempty"`
+
+	// Optional. True if cookie is secure.
+	Secure bool `json:"secure,omitempty"`
+
+	// Optional. True if cookie is http-only.
+	HTTPOnly bool `json:"httpOnly,omitempty"`
+
+	// Optional. Cookie SameSite type. Allowed values:
+	// 	- CookieSameSite.Strict
+	// 	- CookieSameSite.Lax
+	// 	- CookieSameSite.None
+	SameSite CookieSameSiteEnum `json:"sameSite,omitempty"`
+
+	// Optional. Cookie expiration date, session cookie if not set.
+	Expires TimeSinceEpoch `json:"expires,omitempty"`
+}
+
+/*
+CorsErrorStatus represents the Network.CorsErrorStatus type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-CorsErrorStatus
+*/
+type CorsErrorStatus struct {
+	// Allowed values:
+	// 	- CorsError.DisallowedByMode
+	// 	- CorsError.InvalidResponse
+	// 	- CorsError.WildcardOriginNotAllowed
+	// 	- CorsError.MissingAllowOriginHeader
+	// 	- CorsError.MultipleAllowOriginValues
+	// 	- CorsError.InvalidAllowOriginValue
+	// 	- CorsError.AllowOriginMismatch
+	// 	- CorsError.InvalidAllowCredentials
+	// 	- CorsError.CorsDisabledScheme
+	// 	- CorsError.PreflightInvalidStatus
+	// 	- CorsError.PreflightDisallowedRedirect
+	// 	- CorsError.PreflightWildcardOriginNotAllowed
+	// 	- CorsError.PreflightMissingAllowOriginHeader
+	// 	- CorsError.PreflightMultipleAllowOriginValues
+	// 	- CorsError.PreflightInvalidAllowOriginValue
+	// 	- CorsError.PreflightAllowOriginMismatch
+	// 	- CorsError.PreflightInvalidAllowCredentials
+	// 	- CorsError.PreflightMissingAllowExternal
+	// 	- CorsError.PreflightInvalidAllowExternal
+	// 	- CorsError.PreflightMissingAllowPrivateNetwork
+	// 	- CorsError.PreflightInvalidAllowPrivateNetwork
+	// 	- CorsError.InvalidAllowMethodsPreflightResponse
+	// 	- CorsError.InvalidAllowHeadersPreflightResponse
+	// 	- CorsError.MethodDisallowedByPreflightResponse
+	// 	- CorsError.HeaderDisallowedByPreflightResponse
+	// 	- CorsError.RedirectContainsCredentials
+	// 	- CorsError.InsecurePrivateNetwork
+	// 	- CorsError.InvalidPrivateNetworkAccess
+	// 	- CorsError.UnexpectedPrivateNetworkAccess
+	// 	- CorsError.NoCorsRedirectModeNotFollow
+	// 	- CorsError.PreflightMissingPrivateNetworkAccessID
+	// 	- CorsError.PreflightMissingPrivateNetworkAccessName
+	// 	- CorsError.PrivateNetworkAccessPermissionUnavailable
+	// 	- CorsError.PrivateNetworkAccessPermissionDenied
+	// 	- CorsError.LocalNetworkAccessPermissionDenied
+	CorsError CorsErrorEnum `json:"corsError"`
+
+	FailedParameter string `json:"failedParameter"`
+}
+
+/*
+Headers represents the Network.Headers type. Request / response headers as keys
+/ values of JSON object.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-Headers
+*/
+type Headers map[string]interface{}
+
+/*
+Initiator represents the Network.Initiator type. Information about the request
+initiator.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-Initiator
+*/
+type Initiator struct {
+	// Type of this initiator. Allowed values:
+	// 	- Type.Parser
+	// 	- Type.Script
+	// 	- Type.Preload
+	// 	- Type.SignedExchange
+	// 	- Type.Preflight
+	// 	- Type.Other
+	Type TypeEnum `json:"type"`
+
+	// Optional. Initiator JavaScript stack trace, set for Script only.
+	// Requires the Debugger domain to be enabled.
+	Stack *runtime.StackTrace `json:"stack,omitempty"`
+
+	// Optional. Initiator URL, set for Parser type or for Script type (when
+	// script is importing module) or for SignedExchange type.
+	URL string `json:"url,omitempty"`
+
+	// Optional. Initiator line number, set for Parser type or for Script type
+	// (when script is importing module) (0-based).
+	LineNumber float64 `json:"lineNumber,omitempty"`
+
+	// Optional. Initiator column number, set for Parser type or for Script
+	// type (when script is importing module) (0-based).
+	ColumnNumber float64 `json:"columnNumber,omitempty"`
+
+	// Optional. Set if another request triggered this request (e.g.
+	// preflight).
+	RequestID RequestID `json:"requestId,omitempty"`
+}
+
+/*
+InterceptionID represents the Network.InterceptionId type. Unique intercepted
+request identifier.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-InterceptionId
+*/
+type InterceptionID string
+
+/*
+LoaderID represents the Network.LoaderId type. Unique loader identifier.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-LoaderId
+*/
+type LoaderID string
+
+/*
+MonotonicTime represents the Network.MonotonicTime type. Monotonically
+increasing time in seconds since an arbitrary point in the past.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-MonotonicTime
+*/
+type MonotonicTime float64
+
+/*
+PostDataEntry represents the Network.PostDataEntry type. Post data entry for
+HTTP request.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-PostDataEntry
+*/
+type PostDataEntry struct {
+	// Optional.
+	Bytes string `json:"bytes,omitempty"`
+}
+
+/*
+Request represents the Network.Request type. HTTP request data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-Request
+*/
+type Request struct {
+	// Request URL (without fragment).
+	URL string `json:"url"`
+
+	// Optional. Fragment of the requested URL starting with hash, if present.
+	URLFragment string `json:"urlFragment,omitempty"`
+
+	// HTTP request method.
+	Method string `json:"method"`
+
+	// HTTP request headers.
+	Headers Headers `json:"headers"`
+
+	// Optional. HTTP POST request data. Use postDataEntries instead.
+	// DEPRECATED.
+	PostData string `json:"postData,omitempty"`
+
+	// Optional. True when the request has POST data. Note that postData might
+	// still be omitted when this flag is true when the data is too long.
+	HasPostData bool `json:"hasPostData,omitempty"`
+
+	// Optional. The mixed content type of the request. Allowed values:
+	// 	- MixedContentType.Blockable
+	// 	- MixedContentType.OptionallyBlockable
+	// 	- MixedContentType.None
+	MixedContentType security.MixedContentTypeEnum `json:"mixedContentType,omitempty"`
+
+	// Priority of the resource request at the time request is sent. Allowed
+	// values:
+	// 	- ResourcePriority.VeryLow
+	// 	- ResourcePriority.Low
+	// 	- ResourcePriority.Medium
+	// 	- ResourcePriority.High
+	// 	- ResourcePriority.VeryHigh
+	InitialPriority ResourcePriorityEnum `json:"initialPriority"`
+
+	// The referrer policy of the request, as defined in
+	// https://www.w3.org/TR/referrer-policy/. Allowed values:
+	// 	- ReferrerPolicy.UnsafeURL
+	// 	- ReferrerPolicy.NoReferrerWhenDowngrade
+	// 	- ReferrerPolicy.NoReferrer
+	// 	- ReferrerPolicy.Origin
+	// 	- ReferrerPolicy.OriginWhenCrossOrigin
+	// 	- ReferrerPolicy.SameOrigin
+	// 	- ReferrerPolicy.StrictOrigin
+	// 	- ReferrerPolicy.StrictOriginWhenCrossOrigin
+	ReferrerPolicy ReferrerPolicyEnum `json:"referrerPolicy"`
+
+	// Optional. Whether is loaded via link preload.
+	IsLinkPreload bool `json:"isLinkPreload,omitempty"`
+}
+
+/*
+RequestID represents the Network.RequestId type. Unique network request
+identifier. Note that this does not identify individual HTTP requests that are
+part of a network request.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-RequestId
+*/
+type RequestID string
+
+/*
+ResourceTiming represents the Network.ResourceTiming type. Timing information
+for the request.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-ResourceTiming
+*/
+type ResourceTiming struct {
+	// Timing's requestTime is a baseline in seconds, while the other numbers
+	// are ticks in milliseconds relatively to this requestTime.
+	RequestTime float64 `json:"requestTime"`
+
+	// Started resolving proxy.
+	ProxyStart float64 `json:"proxyStart"`
+
+	// Finished resolving proxy.
+	ProxyEnd float64 `json:"proxyEnd"`
+
+	// Started DNS address resolve.
+	DnsStart float64 `json:"dnsStart"`
+
+	// Finished DNS address resolve.
+	DnsEnd float64 `json:"dnsEnd"`
+
+	// Started connecting to the remote host.
+	ConnectStart float64 `json:"connectStart"`
+
+	// Connected to the remote host.
+	ConnectEnd float64 `json:"connectEnd"`
+
+	// Started SSL handshake.
+	SSLStart float64 `json:"sslStart"`
+
+	// Finished SSL handshake.
+	SSLEnd float64 `json:"sslEnd"`
+
+	// Started sending request.
+	SendStart float64 `json:"sendStart"`
+
+	// Finished sending request.
+	SendEnd float64 `json:"sendEnd"`
+
+	// Finished receiving response headers.
+	ReceiveHeadersEnd float64 `json:"receiveHeadersEnd"`
+}
+
+/*
+Response represents the Network.Response type. HTTP response data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-Response
+*/
+type Response struct {
+	// Response URL. This URL can be different from CachedResource.url in case
+	// of redirect.
+	URL string `json:"url"`
+
+	// HTTP response status code.
+	Status int `json:"status"`
+
+	// HTTP response status text.
+	StatusText string `json:"statusText"`
+
+	// HTTP response headers.
+	Headers Headers `json:"headers"`
+
+	// Optional. HTTP response headers text. This has been replaced by the
+	// headers in Network.responseReceivedExtraInfo. DEPRECATED.
+	HeadersText string `json:"headersText,omitempty"`
+
+	// Resource mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+
+	// Resource charset as determined by the browser (if applicable).
+	Charset string `json:"charset"`
+
+	// Optional. Refined HTTP request headers that were actually transmitted
+	// over the network.
+	RequestHeaders Headers `json:"requestHeaders,omitempty"`
+
+	// Optional. HTTP request headers text. This has been replaced by the
+	// headers in Network.requestWillBeSentExtraInfo. DEPRECATED.
+	RequestHeadersText string `json:"requestHeadersText,omitempty"`
+
+	// Specifies whether physical connection was actually reused for this
+	// request.
+	ConnectionReused bool `json:"connectionReused"`
+
+	// Physical connection id that was actually used for this request.
+	ConnectionID float64 `json:"connectionId"`
+
+	// Optional. Remote IP address.
+	RemoteIPAddress string `json:"remoteIPAddress,omitempty"`
+
+	// Optional. Remote port.
+	RemotePort int `json:"remotePort,omitempty"`
+
+	// Optional. Specifies that the request was served from the disk cache.
+	FromDiskCache bool `json:"fromDiskCache,omitempty"`
+
+	// Optional. Specifies that the request was served from the ServiceWorker.
+	FromServiceWorker bool `json:"fromServiceWorker,omitempty"`
+
+	// Optional. Specifies that the request was served from the prefetch cache.
+	FromPrefetchCache bool `json:"fromPrefetchCache,omitempty"`
+
+	// Optional. Specifies that the request was served from the prefetch cache.
+	FromEarlyHints bool `json:"fromEarlyHints,omitempty"`
+
+	// Total number of bytes received for this request so far.
+	EncodedDataLength float64 `json:"encodedDataLength"`
+
+	// Optional. Timing information for the given request.
+	Timing *ResourceTiming `json:"timing,omitempty"`
+
+	// Optional. Response source of response from ServiceWorker. Allowed
+	// values:
+	// 	- ServiceWorkerResponseSource.CacheStorage
+	// 	- ServiceWorkerResponseSource.HTTPCache
+	// 	- ServiceWorkerResponseSource.FallbackCode
+	// 	- ServiceWorkerResponseSource.Network
+	ServiceWorkerResponseSource ServiceWorkerResponseSourceEnum `json:"serviceWorkerResponseSource,omitempty"`
+
+	// Optional. The time at which the returned response was generated.
+	ResponseTime TimeSinceEpoch `json:"responseTime,omitempty"`
+
+	// Optional. Cache Storage Cache Name.
+	CacheStorageCacheName string `json:"cacheStorageCacheName,omitempty"`
+
+	// Optional. Protocol used to fetch this request.
+	Protocol string `json:"protocol,omitempty"`
+
+	// Security state of the request resource. Allowed values:
+	// 	- SecurityState.Unknown
+	// 	- SecurityState.Neutral
+	// 	- SecurityState.Insecure
+	// 	- SecurityState.Secure
+	// 	- SecurityState.Info
+	// 	- SecurityState.InsecureBroken
+	SecurityState security.SecurityStateEnum `json:"securityState"`
+
+	// Optional. Security details for the request.
+	SecurityDetails *SecurityDetails `json:"securityDetails,omitempty"`
+}
+
+/*
+SecurityDetails represents the Network.SecurityDetails type. Security details
+about a request.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-SecurityDetails
+*/
+type SecurityDetails struct {
+	// Protocol name (e.g. "TLS 1.2" or "QUIC").
+	Protocol string `json:"protocol"`
+
+	// Key Exchange used by the connection, or the empty string if not
+	// applicable.
+	KeyExchange string `json:"keyExchange"`
+
+	// Optional. (EC)DH group used by the connection, if applicable.
+	KeyExchangeGroup string `json:"keyExchangeGroup,omitempty"`
+
+	// Cipher name.
+	Cipher string `json:"cipher"`
+
+	// Optional. TLS MAC. Note that AEAD ciphers do not have separate MACs.
+	Mac string `json:"mac,omitempty"`
+
+	// Certificate ID value.
+	CertificateID security.CertificateID `json:"certificateId"`
+
+	// Certificate subject name.
+	SubjectName string `json:"subjectName"`
+
+	// Subject Alternative Name (SAN) DNS names and IP addresses.
+	SanList []string `json:"sanList"`
+
+	// Name of the issuing CA.
+	Issuer string `json:"issuer"`
+
+	// Certificate valid from date.
+	ValidFrom TimeSinceEpoch `json:"validFrom"`
+
+	// Certificate valid to (expiration) date.
+	ValidTo TimeSinceEpoch `json:"validTo"`
+
+	// List of signed certificate timestamps (SCTs).
+	SignedCertificateTimestampList []*SignedCertificateTimestamp `json:"signedCertificateTimestampList"`
+
+	// Whether the request complied with Certificate Transparency policy.
+	// Allowed values:
+	// 	- CertificateTransparencyCompliance.Unknown
+	// 	- CertificateTransparencyCompliance.NotCompliant
+	// 	- CertificateTransparencyCompliance.Compliant
+	CertificateTransparencyCompliance CertificateTransparencyComplianceEnum `json:"certificateTransparencyCompliance"`
+
+	// Optional. The signature algorithm used by the server in the TLS server
+	// signature, represented as a TLS SignatureScheme code point. Omitted if
+	// not applicable or not known.
+	ServerSignatureAlgorithm int `json:"serverSignatureAlgorithm,omitempty"`
+
+	// Whether the connection used Encrypted ClientHello.
+	EncryptedClientHello bool `json:"encryptedClientHello"`
+}
+
+/*
+SignedCertificateTimestamp represents the Network.SignedCertificateTimestamp
+type. Details of a signed certificate timestamp (SCT).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-SignedCertificateTimestamp
+*/
+type SignedCertificateTimestamp struct {
+	// Validation status.
+	Status string `json:"status"`
+
+	// Origin.
+	Origin string `json:"origin"`
+
+	// Log name / description.
+	LogDescription string `json:"logDescription"`
+
+	// Log ID.
+	LogID string `json:"logId"`
+
+	// Issuance date. Unlike TimeSinceEpoch, this contains the number of
+	// milliseconds since January 1, 1970, UTC, not the number of seconds.
+	Timestamp float64 `json:"timestamp"`
+
+	// Hash algorithm.
+	HashAlgorithm string `json:"hashAlgorithm"`
+
+	// Signature algorithm.
+	SignatureAlgorithm string `json:"signatureAlgorithm"`
+
+	// Signature data.
+	SignatureData string `json:"signatureData"`
+}
+
+/*
+TimeSinceEpoch represents the Network.TimeSinceEpoch type. UTC time in seconds,
+counted from January 1, 1970.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-TimeSinceEpoch
+*/
+type TimeSinceEpoch float64
+
+/*
+WebSocketFrame represents the Network.WebSocketFrame type. WebSocket message
+data. This represents an entire WebSocket message, not just a fragmented frame
+as the name suggests.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-WebSocketFrame
+*/
+type WebSocketFrame struct {
+	// WebSocket message opcode.
+	Opcode float64 `json:"opcode"`
+
+	// WebSocket message mask.
+	Mask bool `json:"mask"`
+
+	// WebSocket message payload data. If the opcode is 1, this is a text
+	// message and payloadData is a UTF-8 string. If the opcode isn't 1, then
+	// payloadData is a base64 encoded string representing binary data.
+	PayloadData string `json:"payloadData"`
+}
+
+/*
+WebSocketRequest represents the Network.WebSocketRequest type. WebSocket request
+data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-WebSocketRequest
+*/
+type WebSocketRequest struct {
+	// HTTP request headers.
+	Headers Headers `json:"headers"`
+}
+
+/*
+WebSocketResponse represents the Network.WebSocketResponse type. WebSocket
+response data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-WebSocketResponse
+*/
+type WebSocketResponse struct {
+	// HTTP response status code.
+	Status int `json:"status"`
+
+	// HTTP response status text.
+	StatusText string `json:"statusText"`
+
+	// HTTP response headers.
+	Headers Headers `json:"headers"`
+
+	// Optional. HTTP response headers text.
+	HeadersText string `json:"headersText,omitempty"`
+
+	// Optional. HTTP request headers.
+	RequestHeaders Headers `json:"requestHeaders,omitempty"`
+
+	// Optional. HTTP request headers text.
+	RequestHeadersText string `json:"requestHeadersText,omitempty"`
+}
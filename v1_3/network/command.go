@@ -0,0 +1,416 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+/*
+CanClearBrowserCacheResult represents the result of calls to Network.canClearBrowserCache.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-canClearBrowserCache
+DEPRECATED.
+*/
+type CanClearBrowserCacheResult struct {
+	// True if browser cache can be cleared.
+	Result bool `json:"result"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+CanClearBrowserCookiesResult represents the result of calls to Network.canClearBrowserCookies.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-canClearBrowserCookies
+DEPRECATED.
+*/
+type CanClearBrowserCookiesResult struct {
+	// True if browser cookies can be cleared.
+	Result bool `json:"result"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+CanEmulateNetworkConditionsResult represents the result of calls to Network.canEmulateNetworkConditions.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-canEmulateNetworkConditions
+DEPRECATED.
+*/
+type CanEmulateNetworkConditionsResult struct {
+	// True if emulation of network conditions is supported.
+	Result bool `json:"result"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearBrowserCacheResult represents the result of calls to Network.clearBrowserCache.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-clearBrowserCache
+*/
+type ClearBrowserCacheResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearBrowserCookiesResult represents the result of calls to Network.clearBrowserCookies.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-clearBrowserCookies
+*/
+type ClearBrowserCookiesResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DeleteCookiesParams represents Network.deleteCookies parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-deleteCookies
+*/
+type DeleteCookiesParams struct {
+	// Name of the cookies to remove.
+	Name string `json:"name"`
+
+	// Optional. If specified, deletes all the cookies with the given name
+	// where domain and path match provided URL.
+	URL string `json:"url,omitempty"`
+
+	// Optional. If specified, deletes only cookies with the exact domain.
+	Domain string `json:"domain,omitempty"`
+
+	// Optional. If specified, deletes only cookies with the exact path.
+	Path string `json:"path,omitempty"`
+}
+
+/*
+DeleteCookiesResult represents the result of calls to Network.deleteCookies.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-deleteCookies
+*/
+type DeleteCookiesResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DisableResult represents the result of calls to Network.disable.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+EmulateNetworkConditionsParams represents Network.emulateNetworkConditions parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-emulateNetworkConditions
+*/
+type EmulateNetworkConditionsParams struct {
+	// True to emulate internet disconnection.
+	Offline bool `json:"offline"`
+
+	// Minimum latency from request sent to response headers received (ms).
+	Latency float64 `json:"latency"`
+
+	// Maximal aggregated download throughput (bytes/sec). -1 disables download
+	// throttling.
+	DownloadThroughput float64 `json:"downloadThroughput"`
+
+	// Maximal aggregated upload throughput (bytes/sec). -1 disables upload
+	// throttling.
+	UploadThroughput float64 `json:"uploadThroughput"`
+
+	// Optional. Connection type if known. Allowed values:
+	// 	- ConnectionType.None
+	// 	- ConnectionType.Cellular2g
+	// 	- ConnectionType.Cellular3g
+	// 	- ConnectionType.Cellular4g
+	// 	- ConnectionType.Bluetooth
+	// 	- ConnectionType.Ethernet
+	// 	- ConnectionType.Wifi
+	// 	- ConnectionType.Wimax
+	// 	- ConnectionType.Other
+	ConnectionType ConnectionTypeEnum `json:"connectionType,omitempty"`
+}
+
+/*
+EmulateNetworkConditionsResult represents the result of calls to Network.emulateNetworkConditions.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-emulateNetworkConditions
+*/
+type EmulateNetworkConditionsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+EnableParams represents Network.enable parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-enable
+*/
+type EnableParams struct {
+	// Optional. Longest post body size (in bytes) that would be included in
+	// requestWillBeSent notification.
+	MaxPostDataSize int `json:"maxPostDataSize,omitempty"`
+}
+
+/*
+EnableResult represents the result of calls to Network.enable.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetAllCookiesResult represents the result of calls to Network.getAllCookies.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getAllCookies
+DEPRECATED.
+*/
+type GetAllCookiesResult struct {
+	// Array of cookie objects.
+	Cookies []*Cookie `json:"cookies"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetCookiesParams represents Network.getCookies parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getCookies
+*/
+type GetCookiesParams struct {
+	// Optional. The list of URLs for which applicable cookies will be fetched.
+	// If not specified, it's assumed to be set to the list containing the URLs
+	// of the page and all of its subframes.
+	URLs []string `json:"urls,omitempty"`
+}
+
+/*
+GetCookiesResult represents the result of calls to Network.getCookies.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getCookies
+*/
+type GetCookiesResult struct {
+	// Array of cookie objects.
+	Cookies []*Cookie `json:"cookies"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetRequestPostDataParams represents Network.getRequestPostData parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getRequestPostData
+*/
+type GetRequestPostDataParams struct {
+	// Identifier of the network request to get content for.
+	RequestID RequestID `json:"requestId"`
+}
+
+/*
+GetRequestPostDataResult represents the result of calls to Network.getRequestPostData.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getRequestPostData
+*/
+type GetRequestPostDataResult struct {
+	// Request body string, omitting files from multipart requests.
+	PostData string `json:"postData"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetResponseBodyParams represents Network.getResponseBody parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getResponseBody
+*/
+type GetResponseBodyParams struct {
+	// Identifier of the network request to get content for.
+	RequestID RequestID `json:"requestId"`
+}
+
+/*
+GetResponseBodyResult represents the result of calls to Network.getResponseBody.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getResponseBody
+*/
+type GetResponseBodyResult struct {
+	// Response body.
+	Body string `json:"body"`
+
+	// True, if content was sent as base64.
+	Base64Encoded bool `json:"base64Encoded"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetBypassServiceWorkerParams represents Network.setBypassServiceWorker parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setBypassServiceWorker
+*/
+type SetBypassServiceWorkerParams struct {
+	// Bypass service worker and load from network.
+	Bypass bool `json:"bypass"`
+}
+
+/*
+SetBypassServiceWorkerResult represents the result of calls to Network.setBypassServiceWorker.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setBypassServiceWorker
+*/
+type SetBypassServiceWorkerResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetCacheDisabledParams represents Network.setCacheDisabled parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setCacheDisabled
+*/
+type SetCacheDisabledParams struct {
+	// Cache disabled state.
+	CacheDisabled bool `json:"cacheDisabled"`
+}
+
+/*
+SetCacheDisabledResult represents the result of calls to Network.setCacheDisabled.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setCacheDisabled
+*/
+type SetCacheDisabledResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetCookieParams represents Network.setCookie parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setCookie
+*/
+type SetCookieParams struct {
+	// Cookie name.
+	Name string `json:"name"`
+
+	// Cookie value.
+	Value string `json:"value"`
+
+	// Optional. The request-URI to associate with the setting of the cookie.
+	// This value can affect the default domain, path, source port, and source
+	// scheme values of the created cookie.
+	URL string `json:"url,omitempty"`
+
+	// Optional. Cookie domain.
+	Domain string `json:"domain,omitempty"`
+
+	// Optional. Cookie path.
+	Path string `json:"path,omitempty"`
+
+	// Optional. True if cookie is secure.
+	Secure bool `json:"secure,omitempty"`
+
+	// Optional. True if cookie is http-only.
+	HTTPOnly bool `json:"httpOnly,omitempty"`
+
+	// Optional. Cookie SameSite type. Allowed values:
+	// 	- CookieSameSite.Strict
+	// 	- CookieSameSite.Lax
+	// 	- CookieSameSite.None
+	SameSite CookieSameSiteEnum `json:"sameSite,omitempty"`
+
+	// Optional. Cookie expiration date, session cookie if not set.
+	Expires TimeSinceEpoch `json:"expires,omitempty"`
+}
+
+/*
+SetCookieResult represents the result of calls to Network.setCookie.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setCookie
+*/
+type SetCookieResult struct {
+	// Always set to true. If an error occurs, the response indicates protocol
+	// error. DEPRECATED.
+	Success bool `json:"success"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetCookiesParams represents Network.setCookies parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setCookies
+*/
+type SetCookiesParams struct {
+	// Cookies to be set.
+	Cookies []*CookieParam `json:"cookies"`
+}
+
+/*
+SetCookiesResult represents the result of calls to Network.setCookies.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setCookies
+*/
+type SetCookiesResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetExtraHTTPHeadersParams represents Network.setExtraHTTPHeaders parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setExtraHTTPHeaders
+*/
+type SetExtraHTTPHeadersParams struct {
+	// Map with extra HTTP headers.
+	Headers Headers `json:"headers"`
+}
+
+/*
+SetExtraHTTPHeadersResult represents the result of calls to Network.setExtraHTTPHeaders.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setExtraHTTPHeaders
+*/
+type SetExtraHTTPHeadersResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetUserAgentOverrideParams represents Network.setUserAgentOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setUserAgentOverride
+*/
+type SetUserAgentOverrideParams struct {
+	// User agent to use.
+	UserAgent string `json:"userAgent"`
+
+	// Optional. Browser language to emulate.
+	AcceptLanguage string `json:"acceptLanguage,omitempty"`
+
+	// Optional. The platform navigator.platform should return.
+	Platform string `json:"platform,omitempty"`
+}
+
+/*
+SetUserAgentOverrideResult represents the result of calls to Network.setUserAgentOverride.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setUserAgentOverride
+*/
+type SetUserAgentOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,234 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package page provides type definitions for use with the Chrome Page protocol.
+
+Actions and events related to the inspected page belong to the page domain.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/
+*/
+package page
+
+/*
+LoaderID represents the Network.LoaderId type. Unique loader identifier. This is
+a duplicate of Network.LoaderId to avoid an invalid import cycle.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-LoaderId
+*/
+type LoaderID string
+
+/*
+MonotonicTime represents the Network.MonotonicTime type. Monotonically
+increasing time in seconds since an arbitrary point in the past. This is a
+duplicate of Network.MonotonicTime to avoid an invalid import cycle.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-MonotonicTime
+*/
+type MonotonicTime float64
+
+/*
+Rect represents the DOM.Rect type. Rectangle. This is a duplicate of DOM.Rect to
+avoid an invalid import cycle.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#type-Rect
+*/
+type Rect struct {
+	// X coordinate.
+	X float64 `json:"x"`
+
+	// Y coordinate.
+	Y float64 `json:"y"`
+
+	// Rectangle width.
+	Width float64 `json:"width"`
+
+	// Rectangle height.
+	Height float64 `json:"height"`
+}
+
+/*
+AppManifestError represents the Page.AppManifestError type. Error while paring
+app manifest.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-AppManifestError
+*/
+type AppManifestError struct {
+	// Error message.
+	Message string `json:"message"`
+
+	// If critical, this is a non-recoverable parse error.
+	Critical int `json:"critical"`
+
+	// Error line.
+	Line int `json:"line"`
+
+	// Error column.
+	Column int `json:"column"`
+}
+
+/*
+Frame represents the Page.Frame type. Information about the Frame on the page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-Frame
+*/
+type Frame struct {
+	// Frame unique identifier.
+	ID FrameID `json:"id"`
+
+	// Optional. Parent frame identifier.
+	ParentID FrameID `json:"parentId,omitempty"`
+
+	// Identifier of the loader associated with this frame.
+	LoaderID LoaderID `json:"loaderId"`
+
+	// Optional. Frame's name as specified in the tag.
+	Name string `json:"name,omitempty"`
+
+	// Frame document's URL without fragment.
+	URL string `json:"url"`
+
+	// Frame document's security origin.
+	SecurityOrigin string `json:"securityOrigin"`
+
+	// Frame document's mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+}
+
+/*
+FrameID represents the Page.FrameId type. Unique frame identifier.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-FrameId
+*/
+type FrameID string
+
+/*
+FrameTree represents the Page.FrameTree type. Information about the Frame
+hierarchy.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-FrameTree
+*/
+type FrameTree struct {
+	// Frame information for this tree item.
+	Frame *Frame `json:"frame"`
+
+	// Optional. Child frames.
+	ChildFrames []*FrameTree `json:"childFrames,omitempty"`
+}
+
+/*
+LayoutViewport represents the Page.LayoutViewport type. Layout viewport position
+and dimensions.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-LayoutViewport
+*/
+type LayoutViewport struct {
+	// Horizontal offset relative to the document (CSS pixels).
+	PageX int `json:"pageX"`
+
+	// Vertical offset relative to the document (CSS pixels).
+	PageY int `json:"pageY"`
+
+	// Width (CSS pixels), excludes scrollbar if present.
+	ClientWidth int `json:"clientWidth"`
+
+	// Height (CSS pixels), excludes scrollbar if present.
+	ClientHeight int `json:"clientHeight"`
+}
+
+/*
+NavigationEntry represents the Page.NavigationEntry type. Navigation history
+entry.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-NavigationEntry
+*/
+type NavigationEntry struct {
+	// Unique id of the navigation history entry.
+	ID int `json:"id"`
+
+	// URL of the navigation history entry.
+	URL string `json:"url"`
+
+	// URL that the user typed in the url bar.
+	UserTypedURL string `json:"userTypedURL"`
+
+	// Title of the navigation history entry.
+	Title string `json:"title"`
+
+	// Transition type. Allowed values:
+	// 	- TransitionType.Link
+	// 	- TransitionType.Typed
+	// 	- TransitionType.AddressBar
+	// 	- TransitionType.AutoBookmark
+	// 	- TransitionType.AutoSubframe
+	// 	- TransitionType.ManualSubframe
+	// 	- TransitionType.Generated
+	// 	- TransitionType.AutoToplevel
+	// 	- TransitionType.FormSubmit
+	// 	- TransitionType.Reload
+	// 	- TransitionType.Keyword
+	// 	- TransitionType.KeywordGenerated
+	// 	- TransitionType.Other
+	TransitionType TransitionTypeEnum `json:"transitionType"`
+}
+
+/*
+ScriptIdentifier represents the Page.ScriptIdentifier type. Unique script
+identifier.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-ScriptIdentifier
+*/
+type ScriptIdentifier string
+
+/*
+Viewport represents the Page.Viewport type. Viewport for capturing screenshot.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-Viewport
+*/
+type Viewport struct {
+	// X offset in device independent pixels (dip).
+	X float64 `json:"x"`
+
+	// Y offset in device independent pixels (dip).
+	Y float64 `json:"y"`
+
+	// Rectangle width in device independent pixels (dip).
+	Width float64 `json:"width"`
+
+	// Rectangle height in device independent pixels (dip).
+	Height float64 `json:"height"`
+
+	// Page scale factor.
+	Scale float64 `json:"scale"`
+}
+
+/*
+VisualViewport represents the Page.VisualViewport type. Visual viewport
+position, dimensions, and scale.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-VisualViewport
+*/
+type VisualViewport struct {
+	// Horizontal offset relative to the layout viewport (CSS pixels).
+	OffsetX float64 `json:"offsetX"`
+
+	// Vertical offset relative to the layout viewport (CSS pixels).
+	OffsetY float64 `json:"offsetY"`
+
+	// Horizontal offset relative to the document (CSS pixels).
+	PageX float64 `json:"pageX"`
+
+	// Vertical offset relative to the document (CSS pixels).
+	PageY float64 `json:"pageY"`
+
+	// Width (CSS pixels), excludes scrollbar if present.
+	ClientWidth float64 `json:"clientWidth"`
+
+	// Height (CSS pixels), excludes scrollbar if present.
+	ClientHeight float64 `json:"clientHeight"`
+
+	// Scale relative to the ideal viewport (size at width=device-width).
+	Scale float64 `json:"scale"`
+
+	// Optional. Page zoom factor (CSS to device independent pixels ratio).
+	Zoom float64 `json:"zoom,omitempty"`
+}
@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type formatEnum struct {
+	Jpeg FormatEnum
+	Png  FormatEnum
+	Webp FormatEnum
+}
+
+/*
+Format provides named access to the FormatEnum values.
+*/
+var Format = formatEnum{
+	Jpeg: formatJpeg,
+	Png:  formatPng,
+	Webp: formatWebp,
+}
+
+/*
+FormatEnum represents the allowed values of CaptureScreenshotParams.Format.
+Image compression format (defaults to png). Allowed values:
+  - Format.Jpeg "jpeg"
+  - Format.Png "png"
+  - Format.Webp "webp"
+*/
+type FormatEnum int
+
+/*
+String implements Stringer
+*/
+func (enum FormatEnum) String() string {
+	return _formatEnums[enum]
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum FormatEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler
+*/
+func (enum *FormatEnum) UnmarshalJSON(bytes []byte) error {
+	var err error
+	var val string
+
+	err = json.Unmarshal(bytes, &val)
+	if nil != err {
+		return err
+	}
+
+	for k, v := range _formatEnums {
+		if v == val {
+			*enum = k
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s is not a valid type value", bytes)
+}
+
+const (
+	// formatJpeg represents the "jpeg" value.
+	formatJpeg FormatEnum = iota + 1
+	// formatPng represents the "png" value.
+	formatPng
+	// formatWebp represents the "webp" value.
+	formatWebp
+)
+
+var _formatEnums = map[FormatEnum]string{
+	FormatEnum(0): "",
+	formatJpeg:    "jpeg",
+	formatPng:     "png",
+	formatWebp:    "webp",
+}
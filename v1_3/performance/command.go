@@ -0,0 +1,49 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package performance
+
+/*
+DisableResult represents the result of calls to Performance.disable.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Performance/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+EnableParams represents Performance.enable parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Performance/#method-enable
+*/
+type EnableParams struct {
+	// Optional. Time domain to use for collecting and reporting duration
+	// metrics. Allowed values:
+	// 	- TimeDomain.TimeTicks
+	// 	- TimeDomain.ThreadTicks
+	TimeDomain TimeDomainEnum `json:"timeDomain,omitempty"`
+}
+
+/*
+EnableResult represents the result of calls to Performance.enable.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Performance/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetMetricsResult represents the result of calls to Performance.getMetrics.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Performance/#method-getMetrics
+*/
+type GetMetricsResult struct {
+	// Current values for run-time metrics.
+	Metrics []*Metric `json:"metrics"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
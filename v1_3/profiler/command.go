@@ -0,0 +1,137 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package profiler
+
+/*
+DisableResult represents the result of calls to Profiler.disable.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+EnableResult represents the result of calls to Profiler.enable.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetBestEffortCoverageResult represents the result of calls to Profiler.getBestEffortCoverage.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-getBestEffortCoverage
+*/
+type GetBestEffortCoverageResult struct {
+	// Coverage data for the current isolate.
+	Result []*ScriptCoverage `json:"result"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetSamplingIntervalParams represents Profiler.setSamplingInterval parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-setSamplingInterval
+*/
+type SetSamplingIntervalParams struct {
+	// New sampling interval in microseconds.
+	Interval int `json:"interval"`
+}
+
+/*
+SetSamplingIntervalResult represents the result of calls to Profiler.setSamplingInterval.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-setSamplingInterval
+*/
+type SetSamplingIntervalResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+StartResult represents the result of calls to Profiler.start.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-start
+*/
+type StartResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+StartPreciseCoverageParams represents Profiler.startPreciseCoverage parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-startPreciseCoverage
+*/
+type StartPreciseCoverageParams struct {
+	// Optional. Collect accurate call counts beyond simple 'covered' or 'not
+	// covered'.
+	CallCount bool `json:"callCount,omitempty"`
+
+	// Optional. Collect block-based coverage.
+	Detailed bool `json:"detailed,omitempty"`
+
+	// Optional. Allow the backend to send updates on its own initiative.
+	AllowTriggeredUpdates bool `json:"allowTriggeredUpdates,omitempty"`
+}
+
+/*
+StartPreciseCoverageResult represents the result of calls to Profiler.startPreciseCoverage.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-startPreciseCoverage
+*/
+type StartPreciseCoverageResult struct {
+	// Monotonically increasing time (in seconds) when the coverage update was
+	// taken in the backend.
+	Timestamp float64 `json:"timestamp"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+StopResult represents the result of calls to Profiler.stop.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-stop
+*/
+type StopResult struct {
+	// Recorded profile.
+	Profile *Profile `json:"profile"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+StopPreciseCoverageResult represents the result of calls to Profiler.stopPreciseCoverage.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-stopPreciseCoverage
+*/
+type StopPreciseCoverageResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+TakePreciseCoverageResult represents the result of calls to Profiler.takePreciseCoverage.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-takePreciseCoverage
+*/
+type TakePreciseCoverageResult struct {
+	// Coverage data for the current isolate.
+	Result []*ScriptCoverage `json:"result"`
+
+	// Monotonically increasing time (in seconds) when the coverage update was
+	// taken in the backend.
+	Timestamp float64 `json:"timestamp"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
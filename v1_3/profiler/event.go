@@ -0,0 +1,45 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package profiler
+
+import (
+	"github.com/mkenney/go-chrome/v1_3/debugger"
+)
+
+/*
+ConsoleProfileFinishedEvent represents Profiler.consoleProfileFinished event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#event-consoleProfileFinished
+*/
+type ConsoleProfileFinishedEvent struct {
+	ID string `json:"id"`
+
+	// Location of console.profileEnd().
+	Location *debugger.Location `json:"location"`
+
+	Profile *Profile `json:"profile"`
+
+	// Optional. Profile title passed as an argument to console.profile().
+	Title string `json:"title,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ConsoleProfileStartedEvent represents Profiler.consoleProfileStarted event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#event-consoleProfileStarted
+*/
+type ConsoleProfileStartedEvent struct {
+	ID string `json:"id"`
+
+	// Location of console.profile().
+	Location *debugger.Location `json:"location"`
+
+	// Optional. Profile title passed as an argument to console.profile().
+	Title string `json:"title,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
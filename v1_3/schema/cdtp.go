@@ -0,0 +1,25 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package schema provides type definitions for use with the Chrome Schema
+protocol.
+
+This domain is deprecated.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Schema/
+DEPRECATED.
+*/
+package schema
+
+/*
+Domain represents the Schema.Domain type. Description of the protocol domain.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Schema/#type-Domain
+*/
+type Domain struct {
+	// Domain name.
+	Name string `json:"name"`
+
+	// Domain version.
+	Version string `json:"version"`
+}
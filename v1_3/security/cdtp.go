@@ -0,0 +1,100 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package security provides type definitions for use with the Chrome Security
+protocol.
+
+Security.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/
+*/
+package security
+
+/*
+CertificateID represents the Security.CertificateId type. An internal
+certificate ID value.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#type-CertificateId
+*/
+type CertificateID int
+
+/*
+InsecureContentStatus represents the Security.InsecureContentStatus type.
+Information about insecure content on the page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#type-InsecureContentStatus
+DEPRECATED.
+*/
+type InsecureContentStatus struct {
+	// Always false.
+	RanMixedContent bool `json:"ranMixedContent"`
+
+	// Always false.
+	DisplayedMixedContent bool `json:"displayedMixedContent"`
+
+	// Always false.
+	ContainedMixedForm bool `json:"containedMixedForm"`
+
+	// Always false.
+	RanContentWithCertErrors bool `json:"ranContentWithCertErrors"`
+
+	// Always false.
+	DisplayedContentWithCertErrors bool `json:"displayedContentWithCertErrors"`
+
+	// Always set to unknown. Allowed values:
+	// 	- SecurityState.Unknown
+	// 	- SecurityState.Neutral
+	// 	- SecurityState.Insecure
+	// 	- SecurityState.Secure
+	// 	- SecurityState.Info
+	// 	- SecurityState.InsecureBroken
+	RanInsecureContentStyle SecurityStateEnum `json:"ranInsecureContentStyle"`
+
+	// Always set to unknown. Allowed values:
+	// 	- SecurityState.Unknown
+	// 	- SecurityState.Neutral
+	// 	- SecurityState.Insecure
+	// 	- SecurityState.Secure
+	// 	- SecurityState.Info
+	// 	- SecurityState.InsecureBroken
+	DisplayedInsecureContentStyle SecurityStateEnum `json:"displayedInsecureContentStyle"`
+}
+
+/*
+SecurityStateExplanation represents the Security.SecurityStateExplanation type.
+An explanation of an factor contributing to the security state.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#type-SecurityStateExplanation
+*/
+type SecurityStateExplanation struct {
+	// Security state representing the severity of the factor being explained.
+	// Allowed values:
+	// 	- SecurityState.Unknown
+	// 	- SecurityState.Neutral
+	// 	- SecurityState.Insecure
+	// 	- SecurityState.Secure
+	// 	- SecurityState.Info
+	// 	- SecurityState.InsecureBroken
+	SecurityState SecurityStateEnum `json:"securityState"`
+
+	// Title describing the type of factor.
+	Title string `json:"title"`
+
+	// Short phrase describing the type of factor.
+	Summary string `json:"summary"`
+
+	// Full text explanation of the factor.
+	Description string `json:"description"`
+
+	// The type of mixed content described by the explanation. Allowed values:
+	// 	- MixedContentType.Blockable
+	// 	- MixedContentType.OptionallyBlockable
+	// 	- MixedContentType.None
+	MixedContentType MixedContentTypeEnum `json:"mixedContentType"`
+
+	// Page certificate.
+	Certificate []string `json:"certificate"`
+
+	// Optional. Recommendations to fix any issues.
+	Recommendations []string `json:"recommendations,omitempty"`
+}
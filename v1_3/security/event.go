@@ -0,0 +1,58 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package security
+
+/*
+CertificateErrorEvent represents Security.certificateError event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#event-certificateError
+DEPRECATED.
+*/
+type CertificateErrorEvent struct {
+	// The ID of the event.
+	EventID int `json:"eventId"`
+
+	// The type of the error.
+	ErrorType string `json:"errorType"`
+
+	// The url that was requested.
+	RequestURL string `json:"requestURL"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+SecurityStateChangedEvent represents Security.securityStateChanged event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#event-securityStateChanged
+DEPRECATED.
+*/
+type SecurityStateChangedEvent struct {
+	// Security state. Allowed values:
+	// 	- SecurityState.Unknown
+	// 	- SecurityState.Neutral
+	// 	- SecurityState.Insecure
+	// 	- SecurityState.Secure
+	// 	- SecurityState.Info
+	// 	- SecurityState.InsecureBroken
+	SecurityState SecurityStateEnum `json:"securityState"`
+
+	// True if the page was loaded over cryptographic transport such as HTTPS.
+	// DEPRECATED.
+	SchemeIsCryptographic bool `json:"schemeIsCryptographic"`
+
+	// Previously a list of explanations for the security state. Now always
+	// empty. DEPRECATED.
+	Explanations []*SecurityStateExplanation `json:"explanations"`
+
+	// Information about insecure content on the page. DEPRECATED.
+	InsecureContentStatus *InsecureContentStatus `json:"insecureContentStatus"`
+
+	// Optional. Overrides user-visible description of the state. Always
+	// omitted. DEPRECATED.
+	Summary string `json:"summary,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -0,0 +1,1229 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/v1_3/debugger"
+)
+
+/*
+DebuggerProtocol provides a namespace for the Chrome Debugger protocol methods.
+
+Debugger domain exposes JavaScript debugging capabilities. It allows setting and
+removing breakpoints, stepping through execution, exploring stack traces, etc.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/
+*/
+type DebuggerProtocol struct {
+	Socket Socketer
+}
+
+/*
+ContinueToLocation continues execution until specific location is reached.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-continueToLocation
+*/
+func (protocol *DebuggerProtocol) ContinueToLocation(
+	params *debugger.ContinueToLocationParams,
+) <-chan *debugger.ContinueToLocationResult {
+	return protocol.ContinueToLocationContext(context.Background(), params)
+}
+
+/*
+ContinueToLocationContext performs ContinueToLocation and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-continueToLocation
+*/
+func (protocol *DebuggerProtocol) ContinueToLocationContext(
+	ctx context.Context,
+	params *debugger.ContinueToLocationParams,
+) <-chan *debugger.ContinueToLocationResult {
+	resultChan := make(chan *debugger.ContinueToLocationResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.continueToLocation", params)
+	result := &debugger.ContinueToLocationResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disable disables debugger for given page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-disable
+*/
+func (protocol *DebuggerProtocol) Disable() <-chan *debugger.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-disable
+*/
+func (protocol *DebuggerProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *debugger.DisableResult {
+	resultChan := make(chan *debugger.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.disable", nil)
+	result := &debugger.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enable enables debugger for the given page. Clients should not assume that the
+debugging has been enabled until the result for this command is received.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-enable
+*/
+func (protocol *DebuggerProtocol) Enable() <-chan *debugger.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-enable
+*/
+func (protocol *DebuggerProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *debugger.EnableResult {
+	resultChan := make(chan *debugger.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.enable", nil)
+	result := &debugger.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+EvaluateOnCallFrame evaluates expression on a given call frame.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-evaluateOnCallFrame
+*/
+func (protocol *DebuggerProtocol) EvaluateOnCallFrame(
+	params *debugger.EvaluateOnCallFrameParams,
+) <-chan *debugger.EvaluateOnCallFrameResult {
+	return protocol.EvaluateOnCallFrameContext(context.Background(), params)
+}
+
+/*
+EvaluateOnCallFrameContext performs EvaluateOnCallFrame and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-evaluateOnCallFrame
+*/
+func (protocol *DebuggerProtocol) EvaluateOnCallFrameContext(
+	ctx context.Context,
+	params *debugger.EvaluateOnCallFrameParams,
+) <-chan *debugger.EvaluateOnCallFrameResult {
+	resultChan := make(chan *debugger.EvaluateOnCallFrameResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.evaluateOnCallFrame", params)
+	result := &debugger.EvaluateOnCallFrameResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetPossibleBreakpoints returns possible locations for breakpoint. scriptId in
+start and end range locations should be the same.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-getPossibleBreakpoints
+*/
+func (protocol *DebuggerProtocol) GetPossibleBreakpoints(
+	params *debugger.GetPossibleBreakpointsParams,
+) <-chan *debugger.GetPossibleBreakpointsResult {
+	return protocol.GetPossibleBreakpointsContext(context.Background(), params)
+}
+
+/*
+GetPossibleBreakpointsContext performs GetPossibleBreakpoints and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-getPossibleBreakpoints
+*/
+func (protocol *DebuggerProtocol) GetPossibleBreakpointsContext(
+	ctx context.Context,
+	params *debugger.GetPossibleBreakpointsParams,
+) <-chan *debugger.GetPossibleBreakpointsResult {
+	resultChan := make(chan *debugger.GetPossibleBreakpointsResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.getPossibleBreakpoints", params)
+	result := &debugger.GetPossibleBreakpointsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetScriptSource returns source for the script with given id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-getScriptSource
+*/
+func (protocol *DebuggerProtocol) GetScriptSource(
+	params *debugger.GetScriptSourceParams,
+) <-chan *debugger.GetScriptSourceResult {
+	return protocol.GetScriptSourceContext(context.Background(), params)
+}
+
+/*
+GetScriptSourceContext performs GetScriptSource and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-getScriptSource
+*/
+func (protocol *DebuggerProtocol) GetScriptSourceContext(
+	ctx context.Context,
+	params *debugger.GetScriptSourceParams,
+) <-chan *debugger.GetScriptSourceResult {
+	resultChan := make(chan *debugger.GetScriptSourceResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.getScriptSource", params)
+	result := &debugger.GetScriptSourceResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetWasmBytecode this command is deprecated. Use getScriptSource instead.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-getWasmBytecode
+DEPRECATED.
+*/
+func (protocol *DebuggerProtocol) GetWasmBytecode(
+	params *debugger.GetWasmBytecodeParams,
+) <-chan *debugger.GetWasmBytecodeResult {
+	return protocol.GetWasmBytecodeContext(context.Background(), params)
+}
+
+/*
+GetWasmBytecodeContext performs GetWasmBytecode and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-getWasmBytecode
+DEPRECATED.
+*/
+func (protocol *DebuggerProtocol) GetWasmBytecodeContext(
+	ctx context.Context,
+	params *debugger.GetWasmBytecodeParams,
+) <-chan *debugger.GetWasmBytecodeResult {
+	resultChan := make(chan *debugger.GetWasmBytecodeResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.getWasmBytecode", params)
+	result := &debugger.GetWasmBytecodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Pause stops on the next JavaScript statement.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-pause
+*/
+func (protocol *DebuggerProtocol) Pause() <-chan *debugger.PauseResult {
+	return protocol.PauseContext(context.Background())
+}
+
+/*
+PauseContext performs Pause and returns its result, or returns the context error
+if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-pause
+*/
+func (protocol *DebuggerProtocol) PauseContext(
+	ctx context.Context,
+) <-chan *debugger.PauseResult {
+	resultChan := make(chan *debugger.PauseResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.pause", nil)
+	result := &debugger.PauseResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+RemoveBreakpoint removes JavaScript breakpoint.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-removeBreakpoint
+*/
+func (protocol *DebuggerProtocol) RemoveBreakpoint(
+	params *debugger.RemoveBreakpointParams,
+) <-chan *debugger.RemoveBreakpointResult {
+	return protocol.RemoveBreakpointContext(context.Background(), params)
+}
+
+/*
+RemoveBreakpointContext performs RemoveBreakpoint and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-removeBreakpoint
+*/
+func (protocol *DebuggerProtocol) RemoveBreakpointContext(
+	ctx context.Context,
+	params *debugger.RemoveBreakpointParams,
+) <-chan *debugger.RemoveBreakpointResult {
+	resultChan := make(chan *debugger.RemoveBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.removeBreakpoint", params)
+	result := &debugger.RemoveBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+RestartFrame restarts particular call frame from the beginning. The old,
+deprecated behavior of `restartFrame` is to stay paused and allow further CDP
+commands after a restart was scheduled. This can cause problems with restarting,
+so we now continue execution immediatly after it has been scheduled until we
+reach the beginning of the restarted frame. To stay back-wards compatible,
+`restartFrame` now expects a `mode` parameter to be present. If the `mode`
+parameter is missing, `restartFrame` errors out. The various return values are
+deprecated and `callFrames` is always empty. Use the call frames from the
+`Debugger#paused` events instead, that fires once V8 pauses at the beginning of
+the restarted function.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-restartFrame
+*/
+func (protocol *DebuggerProtocol) RestartFrame(
+	params *debugger.RestartFrameParams,
+) <-chan *debugger.RestartFrameResult {
+	return protocol.RestartFrameContext(context.Background(), params)
+}
+
+/*
+RestartFrameContext performs RestartFrame and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-restartFrame
+*/
+func (protocol *DebuggerProtocol) RestartFrameContext(
+	ctx context.Context,
+	params *debugger.RestartFrameParams,
+) <-chan *debugger.RestartFrameResult {
+	resultChan := make(chan *debugger.RestartFrameResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.restartFrame", params)
+	result := &debugger.RestartFrameResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Resume resumes JavaScript execution.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-resume
+*/
+func (protocol *DebuggerProtocol) Resume(
+	params *debugger.ResumeParams,
+) <-chan *debugger.ResumeResult {
+	return protocol.ResumeContext(context.Background(), params)
+}
+
+/*
+ResumeContext performs Resume and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-resume
+*/
+func (protocol *DebuggerProtocol) ResumeContext(
+	ctx context.Context,
+	params *debugger.ResumeParams,
+) <-chan *debugger.ResumeResult {
+	resultChan := make(chan *debugger.ResumeResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.resume", params)
+	result := &debugger.ResumeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SearchInContent searches for given string in script content.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-searchInContent
+*/
+func (protocol *DebuggerProtocol) SearchInContent(
+	params *debugger.SearchInContentParams,
+) <-chan *debugger.SearchInContentResult {
+	return protocol.SearchInContentContext(context.Background(), params)
+}
+
+/*
+SearchInContentContext performs SearchInContent and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-searchInContent
+*/
+func (protocol *DebuggerProtocol) SearchInContentContext(
+	ctx context.Context,
+	params *debugger.SearchInContentParams,
+) <-chan *debugger.SearchInContentResult {
+	resultChan := make(chan *debugger.SearchInContentResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.searchInContent", params)
+	result := &debugger.SearchInContentResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetAsyncCallStackDepth enables or disables async call stacks tracking.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setAsyncCallStackDepth
+*/
+func (protocol *DebuggerProtocol) SetAsyncCallStackDepth(
+	params *debugger.SetAsyncCallStackDepthParams,
+) <-chan *debugger.SetAsyncCallStackDepthResult {
+	return protocol.SetAsyncCallStackDepthContext(context.Background(), params)
+}
+
+/*
+SetAsyncCallStackDepthContext performs SetAsyncCallStackDepth and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setAsyncCallStackDepth
+*/
+func (protocol *DebuggerProtocol) SetAsyncCallStackDepthContext(
+	ctx context.Context,
+	params *debugger.SetAsyncCallStackDepthParams,
+) <-chan *debugger.SetAsyncCallStackDepthResult {
+	resultChan := make(chan *debugger.SetAsyncCallStackDepthResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setAsyncCallStackDepth", params)
+	result := &debugger.SetAsyncCallStackDepthResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetBreakpoint sets JavaScript breakpoint at a given location.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setBreakpoint
+*/
+func (protocol *DebuggerProtocol) SetBreakpoint(
+	params *debugger.SetBreakpointParams,
+) <-chan *debugger.SetBreakpointResult {
+	return protocol.SetBreakpointContext(context.Background(), params)
+}
+
+/*
+SetBreakpointContext performs SetBreakpoint and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setBreakpoint
+*/
+func (protocol *DebuggerProtocol) SetBreakpointContext(
+	ctx context.Context,
+	params *debugger.SetBreakpointParams,
+) <-chan *debugger.SetBreakpointResult {
+	resultChan := make(chan *debugger.SetBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setBreakpoint", params)
+	result := &debugger.SetBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetBreakpointByURL sets JavaScript breakpoint at given location specified either
+by URL or URL regex. Once this command is issued, all existing parsed scripts
+will have breakpoints resolved and returned in `locations` property. Further
+matching script parsing will result in subsequent `breakpointResolved` events
+issued. This logical breakpoint will survive page reloads.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setBreakpointByUrl
+*/
+func (protocol *DebuggerProtocol) SetBreakpointByURL(
+	params *debugger.SetBreakpointByURLParams,
+) <-chan *debugger.SetBreakpointByURLResult {
+	return protocol.SetBreakpointByURLContext(context.Background(), params)
+}
+
+/*
+SetBreakpointByURLContext performs SetBreakpointByURL and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setBreakpointByUrl
+*/
+func (protocol *DebuggerProtocol) SetBreakpointByURLContext(
+	ctx context.Context,
+	params *debugger.SetBreakpointByURLParams,
+) <-chan *debugger.SetBreakpointByURLResult {
+	resultChan := make(chan *debugger.SetBreakpointByURLResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setBreakpointByUrl", params)
+	result := &debugger.SetBreakpointByURLResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetBreakpointsActive activates / deactivates all breakpoints on the page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setBreakpointsActive
+*/
+func (protocol *DebuggerProtocol) SetBreakpointsActive(
+	params *debugger.SetBreakpointsActiveParams,
+) <-chan *debugger.SetBreakpointsActiveResult {
+	return protocol.SetBreakpointsActiveContext(context.Background(), params)
+}
+
+/*
+SetBreakpointsActiveContext performs SetBreakpointsActive and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setBreakpointsActive
+*/
+func (protocol *DebuggerProtocol) SetBreakpointsActiveContext(
+	ctx context.Context,
+	params *debugger.SetBreakpointsActiveParams,
+) <-chan *debugger.SetBreakpointsActiveResult {
+	resultChan := make(chan *debugger.SetBreakpointsActiveResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setBreakpointsActive", params)
+	result := &debugger.SetBreakpointsActiveResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetInstrumentationBreakpoint sets instrumentation breakpoint.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setInstrumentationBreakpoint
+*/
+func (protocol *DebuggerProtocol) SetInstrumentationBreakpoint(
+	params *debugger.SetInstrumentationBreakpointParams,
+) <-chan *debugger.SetInstrumentationBreakpointResult {
+	return protocol.SetInstrumentationBreakpointContext(context.Background(), params)
+}
+
+/*
+SetInstrumentationBreakpointContext performs SetInstrumentationBreakpoint and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setInstrumentationBreakpoint
+*/
+func (protocol *DebuggerProtocol) SetInstrumentationBreakpointContext(
+	ctx context.Context,
+	params *debugger.SetInstrumentationBreakpointParams,
+) <-chan *debugger.SetInstrumentationBreakpointResult {
+	resultChan := make(chan *debugger.SetInstrumentationBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setInstrumentationBreakpoint", params)
+	result := &debugger.SetInstrumentationBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetPauseOnExceptions defines pause on exceptions state. Can be set to stop on
+all exceptions, uncaught exceptions, or caught exceptions, no exceptions.
+Initial pause on exceptions state is `none`.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setPauseOnExceptions
+*/
+func (protocol *DebuggerProtocol) SetPauseOnExceptions(
+	params *debugger.SetPauseOnExceptionsParams,
+) <-chan *debugger.SetPauseOnExceptionsResult {
+	return protocol.SetPauseOnExceptionsContext(context.Background(), params)
+}
+
+/*
+SetPauseOnExceptionsContext performs SetPauseOnExceptions and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setPauseOnExceptions
+*/
+func (protocol *DebuggerProtocol) SetPauseOnExceptionsContext(
+	ctx context.Context,
+	params *debugger.SetPauseOnExceptionsParams,
+) <-chan *debugger.SetPauseOnExceptionsResult {
+	resultChan := make(chan *debugger.SetPauseOnExceptionsResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setPauseOnExceptions", params)
+	result := &debugger.SetPauseOnExceptionsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetScriptSource edits JavaScript source live. In general, functions that are
+currently on the stack can not be edited with a single exception: If the edited
+function is the top-most stack frame and that is the only activation of that
+function on the stack. In this case the live edit will be successful and a
+`Debugger.restartFrame` for the top-most function is automatically triggered.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setScriptSource
+*/
+func (protocol *DebuggerProtocol) SetScriptSource(
+	params *debugger.SetScriptSourceParams,
+) <-chan *debugger.SetScriptSourceResult {
+	return protocol.SetScriptSourceContext(context.Background(), params)
+}
+
+/*
+SetScriptSourceContext performs SetScriptSource and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setScriptSource
+*/
+func (protocol *DebuggerProtocol) SetScriptSourceContext(
+	ctx context.Context,
+	params *debugger.SetScriptSourceParams,
+) <-chan *debugger.SetScriptSourceResult {
+	resultChan := make(chan *debugger.SetScriptSourceResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setScriptSource", params)
+	result := &debugger.SetScriptSourceResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetSkipAllPauses makes page not interrupt on any pauses (breakpoint, exception,
+dom exception etc).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setSkipAllPauses
+*/
+func (protocol *DebuggerProtocol) SetSkipAllPauses(
+	params *debugger.SetSkipAllPausesParams,
+) <-chan *debugger.SetSkipAllPausesResult {
+	return protocol.SetSkipAllPausesContext(context.Background(), params)
+}
+
+/*
+SetSkipAllPausesContext performs SetSkipAllPauses and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setSkipAllPauses
+*/
+func (protocol *DebuggerProtocol) SetSkipAllPausesContext(
+	ctx context.Context,
+	params *debugger.SetSkipAllPausesParams,
+) <-chan *debugger.SetSkipAllPausesResult {
+	resultChan := make(chan *debugger.SetSkipAllPausesResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setSkipAllPauses", params)
+	result := &debugger.SetSkipAllPausesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetVariableValue changes value of variable in a callframe. Object-based scopes
+are not supported and must be mutated manually.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setVariableValue
+*/
+func (protocol *DebuggerProtocol) SetVariableValue(
+	params *debugger.SetVariableValueParams,
+) <-chan *debugger.SetVariableValueResult {
+	return protocol.SetVariableValueContext(context.Background(), params)
+}
+
+/*
+SetVariableValueContext performs SetVariableValue and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setVariableValue
+*/
+func (protocol *DebuggerProtocol) SetVariableValueContext(
+	ctx context.Context,
+	params *debugger.SetVariableValueParams,
+) <-chan *debugger.SetVariableValueResult {
+	resultChan := make(chan *debugger.SetVariableValueResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setVariableValue", params)
+	result := &debugger.SetVariableValueResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+StepInto steps into the function call.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-stepInto
+*/
+func (protocol *DebuggerProtocol) StepInto() <-chan *debugger.StepIntoResult {
+	return protocol.StepIntoContext(context.Background())
+}
+
+/*
+StepIntoContext performs StepInto and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-stepInto
+*/
+func (protocol *DebuggerProtocol) StepIntoContext(
+	ctx context.Context,
+) <-chan *debugger.StepIntoResult {
+	resultChan := make(chan *debugger.StepIntoResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.stepInto", nil)
+	result := &debugger.StepIntoResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+StepOut steps out of the function call.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-stepOut
+*/
+func (protocol *DebuggerProtocol) StepOut() <-chan *debugger.StepOutResult {
+	return protocol.StepOutContext(context.Background())
+}
+
+/*
+StepOutContext performs StepOut and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-stepOut
+*/
+func (protocol *DebuggerProtocol) StepOutContext(
+	ctx context.Context,
+) <-chan *debugger.StepOutResult {
+	resultChan := make(chan *debugger.StepOutResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.stepOut", nil)
+	result := &debugger.StepOutResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+StepOver steps over the statement.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-stepOver
+*/
+func (protocol *DebuggerProtocol) StepOver() <-chan *debugger.StepOverResult {
+	return protocol.StepOverContext(context.Background())
+}
+
+/*
+StepOverContext performs StepOver and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-stepOver
+*/
+func (protocol *DebuggerProtocol) StepOverContext(
+	ctx context.Context,
+) <-chan *debugger.StepOverResult {
+	resultChan := make(chan *debugger.StepOverResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.stepOver", nil)
+	result := &debugger.StepOverResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnBreakpointResolved adds a handler to the Debugger.breakpointResolved event.
+Fired when breakpoint is resolved to an actual script and location. Deprecated
+in favor of `resolvedBreakpoints` in the `scriptParsed` event.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-breakpointResolved
+DEPRECATED.
+*/
+func (protocol *DebuggerProtocol) OnBreakpointResolved(
+	callback func(event *debugger.BreakpointResolvedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Debugger.breakpointResolved",
+		func(response *Response) {
+			event := &debugger.BreakpointResolvedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnBreakpointResolvedChan returns a channel of Debugger.breakpointResolved
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-breakpointResolved
+DEPRECATED.
+*/
+func (protocol *DebuggerProtocol) OnBreakpointResolvedChan(
+	ctx context.Context,
+) <-chan *debugger.BreakpointResolvedEvent {
+	eventChan := make(chan *debugger.BreakpointResolvedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnBreakpointResolved(func(event *debugger.BreakpointResolvedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnPaused adds a handler to the Debugger.paused event. Fired when the virtual
+machine stopped on breakpoint or exception or any other stop criteria.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-paused
+*/
+func (protocol *DebuggerProtocol) OnPaused(
+	callback func(event *debugger.PausedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Debugger.paused",
+		func(response *Response) {
+			event := &debugger.PausedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnPausedChan returns a channel of Debugger.paused events. The event handler is
+removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-paused
+*/
+func (protocol *DebuggerProtocol) OnPausedChan(
+	ctx context.Context,
+) <-chan *debugger.PausedEvent {
+	eventChan := make(chan *debugger.PausedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnPaused(func(event *debugger.PausedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnResumed adds a handler to the Debugger.resumed event. Fired when the virtual
+machine resumed execution.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-resumed
+*/
+func (protocol *DebuggerProtocol) OnResumed(
+	callback func(event *debugger.ResumedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Debugger.resumed",
+		func(response *Response) {
+			event := &debugger.ResumedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnResumedChan returns a channel of Debugger.resumed events. The event handler is
+removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-resumed
+*/
+func (protocol *DebuggerProtocol) OnResumedChan(
+	ctx context.Context,
+) <-chan *debugger.ResumedEvent {
+	eventChan := make(chan *debugger.ResumedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnResumed(func(event *debugger.ResumedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnScriptFailedToParse adds a handler to the Debugger.scriptFailedToParse event.
+Fired when virtual machine fails to parse the script.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-scriptFailedToParse
+*/
+func (protocol *DebuggerProtocol) OnScriptFailedToParse(
+	callback func(event *debugger.ScriptFailedToParseEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Debugger.scriptFailedToParse",
+		func(response *Response) {
+			event := &debugger.ScriptFailedToParseEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnScriptFailedToParseChan returns a channel of Debugger.scriptFailedToParse
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-scriptFailedToParse
+*/
+func (protocol *DebuggerProtocol) OnScriptFailedToParseChan(
+	ctx context.Context,
+) <-chan *debugger.ScriptFailedToParseEvent {
+	eventChan := make(chan *debugger.ScriptFailedToParseEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnScriptFailedToParse(func(event *debugger.ScriptFailedToParseEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnScriptParsed adds a handler to the Debugger.scriptParsed event. Fired when
+virtual machine parses script. This event is also fired for all known and
+uncollected scripts upon enabling debugger.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-scriptParsed
+*/
+func (protocol *DebuggerProtocol) OnScriptParsed(
+	callback func(event *debugger.ScriptParsedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Debugger.scriptParsed",
+		func(response *Response) {
+			event := &debugger.ScriptParsedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnScriptParsedChan returns a channel of Debugger.scriptParsed events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-scriptParsed
+*/
+func (protocol *DebuggerProtocol) OnScriptParsedChan(
+	ctx context.Context,
+) <-chan *debugger.ScriptParsedEvent {
+	eventChan := make(chan *debugger.ScriptParsedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnScriptParsed(func(event *debugger.ScriptParsedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
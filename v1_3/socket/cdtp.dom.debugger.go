@@ -0,0 +1,307 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	domDebugger "github.com/mkenney/go-chrome/v1_3/dom/debugger"
+)
+
+/*
+DOMDebuggerProtocol provides a namespace for the Chrome DOMDebugger protocol
+methods.
+
+DOM debugging allows setting breakpoints on particular DOM operations and
+events. JavaScript execution will stop on these operations as if there was a
+regular breakpoint set.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/
+*/
+type DOMDebuggerProtocol struct {
+	Socket Socketer
+}
+
+/*
+GetEventListeners returns event listeners of the given object.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-getEventListeners
+*/
+func (protocol *DOMDebuggerProtocol) GetEventListeners(
+	params *domDebugger.GetEventListenersParams,
+) <-chan *domDebugger.GetEventListenersResult {
+	return protocol.GetEventListenersContext(context.Background(), params)
+}
+
+/*
+GetEventListenersContext performs GetEventListeners and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-getEventListeners
+*/
+func (protocol *DOMDebuggerProtocol) GetEventListenersContext(
+	ctx context.Context,
+	params *domDebugger.GetEventListenersParams,
+) <-chan *domDebugger.GetEventListenersResult {
+	resultChan := make(chan *domDebugger.GetEventListenersResult, 1)
+	command := NewCommand(protocol.Socket, "DOMDebugger.getEventListeners", params)
+	result := &domDebugger.GetEventListenersResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+RemoveDOMBreakpoint removes DOM breakpoint that was set using
+`setDOMBreakpoint`.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-removeDOMBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) RemoveDOMBreakpoint(
+	params *domDebugger.RemoveDOMBreakpointParams,
+) <-chan *domDebugger.RemoveDOMBreakpointResult {
+	return protocol.RemoveDOMBreakpointContext(context.Background(), params)
+}
+
+/*
+RemoveDOMBreakpointContext performs RemoveDOMBreakpoint and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-removeDOMBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) RemoveDOMBreakpointContext(
+	ctx context.Context,
+	params *domDebugger.RemoveDOMBreakpointParams,
+) <-chan *domDebugger.RemoveDOMBreakpointResult {
+	resultChan := make(chan *domDebugger.RemoveDOMBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "DOMDebugger.removeDOMBreakpoint", params)
+	result := &domDebugger.RemoveDOMBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+RemoveEventListenerBreakpoint removes breakpoint on particular DOM event.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-removeEventListenerBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) RemoveEventListenerBreakpoint(
+	params *domDebugger.RemoveEventListenerBreakpointParams,
+) <-chan *domDebugger.RemoveEventListenerBreakpointResult {
+	return protocol.RemoveEventListenerBreakpointContext(context.Background(), params)
+}
+
+/*
+RemoveEventListenerBreakpointContext performs RemoveEventListenerBreakpoint and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-removeEventListenerBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) RemoveEventListenerBreakpointContext(
+	ctx context.Context,
+	params *domDebugger.RemoveEventListenerBreakpointParams,
+) <-chan *domDebugger.RemoveEventListenerBreakpointResult {
+	resultChan := make(chan *domDebugger.RemoveEventListenerBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "DOMDebugger.removeEventListenerBreakpoint", params)
+	result := &domDebugger.RemoveEventListenerBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+RemoveXHRBreakpoint removes breakpoint from XMLHttpRequest.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-removeXHRBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) RemoveXHRBreakpoint(
+	params *domDebugger.RemoveXHRBreakpointParams,
+) <-chan *domDebugger.RemoveXHRBreakpointResult {
+	return protocol.RemoveXHRBreakpointContext(context.Background(), params)
+}
+
+/*
+RemoveXHRBreakpointContext performs RemoveXHRBreakpoint and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-removeXHRBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) RemoveXHRBreakpointContext(
+	ctx context.Context,
+	params *domDebugger.RemoveXHRBreakpointParams,
+) <-chan *domDebugger.RemoveXHRBreakpointResult {
+	resultChan := make(chan *domDebugger.RemoveXHRBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "DOMDebugger.removeXHRBreakpoint", params)
+	result := &domDebugger.RemoveXHRBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetDOMBreakpoint sets breakpoint on particular operation with DOM.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-setDOMBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) SetDOMBreakpoint(
+	params *domDebugger.SetDOMBreakpointParams,
+) <-chan *domDebugger.SetDOMBreakpointResult {
+	return protocol.SetDOMBreakpointContext(context.Background(), params)
+}
+
+/*
+SetDOMBreakpointContext performs SetDOMBreakpoint and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-setDOMBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) SetDOMBreakpointContext(
+	ctx context.Context,
+	params *domDebugger.SetDOMBreakpointParams,
+) <-chan *domDebugger.SetDOMBreakpointResult {
+	resultChan := make(chan *domDebugger.SetDOMBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "DOMDebugger.setDOMBreakpoint", params)
+	result := &domDebugger.SetDOMBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetEventListenerBreakpoint sets breakpoint on particular DOM event.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-setEventListenerBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) SetEventListenerBreakpoint(
+	params *domDebugger.SetEventListenerBreakpointParams,
+) <-chan *domDebugger.SetEventListenerBreakpointResult {
+	return protocol.SetEventListenerBreakpointContext(context.Background(), params)
+}
+
+/*
+SetEventListenerBreakpointContext performs SetEventListenerBreakpoint and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-setEventListenerBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) SetEventListenerBreakpointContext(
+	ctx context.Context,
+	params *domDebugger.SetEventListenerBreakpointParams,
+) <-chan *domDebugger.SetEventListenerBreakpointResult {
+	resultChan := make(chan *domDebugger.SetEventListenerBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "DOMDebugger.setEventListenerBreakpoint", params)
+	result := &domDebugger.SetEventListenerBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetXHRBreakpoint sets breakpoint on XMLHttpRequest.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-setXHRBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) SetXHRBreakpoint(
+	params *domDebugger.SetXHRBreakpointParams,
+) <-chan *domDebugger.SetXHRBreakpointResult {
+	return protocol.SetXHRBreakpointContext(context.Background(), params)
+}
+
+/*
+SetXHRBreakpointContext performs SetXHRBreakpoint and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-setXHRBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) SetXHRBreakpointContext(
+	ctx context.Context,
+	params *domDebugger.SetXHRBreakpointParams,
+) <-chan *domDebugger.SetXHRBreakpointResult {
+	resultChan := make(chan *domDebugger.SetXHRBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "DOMDebugger.setXHRBreakpoint", params)
+	result := &domDebugger.SetXHRBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
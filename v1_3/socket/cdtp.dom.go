@@ -0,0 +1,1517 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/v1_3/dom"
+)
+
+/*
+DOMProtocol provides a namespace for the Chrome DOM protocol methods.
+
+This domain exposes DOM read/write operations. Each DOM Node is represented with
+its mirror object that has an `id`. This `id` can be used to get additional
+information on the Node, resolve it into the JavaScript object wrapper, etc. It
+is important that client receives DOM events only for the nodes that are known
+to the client. Backend keeps track of the nodes that were sent to the client and
+never sends the same node twice. It is client's responsibility to collect
+information about the nodes that were sent to the client. Note that `iframe`
+owner elements will return corresponding document elements as their child nodes.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/
+*/
+type DOMProtocol struct {
+	Socket Socketer
+}
+
+/*
+DescribeNode describes node given its id, does not require domain to be enabled.
+Does not start tracking any objects, can be used for automation.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-describeNode
+*/
+func (protocol *DOMProtocol) DescribeNode(
+	params *dom.DescribeNodeParams,
+) <-chan *dom.DescribeNodeResult {
+	return protocol.DescribeNodeContext(context.Background(), params)
+}
+
+/*
+DescribeNodeContext performs DescribeNode and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-describeNode
+*/
+func (protocol *DOMProtocol) DescribeNodeContext(
+	ctx context.Context,
+	params *dom.DescribeNodeParams,
+) <-chan *dom.DescribeNodeResult {
+	resultChan := make(chan *dom.DescribeNodeResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.describeNode", params)
+	result := &dom.DescribeNodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disable disables DOM agent for the given page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-disable
+*/
+func (protocol *DOMProtocol) Disable() <-chan *dom.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-disable
+*/
+func (protocol *DOMProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *dom.DisableResult {
+	resultChan := make(chan *dom.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.disable", nil)
+	result := &dom.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enable enables DOM agent for the given page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-enable
+*/
+func (protocol *DOMProtocol) Enable() <-chan *dom.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-enable
+*/
+func (protocol *DOMProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *dom.EnableResult {
+	resultChan := make(chan *dom.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.enable", nil)
+	result := &dom.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Focus focuses the given element.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-focus
+*/
+func (protocol *DOMProtocol) Focus(
+	params *dom.FocusParams,
+) <-chan *dom.FocusResult {
+	return protocol.FocusContext(context.Background(), params)
+}
+
+/*
+FocusContext performs Focus and returns its result, or returns the context error
+if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-focus
+*/
+func (protocol *DOMProtocol) FocusContext(
+	ctx context.Context,
+	params *dom.FocusParams,
+) <-chan *dom.FocusResult {
+	resultChan := make(chan *dom.FocusResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.focus", params)
+	result := &dom.FocusResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetAttributes returns attributes for the specified node.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getAttributes
+*/
+func (protocol *DOMProtocol) GetAttributes(
+	params *dom.GetAttributesParams,
+) <-chan *dom.GetAttributesResult {
+	return protocol.GetAttributesContext(context.Background(), params)
+}
+
+/*
+GetAttributesContext performs GetAttributes and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getAttributes
+*/
+func (protocol *DOMProtocol) GetAttributesContext(
+	ctx context.Context,
+	params *dom.GetAttributesParams,
+) <-chan *dom.GetAttributesResult {
+	resultChan := make(chan *dom.GetAttributesResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getAttributes", params)
+	result := &dom.GetAttributesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetBoxModel returns boxes for the given node.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getBoxModel
+*/
+func (protocol *DOMProtocol) GetBoxModel(
+	params *dom.GetBoxModelParams,
+) <-chan *dom.GetBoxModelResult {
+	return protocol.GetBoxModelContext(context.Background(), params)
+}
+
+/*
+GetBoxModelContext performs GetBoxModel and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getBoxModel
+*/
+func (protocol *DOMProtocol) GetBoxModelContext(
+	ctx context.Context,
+	params *dom.GetBoxModelParams,
+) <-chan *dom.GetBoxModelResult {
+	resultChan := make(chan *dom.GetBoxModelResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getBoxModel", params)
+	result := &dom.GetBoxModelResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetDocument returns the root DOM node (and optionally the subtree) to the
+caller. Implicitly enables the DOM domain events for the current target.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getDocument
+*/
+func (protocol *DOMProtocol) GetDocument(
+	params *dom.GetDocumentParams,
+) <-chan *dom.GetDocumentResult {
+	return protocol.GetDocumentContext(context.Background(), params)
+}
+
+/*
+GetDocumentContext performs GetDocument and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getDocument
+*/
+func (protocol *DOMProtocol) GetDocumentContext(
+	ctx context.Context,
+	params *dom.GetDocumentParams,
+) <-chan *dom.GetDocumentResult {
+	resultChan := make(chan *dom.GetDocumentResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getDocument", params)
+	result := &dom.GetDocumentResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetFlattenedDocument returns the root DOM node (and optionally the subtree) to
+the caller. Deprecated, as it is not designed to work well with the rest of the
+DOM agent. Use DOMSnapshot.captureSnapshot instead.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getFlattenedDocument
+DEPRECATED.
+*/
+func (protocol *DOMProtocol) GetFlattenedDocument(
+	params *dom.GetFlattenedDocumentParams,
+) <-chan *dom.GetFlattenedDocumentResult {
+	return protocol.GetFlattenedDocumentContext(context.Background(), params)
+}
+
+/*
+GetFlattenedDocumentContext performs GetFlattenedDocument and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getFlattenedDocument
+DEPRECATED.
+*/
+func (protocol *DOMProtocol) GetFlattenedDocumentContext(
+	ctx context.Context,
+	params *dom.GetFlattenedDocumentParams,
+) <-chan *dom.GetFlattenedDocumentResult {
+	resultChan := make(chan *dom.GetFlattenedDocumentResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getFlattenedDocument", params)
+	result := &dom.GetFlattenedDocumentResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetNodeForLocation returns node id at given location. Depending on whether DOM
+domain is enabled, nodeId is either returned or not.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getNodeForLocation
+*/
+func (protocol *DOMProtocol) GetNodeForLocation(
+	params *dom.GetNodeForLocationParams,
+) <-chan *dom.GetNodeForLocationResult {
+	return protocol.GetNodeForLocationContext(context.Background(), params)
+}
+
+/*
+GetNodeForLocationContext performs GetNodeForLocation and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getNodeForLocation
+*/
+func (protocol *DOMProtocol) GetNodeForLocationContext(
+	ctx context.Context,
+	params *dom.GetNodeForLocationParams,
+) <-chan *dom.GetNodeForLocationResult {
+	resultChan := make(chan *dom.GetNodeForLocationResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getNodeForLocation", params)
+	result := &dom.GetNodeForLocationResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetOuterHTML returns node's HTML markup.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getOuterHTML
+*/
+func (protocol *DOMProtocol) GetOuterHTML(
+	params *dom.GetOuterHTMLParams,
+) <-chan *dom.GetOuterHTMLResult {
+	return protocol.GetOuterHTMLContext(context.Background(), params)
+}
+
+/*
+GetOuterHTMLContext performs GetOuterHTML and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getOuterHTML
+*/
+func (protocol *DOMProtocol) GetOuterHTMLContext(
+	ctx context.Context,
+	params *dom.GetOuterHTMLParams,
+) <-chan *dom.GetOuterHTMLResult {
+	resultChan := make(chan *dom.GetOuterHTMLResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getOuterHTML", params)
+	result := &dom.GetOuterHTMLResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+HideHighlight hides any highlight.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-hideHighlight
+*/
+func (protocol *DOMProtocol) HideHighlight() <-chan *dom.HideHighlightResult {
+	return protocol.HideHighlightContext(context.Background())
+}
+
+/*
+HideHighlightContext performs HideHighlight and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-hideHighlight
+*/
+func (protocol *DOMProtocol) HideHighlightContext(
+	ctx context.Context,
+) <-chan *dom.HideHighlightResult {
+	resultChan := make(chan *dom.HideHighlightResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.hideHighlight", nil)
+	result := &dom.HideHighlightResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+HighlightNode highlights DOM node.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-highlightNode
+*/
+func (protocol *DOMProtocol) HighlightNode() <-chan *dom.HighlightNodeResult {
+	return protocol.HighlightNodeContext(context.Background())
+}
+
+/*
+HighlightNodeContext performs HighlightNode and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-highlightNode
+*/
+func (protocol *DOMProtocol) HighlightNodeContext(
+	ctx context.Context,
+) <-chan *dom.HighlightNodeResult {
+	resultChan := make(chan *dom.HighlightNodeResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.highlightNode", nil)
+	result := &dom.HighlightNodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+HighlightRect highlights given rectangle.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-highlightRect
+*/
+func (protocol *DOMProtocol) HighlightRect() <-chan *dom.HighlightRectResult {
+	return protocol.HighlightRectContext(context.Background())
+}
+
+/*
+HighlightRectContext performs HighlightRect and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-highlightRect
+*/
+func (protocol *DOMProtocol) HighlightRectContext(
+	ctx context.Context,
+) <-chan *dom.HighlightRectResult {
+	resultChan := make(chan *dom.HighlightRectResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.highlightRect", nil)
+	result := &dom.HighlightRectResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+MoveTo moves node into the new container, places it before the given anchor.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-moveTo
+*/
+func (protocol *DOMProtocol) MoveTo(
+	params *dom.MoveToParams,
+) <-chan *dom.MoveToResult {
+	return protocol.MoveToContext(context.Background(), params)
+}
+
+/*
+MoveToContext performs MoveTo and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-moveTo
+*/
+func (protocol *DOMProtocol) MoveToContext(
+	ctx context.Context,
+	params *dom.MoveToParams,
+) <-chan *dom.MoveToResult {
+	resultChan := make(chan *dom.MoveToResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.moveTo", params)
+	result := &dom.MoveToResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+QuerySelector executes `querySelector` on a given node.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-querySelector
+*/
+func (protocol *DOMProtocol) QuerySelector(
+	params *dom.QuerySelectorParams,
+) <-chan *dom.QuerySelectorResult {
+	return protocol.QuerySelectorContext(context.Background(), params)
+}
+
+/*
+QuerySelectorContext performs QuerySelector and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-querySelector
+*/
+func (protocol *DOMProtocol) QuerySelectorContext(
+	ctx context.Context,
+	params *dom.QuerySelectorParams,
+) <-chan *dom.QuerySelectorResult {
+	resultChan := make(chan *dom.QuerySelectorResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.querySelector", params)
+	result := &dom.QuerySelectorResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+QuerySelectorAll executes `querySelectorAll` on a given node.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-querySelectorAll
+*/
+func (protocol *DOMProtocol) QuerySelectorAll(
+	params *dom.QuerySelectorAllParams,
+) <-chan *dom.QuerySelectorAllResult {
+	return protocol.QuerySelectorAllContext(context.Background(), params)
+}
+
+/*
+QuerySelectorAllContext performs QuerySelectorAll and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-querySelectorAll
+*/
+func (protocol *DOMProtocol) QuerySelectorAllContext(
+	ctx context.Context,
+	params *dom.QuerySelectorAllParams,
+) <-chan *dom.QuerySelectorAllResult {
+	resultChan := make(chan *dom.QuerySelectorAllResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.querySelectorAll", params)
+	result := &dom.QuerySelectorAllResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+RemoveAttribute removes attribute with given name from an element with given id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-removeAttribute
+*/
+func (protocol *DOMProtocol) RemoveAttribute(
+	params *dom.RemoveAttributeParams,
+) <-chan *dom.RemoveAttributeResult {
+	return protocol.RemoveAttributeContext(context.Background(), params)
+}
+
+/*
+RemoveAttributeContext performs RemoveAttribute and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-removeAttribute
+*/
+func (protocol *DOMProtocol) RemoveAttributeContext(
+	ctx context.Context,
+	params *dom.RemoveAttributeParams,
+) <-chan *dom.RemoveAttributeResult {
+	resultChan := make(chan *dom.RemoveAttributeResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.removeAttribute", params)
+	result := &dom.RemoveAttributeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+RemoveNode removes node with given id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-removeNode
+*/
+func (protocol *DOMProtocol) RemoveNode(
+	params *dom.RemoveNodeParams,
+) <-chan *dom.RemoveNodeResult {
+	return protocol.RemoveNodeContext(context.Background(), params)
+}
+
+/*
+RemoveNodeContext performs RemoveNode and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-removeNode
+*/
+func (protocol *DOMProtocol) RemoveNodeContext(
+	ctx context.Context,
+	params *dom.RemoveNodeParams,
+) <-chan *dom.RemoveNodeResult {
+	resultChan := make(chan *dom.RemoveNodeResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.removeNode", params)
+	result := &dom.RemoveNodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+RequestChildNodes requests that children of the node with given id are returned
+to the caller in form of `setChildNodes` events where not only immediate
+children are retrieved, but all children down to the specified depth.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-requestChildNodes
+*/
+func (protocol *DOMProtocol) RequestChildNodes(
+	params *dom.RequestChildNodesParams,
+) <-chan *dom.RequestChildNodesResult {
+	return protocol.RequestChildNodesContext(context.Background(), params)
+}
+
+/*
+RequestChildNodesContext performs RequestChildNodes and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-requestChildNodes
+*/
+func (protocol *DOMProtocol) RequestChildNodesContext(
+	ctx context.Context,
+	params *dom.RequestChildNodesParams,
+) <-chan *dom.RequestChildNodesResult {
+	resultChan := make(chan *dom.RequestChildNodesResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.requestChildNodes", params)
+	result := &dom.RequestChildNodesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+RequestNode requests that the node is sent to the caller given the JavaScript
+node object reference. All nodes that form the path from the node to the root
+are also sent to the client as a series of `setChildNodes` notifications.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-requestNode
+*/
+func (protocol *DOMProtocol) RequestNode(
+	params *dom.RequestNodeParams,
+) <-chan *dom.RequestNodeResult {
+	return protocol.RequestNodeContext(context.Background(), params)
+}
+
+/*
+RequestNodeContext performs RequestNode and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-requestNode
+*/
+func (protocol *DOMProtocol) RequestNodeContext(
+	ctx context.Context,
+	params *dom.RequestNodeParams,
+) <-chan *dom.RequestNodeResult {
+	resultChan := make(chan *dom.RequestNodeResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.requestNode", params)
+	result := &dom.RequestNodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+ResolveNode resolves the JavaScript node object for a given NodeId or
+BackendNodeId.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-resolveNode
+*/
+func (protocol *DOMProtocol) ResolveNode(
+	params *dom.ResolveNodeParams,
+) <-chan *dom.ResolveNodeResult {
+	return protocol.ResolveNodeContext(context.Background(), params)
+}
+
+/*
+ResolveNodeContext performs ResolveNode and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-resolveNode
+*/
+func (protocol *DOMProtocol) ResolveNodeContext(
+	ctx context.Context,
+	params *dom.ResolveNodeParams,
+) <-chan *dom.ResolveNodeResult {
+	resultChan := make(chan *dom.ResolveNodeResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.resolveNode", params)
+	result := &dom.ResolveNodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+ScrollIntoViewIfNeeded scrolls the specified rect of the given node into view if
+not already visible. Note: exactly one between nodeId, backendNodeId and
+objectId should be passed to identify the node.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-scrollIntoViewIfNeeded
+*/
+func (protocol *DOMProtocol) ScrollIntoViewIfNeeded(
+	params *dom.ScrollIntoViewIfNeededParams,
+) <-chan *dom.ScrollIntoViewIfNeededResult {
+	return protocol.ScrollIntoViewIfNeededContext(context.Background(), params)
+}
+
+/*
+ScrollIntoViewIfNeededContext performs ScrollIntoViewIfNeeded and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-scrollIntoViewIfNeeded
+*/
+func (protocol *DOMProtocol) ScrollIntoViewIfNeededContext(
+	ctx context.Context,
+	params *dom.ScrollIntoViewIfNeededParams,
+) <-chan *dom.ScrollIntoViewIfNeededResult {
+	resultChan := make(chan *dom.ScrollIntoViewIfNeededResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.scrollIntoViewIfNeeded", params)
+	result := &dom.ScrollIntoViewIfNeededResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetAttributeValue sets attribute for an element with given id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setAttributeValue
+*/
+func (protocol *DOMProtocol) SetAttributeValue(
+	params *dom.SetAttributeValueParams,
+) <-chan *dom.SetAttributeValueResult {
+	return protocol.SetAttributeValueContext(context.Background(), params)
+}
+
+/*
+SetAttributeValueContext performs SetAttributeValue and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setAttributeValue
+*/
+func (protocol *DOMProtocol) SetAttributeValueContext(
+	ctx context.Context,
+	params *dom.SetAttributeValueParams,
+) <-chan *dom.SetAttributeValueResult {
+	resultChan := make(chan *dom.SetAttributeValueResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.setAttributeValue", params)
+	result := &dom.SetAttributeValueResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetAttributesAsText sets attributes on element with given id. This method is
+useful when user edits some existing attribute value and types in several
+attribute name/value pairs.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setAttributesAsText
+*/
+func (protocol *DOMProtocol) SetAttributesAsText(
+	params *dom.SetAttributesAsTextParams,
+) <-chan *dom.SetAttributesAsTextResult {
+	return protocol.SetAttributesAsTextContext(context.Background(), params)
+}
+
+/*
+SetAttributesAsTextContext performs SetAttributesAsText and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setAttributesAsText
+*/
+func (protocol *DOMProtocol) SetAttributesAsTextContext(
+	ctx context.Context,
+	params *dom.SetAttributesAsTextParams,
+) <-chan *dom.SetAttributesAsTextResult {
+	resultChan := make(chan *dom.SetAttributesAsTextResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.setAttributesAsText", params)
+	result := &dom.SetAttributesAsTextResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetFileInputFiles sets files for the given file input element.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setFileInputFiles
+*/
+func (protocol *DOMProtocol) SetFileInputFiles(
+	params *dom.SetFileInputFilesParams,
+) <-chan *dom.SetFileInputFilesResult {
+	return protocol.SetFileInputFilesContext(context.Background(), params)
+}
+
+/*
+SetFileInputFilesContext performs SetFileInputFiles and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setFileInputFiles
+*/
+func (protocol *DOMProtocol) SetFileInputFilesContext(
+	ctx context.Context,
+	params *dom.SetFileInputFilesParams,
+) <-chan *dom.SetFileInputFilesResult {
+	resultChan := make(chan *dom.SetFileInputFilesResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.setFileInputFiles", params)
+	result := &dom.SetFileInputFilesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetNodeName sets node name for a node with given id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setNodeName
+*/
+func (protocol *DOMProtocol) SetNodeName(
+	params *dom.SetNodeNameParams,
+) <-chan *dom.SetNodeNameResult {
+	return protocol.SetNodeNameContext(context.Background(), params)
+}
+
+/*
+SetNodeNameContext performs SetNodeName and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setNodeName
+*/
+func (protocol *DOMProtocol) SetNodeNameContext(
+	ctx context.Context,
+	params *dom.SetNodeNameParams,
+) <-chan *dom.SetNodeNameResult {
+	resultChan := make(chan *dom.SetNodeNameResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.setNodeName", params)
+	result := &dom.SetNodeNameResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetNodeValue sets node value for a node with given id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setNodeValue
+*/
+func (protocol *DOMProtocol) SetNodeValue(
+	params *dom.SetNodeValueParams,
+) <-chan *dom.SetNodeValueResult {
+	return protocol.SetNodeValueContext(context.Background(), params)
+}
+
+/*
+SetNodeValueContext performs SetNodeValue and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setNodeValue
+*/
+func (protocol *DOMProtocol) SetNodeValueContext(
+	ctx context.Context,
+	params *dom.SetNodeValueParams,
+) <-chan *dom.SetNodeValueResult {
+	resultChan := make(chan *dom.SetNodeValueResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.setNodeValue", params)
+	result := &dom.SetNodeValueResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetOuterHTML sets node HTML markup, returns new node id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setOuterHTML
+*/
+func (protocol *DOMProtocol) SetOuterHTML(
+	params *dom.SetOuterHTMLParams,
+) <-chan *dom.SetOuterHTMLResult {
+	return protocol.SetOuterHTMLContext(context.Background(), params)
+}
+
+/*
+SetOuterHTMLContext performs SetOuterHTML and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setOuterHTML
+*/
+func (protocol *DOMProtocol) SetOuterHTMLContext(
+	ctx context.Context,
+	params *dom.SetOuterHTMLParams,
+) <-chan *dom.SetOuterHTMLResult {
+	resultChan := make(chan *dom.SetOuterHTMLResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.setOuterHTML", params)
+	result := &dom.SetOuterHTMLResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnAttributeModified adds a handler to the DOM.attributeModified event. Fired
+when `Element`'s attribute is modified.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-attributeModified
+*/
+func (protocol *DOMProtocol) OnAttributeModified(
+	callback func(event *dom.AttributeModifiedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.attributeModified",
+		func(response *Response) {
+			event := &dom.AttributeModifiedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnAttributeModifiedChan returns a channel of DOM.attributeModified events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-attributeModified
+*/
+func (protocol *DOMProtocol) OnAttributeModifiedChan(
+	ctx context.Context,
+) <-chan *dom.AttributeModifiedEvent {
+	eventChan := make(chan *dom.AttributeModifiedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnAttributeModified(func(event *dom.AttributeModifiedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnAttributeRemoved adds a handler to the DOM.attributeRemoved event. Fired when
+`Element`'s attribute is removed.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-attributeRemoved
+*/
+func (protocol *DOMProtocol) OnAttributeRemoved(
+	callback func(event *dom.AttributeRemovedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.attributeRemoved",
+		func(response *Response) {
+			event := &dom.AttributeRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnAttributeRemovedChan returns a channel of DOM.attributeRemoved events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-attributeRemoved
+*/
+func (protocol *DOMProtocol) OnAttributeRemovedChan(
+	ctx context.Context,
+) <-chan *dom.AttributeRemovedEvent {
+	eventChan := make(chan *dom.AttributeRemovedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnAttributeRemoved(func(event *dom.AttributeRemovedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnCharacterDataModified adds a handler to the DOM.characterDataModified event.
+Mirrors `DOMCharacterDataModified` event.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-characterDataModified
+*/
+func (protocol *DOMProtocol) OnCharacterDataModified(
+	callback func(event *dom.CharacterDataModifiedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.characterDataModified",
+		func(response *Response) {
+			event := &dom.CharacterDataModifiedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnCharacterDataModifiedChan returns a channel of DOM.characterDataModified
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-characterDataModified
+*/
+func (protocol *DOMProtocol) OnCharacterDataModifiedChan(
+	ctx context.Context,
+) <-chan *dom.CharacterDataModifiedEvent {
+	eventChan := make(chan *dom.CharacterDataModifiedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnCharacterDataModified(func(event *dom.CharacterDataModifiedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnChildNodeCountUpdated adds a handler to the DOM.childNodeCountUpdated event.
+Fired when `Container`'s child node count has changed.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-childNodeCountUpdated
+*/
+func (protocol *DOMProtocol) OnChildNodeCountUpdated(
+	callback func(event *dom.ChildNodeCountUpdatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.childNodeCountUpdated",
+		func(response *Response) {
+			event := &dom.ChildNodeCountUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnChildNodeCountUpdatedChan returns a channel of DOM.childNodeCountUpdated
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-childNodeCountUpdated
+*/
+func (protocol *DOMProtocol) OnChildNodeCountUpdatedChan(
+	ctx context.Context,
+) <-chan *dom.ChildNodeCountUpdatedEvent {
+	eventChan := make(chan *dom.ChildNodeCountUpdatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnChildNodeCountUpdated(func(event *dom.ChildNodeCountUpdatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnChildNodeInserted adds a handler to the DOM.childNodeInserted event. Mirrors
+`DOMNodeInserted` event.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-childNodeInserted
+*/
+func (protocol *DOMProtocol) OnChildNodeInserted(
+	callback func(event *dom.ChildNodeInsertedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.childNodeInserted",
+		func(response *Response) {
+			event := &dom.ChildNodeInsertedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnChildNodeInsertedChan returns a channel of DOM.childNodeInserted events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-childNodeInserted
+*/
+func (protocol *DOMProtocol) OnChildNodeInsertedChan(
+	ctx context.Context,
+) <-chan *dom.ChildNodeInsertedEvent {
+	eventChan := make(chan *dom.ChildNodeInsertedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnChildNodeInserted(func(event *dom.ChildNodeInsertedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnChildNodeRemoved adds a handler to the DOM.childNodeRemoved event. Mirrors
+`DOMNodeRemoved` event.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-childNodeRemoved
+*/
+func (protocol *DOMProtocol) OnChildNodeRemoved(
+	callback func(event *dom.ChildNodeRemovedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.childNodeRemoved",
+		func(response *Response) {
+			event := &dom.ChildNodeRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnChildNodeRemovedChan returns a channel of DOM.childNodeRemoved events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-childNodeRemoved
+*/
+func (protocol *DOMProtocol) OnChildNodeRemovedChan(
+	ctx context.Context,
+) <-chan *dom.ChildNodeRemovedEvent {
+	eventChan := make(chan *dom.ChildNodeRemovedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnChildNodeRemoved(func(event *dom.ChildNodeRemovedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnDocumentUpdated adds a handler to the DOM.documentUpdated event. Fired when
+`Document` has been totally updated. Node ids are no longer valid.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-documentUpdated
+*/
+func (protocol *DOMProtocol) OnDocumentUpdated(
+	callback func(event *dom.DocumentUpdatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.documentUpdated",
+		func(response *Response) {
+			event := &dom.DocumentUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnDocumentUpdatedChan returns a channel of DOM.documentUpdated events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-documentUpdated
+*/
+func (protocol *DOMProtocol) OnDocumentUpdatedChan(
+	ctx context.Context,
+) <-chan *dom.DocumentUpdatedEvent {
+	eventChan := make(chan *dom.DocumentUpdatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnDocumentUpdated(func(event *dom.DocumentUpdatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnSetChildNodes adds a handler to the DOM.setChildNodes event. Fired when
+backend wants to provide client with the missing DOM structure. This happens
+upon most of the calls requesting node ids.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-setChildNodes
+*/
+func (protocol *DOMProtocol) OnSetChildNodes(
+	callback func(event *dom.SetChildNodesEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"DOM.setChildNodes",
+		func(response *Response) {
+			event := &dom.SetChildNodesEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnSetChildNodesChan returns a channel of DOM.setChildNodes events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-setChildNodes
+*/
+func (protocol *DOMProtocol) OnSetChildNodesChan(
+	ctx context.Context,
+) <-chan *dom.SetChildNodesEvent {
+	eventChan := make(chan *dom.SetChildNodesEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnSetChildNodes(func(event *dom.SetChildNodesEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
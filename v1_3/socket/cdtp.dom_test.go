@@ -0,0 +1,1340 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/v1_3/dom"
+)
+
+func TestDOMDescribeNode(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMDescribeNode")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.DescribeNodeResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().DescribeNode(&dom.DescribeNodeParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().DescribeNode(&dom.DescribeNodeParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMDisable(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMDisable")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.DisableResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().Disable()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().Disable()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMEnable(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMEnable")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.EnableResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().Enable()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().Enable()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMFocus(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMFocus")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.FocusResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().Focus(&dom.FocusParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().Focus(&dom.FocusParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMGetAttributes(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMGetAttributes")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.GetAttributesResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().GetAttributes(&dom.GetAttributesParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().GetAttributes(&dom.GetAttributesParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMGetBoxModel(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMGetBoxModel")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.GetBoxModelResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().GetBoxModel(&dom.GetBoxModelParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().GetBoxModel(&dom.GetBoxModelParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMGetDocument(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMGetDocument")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.GetDocumentResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().GetDocument(&dom.GetDocumentParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().GetDocument(&dom.GetDocumentParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMGetFlattenedDocument(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMGetFlattenedDocument")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.GetFlattenedDocumentResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().GetFlattenedDocument(&dom.GetFlattenedDocumentParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().GetFlattenedDocument(&dom.GetFlattenedDocumentParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMGetNodeForLocation(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMGetNodeForLocation")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.GetNodeForLocationResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().GetNodeForLocation(&dom.GetNodeForLocationParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().GetNodeForLocation(&dom.GetNodeForLocationParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMGetOuterHTML(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMGetOuterHTML")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.GetOuterHTMLResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().GetOuterHTML(&dom.GetOuterHTMLParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().GetOuterHTML(&dom.GetOuterHTMLParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMHideHighlight(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMHideHighlight")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.HideHighlightResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().HideHighlight()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().HideHighlight()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMHighlightNode(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMHighlightNode")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.HighlightNodeResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().HighlightNode()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().HighlightNode()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMHighlightRect(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMHighlightRect")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.HighlightRectResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().HighlightRect()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().HighlightRect()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMMoveTo(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMMoveTo")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.MoveToResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().MoveTo(&dom.MoveToParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().MoveTo(&dom.MoveToParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMQuerySelector(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMQuerySelector")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.QuerySelectorResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().QuerySelector(&dom.QuerySelectorParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().QuerySelector(&dom.QuerySelectorParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMQuerySelectorAll(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMQuerySelectorAll")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.QuerySelectorAllResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().QuerySelectorAll(&dom.QuerySelectorAllParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().QuerySelectorAll(&dom.QuerySelectorAllParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMRemoveAttribute(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMRemoveAttribute")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.RemoveAttributeResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().RemoveAttribute(&dom.RemoveAttributeParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().RemoveAttribute(&dom.RemoveAttributeParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMRemoveNode(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMRemoveNode")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.RemoveNodeResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().RemoveNode(&dom.RemoveNodeParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().RemoveNode(&dom.RemoveNodeParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMRequestChildNodes(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMRequestChildNodes")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.RequestChildNodesResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().RequestChildNodes(&dom.RequestChildNodesParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().RequestChildNodes(&dom.RequestChildNodesParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMRequestNode(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMRequestNode")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.RequestNodeResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().RequestNode(&dom.RequestNodeParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().RequestNode(&dom.RequestNodeParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMResolveNode(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMResolveNode")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.ResolveNodeResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().ResolveNode(&dom.ResolveNodeParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().ResolveNode(&dom.ResolveNodeParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMScrollIntoViewIfNeeded(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMScrollIntoViewIfNeeded")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.ScrollIntoViewIfNeededResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().ScrollIntoViewIfNeeded(&dom.ScrollIntoViewIfNeededParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().ScrollIntoViewIfNeeded(&dom.ScrollIntoViewIfNeededParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMSetAttributeValue(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMSetAttributeValue")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.SetAttributeValueResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().SetAttributeValue(&dom.SetAttributeValueParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().SetAttributeValue(&dom.SetAttributeValueParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMSetAttributesAsText(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMSetAttributesAsText")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.SetAttributesAsTextResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().SetAttributesAsText(&dom.SetAttributesAsTextParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().SetAttributesAsText(&dom.SetAttributesAsTextParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMSetFileInputFiles(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMSetFileInputFiles")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.SetFileInputFilesResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().SetFileInputFiles(&dom.SetFileInputFilesParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().SetFileInputFiles(&dom.SetFileInputFilesParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMSetNodeName(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMSetNodeName")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.SetNodeNameResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().SetNodeName(&dom.SetNodeNameParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().SetNodeName(&dom.SetNodeNameParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMSetNodeValue(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMSetNodeValue")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.SetNodeValueResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().SetNodeValue(&dom.SetNodeValueParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().SetNodeValue(&dom.SetNodeValueParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMSetOuterHTML(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMSetOuterHTML")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &dom.SetOuterHTMLResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.DOM().SetOuterHTML(&dom.SetOuterHTMLParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.DOM().SetOuterHTML(&dom.SetOuterHTMLParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestDOMOnAttributeModified(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMOnAttributeModified")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *dom.AttributeModifiedEvent)
+	sub := mockSocket.DOM().OnAttributeModified(func(eventData *dom.AttributeModifiedEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &dom.AttributeModifiedEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "DOM.attributeModified",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.DOM().OnAttributeModifiedChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "DOM.attributeModified",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestDOMOnAttributeRemoved(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMOnAttributeRemoved")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *dom.AttributeRemovedEvent)
+	sub := mockSocket.DOM().OnAttributeRemoved(func(eventData *dom.AttributeRemovedEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &dom.AttributeRemovedEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "DOM.attributeRemoved",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.DOM().OnAttributeRemovedChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "DOM.attributeRemoved",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestDOMOnCharacterDataModified(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMOnCharacterDataModified")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *dom.CharacterDataModifiedEvent)
+	sub := mockSocket.DOM().OnCharacterDataModified(func(eventData *dom.CharacterDataModifiedEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &dom.CharacterDataModifiedEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "DOM.characterDataModified",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.DOM().OnCharacterDataModifiedChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "DOM.characterDataModified",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestDOMOnChildNodeCountUpdated(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMOnChildNodeCountUpdated")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *dom.ChildNodeCountUpdatedEvent)
+	sub := mockSocket.DOM().OnChildNodeCountUpdated(func(eventData *dom.ChildNodeCountUpdatedEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &dom.ChildNodeCountUpdatedEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "DOM.childNodeCountUpdated",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.DOM().OnChildNodeCountUpdatedChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "DOM.childNodeCountUpdated",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestDOMOnChildNodeInserted(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMOnChildNodeInserted")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *dom.ChildNodeInsertedEvent)
+	sub := mockSocket.DOM().OnChildNodeInserted(func(eventData *dom.ChildNodeInsertedEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &dom.ChildNodeInsertedEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "DOM.childNodeInserted",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.DOM().OnChildNodeInsertedChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "DOM.childNodeInserted",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestDOMOnChildNodeRemoved(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMOnChildNodeRemoved")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *dom.ChildNodeRemovedEvent)
+	sub := mockSocket.DOM().OnChildNodeRemoved(func(eventData *dom.ChildNodeRemovedEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &dom.ChildNodeRemovedEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "DOM.childNodeRemoved",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.DOM().OnChildNodeRemovedChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "DOM.childNodeRemoved",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestDOMOnDocumentUpdated(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMOnDocumentUpdated")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *dom.DocumentUpdatedEvent)
+	sub := mockSocket.DOM().OnDocumentUpdated(func(eventData *dom.DocumentUpdatedEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &dom.DocumentUpdatedEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "DOM.documentUpdated",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.DOM().OnDocumentUpdatedChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "DOM.documentUpdated",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestDOMOnSetChildNodes(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMOnSetChildNodes")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *dom.SetChildNodesEvent)
+	sub := mockSocket.DOM().OnSetChildNodes(func(eventData *dom.SetChildNodesEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &dom.SetChildNodesEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "DOM.setChildNodes",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.DOM().OnSetChildNodesChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "DOM.setChildNodes",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
@@ -0,0 +1,662 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/v1_3/emulation"
+)
+
+/*
+EmulationProtocol provides a namespace for the Chrome Emulation protocol
+methods.
+
+This domain emulates different environments for the page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/
+*/
+type EmulationProtocol struct {
+	Socket Socketer
+}
+
+/*
+CanEmulate tells whether emulation is supported.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-canEmulate
+DEPRECATED.
+*/
+func (protocol *EmulationProtocol) CanEmulate() <-chan *emulation.CanEmulateResult {
+	return protocol.CanEmulateContext(context.Background())
+}
+
+/*
+CanEmulateContext performs CanEmulate and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-canEmulate
+DEPRECATED.
+*/
+func (protocol *EmulationProtocol) CanEmulateContext(
+	ctx context.Context,
+) <-chan *emulation.CanEmulateResult {
+	resultChan := make(chan *emulation.CanEmulateResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.canEmulate", nil)
+	result := &emulation.CanEmulateResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+ClearDeviceMetricsOverride clears the overridden device metrics.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-clearDeviceMetricsOverride
+*/
+func (protocol *EmulationProtocol) ClearDeviceMetricsOverride() <-chan *emulation.ClearDeviceMetricsOverrideResult {
+	return protocol.ClearDeviceMetricsOverrideContext(context.Background())
+}
+
+/*
+ClearDeviceMetricsOverrideContext performs ClearDeviceMetricsOverride and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-clearDeviceMetricsOverride
+*/
+func (protocol *EmulationProtocol) ClearDeviceMetricsOverrideContext(
+	ctx context.Context,
+) <-chan *emulation.ClearDeviceMetricsOverrideResult {
+	resultChan := make(chan *emulation.ClearDeviceMetricsOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.clearDeviceMetricsOverride", nil)
+	result := &emulation.ClearDeviceMetricsOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+ClearGeolocationOverride clears the overridden Geolocation Position and Error.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-clearGeolocationOverride
+*/
+func (protocol *EmulationProtocol) ClearGeolocationOverride() <-chan *emulation.ClearGeolocationOverrideResult {
+	return protocol.ClearGeolocationOverrideContext(context.Background())
+}
+
+/*
+ClearGeolocationOverrideContext performs ClearGeolocationOverride and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-clearGeolocationOverride
+*/
+func (protocol *EmulationProtocol) ClearGeolocationOverrideContext(
+	ctx context.Context,
+) <-chan *emulation.ClearGeolocationOverrideResult {
+	resultChan := make(chan *emulation.ClearGeolocationOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.clearGeolocationOverride", nil)
+	result := &emulation.ClearGeolocationOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+ClearIdleOverride clears Idle state overrides.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-clearIdleOverride
+*/
+func (protocol *EmulationProtocol) ClearIdleOverride() <-chan *emulation.ClearIdleOverrideResult {
+	return protocol.ClearIdleOverrideContext(context.Background())
+}
+
+/*
+ClearIdleOverrideContext performs ClearIdleOverride and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-clearIdleOverride
+*/
+func (protocol *EmulationProtocol) ClearIdleOverrideContext(
+	ctx context.Context,
+) <-chan *emulation.ClearIdleOverrideResult {
+	resultChan := make(chan *emulation.ClearIdleOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.clearIdleOverride", nil)
+	result := &emulation.ClearIdleOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetCPUThrottlingRate enables CPU throttling to emulate slow CPUs.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setCPUThrottlingRate
+*/
+func (protocol *EmulationProtocol) SetCPUThrottlingRate(
+	params *emulation.SetCPUThrottlingRateParams,
+) <-chan *emulation.SetCPUThrottlingRateResult {
+	return protocol.SetCPUThrottlingRateContext(context.Background(), params)
+}
+
+/*
+SetCPUThrottlingRateContext performs SetCPUThrottlingRate and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setCPUThrottlingRate
+*/
+func (protocol *EmulationProtocol) SetCPUThrottlingRateContext(
+	ctx context.Context,
+	params *emulation.SetCPUThrottlingRateParams,
+) <-chan *emulation.SetCPUThrottlingRateResult {
+	resultChan := make(chan *emulation.SetCPUThrottlingRateResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setCPUThrottlingRate", params)
+	result := &emulation.SetCPUThrottlingRateResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetDefaultBackgroundColorOverride sets or clears an override of the default
+background color of the frame. This override is used if the content does not
+specify one.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setDefaultBackgroundColorOverride
+*/
+func (protocol *EmulationProtocol) SetDefaultBackgroundColorOverride(
+	params *emulation.SetDefaultBackgroundColorOverrideParams,
+) <-chan *emulation.SetDefaultBackgroundColorOverrideResult {
+	return protocol.SetDefaultBackgroundColorOverrideContext(context.Background(), params)
+}
+
+/*
+SetDefaultBackgroundColorOverrideContext performs
+SetDefaultBackgroundColorOverride and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setDefaultBackgroundColorOverride
+*/
+func (protocol *EmulationProtocol) SetDefaultBackgroundColorOverrideContext(
+	ctx context.Context,
+	params *emulation.SetDefaultBackgroundColorOverrideParams,
+) <-chan *emulation.SetDefaultBackgroundColorOverrideResult {
+	resultChan := make(chan *emulation.SetDefaultBackgroundColorOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setDefaultBackgroundColorOverride", params)
+	result := &emulation.SetDefaultBackgroundColorOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetDeviceMetricsOverride overrides the values of device screen dimensions
+(window.screen.width, window.screen.height, window.innerWidth,
+window.innerHeight, and "device-width"/"device-height"-related CSS media query
+results).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setDeviceMetricsOverride
+*/
+func (protocol *EmulationProtocol) SetDeviceMetricsOverride(
+	params *emulation.SetDeviceMetricsOverrideParams,
+) <-chan *emulation.SetDeviceMetricsOverrideResult {
+	return protocol.SetDeviceMetricsOverrideContext(context.Background(), params)
+}
+
+/*
+SetDeviceMetricsOverrideContext performs SetDeviceMetricsOverride and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setDeviceMetricsOverride
+*/
+func (protocol *EmulationProtocol) SetDeviceMetricsOverrideContext(
+	ctx context.Context,
+	params *emulation.SetDeviceMetricsOverrideParams,
+) <-chan *emulation.SetDeviceMetricsOverrideResult {
+	resultChan := make(chan *emulation.SetDeviceMetricsOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setDeviceMetricsOverride", params)
+	result := &emulation.SetDeviceMetricsOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetEmulatedMedia emulates the given media type or media feature for CSS media
+queries.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setEmulatedMedia
+*/
+func (protocol *EmulationProtocol) SetEmulatedMedia(
+	params *emulation.SetEmulatedMediaParams,
+) <-chan *emulation.SetEmulatedMediaResult {
+	return protocol.SetEmulatedMediaContext(context.Background(), params)
+}
+
+/*
+SetEmulatedMediaContext performs SetEmulatedMedia and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setEmulatedMedia
+*/
+func (protocol *EmulationProtocol) SetEmulatedMediaContext(
+	ctx context.Context,
+	params *emulation.SetEmulatedMediaParams,
+) <-chan *emulation.SetEmulatedMediaResult {
+	resultChan := make(chan *emulation.SetEmulatedMediaResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setEmulatedMedia", params)
+	result := &emulation.SetEmulatedMediaResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetEmulatedOSTextScale emulates the given OS text scale.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setEmulatedOSTextScale
+*/
+func (protocol *EmulationProtocol) SetEmulatedOSTextScale(
+	params *emulation.SetEmulatedOSTextScaleParams,
+) <-chan *emulation.SetEmulatedOSTextScaleResult {
+	return protocol.SetEmulatedOSTextScaleContext(context.Background(), params)
+}
+
+/*
+SetEmulatedOSTextScaleContext performs SetEmulatedOSTextScale and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setEmulatedOSTextScale
+*/
+func (protocol *EmulationProtocol) SetEmulatedOSTextScaleContext(
+	ctx context.Context,
+	params *emulation.SetEmulatedOSTextScaleParams,
+) <-chan *emulation.SetEmulatedOSTextScaleResult {
+	resultChan := make(chan *emulation.SetEmulatedOSTextScaleResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setEmulatedOSTextScale", params)
+	result := &emulation.SetEmulatedOSTextScaleResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetEmulatedVisionDeficiency emulates the given vision deficiency.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setEmulatedVisionDeficiency
+*/
+func (protocol *EmulationProtocol) SetEmulatedVisionDeficiency(
+	params *emulation.SetEmulatedVisionDeficiencyParams,
+) <-chan *emulation.SetEmulatedVisionDeficiencyResult {
+	return protocol.SetEmulatedVisionDeficiencyContext(context.Background(), params)
+}
+
+/*
+SetEmulatedVisionDeficiencyContext performs SetEmulatedVisionDeficiency and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setEmulatedVisionDeficiency
+*/
+func (protocol *EmulationProtocol) SetEmulatedVisionDeficiencyContext(
+	ctx context.Context,
+	params *emulation.SetEmulatedVisionDeficiencyParams,
+) <-chan *emulation.SetEmulatedVisionDeficiencyResult {
+	resultChan := make(chan *emulation.SetEmulatedVisionDeficiencyResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setEmulatedVisionDeficiency", params)
+	result := &emulation.SetEmulatedVisionDeficiencyResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetGeolocationOverride overrides the Geolocation Position or Error. Omitting
+latitude, longitude or accuracy emulates position unavailable.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setGeolocationOverride
+*/
+func (protocol *EmulationProtocol) SetGeolocationOverride(
+	params *emulation.SetGeolocationOverrideParams,
+) <-chan *emulation.SetGeolocationOverrideResult {
+	return protocol.SetGeolocationOverrideContext(context.Background(), params)
+}
+
+/*
+SetGeolocationOverrideContext performs SetGeolocationOverride and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setGeolocationOverride
+*/
+func (protocol *EmulationProtocol) SetGeolocationOverrideContext(
+	ctx context.Context,
+	params *emulation.SetGeolocationOverrideParams,
+) <-chan *emulation.SetGeolocationOverrideResult {
+	resultChan := make(chan *emulation.SetGeolocationOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setGeolocationOverride", params)
+	result := &emulation.SetGeolocationOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetIdleOverride overrides the Idle state.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setIdleOverride
+*/
+func (protocol *EmulationProtocol) SetIdleOverride(
+	params *emulation.SetIdleOverrideParams,
+) <-chan *emulation.SetIdleOverrideResult {
+	return protocol.SetIdleOverrideContext(context.Background(), params)
+}
+
+/*
+SetIdleOverrideContext performs SetIdleOverride and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setIdleOverride
+*/
+func (protocol *EmulationProtocol) SetIdleOverrideContext(
+	ctx context.Context,
+	params *emulation.SetIdleOverrideParams,
+) <-chan *emulation.SetIdleOverrideResult {
+	resultChan := make(chan *emulation.SetIdleOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setIdleOverride", params)
+	result := &emulation.SetIdleOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetScriptExecutionDisabled switches script execution in the page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setScriptExecutionDisabled
+*/
+func (protocol *EmulationProtocol) SetScriptExecutionDisabled(
+	params *emulation.SetScriptExecutionDisabledParams,
+) <-chan *emulation.SetScriptExecutionDisabledResult {
+	return protocol.SetScriptExecutionDisabledContext(context.Background(), params)
+}
+
+/*
+SetScriptExecutionDisabledContext performs SetScriptExecutionDisabled and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setScriptExecutionDisabled
+*/
+func (protocol *EmulationProtocol) SetScriptExecutionDisabledContext(
+	ctx context.Context,
+	params *emulation.SetScriptExecutionDisabledParams,
+) <-chan *emulation.SetScriptExecutionDisabledResult {
+	resultChan := make(chan *emulation.SetScriptExecutionDisabledResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setScriptExecutionDisabled", params)
+	result := &emulation.SetScriptExecutionDisabledResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetTimezoneOverride overrides default host system timezone with the specified
+one.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setTimezoneOverride
+*/
+func (protocol *EmulationProtocol) SetTimezoneOverride(
+	params *emulation.SetTimezoneOverrideParams,
+) <-chan *emulation.SetTimezoneOverrideResult {
+	return protocol.SetTimezoneOverrideContext(context.Background(), params)
+}
+
+/*
+SetTimezoneOverrideContext performs SetTimezoneOverride and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setTimezoneOverride
+*/
+func (protocol *EmulationProtocol) SetTimezoneOverrideContext(
+	ctx context.Context,
+	params *emulation.SetTimezoneOverrideParams,
+) <-chan *emulation.SetTimezoneOverrideResult {
+	resultChan := make(chan *emulation.SetTimezoneOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setTimezoneOverride", params)
+	result := &emulation.SetTimezoneOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetTouchEmulationEnabled enables touch on platforms which do not support them.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setTouchEmulationEnabled
+*/
+func (protocol *EmulationProtocol) SetTouchEmulationEnabled(
+	params *emulation.SetTouchEmulationEnabledParams,
+) <-chan *emulation.SetTouchEmulationEnabledResult {
+	return protocol.SetTouchEmulationEnabledContext(context.Background(), params)
+}
+
+/*
+SetTouchEmulationEnabledContext performs SetTouchEmulationEnabled and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setTouchEmulationEnabled
+*/
+func (protocol *EmulationProtocol) SetTouchEmulationEnabledContext(
+	ctx context.Context,
+	params *emulation.SetTouchEmulationEnabledParams,
+) <-chan *emulation.SetTouchEmulationEnabledResult {
+	resultChan := make(chan *emulation.SetTouchEmulationEnabledResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setTouchEmulationEnabled", params)
+	result := &emulation.SetTouchEmulationEnabledResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetUserAgentOverride allows overriding user agent with the given string.
+`userAgentMetadata` must be set for Client Hint headers to be sent.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setUserAgentOverride
+*/
+func (protocol *EmulationProtocol) SetUserAgentOverride(
+	params *emulation.SetUserAgentOverrideParams,
+) <-chan *emulation.SetUserAgentOverrideResult {
+	return protocol.SetUserAgentOverrideContext(context.Background(), params)
+}
+
+/*
+SetUserAgentOverrideContext performs SetUserAgentOverride and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setUserAgentOverride
+*/
+func (protocol *EmulationProtocol) SetUserAgentOverrideContext(
+	ctx context.Context,
+	params *emulation.SetUserAgentOverrideParams,
+) <-chan *emulation.SetUserAgentOverrideResult {
+	resultChan := make(chan *emulation.SetUserAgentOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setUserAgentOverride", params)
+	result := &emulation.SetUserAgentOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
@@ -0,0 +1,1563 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/v1_3/network"
+)
+
+/*
+NetworkProtocol provides a namespace for the Chrome Network protocol methods.
+
+Network domain allows tracking network activities of the page. It exposes
+information about http, file, data and other requests and responses, their
+headers, bodies, timing, etc.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/
+*/
+type NetworkProtocol struct {
+	Socket Socketer
+}
+
+/*
+CanClearBrowserCache tells whether clearing browser cache is supported.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-canClearBrowserCache
+DEPRECATED.
+*/
+func (protocol *NetworkProtocol) CanClearBrowserCache() <-chan *network.CanClearBrowserCacheResult {
+	return protocol.CanClearBrowserCacheContext(context.Background())
+}
+
+/*
+CanClearBrowserCacheContext performs CanClearBrowserCache and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-canClearBrowserCache
+DEPRECATED.
+*/
+func (protocol *NetworkProtocol) CanClearBrowserCacheContext(
+	ctx context.Context,
+) <-chan *network.CanClearBrowserCacheResult {
+	resultChan := make(chan *network.CanClearBrowserCacheResult, 1)
+	command := NewCommand(protocol.Socket, "Network.canClearBrowserCache", nil)
+	result := &network.CanClearBrowserCacheResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+CanClearBrowserCookies tells whether clearing browser cookies is supported.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-canClearBrowserCookies
+DEPRECATED.
+*/
+func (protocol *NetworkProtocol) CanClearBrowserCookies() <-chan *network.CanClearBrowserCookiesResult {
+	return protocol.CanClearBrowserCookiesContext(context.Background())
+}
+
+/*
+CanClearBrowserCookiesContext performs CanClearBrowserCookies and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-canClearBrowserCookies
+DEPRECATED.
+*/
+func (protocol *NetworkProtocol) CanClearBrowserCookiesContext(
+	ctx context.Context,
+) <-chan *network.CanClearBrowserCookiesResult {
+	resultChan := make(chan *network.CanClearBrowserCookiesResult, 1)
+	command := NewCommand(protocol.Socket, "Network.canClearBrowserCookies", nil)
+	result := &network.CanClearBrowserCookiesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+CanEmulateNetworkConditions tells whether emulation of network conditions is
+supported.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-canEmulateNetworkConditions
+DEPRECATED.
+*/
+func (protocol *NetworkProtocol) CanEmulateNetworkConditions() <-chan *network.CanEmulateNetworkConditionsResult {
+	return protocol.CanEmulateNetworkConditionsContext(context.Background())
+}
+
+/*
+CanEmulateNetworkConditionsContext performs CanEmulateNetworkConditions and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-canEmulateNetworkConditions
+DEPRECATED.
+*/
+func (protocol *NetworkProtocol) CanEmulateNetworkConditionsContext(
+	ctx context.Context,
+) <-chan *network.CanEmulateNetworkConditionsResult {
+	resultChan := make(chan *network.CanEmulateNetworkConditionsResult, 1)
+	command := NewCommand(protocol.Socket, "Network.canEmulateNetworkConditions", nil)
+	result := &network.CanEmulateNetworkConditionsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+ClearBrowserCache clears browser cache.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-clearBrowserCache
+*/
+func (protocol *NetworkProtocol) ClearBrowserCache() <-chan *network.ClearBrowserCacheResult {
+	return protocol.ClearBrowserCacheContext(context.Background())
+}
+
+/*
+ClearBrowserCacheContext performs ClearBrowserCache and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-clearBrowserCache
+*/
+func (protocol *NetworkProtocol) ClearBrowserCacheContext(
+	ctx context.Context,
+) <-chan *network.ClearBrowserCacheResult {
+	resultChan := make(chan *network.ClearBrowserCacheResult, 1)
+	command := NewCommand(protocol.Socket, "Network.clearBrowserCache", nil)
+	result := &network.ClearBrowserCacheResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+ClearBrowserCookies clears browser cookies.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-clearBrowserCookies
+*/
+func (protocol *NetworkProtocol) ClearBrowserCookies() <-chan *network.ClearBrowserCookiesResult {
+	return protocol.ClearBrowserCookiesContext(context.Background())
+}
+
+/*
+ClearBrowserCookiesContext performs ClearBrowserCookies and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-clearBrowserCookies
+*/
+func (protocol *NetworkProtocol) ClearBrowserCookiesContext(
+	ctx context.Context,
+) <-chan *network.ClearBrowserCookiesResult {
+	resultChan := make(chan *network.ClearBrowserCookiesResult, 1)
+	command := NewCommand(protocol.Socket, "Network.clearBrowserCookies", nil)
+	result := &network.ClearBrowserCookiesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+DeleteCookies deletes browser cookies with matching name and url or
+domain/path/partitionKey pair.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-deleteCookies
+*/
+func (protocol *NetworkProtocol) DeleteCookies(
+	params *network.DeleteCookiesParams,
+) <-chan *network.DeleteCookiesResult {
+	return protocol.DeleteCookiesContext(context.Background(), params)
+}
+
+/*
+DeleteCookiesContext performs DeleteCookies and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-deleteCookies
+*/
+func (protocol *NetworkProtocol) DeleteCookiesContext(
+	ctx context.Context,
+	params *network.DeleteCookiesParams,
+) <-chan *network.DeleteCookiesResult {
+	resultChan := make(chan *network.DeleteCookiesResult, 1)
+	command := NewCommand(protocol.Socket, "Network.deleteCookies", params)
+	result := &network.DeleteCookiesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disable disables network tracking, prevents network events from being sent to
+the client.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-disable
+*/
+func (protocol *NetworkProtocol) Disable() <-chan *network.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-disable
+*/
+func (protocol *NetworkProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *network.DisableResult {
+	resultChan := make(chan *network.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Network.disable", nil)
+	result := &network.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+EmulateNetworkConditions activates emulation of network conditions.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-emulateNetworkConditions
+*/
+func (protocol *NetworkProtocol) EmulateNetworkConditions(
+	params *network.EmulateNetworkConditionsParams,
+) <-chan *network.EmulateNetworkConditionsResult {
+	return protocol.EmulateNetworkConditionsContext(context.Background(), params)
+}
+
+/*
+EmulateNetworkConditionsContext performs EmulateNetworkConditions and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-emulateNetworkConditions
+*/
+func (protocol *NetworkProtocol) EmulateNetworkConditionsContext(
+	ctx context.Context,
+	params *network.EmulateNetworkConditionsParams,
+) <-chan *network.EmulateNetworkConditionsResult {
+	resultChan := make(chan *network.EmulateNetworkConditionsResult, 1)
+	command := NewCommand(protocol.Socket, "Network.emulateNetworkConditions", params)
+	result := &network.EmulateNetworkConditionsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enable enables network tracking, network events will now be delivered to the
+client.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-enable
+*/
+func (protocol *NetworkProtocol) Enable(
+	params *network.EnableParams,
+) <-chan *network.EnableResult {
+	return protocol.EnableContext(context.Background(), params)
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-enable
+*/
+func (protocol *NetworkProtocol) EnableContext(
+	ctx context.Context,
+	params *network.EnableParams,
+) <-chan *network.EnableResult {
+	resultChan := make(chan *network.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Network.enable", params)
+	result := &network.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetAllCookies returns all browser cookies. Depending on the backend support,
+will return detailed cookie information in the `cookies` field. Deprecated. Use
+Storage.getCookies instead.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getAllCookies
+DEPRECATED.
+*/
+func (protocol *NetworkProtocol) GetAllCookies() <-chan *network.GetAllCookiesResult {
+	return protocol.GetAllCookiesContext(context.Background())
+}
+
+/*
+GetAllCookiesContext performs GetAllCookies and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getAllCookies
+DEPRECATED.
+*/
+func (protocol *NetworkProtocol) GetAllCookiesContext(
+	ctx context.Context,
+) <-chan *network.GetAllCookiesResult {
+	resultChan := make(chan *network.GetAllCookiesResult, 1)
+	command := NewCommand(protocol.Socket, "Network.getAllCookies", nil)
+	result := &network.GetAllCookiesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetCookies returns all browser cookies for the current URL. Depending on the
+backend support, will return detailed cookie information in the `cookies` field.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getCookies
+*/
+func (protocol *NetworkProtocol) GetCookies(
+	params *network.GetCookiesParams,
+) <-chan *network.GetCookiesResult {
+	return protocol.GetCookiesContext(context.Background(), params)
+}
+
+/*
+GetCookiesContext performs GetCookies and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getCookies
+*/
+func (protocol *NetworkProtocol) GetCookiesContext(
+	ctx context.Context,
+	params *network.GetCookiesParams,
+) <-chan *network.GetCookiesResult {
+	resultChan := make(chan *network.GetCookiesResult, 1)
+	command := NewCommand(protocol.Socket, "Network.getCookies", params)
+	result := &network.GetCookiesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetRequestPostData returns post data sent with the request. Returns an error
+when no data was sent with the request.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getRequestPostData
+*/
+func (protocol *NetworkProtocol) GetRequestPostData(
+	params *network.GetRequestPostDataParams,
+) <-chan *network.GetRequestPostDataResult {
+	return protocol.GetRequestPostDataContext(context.Background(), params)
+}
+
+/*
+GetRequestPostDataContext performs GetRequestPostData and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getRequestPostData
+*/
+func (protocol *NetworkProtocol) GetRequestPostDataContext(
+	ctx context.Context,
+	params *network.GetRequestPostDataParams,
+) <-chan *network.GetRequestPostDataResult {
+	resultChan := make(chan *network.GetRequestPostDataResult, 1)
+	command := NewCommand(protocol.Socket, "Network.getRequestPostData", params)
+	result := &network.GetRequestPostDataResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetResponseBody returns content served for the given request.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getResponseBody
+*/
+func (protocol *NetworkProtocol) GetResponseBody(
+	params *network.GetResponseBodyParams,
+) <-chan *network.GetResponseBodyResult {
+	return protocol.GetResponseBodyContext(context.Background(), params)
+}
+
+/*
+GetResponseBodyContext performs GetResponseBody and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getResponseBody
+*/
+func (protocol *NetworkProtocol) GetResponseBodyContext(
+	ctx context.Context,
+	params *network.GetResponseBodyParams,
+) <-chan *network.GetResponseBodyResult {
+	resultChan := make(chan *network.GetResponseBodyResult, 1)
+	command := NewCommand(protocol.Socket, "Network.getResponseBody", params)
+	result := &network.GetResponseBodyResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetBypassServiceWorker toggles ignoring of service worker for each request.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setBypassServiceWorker
+*/
+func (protocol *NetworkProtocol) SetBypassServiceWorker(
+	params *network.SetBypassServiceWorkerParams,
+) <-chan *network.SetBypassServiceWorkerResult {
+	return protocol.SetBypassServiceWorkerContext(context.Background(), params)
+}
+
+/*
+SetBypassServiceWorkerContext performs SetBypassServiceWorker and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setBypassServiceWorker
+*/
+func (protocol *NetworkProtocol) SetBypassServiceWorkerContext(
+	ctx context.Context,
+	params *network.SetBypassServiceWorkerParams,
+) <-chan *network.SetBypassServiceWorkerResult {
+	resultChan := make(chan *network.SetBypassServiceWorkerResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setBypassServiceWorker", params)
+	result := &network.SetBypassServiceWorkerResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetCacheDisabled toggles ignoring cache for each request. If `true`, cache will
+not be used.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setCacheDisabled
+*/
+func (protocol *NetworkProtocol) SetCacheDisabled(
+	params *network.SetCacheDisabledParams,
+) <-chan *network.SetCacheDisabledResult {
+	return protocol.SetCacheDisabledContext(context.Background(), params)
+}
+
+/*
+SetCacheDisabledContext performs SetCacheDisabled and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setCacheDisabled
+*/
+func (protocol *NetworkProtocol) SetCacheDisabledContext(
+	ctx context.Context,
+	params *network.SetCacheDisabledParams,
+) <-chan *network.SetCacheDisabledResult {
+	resultChan := make(chan *network.SetCacheDisabledResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setCacheDisabled", params)
+	result := &network.SetCacheDisabledResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetCookie sets a cookie with the given cookie data; may overwrite equivalent
+cookies if they exist.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setCookie
+*/
+func (protocol *NetworkProtocol) SetCookie(
+	params *network.SetCookieParams,
+) <-chan *network.SetCookieResult {
+	return protocol.SetCookieContext(context.Background(), params)
+}
+
+/*
+SetCookieContext performs SetCookie and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setCookie
+*/
+func (protocol *NetworkProtocol) SetCookieContext(
+	ctx context.Context,
+	params *network.SetCookieParams,
+) <-chan *network.SetCookieResult {
+	resultChan := make(chan *network.SetCookieResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setCookie", params)
+	result := &network.SetCookieResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetCookies sets given cookies.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setCookies
+*/
+func (protocol *NetworkProtocol) SetCookies(
+	params *network.SetCookiesParams,
+) <-chan *network.SetCookiesResult {
+	return protocol.SetCookiesContext(context.Background(), params)
+}
+
+/*
+SetCookiesContext performs SetCookies and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setCookies
+*/
+func (protocol *NetworkProtocol) SetCookiesContext(
+	ctx context.Context,
+	params *network.SetCookiesParams,
+) <-chan *network.SetCookiesResult {
+	resultChan := make(chan *network.SetCookiesResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setCookies", params)
+	result := &network.SetCookiesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetExtraHTTPHeaders specifies whether to always send extra HTTP headers with the
+requests from this page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setExtraHTTPHeaders
+*/
+func (protocol *NetworkProtocol) SetExtraHTTPHeaders(
+	params *network.SetExtraHTTPHeadersParams,
+) <-chan *network.SetExtraHTTPHeadersResult {
+	return protocol.SetExtraHTTPHeadersContext(context.Background(), params)
+}
+
+/*
+SetExtraHTTPHeadersContext performs SetExtraHTTPHeaders and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setExtraHTTPHeaders
+*/
+func (protocol *NetworkProtocol) SetExtraHTTPHeadersContext(
+	ctx context.Context,
+	params *network.SetExtraHTTPHeadersParams,
+) <-chan *network.SetExtraHTTPHeadersResult {
+	resultChan := make(chan *network.SetExtraHTTPHeadersResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setExtraHTTPHeaders", params)
+	result := &network.SetExtraHTTPHeadersResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetUserAgentOverride allows overriding user agent with the given string.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setUserAgentOverride
+*/
+func (protocol *NetworkProtocol) SetUserAgentOverride(
+	params *network.SetUserAgentOverrideParams,
+) <-chan *network.SetUserAgentOverrideResult {
+	return protocol.SetUserAgentOverrideContext(context.Background(), params)
+}
+
+/*
+SetUserAgentOverrideContext performs SetUserAgentOverride and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setUserAgentOverride
+*/
+func (protocol *NetworkProtocol) SetUserAgentOverrideContext(
+	ctx context.Context,
+	params *network.SetUserAgentOverrideParams,
+) <-chan *network.SetUserAgentOverrideResult {
+	resultChan := make(chan *network.SetUserAgentOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setUserAgentOverride", params)
+	result := &network.SetUserAgentOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnDataReceived adds a handler to the Network.dataReceived event. Fired when data
+chunk was received over the network.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-dataReceived
+*/
+func (protocol *NetworkProtocol) OnDataReceived(
+	callback func(event *network.DataReceivedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.dataReceived",
+		func(response *Response) {
+			event := &network.DataReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnDataReceivedChan returns a channel of Network.dataReceived events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-dataReceived
+*/
+func (protocol *NetworkProtocol) OnDataReceivedChan(
+	ctx context.Context,
+) <-chan *network.DataReceivedEvent {
+	eventChan := make(chan *network.DataReceivedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnDataReceived(func(event *network.DataReceivedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnEventSourceMessageReceived adds a handler to the
+Network.eventSourceMessageReceived event. Fired when EventSource message is
+received.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-eventSourceMessageReceived
+*/
+func (protocol *NetworkProtocol) OnEventSourceMessageReceived(
+	callback func(event *network.EventSourceMessageReceivedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.eventSourceMessageReceived",
+		func(response *Response) {
+			event := &network.EventSourceMessageReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnEventSourceMessageReceivedChan returns a channel of
+Network.eventSourceMessageReceived events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-eventSourceMessageReceived
+*/
+func (protocol *NetworkProtocol) OnEventSourceMessageReceivedChan(
+	ctx context.Context,
+) <-chan *network.EventSourceMessageReceivedEvent {
+	eventChan := make(chan *network.EventSourceMessageReceivedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnEventSourceMessageReceived(func(event *network.EventSourceMessageReceivedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnLoadingFailed adds a handler to the Network.loadingFailed event. Fired when
+HTTP request has failed to load.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-loadingFailed
+*/
+func (protocol *NetworkProtocol) OnLoadingFailed(
+	callback func(event *network.LoadingFailedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.loadingFailed",
+		func(response *Response) {
+			event := &network.LoadingFailedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnLoadingFailedChan returns a channel of Network.loadingFailed events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-loadingFailed
+*/
+func (protocol *NetworkProtocol) OnLoadingFailedChan(
+	ctx context.Context,
+) <-chan *network.LoadingFailedEvent {
+	eventChan := make(chan *network.LoadingFailedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnLoadingFailed(func(event *network.LoadingFailedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnLoadingFinished adds a handler to the Network.loadingFinished event. Fired
+when HTTP request has finished loading.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-loadingFinished
+*/
+func (protocol *NetworkProtocol) OnLoadingFinished(
+	callback func(event *network.LoadingFinishedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.loadingFinished",
+		func(response *Response) {
+			event := &network.LoadingFinishedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnLoadingFinishedChan returns a channel of Network.loadingFinished events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-loadingFinished
+*/
+func (protocol *NetworkProtocol) OnLoadingFinishedChan(
+	ctx context.Context,
+) <-chan *network.LoadingFinishedEvent {
+	eventChan := make(chan *network.LoadingFinishedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnLoadingFinished(func(event *network.LoadingFinishedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnRequestServedFromCache adds a handler to the Network.requestServedFromCache
+event. Fired if request ended up loading from cache.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-requestServedFromCache
+*/
+func (protocol *NetworkProtocol) OnRequestServedFromCache(
+	callback func(event *network.RequestServedFromCacheEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.requestServedFromCache",
+		func(response *Response) {
+			event := &network.RequestServedFromCacheEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnRequestServedFromCacheChan returns a channel of Network.requestServedFromCache
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-requestServedFromCache
+*/
+func (protocol *NetworkProtocol) OnRequestServedFromCacheChan(
+	ctx context.Context,
+) <-chan *network.RequestServedFromCacheEvent {
+	eventChan := make(chan *network.RequestServedFromCacheEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnRequestServedFromCache(func(event *network.RequestServedFromCacheEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnRequestWillBeSent adds a handler to the Network.requestWillBeSent event. Fired
+when page is about to send HTTP request.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-requestWillBeSent
+*/
+func (protocol *NetworkProtocol) OnRequestWillBeSent(
+	callback func(event *network.RequestWillBeSentEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.requestWillBeSent",
+		func(response *Response) {
+			event := &network.RequestWillBeSentEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnRequestWillBeSentChan returns a channel of Network.requestWillBeSent events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-requestWillBeSent
+*/
+func (protocol *NetworkProtocol) OnRequestWillBeSentChan(
+	ctx context.Context,
+) <-chan *network.RequestWillBeSentEvent {
+	eventChan := make(chan *network.RequestWillBeSentEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnRequestWillBeSent(func(event *network.RequestWillBeSentEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnResponseReceived adds a handler to the Network.responseReceived event. Fired
+when HTTP response is available.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-responseReceived
+*/
+func (protocol *NetworkProtocol) OnResponseReceived(
+	callback func(event *network.ResponseReceivedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.responseReceived",
+		func(response *Response) {
+			event := &network.ResponseReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnResponseReceivedChan returns a channel of Network.responseReceived events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-responseReceived
+*/
+func (protocol *NetworkProtocol) OnResponseReceivedChan(
+	ctx context.Context,
+) <-chan *network.ResponseReceivedEvent {
+	eventChan := make(chan *network.ResponseReceivedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnResponseReceived(func(event *network.ResponseReceivedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnWebSocketClosed adds a handler to the Network.webSocketClosed event. Fired
+when WebSocket is closed.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketClosed
+*/
+func (protocol *NetworkProtocol) OnWebSocketClosed(
+	callback func(event *network.WebSocketClosedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.webSocketClosed",
+		func(response *Response) {
+			event := &network.WebSocketClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketClosedChan returns a channel of Network.webSocketClosed events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketClosed
+*/
+func (protocol *NetworkProtocol) OnWebSocketClosedChan(
+	ctx context.Context,
+) <-chan *network.WebSocketClosedEvent {
+	eventChan := make(chan *network.WebSocketClosedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebSocketClosed(func(event *network.WebSocketClosedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnWebSocketCreated adds a handler to the Network.webSocketCreated event. Fired
+upon WebSocket creation.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketCreated
+*/
+func (protocol *NetworkProtocol) OnWebSocketCreated(
+	callback func(event *network.WebSocketCreatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.webSocketCreated",
+		func(response *Response) {
+			event := &network.WebSocketCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketCreatedChan returns a channel of Network.webSocketCreated events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketCreated
+*/
+func (protocol *NetworkProtocol) OnWebSocketCreatedChan(
+	ctx context.Context,
+) <-chan *network.WebSocketCreatedEvent {
+	eventChan := make(chan *network.WebSocketCreatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebSocketCreated(func(event *network.WebSocketCreatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnWebSocketFrameError adds a handler to the Network.webSocketFrameError event.
+Fired when WebSocket message error occurs.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketFrameError
+*/
+func (protocol *NetworkProtocol) OnWebSocketFrameError(
+	callback func(event *network.WebSocketFrameErrorEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.webSocketFrameError",
+		func(response *Response) {
+			event := &network.WebSocketFrameErrorEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketFrameErrorChan returns a channel of Network.webSocketFrameError
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketFrameError
+*/
+func (protocol *NetworkProtocol) OnWebSocketFrameErrorChan(
+	ctx context.Context,
+) <-chan *network.WebSocketFrameErrorEvent {
+	eventChan := make(chan *network.WebSocketFrameErrorEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebSocketFrameError(func(event *network.WebSocketFrameErrorEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnWebSocketFrameReceived adds a handler to the Network.webSocketFrameReceived
+event. Fired when WebSocket message is received.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketFrameReceived
+*/
+func (protocol *NetworkProtocol) OnWebSocketFrameReceived(
+	callback func(event *network.WebSocketFrameReceivedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.webSocketFrameReceived",
+		func(response *Response) {
+			event := &network.WebSocketFrameReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketFrameReceivedChan returns a channel of Network.webSocketFrameReceived
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketFrameReceived
+*/
+func (protocol *NetworkProtocol) OnWebSocketFrameReceivedChan(
+	ctx context.Context,
+) <-chan *network.WebSocketFrameReceivedEvent {
+	eventChan := make(chan *network.WebSocketFrameReceivedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebSocketFrameReceived(func(event *network.WebSocketFrameReceivedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnWebSocketFrameSent adds a handler to the Network.webSocketFrameSent event.
+Fired when WebSocket message is sent.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketFrameSent
+*/
+func (protocol *NetworkProtocol) OnWebSocketFrameSent(
+	callback func(event *network.WebSocketFrameSentEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.webSocketFrameSent",
+		func(response *Response) {
+			event := &network.WebSocketFrameSentEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketFrameSentChan returns a channel of Network.webSocketFrameSent events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketFrameSent
+*/
+func (protocol *NetworkProtocol) OnWebSocketFrameSentChan(
+	ctx context.Context,
+) <-chan *network.WebSocketFrameSentEvent {
+	eventChan := make(chan *network.WebSocketFrameSentEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebSocketFrameSent(func(event *network.WebSocketFrameSentEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnWebSocketHandshakeResponseReceived adds a handler to the
+Network.webSocketHandshakeResponseReceived event. Fired when WebSocket handshake
+response becomes available.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketHandshakeResponseReceived
+*/
+func (protocol *NetworkProtocol) OnWebSocketHandshakeResponseReceived(
+	callback func(event *network.WebSocketHandshakeResponseReceivedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.webSocketHandshakeResponseReceived",
+		func(response *Response) {
+			event := &network.WebSocketHandshakeResponseReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketHandshakeResponseReceivedChan returns a channel of
+Network.webSocketHandshakeResponseReceived events. The event handler is removed
+and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketHandshakeResponseReceived
+*/
+func (protocol *NetworkProtocol) OnWebSocketHandshakeResponseReceivedChan(
+	ctx context.Context,
+) <-chan *network.WebSocketHandshakeResponseReceivedEvent {
+	eventChan := make(chan *network.WebSocketHandshakeResponseReceivedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebSocketHandshakeResponseReceived(func(event *network.WebSocketHandshakeResponseReceivedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnWebSocketWillSendHandshakeRequest adds a handler to the
+Network.webSocketWillSendHandshakeRequest event. Fired when WebSocket is about
+to initiate handshake.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketWillSendHandshakeRequest
+*/
+func (protocol *NetworkProtocol) OnWebSocketWillSendHandshakeRequest(
+	callback func(event *network.WebSocketWillSendHandshakeRequestEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.webSocketWillSendHandshakeRequest",
+		func(response *Response) {
+			event := &network.WebSocketWillSendHandshakeRequestEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketWillSendHandshakeRequestChan returns a channel of
+Network.webSocketWillSendHandshakeRequest events. The event handler is removed
+and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketWillSendHandshakeRequest
+*/
+func (protocol *NetworkProtocol) OnWebSocketWillSendHandshakeRequestChan(
+	ctx context.Context,
+) <-chan *network.WebSocketWillSendHandshakeRequestEvent {
+	eventChan := make(chan *network.WebSocketWillSendHandshakeRequestEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebSocketWillSendHandshakeRequest(func(event *network.WebSocketWillSendHandshakeRequestEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnWebTransportClosed adds a handler to the Network.webTransportClosed event.
+Fired when WebTransport is disposed.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webTransportClosed
+*/
+func (protocol *NetworkProtocol) OnWebTransportClosed(
+	callback func(event *network.WebTransportClosedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.webTransportClosed",
+		func(response *Response) {
+			event := &network.WebTransportClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebTransportClosedChan returns a channel of Network.webTransportClosed events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webTransportClosed
+*/
+func (protocol *NetworkProtocol) OnWebTransportClosedChan(
+	ctx context.Context,
+) <-chan *network.WebTransportClosedEvent {
+	eventChan := make(chan *network.WebTransportClosedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebTransportClosed(func(event *network.WebTransportClosedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnWebTransportConnectionEstablished adds a handler to the
+Network.webTransportConnectionEstablished event. Fired when WebTransport
+handshake is finished.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webTransportConnectionEstablished
+*/
+func (protocol *NetworkProtocol) OnWebTransportConnectionEstablished(
+	callback func(event *network.WebTransportConnectionEstablishedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.webTransportConnectionEstablished",
+		func(response *Response) {
+			event := &network.WebTransportConnectionEstablishedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebTransportConnectionEstablishedChan returns a channel of
+Network.webTransportConnectionEstablished events. The event handler is removed
+and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webTransportConnectionEstablished
+*/
+func (protocol *NetworkProtocol) OnWebTransportConnectionEstablishedChan(
+	ctx context.Context,
+) <-chan *network.WebTransportConnectionEstablishedEvent {
+	eventChan := make(chan *network.WebTransportConnectionEstablishedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebTransportConnectionEstablished(func(event *network.WebTransportConnectionEstablishedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnWebTransportCreated adds a handler to the Network.webTransportCreated event.
+Fired upon WebTransport creation.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webTransportCreated
+*/
+func (protocol *NetworkProtocol) OnWebTransportCreated(
+	callback func(event *network.WebTransportCreatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Network.webTransportCreated",
+		func(response *Response) {
+			event := &network.WebTransportCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWebTransportCreatedChan returns a channel of Network.webTransportCreated
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webTransportCreated
+*/
+func (protocol *NetworkProtocol) OnWebTransportCreatedChan(
+	ctx context.Context,
+) <-chan *network.WebTransportCreatedEvent {
+	eventChan := make(chan *network.WebTransportCreatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWebTransportCreated(func(event *network.WebTransportCreatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
@@ -0,0 +1,1652 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/v1_3/page"
+)
+
+/*
+PageProtocol provides a namespace for the Chrome Page protocol methods.
+
+Actions and events related to the inspected page belong to the page domain.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/
+*/
+type PageProtocol struct {
+	Socket Socketer
+}
+
+/*
+AddScriptToEvaluateOnNewDocument evaluates given script in every frame upon
+creation (before loading frame's scripts).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-addScriptToEvaluateOnNewDocument
+*/
+func (protocol *PageProtocol) AddScriptToEvaluateOnNewDocument(
+	params *page.AddScriptToEvaluateOnNewDocumentParams,
+) <-chan *page.AddScriptToEvaluateOnNewDocumentResult {
+	return protocol.AddScriptToEvaluateOnNewDocumentContext(context.Background(), params)
+}
+
+/*
+AddScriptToEvaluateOnNewDocumentContext performs
+AddScriptToEvaluateOnNewDocument and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-addScriptToEvaluateOnNewDocument
+*/
+func (protocol *PageProtocol) AddScriptToEvaluateOnNewDocumentContext(
+	ctx context.Context,
+	params *page.AddScriptToEvaluateOnNewDocumentParams,
+) <-chan *page.AddScriptToEvaluateOnNewDocumentResult {
+	resultChan := make(chan *page.AddScriptToEvaluateOnNewDocumentResult, 1)
+	command := NewCommand(protocol.Socket, "Page.addScriptToEvaluateOnNewDocument", params)
+	result := &page.AddScriptToEvaluateOnNewDocumentResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+BringToFront brings page to front (activates tab).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-bringToFront
+*/
+func (protocol *PageProtocol) BringToFront() <-chan *page.BringToFrontResult {
+	return protocol.BringToFrontContext(context.Background())
+}
+
+/*
+BringToFrontContext performs BringToFront and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-bringToFront
+*/
+func (protocol *PageProtocol) BringToFrontContext(
+	ctx context.Context,
+) <-chan *page.BringToFrontResult {
+	resultChan := make(chan *page.BringToFrontResult, 1)
+	command := NewCommand(protocol.Socket, "Page.bringToFront", nil)
+	result := &page.BringToFrontResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+CaptureScreenshot capture page screenshot.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-captureScreenshot
+*/
+func (protocol *PageProtocol) CaptureScreenshot(
+	params *page.CaptureScreenshotParams,
+) <-chan *page.CaptureScreenshotResult {
+	return protocol.CaptureScreenshotContext(context.Background(), params)
+}
+
+/*
+CaptureScreenshotContext performs CaptureScreenshot and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-captureScreenshot
+*/
+func (protocol *PageProtocol) CaptureScreenshotContext(
+	ctx context.Context,
+	params *page.CaptureScreenshotParams,
+) <-chan *page.CaptureScreenshotResult {
+	resultChan := make(chan *page.CaptureScreenshotResult, 1)
+	command := NewCommand(protocol.Socket, "Page.captureScreenshot", params)
+	result := &page.CaptureScreenshotResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+ClearGeolocationOverride clears the overridden Geolocation Position and Error.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-clearGeolocationOverride
+DEPRECATED.
+*/
+func (protocol *PageProtocol) ClearGeolocationOverride() <-chan *page.ClearGeolocationOverrideResult {
+	return protocol.ClearGeolocationOverrideContext(context.Background())
+}
+
+/*
+ClearGeolocationOverrideContext performs ClearGeolocationOverride and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-clearGeolocationOverride
+DEPRECATED.
+*/
+func (protocol *PageProtocol) ClearGeolocationOverrideContext(
+	ctx context.Context,
+) <-chan *page.ClearGeolocationOverrideResult {
+	resultChan := make(chan *page.ClearGeolocationOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Page.clearGeolocationOverride", nil)
+	result := &page.ClearGeolocationOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Close tries to close page, running its beforeunload hooks, if any.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-close
+*/
+func (protocol *PageProtocol) Close() <-chan *page.CloseResult {
+	return protocol.CloseContext(context.Background())
+}
+
+/*
+CloseContext performs Close and returns its result, or returns the context error
+if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-close
+*/
+func (protocol *PageProtocol) CloseContext(
+	ctx context.Context,
+) <-chan *page.CloseResult {
+	resultChan := make(chan *page.CloseResult, 1)
+	command := NewCommand(protocol.Socket, "Page.close", nil)
+	result := &page.CloseResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+CreateIsolatedWorld creates an isolated world for the given frame.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-createIsolatedWorld
+*/
+func (protocol *PageProtocol) CreateIsolatedWorld(
+	params *page.CreateIsolatedWorldParams,
+) <-chan *page.CreateIsolatedWorldResult {
+	return protocol.CreateIsolatedWorldContext(context.Background(), params)
+}
+
+/*
+CreateIsolatedWorldContext performs CreateIsolatedWorld and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-createIsolatedWorld
+*/
+func (protocol *PageProtocol) CreateIsolatedWorldContext(
+	ctx context.Context,
+	params *page.CreateIsolatedWorldParams,
+) <-chan *page.CreateIsolatedWorldResult {
+	resultChan := make(chan *page.CreateIsolatedWorldResult, 1)
+	command := NewCommand(protocol.Socket, "Page.createIsolatedWorld", params)
+	result := &page.CreateIsolatedWorldResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disable disables page domain notifications.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-disable
+*/
+func (protocol *PageProtocol) Disable() <-chan *page.DisableResult {
+	return protocol.DisableContext(context.Background())
+}
+
+/*
+DisableContext performs Disable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-disable
+*/
+func (protocol *PageProtocol) DisableContext(
+	ctx context.Context,
+) <-chan *page.DisableResult {
+	resultChan := make(chan *page.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Page.disable", nil)
+	result := &page.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enable enables page domain notifications.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-enable
+*/
+func (protocol *PageProtocol) Enable() <-chan *page.EnableResult {
+	return protocol.EnableContext(context.Background())
+}
+
+/*
+EnableContext performs Enable and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-enable
+*/
+func (protocol *PageProtocol) EnableContext(
+	ctx context.Context,
+) <-chan *page.EnableResult {
+	resultChan := make(chan *page.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Page.enable", nil)
+	result := &page.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetAppManifest gets the processed manifest for this current document. This API
+always waits for the manifest to be loaded. If manifestId is provided, and it
+does not match the manifest of the current document, this API errors out. If
+there is not a loaded page, this API errors out immediately.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getAppManifest
+*/
+func (protocol *PageProtocol) GetAppManifest(
+	params *page.GetAppManifestParams,
+) <-chan *page.GetAppManifestResult {
+	return protocol.GetAppManifestContext(context.Background(), params)
+}
+
+/*
+GetAppManifestContext performs GetAppManifest and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getAppManifest
+*/
+func (protocol *PageProtocol) GetAppManifestContext(
+	ctx context.Context,
+	params *page.GetAppManifestParams,
+) <-chan *page.GetAppManifestResult {
+	resultChan := make(chan *page.GetAppManifestResult, 1)
+	command := NewCommand(protocol.Socket, "Page.getAppManifest", params)
+	result := &page.GetAppManifestResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetFrameTree returns present frame tree structure.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getFrameTree
+*/
+func (protocol *PageProtocol) GetFrameTree() <-chan *page.GetFrameTreeResult {
+	return protocol.GetFrameTreeContext(context.Background())
+}
+
+/*
+GetFrameTreeContext performs GetFrameTree and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getFrameTree
+*/
+func (protocol *PageProtocol) GetFrameTreeContext(
+	ctx context.Context,
+) <-chan *page.GetFrameTreeResult {
+	resultChan := make(chan *page.GetFrameTreeResult, 1)
+	command := NewCommand(protocol.Socket, "Page.getFrameTree", nil)
+	result := &page.GetFrameTreeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetLayoutMetrics returns metrics relating to the layouting of the page, such as
+viewport bounds/scale.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getLayoutMetrics
+*/
+func (protocol *PageProtocol) GetLayoutMetrics() <-chan *page.GetLayoutMetricsResult {
+	return protocol.GetLayoutMetricsContext(context.Background())
+}
+
+/*
+GetLayoutMetricsContext performs GetLayoutMetrics and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getLayoutMetrics
+*/
+func (protocol *PageProtocol) GetLayoutMetricsContext(
+	ctx context.Context,
+) <-chan *page.GetLayoutMetricsResult {
+	resultChan := make(chan *page.GetLayoutMetricsResult, 1)
+	command := NewCommand(protocol.Socket, "Page.getLayoutMetrics", nil)
+	result := &page.GetLayoutMetricsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetNavigationHistory returns navigation history for the current page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getNavigationHistory
+*/
+func (protocol *PageProtocol) GetNavigationHistory() <-chan *page.GetNavigationHistoryResult {
+	return protocol.GetNavigationHistoryContext(context.Background())
+}
+
+/*
+GetNavigationHistoryContext performs GetNavigationHistory and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getNavigationHistory
+*/
+func (protocol *PageProtocol) GetNavigationHistoryContext(
+	ctx context.Context,
+) <-chan *page.GetNavigationHistoryResult {
+	resultChan := make(chan *page.GetNavigationHistoryResult, 1)
+	command := NewCommand(protocol.Socket, "Page.getNavigationHistory", nil)
+	result := &page.GetNavigationHistoryResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+HandleJavaScriptDialog accepts or dismisses a JavaScript initiated dialog
+(alert, confirm, prompt, or onbeforeunload).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-handleJavaScriptDialog
+*/
+func (protocol *PageProtocol) HandleJavaScriptDialog(
+	params *page.HandleJavaScriptDialogParams,
+) <-chan *page.HandleJavaScriptDialogResult {
+	return protocol.HandleJavaScriptDialogContext(context.Background(), params)
+}
+
+/*
+HandleJavaScriptDialogContext performs HandleJavaScriptDialog and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-handleJavaScriptDialog
+*/
+func (protocol *PageProtocol) HandleJavaScriptDialogContext(
+	ctx context.Context,
+	params *page.HandleJavaScriptDialogParams,
+) <-chan *page.HandleJavaScriptDialogResult {
+	resultChan := make(chan *page.HandleJavaScriptDialogResult, 1)
+	command := NewCommand(protocol.Socket, "Page.handleJavaScriptDialog", params)
+	result := &page.HandleJavaScriptDialogResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Navigate navigates current page to the given URL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-navigate
+*/
+func (protocol *PageProtocol) Navigate(
+	params *page.NavigateParams,
+) <-chan *page.NavigateResult {
+	return protocol.NavigateContext(context.Background(), params)
+}
+
+/*
+NavigateContext performs Navigate and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-navigate
+*/
+func (protocol *PageProtocol) NavigateContext(
+	ctx context.Context,
+	params *page.NavigateParams,
+) <-chan *page.NavigateResult {
+	resultChan := make(chan *page.NavigateResult, 1)
+	command := NewCommand(protocol.Socket, "Page.navigate", params)
+	result := &page.NavigateResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+NavigateToHistoryEntry navigates current page to the given history entry.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-navigateToHistoryEntry
+*/
+func (protocol *PageProtocol) NavigateToHistoryEntry(
+	params *page.NavigateToHistoryEntryParams,
+) <-chan *page.NavigateToHistoryEntryResult {
+	return protocol.NavigateToHistoryEntryContext(context.Background(), params)
+}
+
+/*
+NavigateToHistoryEntryContext performs NavigateToHistoryEntry and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-navigateToHistoryEntry
+*/
+func (protocol *PageProtocol) NavigateToHistoryEntryContext(
+	ctx context.Context,
+	params *page.NavigateToHistoryEntryParams,
+) <-chan *page.NavigateToHistoryEntryResult {
+	resultChan := make(chan *page.NavigateToHistoryEntryResult, 1)
+	command := NewCommand(protocol.Socket, "Page.navigateToHistoryEntry", params)
+	result := &page.NavigateToHistoryEntryResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+PrintToPDF print page as PDF.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-printToPDF
+*/
+func (protocol *PageProtocol) PrintToPDF(
+	params *page.PrintToPDFParams,
+) <-chan *page.PrintToPDFResult {
+	return protocol.PrintToPDFContext(context.Background(), params)
+}
+
+/*
+PrintToPDFContext performs PrintToPDF and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-printToPDF
+*/
+func (protocol *PageProtocol) PrintToPDFContext(
+	ctx context.Context,
+	params *page.PrintToPDFParams,
+) <-chan *page.PrintToPDFResult {
+	resultChan := make(chan *page.PrintToPDFResult, 1)
+	command := NewCommand(protocol.Socket, "Page.printToPDF", params)
+	result := &page.PrintToPDFResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Reload reloads given page optionally ignoring the cache.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-reload
+*/
+func (protocol *PageProtocol) Reload(
+	params *page.ReloadParams,
+) <-chan *page.ReloadResult {
+	return protocol.ReloadContext(context.Background(), params)
+}
+
+/*
+ReloadContext performs Reload and returns its result, or returns the context
+error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-reload
+*/
+func (protocol *PageProtocol) ReloadContext(
+	ctx context.Context,
+	params *page.ReloadParams,
+) <-chan *page.ReloadResult {
+	resultChan := make(chan *page.ReloadResult, 1)
+	command := NewCommand(protocol.Socket, "Page.reload", params)
+	result := &page.ReloadResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+RemoveScriptToEvaluateOnNewDocument removes given script from the list.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-removeScriptToEvaluateOnNewDocument
+*/
+func (protocol *PageProtocol) RemoveScriptToEvaluateOnNewDocument(
+	params *page.RemoveScriptToEvaluateOnNewDocumentParams,
+) <-chan *page.RemoveScriptToEvaluateOnNewDocumentResult {
+	return protocol.RemoveScriptToEvaluateOnNewDocumentContext(context.Background(), params)
+}
+
+/*
+RemoveScriptToEvaluateOnNewDocumentContext performs
+RemoveScriptToEvaluateOnNewDocument and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-removeScriptToEvaluateOnNewDocument
+*/
+func (protocol *PageProtocol) RemoveScriptToEvaluateOnNewDocumentContext(
+	ctx context.Context,
+	params *page.RemoveScriptToEvaluateOnNewDocumentParams,
+) <-chan *page.RemoveScriptToEvaluateOnNewDocumentResult {
+	resultChan := make(chan *page.RemoveScriptToEvaluateOnNewDocumentResult, 1)
+	command := NewCommand(protocol.Socket, "Page.removeScriptToEvaluateOnNewDocument", params)
+	result := &page.RemoveScriptToEvaluateOnNewDocumentResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+ResetNavigationHistory resets navigation history for the current page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-resetNavigationHistory
+*/
+func (protocol *PageProtocol) ResetNavigationHistory() <-chan *page.ResetNavigationHistoryResult {
+	return protocol.ResetNavigationHistoryContext(context.Background())
+}
+
+/*
+ResetNavigationHistoryContext performs ResetNavigationHistory and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-resetNavigationHistory
+*/
+func (protocol *PageProtocol) ResetNavigationHistoryContext(
+	ctx context.Context,
+) <-chan *page.ResetNavigationHistoryResult {
+	resultChan := make(chan *page.ResetNavigationHistoryResult, 1)
+	command := NewCommand(protocol.Socket, "Page.resetNavigationHistory", nil)
+	result := &page.ResetNavigationHistoryResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetBypassCSP enable page Content Security Policy by-passing.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setBypassCSP
+*/
+func (protocol *PageProtocol) SetBypassCSP(
+	params *page.SetBypassCSPParams,
+) <-chan *page.SetBypassCSPResult {
+	return protocol.SetBypassCSPContext(context.Background(), params)
+}
+
+/*
+SetBypassCSPContext performs SetBypassCSP and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setBypassCSP
+*/
+func (protocol *PageProtocol) SetBypassCSPContext(
+	ctx context.Context,
+	params *page.SetBypassCSPParams,
+) <-chan *page.SetBypassCSPResult {
+	resultChan := make(chan *page.SetBypassCSPResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setBypassCSP", params)
+	result := &page.SetBypassCSPResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetDocumentContent sets given markup as the document's HTML.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setDocumentContent
+*/
+func (protocol *PageProtocol) SetDocumentContent(
+	params *page.SetDocumentContentParams,
+) <-chan *page.SetDocumentContentResult {
+	return protocol.SetDocumentContentContext(context.Background(), params)
+}
+
+/*
+SetDocumentContentContext performs SetDocumentContent and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setDocumentContent
+*/
+func (protocol *PageProtocol) SetDocumentContentContext(
+	ctx context.Context,
+	params *page.SetDocumentContentParams,
+) <-chan *page.SetDocumentContentResult {
+	resultChan := make(chan *page.SetDocumentContentResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setDocumentContent", params)
+	result := &page.SetDocumentContentResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetGeolocationOverride overrides the Geolocation Position or Error. Omitting any
+of the parameters emulates position unavailable.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setGeolocationOverride
+DEPRECATED.
+*/
+func (protocol *PageProtocol) SetGeolocationOverride(
+	params *page.SetGeolocationOverrideParams,
+) <-chan *page.SetGeolocationOverrideResult {
+	return protocol.SetGeolocationOverrideContext(context.Background(), params)
+}
+
+/*
+SetGeolocationOverrideContext performs SetGeolocationOverride and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setGeolocationOverride
+DEPRECATED.
+*/
+func (protocol *PageProtocol) SetGeolocationOverrideContext(
+	ctx context.Context,
+	params *page.SetGeolocationOverrideParams,
+) <-chan *page.SetGeolocationOverrideResult {
+	resultChan := make(chan *page.SetGeolocationOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setGeolocationOverride", params)
+	result := &page.SetGeolocationOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetInterceptFileChooserDialog intercept file chooser requests and transfer
+control to protocol clients. When file chooser interception is enabled, native
+file chooser dialog is not shown. Instead, a protocol event
+`Page.fileChooserOpened` is emitted.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setInterceptFileChooserDialog
+*/
+func (protocol *PageProtocol) SetInterceptFileChooserDialog(
+	params *page.SetInterceptFileChooserDialogParams,
+) <-chan *page.SetInterceptFileChooserDialogResult {
+	return protocol.SetInterceptFileChooserDialogContext(context.Background(), params)
+}
+
+/*
+SetInterceptFileChooserDialogContext performs SetInterceptFileChooserDialog and
+returns its result, or returns the context error if ctx is cancelled or times
+out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setInterceptFileChooserDialog
+*/
+func (protocol *PageProtocol) SetInterceptFileChooserDialogContext(
+	ctx context.Context,
+	params *page.SetInterceptFileChooserDialogParams,
+) <-chan *page.SetInterceptFileChooserDialogResult {
+	resultChan := make(chan *page.SetInterceptFileChooserDialogResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setInterceptFileChooserDialog", params)
+	result := &page.SetInterceptFileChooserDialogResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetLifecycleEventsEnabled controls whether page will emit lifecycle events.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setLifecycleEventsEnabled
+*/
+func (protocol *PageProtocol) SetLifecycleEventsEnabled(
+	params *page.SetLifecycleEventsEnabledParams,
+) <-chan *page.SetLifecycleEventsEnabledResult {
+	return protocol.SetLifecycleEventsEnabledContext(context.Background(), params)
+}
+
+/*
+SetLifecycleEventsEnabledContext performs SetLifecycleEventsEnabled and returns
+its result, or returns the context error if ctx is cancelled or times out before
+a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setLifecycleEventsEnabled
+*/
+func (protocol *PageProtocol) SetLifecycleEventsEnabledContext(
+	ctx context.Context,
+	params *page.SetLifecycleEventsEnabledParams,
+) <-chan *page.SetLifecycleEventsEnabledResult {
+	resultChan := make(chan *page.SetLifecycleEventsEnabledResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setLifecycleEventsEnabled", params)
+	result := &page.SetLifecycleEventsEnabledResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+StopLoading force the page stop all navigations and pending resource fetches.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-stopLoading
+*/
+func (protocol *PageProtocol) StopLoading() <-chan *page.StopLoadingResult {
+	return protocol.StopLoadingContext(context.Background())
+}
+
+/*
+StopLoadingContext performs StopLoading and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-stopLoading
+*/
+func (protocol *PageProtocol) StopLoadingContext(
+	ctx context.Context,
+) <-chan *page.StopLoadingResult {
+	resultChan := make(chan *page.StopLoadingResult, 1)
+	command := NewCommand(protocol.Socket, "Page.stopLoading", nil)
+	result := &page.StopLoadingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnDOMContentEventFired adds a handler to the Page.domContentEventFired event.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-domContentEventFired
+*/
+func (protocol *PageProtocol) OnDOMContentEventFired(
+	callback func(event *page.DOMContentEventFiredEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.domContentEventFired",
+		func(response *Response) {
+			event := &page.DOMContentEventFiredEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnDOMContentEventFiredChan returns a channel of Page.domContentEventFired
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-domContentEventFired
+*/
+func (protocol *PageProtocol) OnDOMContentEventFiredChan(
+	ctx context.Context,
+) <-chan *page.DOMContentEventFiredEvent {
+	eventChan := make(chan *page.DOMContentEventFiredEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnDOMContentEventFired(func(event *page.DOMContentEventFiredEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnFileChooserOpened adds a handler to the Page.fileChooserOpened event. Emitted
+only when `page.interceptFileChooser` is enabled.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-fileChooserOpened
+*/
+func (protocol *PageProtocol) OnFileChooserOpened(
+	callback func(event *page.FileChooserOpenedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.fileChooserOpened",
+		func(response *Response) {
+			event := &page.FileChooserOpenedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnFileChooserOpenedChan returns a channel of Page.fileChooserOpened events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-fileChooserOpened
+*/
+func (protocol *PageProtocol) OnFileChooserOpenedChan(
+	ctx context.Context,
+) <-chan *page.FileChooserOpenedEvent {
+	eventChan := make(chan *page.FileChooserOpenedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnFileChooserOpened(func(event *page.FileChooserOpenedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnFrameAttached adds a handler to the Page.frameAttached event. Fired when frame
+has been attached to its parent.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-frameAttached
+*/
+func (protocol *PageProtocol) OnFrameAttached(
+	callback func(event *page.FrameAttachedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameAttached",
+		func(response *Response) {
+			event := &page.FrameAttachedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnFrameAttachedChan returns a channel of Page.frameAttached events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-frameAttached
+*/
+func (protocol *PageProtocol) OnFrameAttachedChan(
+	ctx context.Context,
+) <-chan *page.FrameAttachedEvent {
+	eventChan := make(chan *page.FrameAttachedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnFrameAttached(func(event *page.FrameAttachedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnFrameClearedScheduledNavigation adds a handler to the
+Page.frameClearedScheduledNavigation event. Fired when frame no longer has a
+scheduled navigation.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-frameClearedScheduledNavigation
+DEPRECATED.
+*/
+func (protocol *PageProtocol) OnFrameClearedScheduledNavigation(
+	callback func(event *page.FrameClearedScheduledNavigationEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameClearedScheduledNavigation",
+		func(response *Response) {
+			event := &page.FrameClearedScheduledNavigationEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnFrameClearedScheduledNavigationChan returns a channel of
+Page.frameClearedScheduledNavigation events. The event handler is removed and
+the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-frameClearedScheduledNavigation
+DEPRECATED.
+*/
+func (protocol *PageProtocol) OnFrameClearedScheduledNavigationChan(
+	ctx context.Context,
+) <-chan *page.FrameClearedScheduledNavigationEvent {
+	eventChan := make(chan *page.FrameClearedScheduledNavigationEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnFrameClearedScheduledNavigation(func(event *page.FrameClearedScheduledNavigationEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnFrameDetached adds a handler to the Page.frameDetached event. Fired when frame
+has been detached from its parent.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-frameDetached
+*/
+func (protocol *PageProtocol) OnFrameDetached(
+	callback func(event *page.FrameDetachedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameDetached",
+		func(response *Response) {
+			event := &page.FrameDetachedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnFrameDetachedChan returns a channel of Page.frameDetached events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-frameDetached
+*/
+func (protocol *PageProtocol) OnFrameDetachedChan(
+	ctx context.Context,
+) <-chan *page.FrameDetachedEvent {
+	eventChan := make(chan *page.FrameDetachedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnFrameDetached(func(event *page.FrameDetachedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnFrameNavigated adds a handler to the Page.frameNavigated event. Fired once
+navigation of the frame has completed. Frame is now associated with the new
+loader.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-frameNavigated
+*/
+func (protocol *PageProtocol) OnFrameNavigated(
+	callback func(event *page.FrameNavigatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameNavigated",
+		func(response *Response) {
+			event := &page.FrameNavigatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnFrameNavigatedChan returns a channel of Page.frameNavigated events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-frameNavigated
+*/
+func (protocol *PageProtocol) OnFrameNavigatedChan(
+	ctx context.Context,
+) <-chan *page.FrameNavigatedEvent {
+	eventChan := make(chan *page.FrameNavigatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnFrameNavigated(func(event *page.FrameNavigatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnFrameScheduledNavigation adds a handler to the Page.frameScheduledNavigation
+event. Fired when frame schedules a potential navigation.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-frameScheduledNavigation
+DEPRECATED.
+*/
+func (protocol *PageProtocol) OnFrameScheduledNavigation(
+	callback func(event *page.FrameScheduledNavigationEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.frameScheduledNavigation",
+		func(response *Response) {
+			event := &page.FrameScheduledNavigationEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnFrameScheduledNavigationChan returns a channel of
+Page.frameScheduledNavigation events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-frameScheduledNavigation
+DEPRECATED.
+*/
+func (protocol *PageProtocol) OnFrameScheduledNavigationChan(
+	ctx context.Context,
+) <-chan *page.FrameScheduledNavigationEvent {
+	eventChan := make(chan *page.FrameScheduledNavigationEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnFrameScheduledNavigation(func(event *page.FrameScheduledNavigationEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnInterstitialHidden adds a handler to the Page.interstitialHidden event. Fired
+when interstitial page was hidden.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-interstitialHidden
+*/
+func (protocol *PageProtocol) OnInterstitialHidden(
+	callback func(event *page.InterstitialHiddenEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.interstitialHidden",
+		func(response *Response) {
+			event := &page.InterstitialHiddenEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnInterstitialHiddenChan returns a channel of Page.interstitialHidden events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-interstitialHidden
+*/
+func (protocol *PageProtocol) OnInterstitialHiddenChan(
+	ctx context.Context,
+) <-chan *page.InterstitialHiddenEvent {
+	eventChan := make(chan *page.InterstitialHiddenEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnInterstitialHidden(func(event *page.InterstitialHiddenEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnInterstitialShown adds a handler to the Page.interstitialShown event. Fired
+when interstitial page was shown.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-interstitialShown
+*/
+func (protocol *PageProtocol) OnInterstitialShown(
+	callback func(event *page.InterstitialShownEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.interstitialShown",
+		func(response *Response) {
+			event := &page.InterstitialShownEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnInterstitialShownChan returns a channel of Page.interstitialShown events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-interstitialShown
+*/
+func (protocol *PageProtocol) OnInterstitialShownChan(
+	ctx context.Context,
+) <-chan *page.InterstitialShownEvent {
+	eventChan := make(chan *page.InterstitialShownEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnInterstitialShown(func(event *page.InterstitialShownEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnJavascriptDialogClosed adds a handler to the Page.javascriptDialogClosed
+event. Fired when a JavaScript initiated dialog (alert, confirm, prompt, or
+onbeforeunload) has been closed.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-javascriptDialogClosed
+*/
+func (protocol *PageProtocol) OnJavascriptDialogClosed(
+	callback func(event *page.JavascriptDialogClosedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.javascriptDialogClosed",
+		func(response *Response) {
+			event := &page.JavascriptDialogClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnJavascriptDialogClosedChan returns a channel of Page.javascriptDialogClosed
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-javascriptDialogClosed
+*/
+func (protocol *PageProtocol) OnJavascriptDialogClosedChan(
+	ctx context.Context,
+) <-chan *page.JavascriptDialogClosedEvent {
+	eventChan := make(chan *page.JavascriptDialogClosedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnJavascriptDialogClosed(func(event *page.JavascriptDialogClosedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnJavascriptDialogOpening adds a handler to the Page.javascriptDialogOpening
+event. Fired when a JavaScript initiated dialog (alert, confirm, prompt, or
+onbeforeunload) is about to open.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-javascriptDialogOpening
+*/
+func (protocol *PageProtocol) OnJavascriptDialogOpening(
+	callback func(event *page.JavascriptDialogOpeningEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.javascriptDialogOpening",
+		func(response *Response) {
+			event := &page.JavascriptDialogOpeningEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnJavascriptDialogOpeningChan returns a channel of Page.javascriptDialogOpening
+events. The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-javascriptDialogOpening
+*/
+func (protocol *PageProtocol) OnJavascriptDialogOpeningChan(
+	ctx context.Context,
+) <-chan *page.JavascriptDialogOpeningEvent {
+	eventChan := make(chan *page.JavascriptDialogOpeningEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnJavascriptDialogOpening(func(event *page.JavascriptDialogOpeningEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnLifecycleEvent adds a handler to the Page.lifecycleEvent event. Fired for
+lifecycle events (navigation, load, paint, etc) in the current target (including
+local frames).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-lifecycleEvent
+*/
+func (protocol *PageProtocol) OnLifecycleEvent(
+	callback func(event *page.LifecycleEventEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.lifecycleEvent",
+		func(response *Response) {
+			event := &page.LifecycleEventEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnLifecycleEventChan returns a channel of Page.lifecycleEvent events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-lifecycleEvent
+*/
+func (protocol *PageProtocol) OnLifecycleEventChan(
+	ctx context.Context,
+) <-chan *page.LifecycleEventEvent {
+	eventChan := make(chan *page.LifecycleEventEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnLifecycleEvent(func(event *page.LifecycleEventEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnLoadEventFired adds a handler to the Page.loadEventFired event.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-loadEventFired
+*/
+func (protocol *PageProtocol) OnLoadEventFired(
+	callback func(event *page.LoadEventFiredEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.loadEventFired",
+		func(response *Response) {
+			event := &page.LoadEventFiredEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnLoadEventFiredChan returns a channel of Page.loadEventFired events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-loadEventFired
+*/
+func (protocol *PageProtocol) OnLoadEventFiredChan(
+	ctx context.Context,
+) <-chan *page.LoadEventFiredEvent {
+	eventChan := make(chan *page.LoadEventFiredEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnLoadEventFired(func(event *page.LoadEventFiredEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnWindowOpen adds a handler to the Page.windowOpen event. Fired when a new
+window is going to be opened, via window.open(), link click, form submission,
+etc.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-windowOpen
+*/
+func (protocol *PageProtocol) OnWindowOpen(
+	callback func(event *page.WindowOpenEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Page.windowOpen",
+		func(response *Response) {
+			event := &page.WindowOpenEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnWindowOpenChan returns a channel of Page.windowOpen events. The event handler
+is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-windowOpen
+*/
+func (protocol *PageProtocol) OnWindowOpenChan(
+	ctx context.Context,
+) <-chan *page.WindowOpenEvent {
+	eventChan := make(chan *page.WindowOpenEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnWindowOpen(func(event *page.WindowOpenEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
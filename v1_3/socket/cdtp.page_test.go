@@ -0,0 +1,1520 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/v1_3/page"
+)
+
+func TestPageAddScriptToEvaluateOnNewDocument(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageAddScriptToEvaluateOnNewDocument")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.AddScriptToEvaluateOnNewDocumentResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().AddScriptToEvaluateOnNewDocument(&page.AddScriptToEvaluateOnNewDocumentParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().AddScriptToEvaluateOnNewDocument(&page.AddScriptToEvaluateOnNewDocumentParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageBringToFront(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageBringToFront")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.BringToFrontResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().BringToFront()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().BringToFront()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageCaptureScreenshot(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageCaptureScreenshot")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.CaptureScreenshotResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().CaptureScreenshot(&page.CaptureScreenshotParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().CaptureScreenshot(&page.CaptureScreenshotParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageClearGeolocationOverride(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageClearGeolocationOverride")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.ClearGeolocationOverrideResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().ClearGeolocationOverride()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().ClearGeolocationOverride()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageClose(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageClose")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.CloseResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().Close()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().Close()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageCreateIsolatedWorld(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageCreateIsolatedWorld")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.CreateIsolatedWorldResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().CreateIsolatedWorld(&page.CreateIsolatedWorldParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().CreateIsolatedWorld(&page.CreateIsolatedWorldParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageDisable(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageDisable")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.DisableResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().Disable()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().Disable()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageEnable(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageEnable")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.EnableResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().Enable()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().Enable()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageGetAppManifest(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageGetAppManifest")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.GetAppManifestResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().GetAppManifest(&page.GetAppManifestParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().GetAppManifest(&page.GetAppManifestParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageGetFrameTree(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageGetFrameTree")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.GetFrameTreeResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().GetFrameTree()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().GetFrameTree()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageGetLayoutMetrics(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageGetLayoutMetrics")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.GetLayoutMetricsResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().GetLayoutMetrics()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().GetLayoutMetrics()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageGetNavigationHistory(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageGetNavigationHistory")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.GetNavigationHistoryResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().GetNavigationHistory()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().GetNavigationHistory()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageHandleJavaScriptDialog(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageHandleJavaScriptDialog")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.HandleJavaScriptDialogResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().HandleJavaScriptDialog(&page.HandleJavaScriptDialogParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().HandleJavaScriptDialog(&page.HandleJavaScriptDialogParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageNavigate(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageNavigate")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.NavigateResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().Navigate(&page.NavigateParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().Navigate(&page.NavigateParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageNavigateToHistoryEntry(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageNavigateToHistoryEntry")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.NavigateToHistoryEntryResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().NavigateToHistoryEntry(&page.NavigateToHistoryEntryParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().NavigateToHistoryEntry(&page.NavigateToHistoryEntryParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPagePrintToPDF(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPagePrintToPDF")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.PrintToPDFResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().PrintToPDF(&page.PrintToPDFParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().PrintToPDF(&page.PrintToPDFParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageReload(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageReload")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.ReloadResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().Reload(&page.ReloadParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().Reload(&page.ReloadParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageRemoveScriptToEvaluateOnNewDocument(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageRemoveScriptToEvaluateOnNewDocument")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.RemoveScriptToEvaluateOnNewDocumentResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().RemoveScriptToEvaluateOnNewDocument(&page.RemoveScriptToEvaluateOnNewDocumentParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().RemoveScriptToEvaluateOnNewDocument(&page.RemoveScriptToEvaluateOnNewDocumentParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageResetNavigationHistory(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageResetNavigationHistory")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.ResetNavigationHistoryResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().ResetNavigationHistory()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().ResetNavigationHistory()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageSetBypassCSP(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageSetBypassCSP")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.SetBypassCSPResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().SetBypassCSP(&page.SetBypassCSPParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().SetBypassCSP(&page.SetBypassCSPParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageSetDocumentContent(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageSetDocumentContent")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.SetDocumentContentResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().SetDocumentContent(&page.SetDocumentContentParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().SetDocumentContent(&page.SetDocumentContentParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageSetGeolocationOverride(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageSetGeolocationOverride")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.SetGeolocationOverrideResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().SetGeolocationOverride(&page.SetGeolocationOverrideParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().SetGeolocationOverride(&page.SetGeolocationOverrideParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageSetInterceptFileChooserDialog(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageSetInterceptFileChooserDialog")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.SetInterceptFileChooserDialogResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().SetInterceptFileChooserDialog(&page.SetInterceptFileChooserDialogParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().SetInterceptFileChooserDialog(&page.SetInterceptFileChooserDialogParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageSetLifecycleEventsEnabled(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageSetLifecycleEventsEnabled")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.SetLifecycleEventsEnabledResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().SetLifecycleEventsEnabled(&page.SetLifecycleEventsEnabledParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().SetLifecycleEventsEnabled(&page.SetLifecycleEventsEnabledParams{})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageStopLoading(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageStopLoading")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	mockResult := &page.StopLoadingResult{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	resultChan := mockSocket.Page().StopLoading()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     mockSocket.CurCommandID(),
+		Error:  &Error{},
+		Result: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+
+	resultChan = mockSocket.Page().StopLoading()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: mockSocket.CurCommandID(),
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+	})
+	result = <-resultChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
+func TestPageOnDOMContentEventFired(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageOnDOMContentEventFired")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *page.DOMContentEventFiredEvent)
+	sub := mockSocket.Page().OnDOMContentEventFired(func(eventData *page.DOMContentEventFiredEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &page.DOMContentEventFiredEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.domContentEventFired",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.Page().OnDOMContentEventFiredChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "Page.domContentEventFired",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestPageOnFileChooserOpened(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageOnFileChooserOpened")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *page.FileChooserOpenedEvent)
+	sub := mockSocket.Page().OnFileChooserOpened(func(eventData *page.FileChooserOpenedEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &page.FileChooserOpenedEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.fileChooserOpened",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.Page().OnFileChooserOpenedChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "Page.fileChooserOpened",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestPageOnFrameAttached(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageOnFrameAttached")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *page.FrameAttachedEvent)
+	sub := mockSocket.Page().OnFrameAttached(func(eventData *page.FrameAttachedEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &page.FrameAttachedEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.frameAttached",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.Page().OnFrameAttachedChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "Page.frameAttached",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestPageOnFrameClearedScheduledNavigation(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageOnFrameClearedScheduledNavigation")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *page.FrameClearedScheduledNavigationEvent)
+	sub := mockSocket.Page().OnFrameClearedScheduledNavigation(func(eventData *page.FrameClearedScheduledNavigationEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &page.FrameClearedScheduledNavigationEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.frameClearedScheduledNavigation",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.Page().OnFrameClearedScheduledNavigationChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "Page.frameClearedScheduledNavigation",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestPageOnFrameDetached(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageOnFrameDetached")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *page.FrameDetachedEvent)
+	sub := mockSocket.Page().OnFrameDetached(func(eventData *page.FrameDetachedEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &page.FrameDetachedEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.frameDetached",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.Page().OnFrameDetachedChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "Page.frameDetached",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestPageOnFrameNavigated(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageOnFrameNavigated")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *page.FrameNavigatedEvent)
+	sub := mockSocket.Page().OnFrameNavigated(func(eventData *page.FrameNavigatedEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &page.FrameNavigatedEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.frameNavigated",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.Page().OnFrameNavigatedChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "Page.frameNavigated",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestPageOnFrameScheduledNavigation(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageOnFrameScheduledNavigation")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *page.FrameScheduledNavigationEvent)
+	sub := mockSocket.Page().OnFrameScheduledNavigation(func(eventData *page.FrameScheduledNavigationEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &page.FrameScheduledNavigationEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.frameScheduledNavigation",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.Page().OnFrameScheduledNavigationChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "Page.frameScheduledNavigation",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestPageOnInterstitialHidden(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageOnInterstitialHidden")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *page.InterstitialHiddenEvent)
+	sub := mockSocket.Page().OnInterstitialHidden(func(eventData *page.InterstitialHiddenEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &page.InterstitialHiddenEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.interstitialHidden",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.Page().OnInterstitialHiddenChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "Page.interstitialHidden",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestPageOnInterstitialShown(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageOnInterstitialShown")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *page.InterstitialShownEvent)
+	sub := mockSocket.Page().OnInterstitialShown(func(eventData *page.InterstitialShownEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &page.InterstitialShownEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.interstitialShown",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.Page().OnInterstitialShownChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "Page.interstitialShown",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestPageOnJavascriptDialogClosed(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageOnJavascriptDialogClosed")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *page.JavascriptDialogClosedEvent)
+	sub := mockSocket.Page().OnJavascriptDialogClosed(func(eventData *page.JavascriptDialogClosedEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &page.JavascriptDialogClosedEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.javascriptDialogClosed",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.Page().OnJavascriptDialogClosedChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "Page.javascriptDialogClosed",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestPageOnJavascriptDialogOpening(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageOnJavascriptDialogOpening")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *page.JavascriptDialogOpeningEvent)
+	sub := mockSocket.Page().OnJavascriptDialogOpening(func(eventData *page.JavascriptDialogOpeningEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &page.JavascriptDialogOpeningEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.javascriptDialogOpening",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.Page().OnJavascriptDialogOpeningChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "Page.javascriptDialogOpening",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestPageOnLifecycleEvent(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageOnLifecycleEvent")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *page.LifecycleEventEvent)
+	sub := mockSocket.Page().OnLifecycleEvent(func(eventData *page.LifecycleEventEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &page.LifecycleEventEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.lifecycleEvent",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.Page().OnLifecycleEventChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "Page.lifecycleEvent",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestPageOnLoadEventFired(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageOnLoadEventFired")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *page.LoadEventFiredEvent)
+	sub := mockSocket.Page().OnLoadEventFired(func(eventData *page.LoadEventFiredEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &page.LoadEventFiredEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.loadEventFired",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.Page().OnLoadEventFiredChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "Page.loadEventFired",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
+
+func TestPageOnWindowOpen(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageOnWindowOpen")
+	mockSocket := NewMock(socketURL)
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+
+	resultChan := make(chan *page.WindowOpenEvent)
+	sub := mockSocket.Page().OnWindowOpen(func(eventData *page.WindowOpenEvent) {
+		resultChan <- eventData
+	})
+	mockResult := &page.WindowOpenEvent{}
+	mockResultBytes, _ := json.Marshal(mockResult)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     0,
+		Error:  &Error{},
+		Method: "Page.windowOpen",
+		Params: mockResultBytes,
+	})
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+	if err := sub.Unsubscribe(); nil != err {
+		t.Errorf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventChan := mockSocket.Page().OnWindowOpenChan(ctx)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: 0,
+		Error: &Error{
+			Code:    1,
+			Data:    []byte(`"error data"`),
+			Message: "error message",
+		},
+		Method: "Page.windowOpen",
+	})
+	result = <-eventChan
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+	cancel()
+	if _, ok := <-eventChan; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+}
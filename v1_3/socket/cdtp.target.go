@@ -0,0 +1,740 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/v1_3/target"
+)
+
+/*
+TargetProtocol provides a namespace for the Chrome Target protocol methods.
+
+Supports additional targets discovery and allows to attach to them.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/
+*/
+type TargetProtocol struct {
+	Socket Socketer
+}
+
+/*
+ActivateTarget activates (focuses) the target.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-activateTarget
+*/
+func (protocol *TargetProtocol) ActivateTarget(
+	params *target.ActivateTargetParams,
+) <-chan *target.ActivateTargetResult {
+	return protocol.ActivateTargetContext(context.Background(), params)
+}
+
+/*
+ActivateTargetContext performs ActivateTarget and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-activateTarget
+*/
+func (protocol *TargetProtocol) ActivateTargetContext(
+	ctx context.Context,
+	params *target.ActivateTargetParams,
+) <-chan *target.ActivateTargetResult {
+	resultChan := make(chan *target.ActivateTargetResult, 1)
+	command := NewCommand(protocol.Socket, "Target.activateTarget", params)
+	result := &target.ActivateTargetResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+AttachToTarget attaches to the target with given id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-attachToTarget
+*/
+func (protocol *TargetProtocol) AttachToTarget(
+	params *target.AttachToTargetParams,
+) <-chan *target.AttachToTargetResult {
+	return protocol.AttachToTargetContext(context.Background(), params)
+}
+
+/*
+AttachToTargetContext performs AttachToTarget and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-attachToTarget
+*/
+func (protocol *TargetProtocol) AttachToTargetContext(
+	ctx context.Context,
+	params *target.AttachToTargetParams,
+) <-chan *target.AttachToTargetResult {
+	resultChan := make(chan *target.AttachToTargetResult, 1)
+	command := NewCommand(protocol.Socket, "Target.attachToTarget", params)
+	result := &target.AttachToTargetResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+CloseTarget closes the target. If the target is a page that gets closed too.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-closeTarget
+*/
+func (protocol *TargetProtocol) CloseTarget(
+	params *target.CloseTargetParams,
+) <-chan *target.CloseTargetResult {
+	return protocol.CloseTargetContext(context.Background(), params)
+}
+
+/*
+CloseTargetContext performs CloseTarget and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-closeTarget
+*/
+func (protocol *TargetProtocol) CloseTargetContext(
+	ctx context.Context,
+	params *target.CloseTargetParams,
+) <-chan *target.CloseTargetResult {
+	resultChan := make(chan *target.CloseTargetResult, 1)
+	command := NewCommand(protocol.Socket, "Target.closeTarget", params)
+	result := &target.CloseTargetResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+CreateBrowserContext creates a new empty BrowserContext. Similar to an incognito
+profile but you can have more than one.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-createBrowserContext
+*/
+func (protocol *TargetProtocol) CreateBrowserContext() <-chan *target.CreateBrowserContextResult {
+	return protocol.CreateBrowserContextContext(context.Background())
+}
+
+/*
+CreateBrowserContextContext performs CreateBrowserContext and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-createBrowserContext
+*/
+func (protocol *TargetProtocol) CreateBrowserContextContext(
+	ctx context.Context,
+) <-chan *target.CreateBrowserContextResult {
+	resultChan := make(chan *target.CreateBrowserContextResult, 1)
+	command := NewCommand(protocol.Socket, "Target.createBrowserContext", nil)
+	result := &target.CreateBrowserContextResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+CreateTarget creates a new page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-createTarget
+*/
+func (protocol *TargetProtocol) CreateTarget(
+	params *target.CreateTargetParams,
+) <-chan *target.CreateTargetResult {
+	return protocol.CreateTargetContext(context.Background(), params)
+}
+
+/*
+CreateTargetContext performs CreateTarget and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-createTarget
+*/
+func (protocol *TargetProtocol) CreateTargetContext(
+	ctx context.Context,
+	params *target.CreateTargetParams,
+) <-chan *target.CreateTargetResult {
+	resultChan := make(chan *target.CreateTargetResult, 1)
+	command := NewCommand(protocol.Socket, "Target.createTarget", params)
+	result := &target.CreateTargetResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+DetachFromTarget detaches session with given id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-detachFromTarget
+*/
+func (protocol *TargetProtocol) DetachFromTarget(
+	params *target.DetachFromTargetParams,
+) <-chan *target.DetachFromTargetResult {
+	return protocol.DetachFromTargetContext(context.Background(), params)
+}
+
+/*
+DetachFromTargetContext performs DetachFromTarget and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-detachFromTarget
+*/
+func (protocol *TargetProtocol) DetachFromTargetContext(
+	ctx context.Context,
+	params *target.DetachFromTargetParams,
+) <-chan *target.DetachFromTargetResult {
+	resultChan := make(chan *target.DetachFromTargetResult, 1)
+	command := NewCommand(protocol.Socket, "Target.detachFromTarget", params)
+	result := &target.DetachFromTargetResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+DisposeBrowserContext deletes a BrowserContext. All the belonging pages will be
+closed without calling their beforeunload hooks.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-disposeBrowserContext
+*/
+func (protocol *TargetProtocol) DisposeBrowserContext(
+	params *target.DisposeBrowserContextParams,
+) <-chan *target.DisposeBrowserContextResult {
+	return protocol.DisposeBrowserContextContext(context.Background(), params)
+}
+
+/*
+DisposeBrowserContextContext performs DisposeBrowserContext and returns its
+result, or returns the context error if ctx is cancelled or times out before a
+response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-disposeBrowserContext
+*/
+func (protocol *TargetProtocol) DisposeBrowserContextContext(
+	ctx context.Context,
+	params *target.DisposeBrowserContextParams,
+) <-chan *target.DisposeBrowserContextResult {
+	resultChan := make(chan *target.DisposeBrowserContextResult, 1)
+	command := NewCommand(protocol.Socket, "Target.disposeBrowserContext", params)
+	result := &target.DisposeBrowserContextResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetBrowserContexts returns all browser contexts created with
+`Target.createBrowserContext` method.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-getBrowserContexts
+*/
+func (protocol *TargetProtocol) GetBrowserContexts() <-chan *target.GetBrowserContextsResult {
+	return protocol.GetBrowserContextsContext(context.Background())
+}
+
+/*
+GetBrowserContextsContext performs GetBrowserContexts and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-getBrowserContexts
+*/
+func (protocol *TargetProtocol) GetBrowserContextsContext(
+	ctx context.Context,
+) <-chan *target.GetBrowserContextsResult {
+	resultChan := make(chan *target.GetBrowserContextsResult, 1)
+	command := NewCommand(protocol.Socket, "Target.getBrowserContexts", nil)
+	result := &target.GetBrowserContextsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetTargets retrieves a list of available targets.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-getTargets
+*/
+func (protocol *TargetProtocol) GetTargets() <-chan *target.GetTargetsResult {
+	return protocol.GetTargetsContext(context.Background())
+}
+
+/*
+GetTargetsContext performs GetTargets and returns its result, or returns the
+context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-getTargets
+*/
+func (protocol *TargetProtocol) GetTargetsContext(
+	ctx context.Context,
+) <-chan *target.GetTargetsResult {
+	resultChan := make(chan *target.GetTargetsResult, 1)
+	command := NewCommand(protocol.Socket, "Target.getTargets", nil)
+	result := &target.GetTargetsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SendMessageToTarget sends protocol message over session with given id. Consider
+using flat mode instead; see commands attachToTarget, setAutoAttach, and
+crbug.com/991325.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-sendMessageToTarget
+DEPRECATED.
+*/
+func (protocol *TargetProtocol) SendMessageToTarget(
+	params *target.SendMessageToTargetParams,
+) <-chan *target.SendMessageToTargetResult {
+	return protocol.SendMessageToTargetContext(context.Background(), params)
+}
+
+/*
+SendMessageToTargetContext performs SendMessageToTarget and returns its result,
+or returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-sendMessageToTarget
+DEPRECATED.
+*/
+func (protocol *TargetProtocol) SendMessageToTargetContext(
+	ctx context.Context,
+	params *target.SendMessageToTargetParams,
+) <-chan *target.SendMessageToTargetResult {
+	resultChan := make(chan *target.SendMessageToTargetResult, 1)
+	command := NewCommand(protocol.Socket, "Target.sendMessageToTarget", params)
+	result := &target.SendMessageToTargetResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetAutoAttach controls whether to automatically attach to new targets which are
+considered to be directly related to this one (for example, iframes or workers).
+When turned on, attaches to all existing related targets as well. When turned
+off, automatically detaches from all currently attached targets. This also
+clears all targets added by `autoAttachRelated` from the list of targets to
+watch for creation of related targets. You might want to call this recursively
+for auto-attached targets to attach to all available targets.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-setAutoAttach
+*/
+func (protocol *TargetProtocol) SetAutoAttach(
+	params *target.SetAutoAttachParams,
+) <-chan *target.SetAutoAttachResult {
+	return protocol.SetAutoAttachContext(context.Background(), params)
+}
+
+/*
+SetAutoAttachContext performs SetAutoAttach and returns its result, or returns
+the context error if ctx is cancelled or times out before a response arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-setAutoAttach
+*/
+func (protocol *TargetProtocol) SetAutoAttachContext(
+	ctx context.Context,
+	params *target.SetAutoAttachParams,
+) <-chan *target.SetAutoAttachResult {
+	resultChan := make(chan *target.SetAutoAttachResult, 1)
+	command := NewCommand(protocol.Socket, "Target.setAutoAttach", params)
+	result := &target.SetAutoAttachResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetDiscoverTargets controls whether to discover available targets and notify via
+`targetCreated/targetInfoChanged/targetDestroyed` events.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-setDiscoverTargets
+*/
+func (protocol *TargetProtocol) SetDiscoverTargets(
+	params *target.SetDiscoverTargetsParams,
+) <-chan *target.SetDiscoverTargetsResult {
+	return protocol.SetDiscoverTargetsContext(context.Background(), params)
+}
+
+/*
+SetDiscoverTargetsContext performs SetDiscoverTargets and returns its result, or
+returns the context error if ctx is cancelled or times out before a response
+arrives.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-setDiscoverTargets
+*/
+func (protocol *TargetProtocol) SetDiscoverTargetsContext(
+	ctx context.Context,
+	params *target.SetDiscoverTargetsParams,
+) <-chan *target.SetDiscoverTargetsResult {
+	resultChan := make(chan *target.SetDiscoverTargetsResult, 1)
+	command := NewCommand(protocol.Socket, "Target.setDiscoverTargets", params)
+	result := &target.SetDiscoverTargetsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(ctx, command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnReceivedMessageFromTarget adds a handler to the
+Target.receivedMessageFromTarget event. Notifies about a new protocol message
+received from the session (as reported in `attachedToTarget` event).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-receivedMessageFromTarget
+*/
+func (protocol *TargetProtocol) OnReceivedMessageFromTarget(
+	callback func(event *target.ReceivedMessageFromTargetEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Target.receivedMessageFromTarget",
+		func(response *Response) {
+			event := &target.ReceivedMessageFromTargetEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnReceivedMessageFromTargetChan returns a channel of
+Target.receivedMessageFromTarget events. The event handler is removed and the
+channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-receivedMessageFromTarget
+*/
+func (protocol *TargetProtocol) OnReceivedMessageFromTargetChan(
+	ctx context.Context,
+) <-chan *target.ReceivedMessageFromTargetEvent {
+	eventChan := make(chan *target.ReceivedMessageFromTargetEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnReceivedMessageFromTarget(func(event *target.ReceivedMessageFromTargetEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnTargetCrashed adds a handler to the Target.targetCrashed event. Issued when a
+target has crashed.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetCrashed
+*/
+func (protocol *TargetProtocol) OnTargetCrashed(
+	callback func(event *target.TargetCrashedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Target.targetCrashed",
+		func(response *Response) {
+			event := &target.TargetCrashedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnTargetCrashedChan returns a channel of Target.targetCrashed events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetCrashed
+*/
+func (protocol *TargetProtocol) OnTargetCrashedChan(
+	ctx context.Context,
+) <-chan *target.TargetCrashedEvent {
+	eventChan := make(chan *target.TargetCrashedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnTargetCrashed(func(event *target.TargetCrashedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnTargetCreated adds a handler to the Target.targetCreated event. Issued when a
+possible inspection target is created.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetCreated
+*/
+func (protocol *TargetProtocol) OnTargetCreated(
+	callback func(event *target.TargetCreatedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Target.targetCreated",
+		func(response *Response) {
+			event := &target.TargetCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnTargetCreatedChan returns a channel of Target.targetCreated events. The event
+handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetCreated
+*/
+func (protocol *TargetProtocol) OnTargetCreatedChan(
+	ctx context.Context,
+) <-chan *target.TargetCreatedEvent {
+	eventChan := make(chan *target.TargetCreatedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnTargetCreated(func(event *target.TargetCreatedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnTargetDestroyed adds a handler to the Target.targetDestroyed event. Issued
+when a target is destroyed.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetDestroyed
+*/
+func (protocol *TargetProtocol) OnTargetDestroyed(
+	callback func(event *target.TargetDestroyedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Target.targetDestroyed",
+		func(response *Response) {
+			event := &target.TargetDestroyedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnTargetDestroyedChan returns a channel of Target.targetDestroyed events. The
+event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetDestroyed
+*/
+func (protocol *TargetProtocol) OnTargetDestroyedChan(
+	ctx context.Context,
+) <-chan *target.TargetDestroyedEvent {
+	eventChan := make(chan *target.TargetDestroyedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnTargetDestroyed(func(event *target.TargetDestroyedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
+
+/*
+OnTargetInfoChanged adds a handler to the Target.targetInfoChanged event. Issued
+when some information about a target has changed. This only happens between
+`targetCreated` and `targetDestroyed`.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetInfoChanged
+*/
+func (protocol *TargetProtocol) OnTargetInfoChanged(
+	callback func(event *target.TargetInfoChangedEvent),
+) *Subscription {
+	handler := NewEventHandler(
+		"Target.targetInfoChanged",
+		func(response *Response) {
+			event := &target.TargetInfoChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	return NewSubscription(protocol.Socket, handler)
+}
+
+/*
+OnTargetInfoChangedChan returns a channel of Target.targetInfoChanged events.
+The event handler is removed and the channel closed when ctx is done.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetInfoChanged
+*/
+func (protocol *TargetProtocol) OnTargetInfoChangedChan(
+	ctx context.Context,
+) <-chan *target.TargetInfoChangedEvent {
+	eventChan := make(chan *target.TargetInfoChangedEvent)
+	sub := NewChanSubscription(ctx)
+	sub.CloseWhenDone(protocol.OnTargetInfoChanged(func(event *target.TargetInfoChangedEvent) {
+		sub.Send(func() {
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+			}
+		})
+	}), func() { close(eventChan) })
+	return eventChan
+}
@@ -0,0 +1,18 @@
+package socket
+
+import (
+	"net/url"
+
+	"github.com/bdlm/log"
+)
+
+func init() {
+	log.SetLevel(log.DebugLevel)
+}
+
+/*
+NewMock returns a Chromium Socketer mock for unit testing
+*/
+func NewMock(socketURL *url.URL) *Socket {
+	return NewWithWebsocket(socketURL, NewMockWebsocket)
+}
@@ -0,0 +1,137 @@
+/*
+Package socket allows for tools to instrument, inspect, debug and profile
+Chromium, Chrome and other Blink-based browsers using the stable v1.3 version of
+the Chrome DevTools Protocol.
+
+The websocket transport is shared with the Tip-of-Tree implementation, see
+github.com/mkenney/go-chrome/tot/socket. Only the protocol API differs.
+
+See https://chromedevtools.github.io/devtools-protocol/1-3/ for details.
+*/
+package socket
+
+import (
+	"context"
+	"net/url"
+
+	transport "github.com/mkenney/go-chrome/tot/socket"
+)
+
+type (
+	// ChanSubscription ties the channel returned by an On*Chan method to a
+	// context.
+	ChanSubscription = transport.ChanSubscription
+	// Command is the transport Commander implementation.
+	Command = transport.Command
+
+	// Commander defines the interface for websocket commands.
+	Commander = transport.Commander
+
+	// Conner defines the Socket connection interface.
+	Conner = transport.Conner
+	// ConnectionState represents the state of a socket connection.
+	ConnectionState = transport.ConnectionState
+	// ConnectionStateEvent represents a change of the connection state.
+	ConnectionStateEvent = transport.ConnectionStateEvent
+
+	// DispatchMetrics contains event queue metrics.
+	DispatchMetrics = transport.DispatchMetrics
+	// DispatchMode defines how events are ordered when they are delivered.
+	DispatchMode = transport.DispatchMode
+	// DispatchPolicy defines ordered event delivery through bounded queues.
+	DispatchPolicy = transport.DispatchPolicy
+
+	// Error represents a socket response error.
+	Error = transport.Error
+
+	// EventDispatcher defines the interface for managing how events are
+	// delivered to event handlers.
+	EventDispatcher = transport.EventDispatcher
+	// EventHandler defines the interface for websocket event handlers.
+	EventHandler = transport.EventHandler
+
+	// Handler is the transport EventHandler implementation.
+	Handler = transport.Handler
+
+	// OverflowPolicy defines what happens when an event arrives and its queue
+	// is full.
+	OverflowPolicy = transport.OverflowPolicy
+
+	// Payload represents a WebSocket JSON payload for sending a command.
+	Payload = transport.Payload
+
+	// ReconnectPolicy defines how a socket reconnects when its connection
+	// drops.
+	ReconnectPolicy = transport.ReconnectPolicy
+	// Reconnecter defines the interface for managing automatic reconnects.
+	Reconnecter = transport.Reconnecter
+	// Response represents a socket message.
+	Response = transport.Response
+
+	// Socketer defines the websocket connection interface.
+	Socketer = transport.Socketer
+
+	// Subscription is a handle to an event handler added by an On* method.
+	Subscription = transport.Subscription
+	// WebSocketer defines the minimum interface required for web socket
+	// connections to the Chromium browser.
+	WebSocketer = transport.WebSocketer
+	// WebsocketOptions configures the handshake of websocket connections.
+	WebsocketOptions = transport.WebsocketOptions
+)
+
+// Connection states, see github.com/mkenney/go-chrome/tot/socket.
+const (
+	ConnectionStateChangedEvent = transport.ConnectionStateChangedEvent
+	StateConnected              = transport.StateConnected
+	StateDisconnected           = transport.StateDisconnected
+	StateReconnecting           = transport.StateReconnecting
+	StateFailed                 = transport.StateFailed
+)
+
+// Event dispatch modes and overflow policies, see
+// github.com/mkenney/go-chrome/tot/socket.
+const (
+	DispatchOrdered         = transport.DispatchOrdered
+	DispatchOrderedPerEvent = transport.DispatchOrderedPerEvent
+	OverflowBlock           = transport.OverflowBlock
+	OverflowDropOldest      = transport.OverflowDropOldest
+	OverflowError           = transport.OverflowError
+)
+
+/*
+NewChanSubscription returns a ChanSubscription for ctx.
+*/
+func NewChanSubscription(ctx context.Context) *ChanSubscription {
+	return transport.NewChanSubscription(ctx)
+}
+
+/*
+NewCommand returns a pointer to a Command for the specified protocol method.
+*/
+func NewCommand(socket Socketer, method string, params interface{}) *Command {
+	return transport.NewCommand(socket, method, params)
+}
+
+/*
+NewEventHandler returns a pointer to an event handler for the specified
+protocol event.
+*/
+func NewEventHandler(name string, callback func(response *Response)) *Handler {
+	return transport.NewEventHandler(name, callback)
+}
+
+/*
+NewSubscription adds an event handler to a socket and returns a Subscription
+that can be used to remove it again.
+*/
+func NewSubscription(socket Socketer, handler EventHandler) *Subscription {
+	return transport.NewSubscription(socket, handler)
+}
+
+/*
+NewWebsocket returns a connected websocket for the specified URL.
+*/
+func NewWebsocket(socketURL *url.URL) (WebSocketer, error) {
+	return transport.NewWebsocket(socketURL)
+}
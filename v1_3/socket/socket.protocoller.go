@@ -0,0 +1,226 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+/*
+protocols holds the protocol API instances of a Socket.
+*/
+type protocols struct {
+	browser     *BrowserProtocol
+	console     *ConsoleProtocol
+	dom         *DOMProtocol
+	domDebugger *DOMDebuggerProtocol
+	debugger    *DebuggerProtocol
+	emulation   *EmulationProtocol
+	fetch       *FetchProtocol
+	io          *IOProtocol
+	input       *InputProtocol
+	log         *LogProtocol
+	network     *NetworkProtocol
+	page        *PageProtocol
+	performance *PerformanceProtocol
+	profiler    *ProfilerProtocol
+	runtime     *RuntimeProtocol
+	schema      *SchemaProtocol
+	security    *SecurityProtocol
+	target      *TargetProtocol
+	tracing     *TracingProtocol
+}
+
+/*
+newProtocols returns the protocol API instances for a socket.
+*/
+func newProtocols(socket Socketer) protocols {
+	return protocols{
+		browser:     &BrowserProtocol{Socket: socket},
+		console:     &ConsoleProtocol{Socket: socket},
+		dom:         &DOMProtocol{Socket: socket},
+		domDebugger: &DOMDebuggerProtocol{Socket: socket},
+		debugger:    &DebuggerProtocol{Socket: socket},
+		emulation:   &EmulationProtocol{Socket: socket},
+		fetch:       &FetchProtocol{Socket: socket},
+		io:          &IOProtocol{Socket: socket},
+		input:       &InputProtocol{Socket: socket},
+		log:         &LogProtocol{Socket: socket},
+		network:     &NetworkProtocol{Socket: socket},
+		page:        &PageProtocol{Socket: socket},
+		performance: &PerformanceProtocol{Socket: socket},
+		profiler:    &ProfilerProtocol{Socket: socket},
+		runtime:     &RuntimeProtocol{Socket: socket},
+		schema:      &SchemaProtocol{Socket: socket},
+		security:    &SecurityProtocol{Socket: socket},
+		target:      &TargetProtocol{Socket: socket},
+		tracing:     &TracingProtocol{Socket: socket},
+	}
+}
+
+/*
+Browser returns the BrowserProtocol instance.
+
+Browser is a Protocoller implementation.
+*/
+func (socket *Socket) Browser() *BrowserProtocol {
+	return socket.browser
+}
+
+/*
+Console returns the ConsoleProtocol instance.
+
+Console is a Protocoller implementation.
+*/
+func (socket *Socket) Console() *ConsoleProtocol {
+	return socket.console
+}
+
+/*
+DOM returns the DOMProtocol instance.
+
+DOM is a Protocoller implementation.
+*/
+func (socket *Socket) DOM() *DOMProtocol {
+	return socket.dom
+}
+
+/*
+DOMDebugger returns the DOMDebuggerProtocol instance.
+
+DOMDebugger is a Protocoller implementation.
+*/
+func (socket *Socket) DOMDebugger() *DOMDebuggerProtocol {
+	return socket.domDebugger
+}
+
+/*
+Debugger returns the DebuggerProtocol instance.
+
+Debugger is a Protocoller implementation.
+*/
+func (socket *Socket) Debugger() *DebuggerProtocol {
+	return socket.debugger
+}
+
+/*
+Emulation returns the EmulationProtocol instance.
+
+Emulation is a Protocoller implementation.
+*/
+func (socket *Socket) Emulation() *EmulationProtocol {
+	return socket.emulation
+}
+
+/*
+Fetch returns the FetchProtocol instance.
+
+Fetch is a Protocoller implementation.
+*/
+func (socket *Socket) Fetch() *FetchProtocol {
+	return socket.fetch
+}
+
+/*
+IO returns the IOProtocol instance.
+
+IO is a Protocoller implementation.
+*/
+func (socket *Socket) IO() *IOProtocol {
+	return socket.io
+}
+
+/*
+Input returns the InputProtocol instance.
+
+Input is a Protocoller implementation.
+*/
+func (socket *Socket) Input() *InputProtocol {
+	return socket.input
+}
+
+/*
+Log returns the LogProtocol instance.
+
+Log is a Protocoller implementation.
+*/
+func (socket *Socket) Log() *LogProtocol {
+	return socket.log
+}
+
+/*
+Network returns the NetworkProtocol instance.
+
+Network is a Protocoller implementation.
+*/
+func (socket *Socket) Network() *NetworkProtocol {
+	return socket.network
+}
+
+/*
+Page returns the PageProtocol instance.
+
+Page is a Protocoller implementation.
+*/
+func (socket *Socket) Page() *PageProtocol {
+	return socket.page
+}
+
+/*
+Performance returns the PerformanceProtocol instance.
+
+Performance is a Protocoller implementation.
+*/
+func (socket *Socket) Performance() *PerformanceProtocol {
+	return socket.performance
+}
+
+/*
+Profiler returns the ProfilerProtocol instance.
+
+Profiler is a Protocoller implementation.
+*/
+func (socket *Socket) Profiler() *ProfilerProtocol {
+	return socket.profiler
+}
+
+/*
+Runtime returns the RuntimeProtocol instance.
+
+Runtime is a Protocoller implementation.
+*/
+func (socket *Socket) Runtime() *RuntimeProtocol {
+	return socket.runtime
+}
+
+/*
+Schema returns the SchemaProtocol instance.
+
+Schema is a Protocoller implementation.
+*/
+func (socket *Socket) Schema() *SchemaProtocol {
+	return socket.schema
+}
+
+/*
+Security returns the SecurityProtocol instance.
+
+Security is a Protocoller implementation.
+*/
+func (socket *Socket) Security() *SecurityProtocol {
+	return socket.security
+}
+
+/*
+Target returns the TargetProtocol instance.
+
+Target is a Protocoller implementation.
+*/
+func (socket *Socket) Target() *TargetProtocol {
+	return socket.target
+}
+
+/*
+Tracing returns the TracingProtocol instance.
+
+Tracing is a Protocoller implementation.
+*/
+func (socket *Socket) Tracing() *TracingProtocol {
+	return socket.tracing
+}
@@ -0,0 +1,60 @@
+package socket
+
+import (
+	"context"
+	"fmt"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/v1_3/target"
+)
+
+/*
+AttachToTarget attaches to a target in flattened session mode and returns a
+socket for the new session. The session shares the browser-level connection,
+messages are routed using their session ID.
+*/
+func (socket *Socket) AttachToTarget(
+	ctx context.Context,
+	targetID target.TargetID,
+) (*Socket, error) {
+	result := <-socket.Target().AttachToTargetContext(ctx, &target.AttachToTargetParams{
+		TargetID: targetID,
+		Flatten:  true,
+	})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.SocketSessionAttachFailed, fmt.Sprintf("could not attach to target '%s'", targetID))
+	}
+	return socket.NewSession(string(result.SessionID)), nil
+}
+
+/*
+NewSession returns a socket that sends commands to and receives messages from
+an existing target session over the browser-level connection.
+*/
+func (socket *Socket) NewSession(sessionID string) *Socket {
+	return NewWithTransport(socket.connection.NewSession(sessionID))
+}
+
+/*
+Session returns the socket for an attached target session.
+*/
+func (socket *Socket) Session(sessionID string) (*Socket, error) {
+	conn, err := socket.connection.Session(sessionID)
+	if nil != err {
+		return nil, err
+	}
+	return NewWithTransport(conn), nil
+}
+
+/*
+Sessions returns the sockets for all attached target sessions.
+*/
+func (socket *Socket) Sessions() []*Socket {
+	conns := socket.connection.Sessions()
+	sessions := make([]*Socket, 0, len(conns))
+	for _, conn := range conns {
+		sessions = append(sessions, NewWithTransport(conn))
+	}
+	return sessions
+}
@@ -0,0 +1,48 @@
+package socket
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestSessions(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSessions")
+	browser := NewMock(socketURL)
+	browser.Listen()
+	defer browser.Stop()
+
+	session := browser.NewSession("session-1")
+	if "session-1" != session.SessionID() {
+		t.Errorf("Expected session ID 'session-1', got '%s'", session.SessionID())
+	}
+	if nil == session.Page() || session.Page().Socket != Socketer(session) {
+		t.Errorf("Expected session protocols to use the session socket")
+	}
+	if found, err := browser.Session("session-1"); nil != err || "session-1" != found.SessionID() {
+		t.Errorf("Expected to find the session, got error: '%v'", err)
+	}
+	if 1 != len(browser.Sessions()) {
+		t.Errorf("Expected 1 session, got %d", len(browser.Sessions()))
+	}
+
+	resultChan := session.Page().Enable()
+	browser.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:        session.CurCommandID(),
+		Error:     &Error{},
+		SessionID: "session-1",
+	})
+	select {
+	case result := <-resultChan:
+		if nil != result.Err {
+			t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Expected the session to receive the response")
+	}
+
+	session.Stop()
+	if _, err := browser.Session("session-1"); nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+}
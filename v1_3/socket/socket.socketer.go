@@ -0,0 +1,77 @@
+package socket
+
+import (
+	"net/url"
+
+	"github.com/bdlm/log"
+	transport "github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+New returns a pointer to a Socket listening to the specified URL.
+*/
+func New(url *url.URL) *Socket {
+	return NewWithOptions(url, nil)
+}
+
+/*
+NewWithOptions returns a pointer to a Socket listening to the specified URL.
+The websocket connection is opened with the options.
+*/
+func NewWithOptions(url *url.URL, options *WebsocketOptions) *Socket {
+	socket := NewWithWebsocket(url, options.NewWebsocket)
+	socket.Listen()
+
+	log.WithFields(log.Fields{"url": url.String()}).
+		Info("New socket connection listening")
+
+	return socket
+}
+
+/*
+NewWithWebsocket returns a pointer to a Socket that uses newSocket to open the
+connection to the specified URL. Unlike New, the socket does not connect until
+Listen() is called.
+*/
+func NewWithWebsocket(
+	url *url.URL,
+	newSocket func(socketURL *url.URL) (WebSocketer, error),
+) *Socket {
+	socket := &Socket{connection: transport.NewWithWebsocket(url, newSocket)}
+	socket.protocols = newProtocols(socket)
+	return socket
+}
+
+/*
+NewWithTransport returns a pointer to a Socket providing the v1.3 protocol API
+for a transport socket, such as a target session or a socket connected over a
+debugging pipe.
+*/
+func NewWithTransport(conn *transport.Socket) *Socket {
+	socket := &Socket{connection: conn}
+	socket.protocols = newProtocols(socket)
+	return socket
+}
+
+/*
+connection is the transport API exposed by a Socket.
+*/
+type connection interface {
+	Conner
+	EventDispatcher
+	Reconnecter
+	Socketer
+	NewSession(sessionID string) *transport.Socket
+	Session(sessionID string) (*transport.Socket, error)
+	SessionID() string
+	Sessions() []*transport.Socket
+}
+
+/*
+Socket is a Socketer and Protocoller implementation. The connection and command
+handling are delegated to the shared transport.
+*/
+type Socket struct {
+	connection
+	protocols
+}
@@ -0,0 +1,53 @@
+package socket
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestNewSocket(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestNewSocket")
+	socket := New(socketURL)
+	if socket.URL() != socketURL {
+		t.Errorf("Expected URL '%s', got '%s'", socketURL, socket.URL())
+	}
+	if err := socket.Disconnect(); nil != err && "*errors.errorString" != reflect.TypeOf(err).String() {
+		t.Errorf("Socketer.Disconnect() must return an error or nil, %s found", reflect.TypeOf(err).String())
+	}
+}
+
+func TestSocketProtocols(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketProtocols")
+	mockSocket := NewMock(socketURL)
+	if mockSocket.Connected() {
+		t.Errorf("Expected socket not to connect before Listen()")
+	}
+	if nil == mockSocket.Page() || mockSocket.Page().Socket != Socketer(mockSocket) {
+		t.Errorf("Expected protocols to use the socket")
+	}
+
+	mockSocket.Listen()
+	defer mockSocket.Stop()
+	resultChan := mockSocket.Page().Enable()
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:    mockSocket.CurCommandID(),
+		Error: &Error{},
+	})
+	if result := <-resultChan; nil != result.Err {
+		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
+	}
+}
+
+func TestSocketReconnectPolicy(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketReconnectPolicy")
+	mockSocket := NewMock(socketURL)
+	if nil != mockSocket.ReconnectPolicy() {
+		t.Errorf("Expected reconnects to be disabled by default")
+	}
+	policy := &ReconnectPolicy{MaxAttempts: 3}
+	mockSocket.SetReconnectPolicy(policy)
+	if policy != mockSocket.ReconnectPolicy() {
+		t.Errorf("Expected the reconnect policy to be set")
+	}
+}
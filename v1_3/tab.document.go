@@ -0,0 +1,49 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	tot "github.com/mkenney/go-chrome/tot"
+	"github.com/mkenney/go-chrome/v1_3/runtime"
+)
+
+/*
+DefaultDocumentChunkSize is the default number of document bytes WriteDocument
+sends in each message.
+*/
+const DefaultDocumentChunkSize = tot.DefaultDocumentChunkSize
+
+/*
+WriteDocument replaces the document of the tab with the HTML read from html. The
+document is sent in pieces of at most chunkSize bytes, so documents too large
+for a single message, or not yet fully available, can be written. A chunkSize
+of 0 uses DefaultDocumentChunkSize.
+
+The document is opened with document.open(), each piece is appended with
+document.write() and the document is closed once html is exhausted. The HTML
+parser handles markup split across pieces, multi-byte characters are never
+split.
+*/
+func (tab *Tab) WriteDocument(ctx context.Context, html io.Reader, chunkSize int) error {
+	return tot.WriteDocument(ctx, tab.evaluate, html, chunkSize)
+}
+
+/*
+evaluate evaluates a document expression in the tab.
+*/
+func (tab *Tab) evaluate(ctx context.Context, expression string) error {
+	result := <-tab.Protocol().Runtime().EvaluateContext(ctx, &runtime.EvaluateParams{
+		Expression: expression,
+	})
+	if nil != result.Err {
+		return errs.Wrap(result.Err, codes.TabDocumentWriteFailed, "document write failed")
+	}
+	if nil != result.ExceptionDetails {
+		return errs.New(codes.TabDocumentWriteFailed, fmt.Sprintf("document write failed: %s", result.ExceptionDetails.Text))
+	}
+	return nil
+}
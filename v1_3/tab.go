@@ -0,0 +1,10 @@
+package chrome
+
+import (
+	tot "github.com/mkenney/go-chrome/tot"
+)
+
+/*
+TabData holds metadata about a browser tab
+*/
+type TabData = tot.TabData
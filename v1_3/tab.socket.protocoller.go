@@ -0,0 +1,140 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package chrome
+
+import (
+	"github.com/mkenney/go-chrome/v1_3/socket"
+)
+
+/*
+Browser implements socket.Protocoller
+*/
+func (tab *Tab) Browser() *socket.BrowserProtocol {
+	return tab.protocol.Browser()
+}
+
+/*
+Console implements socket.Protocoller
+*/
+func (tab *Tab) Console() *socket.ConsoleProtocol {
+	return tab.protocol.Console()
+}
+
+/*
+DOM implements socket.Protocoller
+*/
+func (tab *Tab) DOM() *socket.DOMProtocol {
+	return tab.protocol.DOM()
+}
+
+/*
+DOMDebugger implements socket.Protocoller
+*/
+func (tab *Tab) DOMDebugger() *socket.DOMDebuggerProtocol {
+	return tab.protocol.DOMDebugger()
+}
+
+/*
+Debugger implements socket.Protocoller
+*/
+func (tab *Tab) Debugger() *socket.DebuggerProtocol {
+	return tab.protocol.Debugger()
+}
+
+/*
+Emulation implements socket.Protocoller
+*/
+func (tab *Tab) Emulation() *socket.EmulationProtocol {
+	return tab.protocol.Emulation()
+}
+
+/*
+Fetch implements socket.Protocoller
+*/
+func (tab *Tab) Fetch() *socket.FetchProtocol {
+	return tab.protocol.Fetch()
+}
+
+/*
+IO implements socket.Protocoller
+*/
+func (tab *Tab) IO() *socket.IOProtocol {
+	return tab.protocol.IO()
+}
+
+/*
+Input implements socket.Protocoller
+*/
+func (tab *Tab) Input() *socket.InputProtocol {
+	return tab.protocol.Input()
+}
+
+/*
+Log implements socket.Protocoller
+*/
+func (tab *Tab) Log() *socket.LogProtocol {
+	return tab.protocol.Log()
+}
+
+/*
+Network implements socket.Protocoller
+*/
+func (tab *Tab) Network() *socket.NetworkProtocol {
+	return tab.protocol.Network()
+}
+
+/*
+Page implements socket.Protocoller
+*/
+func (tab *Tab) Page() *socket.PageProtocol {
+	return tab.protocol.Page()
+}
+
+/*
+Performance implements socket.Protocoller
+*/
+func (tab *Tab) Performance() *socket.PerformanceProtocol {
+	return tab.protocol.Performance()
+}
+
+/*
+Profiler implements socket.Protocoller
+*/
+func (tab *Tab) Profiler() *socket.ProfilerProtocol {
+	return tab.protocol.Profiler()
+}
+
+/*
+Runtime implements socket.Protocoller
+*/
+func (tab *Tab) Runtime() *socket.RuntimeProtocol {
+	return tab.protocol.Runtime()
+}
+
+/*
+Schema implements socket.Protocoller
+*/
+func (tab *Tab) Schema() *socket.SchemaProtocol {
+	return tab.protocol.Schema()
+}
+
+/*
+Security implements socket.Protocoller
+*/
+func (tab *Tab) Security() *socket.SecurityProtocol {
+	return tab.protocol.Security()
+}
+
+/*
+Target implements socket.Protocoller
+*/
+func (tab *Tab) Target() *socket.TargetProtocol {
+	return tab.protocol.Target()
+}
+
+/*
+Tracing implements socket.Protocoller
+*/
+func (tab *Tab) Tracing() *socket.TracingProtocol {
+	return tab.protocol.Tracing()
+}
@@ -0,0 +1,35 @@
+package chrome
+
+import (
+	"context"
+
+	"github.com/mkenney/go-chrome/v1_3/socket"
+)
+
+/*
+AddEventHandler implements Socketer
+*/
+func (tab *Tab) AddEventHandler(handler socket.EventHandler) {
+	tab.Socket().AddEventHandler(handler)
+}
+
+/*
+RemoveEventHandler implements Socketer
+*/
+func (tab *Tab) RemoveEventHandler(handler socket.EventHandler) {
+	tab.Socket().RemoveEventHandler(handler)
+}
+
+/*
+SendCommand implements Socketer
+*/
+func (tab *Tab) SendCommand(command socket.Commander) chan *socket.Response {
+	return tab.Socket().SendCommand(command)
+}
+
+/*
+SendCommandContext implements Socketer
+*/
+func (tab *Tab) SendCommandContext(ctx context.Context, command socket.Commander) (*socket.Response, error) {
+	return tab.Socket().SendCommandContext(ctx, command)
+}
@@ -0,0 +1,229 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/v1_3/socket"
+	"github.com/mkenney/go-chrome/v1_3/target"
+)
+
+/*
+NewTab spawns a new Tab and returns a reference to it. In pipe mode the tab is
+a session tab, see NewSessionTab.
+*/
+func (chrome *Chrome) NewTab(uri string) (*Tab, error) {
+	var err error
+
+	if chrome.Pipe() {
+		return chrome.NewSessionTab(context.Background(), uri)
+	}
+
+	if "" == uri {
+		uri = "about:blank"
+	}
+	targetURL, err := url.Parse(uri)
+	if nil != err {
+		return nil, errs.Wrap(err, codes.TabURLInvalid, "invalid URL")
+	}
+
+	endpoint := chrome.Endpoint()
+	data, err := endpoint.New(context.Background(), uri)
+	if nil != err {
+		return nil, errs.Wrap(err, codes.TabQueryFailed, fmt.Sprintf("/new?%s query failed", url.QueryEscape(uri)))
+	}
+	tab := &Tab{
+		chrome: chrome,
+		data:   data,
+		url:    targetURL,
+	}
+
+	websocketURL, err := endpoint.WebsocketURL(tab.Data().WebSocketDebuggerURL)
+	if nil != err {
+		return nil, err
+	}
+
+	socket := socket.NewWithOptions(websocketURL, endpoint.WebsocketOptions())
+	tab.socket = socket
+	tab.protocol = socket
+	tab.watch()
+	chrome.addTab(tab)
+
+	return tab, nil
+}
+
+/*
+NewSessionTab creates a new target and returns a Tab attached to it over the
+browser-level socket connection. Unlike tabs created by NewTab, session tabs do
+not open a websocket connection of their own.
+*/
+func (chrome *Chrome) NewSessionTab(ctx context.Context, uri string) (*Tab, error) {
+	if "" == uri {
+		uri = "about:blank"
+	}
+	targetURL, err := url.Parse(uri)
+	if nil != err {
+		return nil, errs.Wrap(err, codes.TabURLInvalid, "invalid URL")
+	}
+
+	browser, err := chrome.BrowserSocket()
+	if nil != err {
+		return nil, err
+	}
+	result := <-browser.Target().CreateTargetContext(ctx, &target.CreateTargetParams{URL: uri})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.TabQueryFailed, fmt.Sprintf("could not create target for '%s'", uri))
+	}
+
+	tab, err := chrome.AttachTab(ctx, string(result.TargetID))
+	if nil != err {
+		return nil, err
+	}
+	tab.mux.Lock()
+	updated := *tab.data
+	updated.URL = uri
+	tab.data = &updated
+	tab.url = targetURL
+	tab.mux.Unlock()
+	return tab, nil
+}
+
+/*
+AttachTab attaches to an existing target over the browser-level socket
+connection and returns a Tab backed by the target session.
+*/
+func (chrome *Chrome) AttachTab(ctx context.Context, targetID string) (*Tab, error) {
+	browser, err := chrome.BrowserSocket()
+	if nil != err {
+		return nil, err
+	}
+	session, err := browser.AttachToTarget(ctx, target.TargetID(targetID))
+	if nil != err {
+		return nil, err
+	}
+
+	tab := &Tab{
+		browser:  browser,
+		chrome:   chrome,
+		data:     &TabData{ID: targetID},
+		protocol: session,
+		socket:   session,
+	}
+	tab.watch()
+	chrome.addTab(tab)
+
+	return tab, nil
+}
+
+/*
+Tab is a struct representing an individual Chrome tab
+*/
+type Tab struct {
+	// browser is the browser-level socket a session tab is attached through,
+	// nil for tabs with their own connection.
+	browser  *socket.Socket
+	chrome   Chromium
+	data     *TabData
+	protocol socket.Protocoller
+	socket   socket.Socketer
+	url      *url.URL
+
+	// mux guards the connection fields, which are replaced when a tab is
+	// reopened after a relaunch. navigated tracks the URL of the tab.
+	mux       sync.Mutex
+	navigated *socket.Subscription
+}
+
+/*
+Chromium implements Tabber.
+*/
+func (tab *Tab) Chromium() Chromium {
+	return tab.chrome
+}
+
+/*
+Close implements Tabber.
+*/
+func (tab *Tab) Close() (interface{}, error) {
+	var err error
+	var result interface{}
+	tab.disconnect()
+	if nil != tab.browser {
+		return tab.closeTarget()
+	}
+	if chrome, ok := tab.Chromium().(*Chrome); ok {
+		err = chrome.Endpoint().Close(context.Background(), tab.Data().ID)
+	} else {
+		_, err = tab.Chromium().Query(fmt.Sprintf("/json/close/%s", tab.Data().ID), url.Values{}, &result)
+	}
+	if nil != err {
+		log.WithFields(log.Fields{
+			"result": result,
+			"error":  err,
+		}).Warn(err)
+		return nil, errs.Wrap(err, 0, fmt.Sprintf("close/%s query failed", tab.Data().ID))
+	}
+	tab.Chromium().RemoveTab(tab)
+	return result, nil
+}
+
+/*
+closeTarget closes a session tab over the browser-level socket.
+*/
+func (tab *Tab) closeTarget() (interface{}, error) {
+	result := <-tab.browser.Target().CloseTarget(&target.CloseTargetParams{
+		TargetID: target.TargetID(tab.Data().ID),
+	})
+	if nil != result.Err {
+		log.WithFields(log.Fields{
+			"error":    result.Err,
+			"targetID": tab.Data().ID,
+		}).Warn(result.Err)
+		return nil, errs.Wrap(result.Err, 0, fmt.Sprintf("could not close target '%s'", tab.Data().ID))
+	}
+	tab.Chromium().RemoveTab(tab)
+	return result, nil
+}
+
+/*
+Data implements Tabber.
+*/
+func (tab *Tab) Data() *TabData {
+	tab.mux.Lock()
+	defer tab.mux.Unlock()
+	return tab.data
+}
+
+/*
+Protocol implements Tabber.
+*/
+func (tab *Tab) Protocol() socket.Protocoller {
+	tab.connect()
+	tab.mux.Lock()
+	defer tab.mux.Unlock()
+	return tab.protocol
+}
+
+/*
+Socket implements Tabber.
+*/
+func (tab *Tab) Socket() socket.Socketer {
+	tab.connect()
+	tab.mux.Lock()
+	defer tab.mux.Unlock()
+	return tab.socket
+}
+
+/*
+URL implements Tabber.
+*/
+func (tab *Tab) URL() *url.URL {
+	tab.mux.Lock()
+	defer tab.mux.Unlock()
+	return tab.url
+}
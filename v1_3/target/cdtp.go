@@ -0,0 +1,49 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package target provides type definitions for use with the Chrome Target
+protocol.
+
+Supports additional targets discovery and allows to attach to them.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/
+*/
+package target
+
+/*
+SessionID represents the Target.SessionID type. Unique identifier of attached
+debugging session.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#type-SessionID
+*/
+type SessionID string
+
+/*
+TargetID represents the Target.TargetID type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#type-TargetID
+*/
+type TargetID string
+
+/*
+TargetInfo represents the Target.TargetInfo type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#type-TargetInfo
+*/
+type TargetInfo struct {
+	TargetID TargetID `json:"targetId"`
+
+	// List of types:
+	// https://source.chromium.org/chromium/chromium/src/+/main:content/browser/devtools/devtools_agent_host_impl.cc?ss=chromium&q=f:devtools%20-f:out%20%22::kTypeTab%5B%5D%22.
+	Type string `json:"type"`
+
+	Title string `json:"title"`
+
+	URL string `json:"url"`
+
+	// Whether the target has an attached client.
+	Attached bool `json:"attached"`
+
+	// Optional. Opener target Id.
+	OpenerID TargetID `json:"openerId,omitempty"`
+}
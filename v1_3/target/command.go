@@ -0,0 +1,276 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package target
+
+import (
+	"github.com/mkenney/go-chrome/v1_3/browser"
+)
+
+/*
+ActivateTargetParams represents Target.activateTarget parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-activateTarget
+*/
+type ActivateTargetParams struct {
+	TargetID TargetID `json:"targetId"`
+}
+
+/*
+ActivateTargetResult represents the result of calls to Target.activateTarget.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-activateTarget
+*/
+type ActivateTargetResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+AttachToTargetParams represents Target.attachToTarget parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-attachToTarget
+*/
+type AttachToTargetParams struct {
+	TargetID TargetID `json:"targetId"`
+
+	// Optional. Enables "flat" access to the session via specifying sessionId
+	// attribute in the commands. We plan to make this the default, deprecate
+	// non-flattened mode, and eventually retire it. See crbug.com/991325.
+	Flatten bool `json:"flatten,omitempty"`
+}
+
+/*
+AttachToTargetResult represents the result of calls to Target.attachToTarget.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-attachToTarget
+*/
+type AttachToTargetResult struct {
+	// Id assigned to the session.
+	SessionID SessionID `json:"sessionId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+CloseTargetParams represents Target.closeTarget parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-closeTarget
+*/
+type CloseTargetParams struct {
+	TargetID TargetID `json:"targetId"`
+}
+
+/*
+CloseTargetResult represents the result of calls to Target.closeTarget.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-closeTarget
+*/
+type CloseTargetResult struct {
+	// Always set to true. If an error occurs, the response indicates protocol
+	// error. DEPRECATED.
+	Success bool `json:"success"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+CreateBrowserContextResult represents the result of calls to Target.createBrowserContext.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-createBrowserContext
+*/
+type CreateBrowserContextResult struct {
+	// The id of the context created.
+	BrowserContextID browser.BrowserContextID `json:"browserContextId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+CreateTargetParams represents Target.createTarget parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-createTarget
+*/
+type CreateTargetParams struct {
+	// The initial URL the page will be navigated to. An empty string indicates
+	// about:blank.
+	URL string `json:"url"`
+
+	// Optional. Frame width in DIP (requires newWindow to be true or headless
+	// shell).
+	Width int `json:"width,omitempty"`
+
+	// Optional. Frame height in DIP (requires newWindow to be true or headless
+	// shell).
+	Height int `json:"height,omitempty"`
+
+	// Optional. Frame window state (requires newWindow to be true or headless
+	// shell). Default is normal. Allowed values:
+	// 	- WindowState.Normal
+	// 	- WindowState.Minimized
+	// 	- WindowState.Maximized
+	// 	- WindowState.Fullscreen
+	WindowState WindowStateEnum `json:"windowState,omitempty"`
+
+	// Optional. Whether to create a new Window or Tab (false by default, not
+	// supported by headless shell).
+	NewWindow bool `json:"newWindow,omitempty"`
+
+	// Optional. Whether to create the target in background or foreground
+	// (false by default, not supported by headless shell).
+	Background bool `json:"background,omitempty"`
+}
+
+/*
+CreateTargetResult represents the result of calls to Target.createTarget.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-createTarget
+*/
+type CreateTargetResult struct {
+	// The id of the page opened.
+	TargetID TargetID `json:"targetId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DetachFromTargetParams represents Target.detachFromTarget parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-detachFromTarget
+*/
+type DetachFromTargetParams struct {
+	// Optional. Session to detach.
+	SessionID SessionID `json:"sessionId,omitempty"`
+
+	// Optional. Deprecated. DEPRECATED.
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+/*
+DetachFromTargetResult represents the result of calls to Target.detachFromTarget.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-detachFromTarget
+*/
+type DetachFromTargetResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DisposeBrowserContextParams represents Target.disposeBrowserContext parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-disposeBrowserContext
+*/
+type DisposeBrowserContextParams struct {
+	BrowserContextID browser.BrowserContextID `json:"browserContextId"`
+}
+
+/*
+DisposeBrowserContextResult represents the result of calls to Target.disposeBrowserContext.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-disposeBrowserContext
+*/
+type DisposeBrowserContextResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetBrowserContextsResult represents the result of calls to Target.getBrowserContexts.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-getBrowserContexts
+*/
+type GetBrowserContextsResult struct {
+	// An array of browser context ids.
+	BrowserContextIDs []browser.BrowserContextID `json:"browserContextIds"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetTargetsResult represents the result of calls to Target.getTargets.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-getTargets
+*/
+type GetTargetsResult struct {
+	// The list of targets.
+	TargetInfos []*TargetInfo `json:"targetInfos"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SendMessageToTargetParams represents Target.sendMessageToTarget parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-sendMessageToTarget
+DEPRECATED.
+*/
+type SendMessageToTargetParams struct {
+	Message string `json:"message"`
+
+	// Optional. Identifier of the session.
+	SessionID SessionID `json:"sessionId,omitempty"`
+
+	// Optional. Deprecated. DEPRECATED.
+	TargetID TargetID `json:"targetId,omitempty"`
+}
+
+/*
+SendMessageToTargetResult represents the result of calls to Target.sendMessageToTarget.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-sendMessageToTarget
+DEPRECATED.
+*/
+type SendMessageToTargetResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetAutoAttachParams represents Target.setAutoAttach parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-setAutoAttach
+*/
+type SetAutoAttachParams struct {
+	// Whether to auto-attach to related targets.
+	AutoAttach bool `json:"autoAttach"`
+
+	// Whether to pause new targets when attaching to them. Use
+	// `Runtime.runIfWaitingForDebugger` to run paused targets.
+	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"`
+}
+
+/*
+SetAutoAttachResult represents the result of calls to Target.setAutoAttach.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-setAutoAttach
+*/
+type SetAutoAttachResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetDiscoverTargetsParams represents Target.setDiscoverTargets parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-setDiscoverTargets
+*/
+type SetDiscoverTargetsParams struct {
+	// Whether to discover available targets.
+	Discover bool `json:"discover"`
+}
+
+/*
+SetDiscoverTargetsResult represents the result of calls to Target.setDiscoverTargets.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-setDiscoverTargets
+*/
+type SetDiscoverTargetsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,75 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package target
+
+/*
+ReceivedMessageFromTargetEvent represents Target.receivedMessageFromTarget event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-receivedMessageFromTarget
+*/
+type ReceivedMessageFromTargetEvent struct {
+	// Identifier of a session which sends a message.
+	SessionID SessionID `json:"sessionId"`
+
+	Message string `json:"message"`
+
+	// Optional. Deprecated. DEPRECATED.
+	TargetID TargetID `json:"targetId,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+TargetCrashedEvent represents Target.targetCrashed event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetCrashed
+*/
+type TargetCrashedEvent struct {
+	TargetID TargetID `json:"targetId"`
+
+	// Termination status type.
+	Status string `json:"status"`
+
+	// Termination error code.
+	ErrorCode int `json:"errorCode"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+TargetCreatedEvent represents Target.targetCreated event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetCreated
+*/
+type TargetCreatedEvent struct {
+	TargetInfo *TargetInfo `json:"targetInfo"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+TargetDestroyedEvent represents Target.targetDestroyed event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetDestroyed
+*/
+type TargetDestroyedEvent struct {
+	TargetID TargetID `json:"targetId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+TargetInfoChangedEvent represents Target.targetInfoChanged event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetInfoChanged
+*/
+type TargetInfoChangedEvent struct {
+	TargetInfo *TargetInfo `json:"targetInfo"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -0,0 +1,34 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package tracing
+
+import (
+	"github.com/mkenney/go-chrome/v1_3/io"
+)
+
+/*
+TracingCompleteEvent represents Tracing.tracingComplete event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Tracing/#event-tracingComplete
+*/
+type TracingCompleteEvent struct {
+	// Indicates whether some trace data is known to have been lost, e.g.
+	// because the trace ring buffer wrapped around.
+	DataLossOccurred bool `json:"dataLossOccurred"`
+
+	// Optional. A handle of the stream that holds resulting trace data.
+	Stream io.StreamHandle `json:"stream,omitempty"`
+
+	// Optional. Trace data format of returned stream. Allowed values:
+	// 	- StreamFormat.JSON
+	// 	- StreamFormat.Proto
+	TraceFormat StreamFormatEnum `json:"traceFormat,omitempty"`
+
+	// Optional. Compression format of returned stream. Allowed values:
+	// 	- StreamCompression.None
+	// 	- StreamCompression.Gzip
+	StreamCompression StreamCompressionEnum `json:"streamCompression,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}